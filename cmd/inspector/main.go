@@ -18,6 +18,7 @@ import (
 	"storj.io/common/identity"
 	"storj.io/common/rpc"
 	"storj.io/common/storj"
+	"storj.io/common/uuid"
 	"storj.io/private/process"
 	_ "storj.io/storj/private/version" // This attaches version information during release builds.
 	"storj.io/storj/satellite/internalpb"
@@ -71,6 +72,40 @@ var (
 		Args:  cobra.MinimumNArgs(4),
 		RunE:  SegmentHealth,
 	}
+	auditCmd = &cobra.Command{
+		Use:   "audit",
+		Short: "commands for requesting audits",
+	}
+	pieceAuditCmd = &cobra.Command{
+		Use:   "piece <node-id>",
+		Short: "Request full piece audits of the pieces stored on a node",
+		Args:  cobra.ExactArgs(1),
+		RunE:  PieceAudit,
+	}
+	nodeAuditCmd = &cobra.Command{
+		Use:   "node <node-id>",
+		Short: "Request audits of a sample of the segments held by a node",
+		Args:  cobra.ExactArgs(1),
+		RunE:  NodeAudit,
+	}
+	objectAuditCmd = &cobra.Command{
+		Use:   "object <project-id> <bucket> <encrypted-path>",
+		Short: "Request audits of the segments of an object",
+		Args:  cobra.ExactArgs(3),
+		RunE:  ObjectAudit,
+	}
+	auditReportCmd = &cobra.Command{
+		Use:   "report <request-id>",
+		Short: "Get the status and the results of an audit request",
+		Args:  cobra.ExactArgs(1),
+		RunE:  AuditReport,
+	}
+
+	// AuditLimit is the number of randomly sampled segments of a node to audit.
+	AuditLimit int32
+
+	// AuditVersion is the version of the object to audit.
+	AuditVersion int64
 )
 
 // Inspector gives access to overlay.
@@ -78,6 +113,7 @@ type Inspector struct {
 	conn         *rpc.Conn
 	identity     *identity.FullIdentity
 	healthclient internalpb.DRPCHealthInspectorClient
+	auditclient  internalpb.DRPCAuditInspectorClient
 }
 
 // NewInspector creates a new inspector client for access to overlay.
@@ -99,6 +135,7 @@ func NewInspector(ctx context.Context, address, path string) (*Inspector, error)
 		conn:         conn,
 		identity:     id,
 		healthclient: internalpb.NewDRPCHealthInspectorClient(conn),
+		auditclient:  internalpb.NewDRPCAuditInspectorClient(conn),
 	}, nil
 }
 
@@ -243,6 +280,172 @@ func SegmentHealth(cmd *cobra.Command, args []string) (err error) {
 	return nil
 }
 
+// PieceAudit requests full piece audits of the pieces stored on a node.
+func PieceAudit(cmd *cobra.Command, args []string) (err error) {
+	ctx, _ := process.Ctx(cmd)
+	i, err := NewInspector(ctx, *Addr, *IdentityPath)
+	if err != nil {
+		return ErrArgs.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, i.Close()) }()
+
+	nodeID, err := storj.NodeIDFromString(args[0])
+	if err != nil {
+		return ErrArgs.Wrap(err)
+	}
+
+	_, err = i.auditclient.PieceAudit(ctx, &internalpb.PieceAuditRequest{
+		NodeId: nodeID,
+	})
+	if err != nil {
+		return ErrRequest.Wrap(err)
+	}
+
+	fmt.Printf("full piece audits of node %s requested, the pieces will be audited after the next run of the audit chore\n", nodeID)
+	return nil
+}
+
+// NodeAudit requests audits of all, or a random sample of, the segments held by a node.
+func NodeAudit(cmd *cobra.Command, args []string) (err error) {
+	ctx, _ := process.Ctx(cmd)
+	i, err := NewInspector(ctx, *Addr, *IdentityPath)
+	if err != nil {
+		return ErrArgs.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, i.Close()) }()
+
+	nodeID, err := storj.NodeIDFromString(args[0])
+	if err != nil {
+		return ErrArgs.Wrap(err)
+	}
+
+	resp, err := i.auditclient.NodeAudit(ctx, &internalpb.NodeAuditRequest{
+		NodeId: nodeID,
+		Limit:  AuditLimit,
+	})
+	if err != nil {
+		return ErrRequest.Wrap(err)
+	}
+
+	return printAuditRequestID(resp)
+}
+
+// ObjectAudit requests audits of all segments of an object.
+func ObjectAudit(cmd *cobra.Command, args []string) (err error) {
+	ctx, _ := process.Ctx(cmd)
+	i, err := NewInspector(ctx, *Addr, *IdentityPath)
+	if err != nil {
+		return ErrArgs.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, i.Close()) }()
+
+	projectID, err := uuid.FromString(args[0])
+	if err != nil {
+		return ErrArgs.Wrap(err)
+	}
+	decodedPath, err := base64.URLEncoding.DecodeString(args[2])
+	if err != nil {
+		return ErrArgs.Wrap(err)
+	}
+
+	resp, err := i.auditclient.ObjectAudit(ctx, &internalpb.ObjectAuditRequest{
+		ProjectId:     projectID.Bytes(),
+		Bucket:        []byte(args[1]),
+		EncryptedPath: decodedPath,
+		Version:       AuditVersion,
+	})
+	if err != nil {
+		return ErrRequest.Wrap(err)
+	}
+
+	return printAuditRequestID(resp)
+}
+
+func printAuditRequestID(resp *internalpb.AuditRequestResponse) error {
+	requestID, err := uuid.FromBytes(resp.RequestId)
+	if err != nil {
+		return ErrRequest.Wrap(err)
+	}
+
+	fmt.Printf("audit request %s created, get its report with 'inspector audit report %s'\n", requestID, requestID)
+	return nil
+}
+
+// AuditReport gets the status and the results of an audit request.
+func AuditReport(cmd *cobra.Command, args []string) (err error) {
+	ctx, _ := process.Ctx(cmd)
+	i, err := NewInspector(ctx, *Addr, *IdentityPath)
+	if err != nil {
+		return ErrArgs.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, i.Close()) }()
+
+	requestID, err := uuid.FromString(args[0])
+	if err != nil {
+		return ErrArgs.Wrap(err)
+	}
+
+	resp, err := i.auditclient.AuditReport(ctx, &internalpb.AuditReportRequest{
+		RequestId: requestID.Bytes(),
+	})
+	if err != nil {
+		return ErrRequest.Wrap(err)
+	}
+
+	f, err := csvOutput()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err := f.Close()
+		if err != nil {
+			fmt.Printf("error closing file: %+v\n", err)
+		}
+	}()
+
+	w := csv.NewWriter(f)
+	defer w.Flush()
+
+	return printAuditReport(w, resp)
+}
+
+func printAuditReport(w *csv.Writer, resp *internalpb.AuditReportResponse) error {
+	outcomes := []string{"success", "fail", "offline", "contained", "unknown"}
+	counts := make(map[string]int)
+	for _, result := range resp.Results {
+		counts[result.Outcome]++
+	}
+
+	records := [][]string{
+		{"Status", resp.Status},
+		{"Error", resp.Error},
+		{},
+		{"Outcome", "Pieces"},
+	}
+	for _, outcome := range outcomes {
+		records = append(records, []string{outcome, strconv.Itoa(counts[outcome])})
+	}
+	records = append(records, []string{}, []string{"Stream ID", "Position", "Node ID", "Outcome"})
+
+	for _, result := range resp.Results {
+		streamID, err := uuid.FromBytes(result.StreamId)
+		if err != nil {
+			return ErrRequest.Wrap(err)
+		}
+		records = append(records, []string{
+			streamID.String(),
+			strconv.FormatUint(result.Position, 10),
+			result.NodeId.String(),
+			result.Outcome,
+		})
+	}
+
+	if err := w.WriteAll(records); err != nil {
+		return fmt.Errorf("error writing record to csv: %w", err)
+	}
+	return nil
+}
+
 func csvOutput() (*os.File, error) {
 	if CSVPath == "stdout" {
 		return os.Stdout, nil
@@ -353,11 +556,20 @@ func printRedundancyTable(w *csv.Writer, redundancy eestream.RedundancyStrategy)
 func init() {
 	rootCmd.AddCommand(statsCmd)
 	rootCmd.AddCommand(healthCmd)
+	rootCmd.AddCommand(auditCmd)
 
 	healthCmd.AddCommand(objectHealthCmd)
 	healthCmd.AddCommand(segmentHealthCmd)
 
+	auditCmd.AddCommand(pieceAuditCmd)
+	auditCmd.AddCommand(nodeAuditCmd)
+	auditCmd.AddCommand(objectAuditCmd)
+	auditCmd.AddCommand(auditReportCmd)
+
 	objectHealthCmd.Flags().StringVar(&CSVPath, "csv-path", "stdout", "csv path where command output is written")
+	auditReportCmd.Flags().StringVar(&CSVPath, "csv-path", "stdout", "csv path where command output is written")
+	nodeAuditCmd.Flags().Int32Var(&AuditLimit, "limit", 0, "number of randomly sampled segments to audit, zero uses the satellite default")
+	objectAuditCmd.Flags().Int64Var(&AuditVersion, "version", 0, "version of the object to audit, zero audits the latest version")
 
 	flag.Parse()
 }
@@ -4,14 +4,19 @@
 package main
 
 import (
+	"fmt"
+
 	"github.com/spf13/cobra"
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
 
+	"storj.io/common/bloomfilter"
+	"storj.io/common/storj"
 	"storj.io/private/process"
 	"storj.io/private/version"
 	"storj.io/storj/private/revocation"
 	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/gc"
 	"storj.io/storj/satellite/metabase"
 	"storj.io/storj/satellite/satellitedb"
 )
@@ -85,3 +90,139 @@ func cmdGCRun(cmd *cobra.Command, args []string) (err error) {
 	closeError := peer.Close()
 	return errs.Combine(runError, closeError)
 }
+
+func cmdGCSenderRun(cmd *cobra.Command, args []string) (err error) {
+	ctx, _ := process.Ctx(cmd)
+	log := zap.L()
+
+	runCfg.Debug.Address = *process.DebugAddrFlag
+
+	identity, err := runCfg.Identity.Load()
+	if err != nil {
+		log.Error("Failed to load identity.", zap.Error(err))
+		return errs.New("Failed to load identity: %+v", err)
+	}
+
+	db, err := satellitedb.Open(ctx, log.Named("db"), runCfg.Database, satellitedb.Options{ApplicationName: "satellite-gc-sender"})
+	if err != nil {
+		return errs.New("Error starting master database on satellite GC sender: %+v", err)
+	}
+	defer func() {
+		err = errs.Combine(err, db.Close())
+	}()
+
+	revocationDB, err := revocation.OpenDBFromCfg(ctx, runCfg.Server.Config)
+	if err != nil {
+		return errs.New("Error creating revocation database GC sender: %+v", err)
+	}
+	defer func() {
+		err = errs.Combine(err, revocationDB.Close())
+	}()
+
+	peer, err := satellite.NewGarbageCollectionSender(log, identity, db, revocationDB, version.Build, &runCfg.Config, process.AtomicLevel(cmd))
+	if err != nil {
+		return err
+	}
+
+	_, err = peer.Version.Service.CheckVersion(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := process.InitMetricsWithHostname(ctx, log, nil); err != nil {
+		log.Warn("Failed to initialize telemetry batcher on satellite GC sender", zap.Error(err))
+	}
+
+	err = db.CheckVersion(ctx)
+	if err != nil {
+		log.Error("Failed satellite database version check.", zap.Error(err))
+		return errs.New("Error checking version for satellitedb: %+v", err)
+	}
+
+	runError := peer.Run(ctx)
+	closeError := peer.Close()
+	return errs.Combine(runError, closeError)
+}
+
+func cmdRetainFilter(cmd *cobra.Command, args []string) (err error) {
+	ctx, _ := process.Ctx(cmd)
+
+	nodeID, err := storj.NodeIDFromString(args[0])
+	if err != nil {
+		return errs.New("invalid node id: %+v", err)
+	}
+	var pieceIDs []storj.PieceID
+	for _, arg := range args[1:] {
+		pieceID, err := storj.PieceIDFromString(arg)
+		if err != nil {
+			return errs.New("invalid piece id %q: %+v", arg, err)
+		}
+		pieceIDs = append(pieceIDs, pieceID)
+	}
+
+	if retainFilterCfg.FilterStore == "" {
+		return errs.New("--filter-store is required")
+	}
+	filters, err := gc.NewDirFilterStore(retainFilterCfg.FilterStore)
+	if err != nil {
+		return err
+	}
+
+	var manifest gc.Manifest
+	if retainFilterCfg.Run == "" {
+		manifest, err = gc.LatestManifest(ctx, filters)
+	} else {
+		manifest, err = gc.LoadManifest(ctx, filters, retainFilterCfg.Run)
+	}
+	if err != nil {
+		return err
+	}
+
+	var node *gc.ManifestNode
+	for i := range manifest.Nodes {
+		if manifest.Nodes[i].NodeID == nodeID {
+			node = &manifest.Nodes[i]
+		}
+	}
+	if node == nil {
+		return errs.New("run %s has no retain filter for node %s", manifest.RunID, nodeID)
+	}
+
+	request, err := gc.LoadFilter(ctx, filters, manifest.RunID, nodeID)
+	if err != nil {
+		return err
+	}
+	filter, err := bloomfilter.NewFromBytes(request.Filter)
+	if err != nil {
+		return err
+	}
+	status, err := gc.LoadStatus(ctx, filters, manifest.RunID, nodeID)
+	if err != nil {
+		return err
+	}
+
+	hashCount, size := filter.Parameters()
+	fmt.Printf("Run:            %s\n", manifest.RunID)
+	fmt.Printf("Creation date:  %s\n", request.CreationDate)
+	fmt.Printf("Pieces:         %d\n", node.PieceCount)
+	fmt.Printf("Filter size:    %d bytes, %d hash functions\n", size, hashCount)
+
+	switch {
+	case status.Delivered():
+		fmt.Printf("Delivery:       delivered at %s after %d attempts\n", status.DeliveredAt, status.Attempts)
+	case status.Attempts > 0:
+		fmt.Printf("Delivery:       %d failed attempts, last at %s: %s\n", status.Attempts, status.LastAttempt, status.LastError)
+	default:
+		fmt.Println("Delivery:       not attempted")
+	}
+
+	for _, pieceID := range pieceIDs {
+		if filter.Contains(pieceID) {
+			fmt.Printf("%s retained\n", pieceID)
+		} else {
+			fmt.Printf("%s not retained, will be moved to the trash\n", pieceID)
+		}
+	}
+
+	return nil
+}
@@ -43,6 +43,8 @@ import (
 	"storj.io/storj/satellite/orders"
 	"storj.io/storj/satellite/overlay"
 	"storj.io/storj/satellite/payments/stripecoinpayments"
+	"storj.io/storj/satellite/repair/queue"
+	"storj.io/storj/satellite/reputation"
 	"storj.io/storj/satellite/satellitedb"
 )
 
@@ -115,6 +117,11 @@ var (
 		Short: "Run the satellite garbage collection process",
 		RunE:  cmdGCRun,
 	}
+	runGCSenderCmd = &cobra.Command{
+		Use:   "garbage-collection-sender",
+		Short: "Run the satellite garbage collection sender process",
+		RunE:  cmdGCSenderRun,
+	}
 	setupCmd = &cobra.Command{
 		Use:         "setup",
 		Short:       "Create config files",
@@ -251,6 +258,45 @@ var (
 		Args:  cobra.ExactArgs(1),
 		RunE:  cmdRegisterLostSegments,
 	}
+	repairSimulateCmd = &cobra.Command{
+		Use:   "repair-simulate",
+		Short: "Simulate the repair checker with the provided configuration",
+		Long: "Runs the repair checker over all segments without modifying the repair queue and reports " +
+			"how many segments would be queued for repair, their health distribution and the estimated repair traffic. " +
+			"Use the checker flags, e.g. --checker.repair-overrides, to evaluate configuration changes.",
+		Args: cobra.NoArgs,
+		RunE: cmdRepairSimulate,
+	}
+	retainFilterCmd = &cobra.Command{
+		Use:   "retain-filter [node-id] [piece-id...]",
+		Short: "Inspect the stored garbage collection retain filter of a node",
+		Long: "Shows the stored retain filter of the node and its delivery status. " +
+			"When piece ids are given, it shows whether the filter contains them, i.e. whether the node will keep them.",
+		Args: cobra.MinimumNArgs(1),
+		RunE: cmdRetainFilter,
+	}
+	reputationCmd = &cobra.Command{
+		Use:   "reputation",
+		Short: "Node reputation commands",
+	}
+	reputationWhatIfCmd = &cobra.Command{
+		Use:   "what-if [node-id...]",
+		Short: "Replay node reputation with the provided configuration",
+		Long: "Replays the stored audit outcomes of the nodes with the provided reputation configuration, " +
+			"e.g. --reputation.audit-lambda 0.98, and reports which nodes would be suspended or disqualified. " +
+			"Audit outcomes are stored only when reputation.record-audit-outcomes is enabled, and outcomes older than " +
+			"reputation.audit-outcomes-retention are folded into the reputation the replay starts from. " +
+			"If node ids aren't provided, all nodes with stored audit outcomes are replayed.",
+		RunE: cmdReputationWhatIf,
+	}
+	reputationHistoryCmd = &cobra.Command{
+		Use:   "history [node-id...]",
+		Short: "Export the reputation history of nodes",
+		Long: "Replays the stored audit outcomes of the nodes with the provided reputation configuration " +
+			"and exports the reputation after every audit as CSV or JSON. " +
+			"If node ids aren't provided, all nodes with stored audit outcomes are exported.",
+		RunE: cmdReputationHistory,
+	}
 
 	runCfg   Satellite
 	setupCfg Satellite
@@ -258,6 +304,8 @@ var (
 	qdiagCfg struct {
 		Database   string `help:"satellite database connection string" releaseDefault:"postgres://" devDefault:"postgres://"`
 		QListLimit int    `help:"maximum segments that can be requested" default:"1000"`
+		Reason     string `help:"only list segments queued for the reason (below_threshold, placement_violation, operator_requested or graceful_exit)" default:""`
+		Stats      bool   `help:"count segments by reason and health bucket instead of listing them" default:"false"`
 	}
 	nodeUsageCfg struct {
 		Database string `help:"satellite database connection string" releaseDefault:"postgres://" devDefault:"postgres://"`
@@ -286,6 +334,16 @@ var (
 	}
 	reportsVerifyGracefulExitReceiptCfg struct {
 	}
+	retainFilterCfg struct {
+		FilterStore string `help:"local directory of the stored retain filters" default:""`
+		Run         string `help:"id of the garbage collection run, the latest complete run when empty" default:""`
+	}
+	reputationCfg struct {
+		Database   string `help:"satellite database connection string" releaseDefault:"postgres://" devDefault:"postgres://"`
+		Output     string `help:"destination of the output" default:""`
+		Format     string `help:"format of the exported reputation history (csv or json)" default:"csv"`
+		Reputation reputation.Config
+	}
 	consistencyGECleanupCfg struct {
 		Database string `help:"satellite database connection string" releaseDefault:"postgres://" devDefault:"postgres://"`
 		Before   string `help:"select only exited nodes before this UTC date formatted like YYYY-MM. Date cannot be newer than the current time (required)"`
@@ -307,6 +365,7 @@ func init() {
 	runCmd.AddCommand(runAdminCmd)
 	runCmd.AddCommand(runRepairerCmd)
 	runCmd.AddCommand(runGCCmd)
+	runCmd.AddCommand(runGCSenderCmd)
 	rootCmd.AddCommand(setupCmd)
 	rootCmd.AddCommand(qdiagCmd)
 	rootCmd.AddCommand(reportsCmd)
@@ -315,6 +374,9 @@ func init() {
 	rootCmd.AddCommand(consistencyCmd)
 	rootCmd.AddCommand(restoreTrashCmd)
 	rootCmd.AddCommand(registerLostSegments)
+	rootCmd.AddCommand(repairSimulateCmd)
+	rootCmd.AddCommand(retainFilterCmd)
+	rootCmd.AddCommand(reputationCmd)
 	reportsCmd.AddCommand(nodeUsageCmd)
 	reportsCmd.AddCommand(partnerAttributionCmd)
 	reportsCmd.AddCommand(reportsGracefulExitCmd)
@@ -329,14 +391,19 @@ func init() {
 	billingCmd.AddCommand(finalizeCustomerInvoicesCmd)
 	billingCmd.AddCommand(stripeCustomerCmd)
 	consistencyCmd.AddCommand(consistencyGECleanupCmd)
+	reputationCmd.AddCommand(reputationWhatIfCmd)
+	reputationCmd.AddCommand(reputationHistoryCmd)
 	process.Bind(runCmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	process.Bind(runMigrationCmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	process.Bind(runAPICmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	process.Bind(runAdminCmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	process.Bind(runRepairerCmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	process.Bind(runGCCmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
+	process.Bind(runGCSenderCmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	process.Bind(restoreTrashCmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	process.Bind(registerLostSegments, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
+	process.Bind(repairSimulateCmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
+	process.Bind(retainFilterCmd, &retainFilterCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	process.Bind(setupCmd, &setupCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir), cfgstruct.SetupMode())
 	process.Bind(qdiagCmd, &qdiagCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	process.Bind(nodeUsageCmd, &nodeUsageCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
@@ -353,6 +420,8 @@ func init() {
 	process.Bind(finalizeCustomerInvoicesCmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	process.Bind(stripeCustomerCmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	process.Bind(consistencyGECleanupCmd, &consistencyGECleanupCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
+	process.Bind(reputationWhatIfCmd, &reputationCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
+	process.Bind(reputationHistoryCmd, &reputationCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 
 	if err := consistencyGECleanupCmd.MarkFlagRequired("before"); err != nil {
 		panic(err)
@@ -524,19 +593,42 @@ func cmdQDiag(cmd *cobra.Command, args []string) (err error) {
 		}
 	}()
 
-	list, err := database.RepairQueue().SelectN(context.Background(), qdiagCfg.QListLimit)
+	const padding = 3
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, padding, ' ', tabwriter.AlignRight|tabwriter.Debug)
+
+	if qdiagCfg.Stats {
+		stats, err := database.RepairQueue().Stats(ctx)
+		if err != nil {
+			return err
+		}
+
+		fmt.Fprintln(w, "Reason\tSegment Health\tCount\t")
+		for _, stat := range stats {
+			fmt.Fprintf(w, "%s\t%s\t%d\t\n", stat.Reason, queue.HealthBucketName(stat.HealthBucket), stat.Count)
+		}
+		return w.Flush()
+	}
+
+	var reasons []queue.Reason
+	if qdiagCfg.Reason != "" {
+		reason, err := queue.ParseReason(qdiagCfg.Reason)
+		if err != nil {
+			return err
+		}
+		reasons = append(reasons, reason)
+	}
+
+	list, err := database.RepairQueue().SelectN(context.Background(), qdiagCfg.QListLimit, reasons...)
 	if err != nil {
 		return err
 	}
 
 	// initialize the table header (fields)
-	const padding = 3
-	w := tabwriter.NewWriter(os.Stdout, 0, 0, padding, ' ', tabwriter.AlignRight|tabwriter.Debug)
-	fmt.Fprintln(w, "Segment StreamID\tSegment Position\tSegment Health\t")
+	fmt.Fprintln(w, "Segment StreamID\tSegment Position\tSegment Health\tReason\t")
 
 	// populate the row fields
 	for _, v := range list {
-		fmt.Fprint(w, v.StreamID.String(), "\t", v.Position.Encode(), "\t", v.SegmentHealth, "\t")
+		fmt.Fprintf(w, "%s\t%d\t%v\t%s\t\n", v.StreamID, v.Position.Encode(), v.SegmentHealth, v.Reason)
 	}
 
 	// display the data
@@ -0,0 +1,102 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+
+	"storj.io/common/memory"
+	"storj.io/private/process"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/segmentloop"
+	"storj.io/storj/satellite/overlay"
+	"storj.io/storj/satellite/repair/checker"
+	"storj.io/storj/satellite/repair/queue"
+	"storj.io/storj/satellite/satellitedb"
+)
+
+func cmdRepairSimulate(cmd *cobra.Command, args []string) (err error) {
+	ctx, _ := process.Ctx(cmd)
+	log := zap.L()
+
+	db, err := satellitedb.Open(ctx, log.Named("db"), runCfg.Database, satellitedb.Options{ApplicationName: "satellite-repair-simulate"})
+	if err != nil {
+		return errs.New("Error starting master database: %+v", err)
+	}
+	defer func() {
+		err = errs.Combine(err, db.Close())
+	}()
+
+	metabaseDB, err := metabase.Open(ctx, log.Named("metabase"), runCfg.Metainfo.DatabaseURL, metabase.Config{
+		MinPartSize:      runCfg.Config.Metainfo.MinPartSize,
+		MaxNumberOfParts: runCfg.Config.Metainfo.MaxNumberOfParts,
+	})
+	if err != nil {
+		return errs.New("Error creating metabase connection: %+v", err)
+	}
+	defer func() {
+		err = errs.Combine(err, metabaseDB.Close())
+	}()
+
+	overlayService, err := overlay.NewService(log.Named("overlay"), db.OverlayCache(), runCfg.Overlay)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err = errs.Combine(err, overlayService.Close())
+	}()
+
+	segmentLoop := segmentloop.New(log.Named("segmentloop"), runCfg.Metainfo.SegmentLoop, metabaseDB)
+	simulation := checker.NewSimulation(log.Named("checker"), segmentLoop, overlayService, runCfg.Checker)
+
+	loopCtx, cancelLoop := context.WithCancel(ctx)
+	defer cancelLoop()
+
+	var group errgroup.Group
+	group.Go(func() error {
+		return segmentLoop.RunOnce(loopCtx)
+	})
+
+	result, err := simulation.Run(ctx)
+	if err != nil {
+		// the loop waits for the observer to join, which may not have happened.
+		cancelLoop()
+		_ = group.Wait()
+		return err
+	}
+	if err := group.Wait(); err != nil {
+		return err
+	}
+
+	return printRepairSimulation(cmd.OutOrStdout(), result)
+}
+
+func printRepairSimulation(output io.Writer, result checker.SimulationResult) error {
+	w := tabwriter.NewWriter(output, 0, 0, 3, ' ', 0)
+
+	fmt.Fprintf(w, "objects checked\t%d\n", result.ObjectsChecked)
+	fmt.Fprintf(w, "segments checked\t%d\n", result.SegmentsChecked)
+	fmt.Fprintf(w, "segments failed to check\t%d\n", result.SegmentsFailedToCheck)
+	fmt.Fprintf(w, "segments to repair\t%d\n", result.SegmentsToRepair)
+	fmt.Fprintf(w, "  out of placement\t%d\n", result.SegmentsOutOfPlacement)
+	fmt.Fprintf(w, "  irreparable\t%d\n", result.SegmentsLost)
+	fmt.Fprintf(w, "estimated repair download\t%s\n", memory.Size(result.RepairDownloadBytes))
+	fmt.Fprintf(w, "estimated repair upload\t%s\n", memory.Size(result.RepairUploadBytes))
+	fmt.Fprintln(w)
+
+	fmt.Fprintln(w, "segment health\tsegments to repair")
+	for bucket, count := range result.HealthBuckets {
+		fmt.Fprintf(w, "%s\t%d\n", queue.HealthBucketName(bucket), count)
+	}
+
+	return w.Flush()
+}
@@ -0,0 +1,243 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/storj"
+	"storj.io/private/process"
+	"storj.io/storj/satellite/reputation"
+	"storj.io/storj/satellite/satellitedb"
+)
+
+func cmdReputationWhatIf(cmd *cobra.Command, args []string) (err error) {
+	ctx, _ := process.Ctx(cmd)
+
+	nodeIDs, err := parseNodeIDs(args)
+	if err != nil {
+		return err
+	}
+
+	db, err := satellitedb.Open(ctx, zap.L().Named("db"), reputationCfg.Database, satellitedb.Options{ApplicationName: "satellite-reputation"})
+	if err != nil {
+		return errs.New("error connecting to master database on satellite: %+v", err)
+	}
+	defer func() {
+		err = errs.Combine(err, db.Close())
+	}()
+
+	return runWithOutput(reputationCfg.Output, func(output io.Writer) error {
+		return printReputationWhatIf(ctx, output, db.Reputation(), nodeIDs)
+	})
+}
+
+func printReputationWhatIf(ctx context.Context, output io.Writer, db reputation.DB, nodeIDs []storj.NodeID) error {
+	w := tabwriter.NewWriter(output, 0, 0, 3, ' ', 0)
+	fmt.Fprintln(w, "node id\taudits\taudit score\tunknown audit score\tonline score\tcurrent status\treplayed status")
+
+	var nodes, suspended, disqualified, changed int
+	err := reputation.ReplayAuditOutcomes(ctx, db, reputationCfg.Reputation, nodeIDs, func(result reputation.ReplayResult) error {
+		info, err := db.Get(ctx, result.NodeID)
+		if err != nil && !reputation.ErrNodeNotFound.Has(err) {
+			return err
+		}
+
+		current := "unknown"
+		if info != nil {
+			current = reputationStatus(info.Disqualified, info.UnknownAuditSuspended, info.OfflineSuspended, "")
+		}
+		replayed := reputationStatus(result.Disqualified, result.UnknownAuditSuspended, result.OfflineSuspended, result.DisqualificationReason)
+
+		nodes++
+		switch {
+		case result.Disqualified != nil:
+			disqualified++
+		case result.UnknownAuditSuspended != nil || result.OfflineSuspended != nil:
+			suspended++
+		}
+		if current != replayed {
+			changed++
+		}
+
+		last := result.Last()
+		fmt.Fprintf(w, "%s\t%d\t%.4f\t%.4f\t%.4f\t%s\t%s\n", result.NodeID, len(result.History),
+			last.AuditScore(), last.UnknownAuditScore(), last.OnlineScore, current, replayed)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintln(w)
+	fmt.Fprintf(w, "nodes replayed\t%d\n", nodes)
+	fmt.Fprintf(w, "nodes suspended\t%d\n", suspended)
+	fmt.Fprintf(w, "nodes disqualified\t%d\n", disqualified)
+	fmt.Fprintf(w, "nodes with different status\t%d\n", changed)
+	return w.Flush()
+}
+
+func reputationStatus(disqualified, unknownAuditSuspended, offlineSuspended *time.Time, reason string) string {
+	switch {
+	case disqualified != nil && reason != "":
+		return "disqualified (" + reason + ")"
+	case disqualified != nil:
+		return "disqualified"
+	case unknownAuditSuspended != nil:
+		return "suspended (unknown audits)"
+	case offlineSuspended != nil:
+		return "suspended (offline)"
+	default:
+		return "ok"
+	}
+}
+
+func cmdReputationHistory(cmd *cobra.Command, args []string) (err error) {
+	ctx, _ := process.Ctx(cmd)
+
+	nodeIDs, err := parseNodeIDs(args)
+	if err != nil {
+		return err
+	}
+
+	var write func(io.Writer, []reputation.ReplayResult) error
+	switch reputationCfg.Format {
+	case "csv":
+		write = writeReputationHistoryCSV
+	case "json":
+		write = writeReputationHistoryJSON
+	default:
+		return errs.New("unsupported format %q, use csv or json", reputationCfg.Format)
+	}
+
+	db, err := satellitedb.Open(ctx, zap.L().Named("db"), reputationCfg.Database, satellitedb.Options{ApplicationName: "satellite-reputation"})
+	if err != nil {
+		return errs.New("error connecting to master database on satellite: %+v", err)
+	}
+	defer func() {
+		err = errs.Combine(err, db.Close())
+	}()
+
+	var results []reputation.ReplayResult
+	err = reputation.ReplayAuditOutcomes(ctx, db.Reputation(), reputationCfg.Reputation, nodeIDs, func(result reputation.ReplayResult) error {
+		results = append(results, result)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return runWithOutput(reputationCfg.Output, func(output io.Writer) error {
+		return write(output, results)
+	})
+}
+
+// reputationHistoryPoint is a single entry of the exported reputation history.
+type reputationHistoryPoint struct {
+	NodeID                storj.NodeID `json:"nodeId"`
+	Time                  time.Time    `json:"time"`
+	Outcome               string       `json:"outcome"`
+	AuditScore            float64      `json:"auditScore"`
+	UnknownAuditScore     float64      `json:"unknownAuditScore"`
+	OnlineScore           float64      `json:"onlineScore"`
+	TotalAuditCount       int64        `json:"totalAuditCount"`
+	Vetted                bool         `json:"vetted"`
+	UnknownAuditSuspended bool         `json:"unknownAuditSuspended"`
+	OfflineSuspended      bool         `json:"offlineSuspended"`
+	Disqualified          bool         `json:"disqualified"`
+}
+
+func reputationHistoryPoints(results []reputation.ReplayResult) []reputationHistoryPoint {
+	points := []reputationHistoryPoint{}
+	for _, result := range results {
+		for _, point := range result.History {
+			points = append(points, reputationHistoryPoint{
+				NodeID:                result.NodeID,
+				Time:                  point.Time.UTC(),
+				Outcome:               point.Outcome.String(),
+				AuditScore:            point.AuditScore(),
+				UnknownAuditScore:     point.UnknownAuditScore(),
+				OnlineScore:           point.OnlineScore,
+				TotalAuditCount:       point.TotalAuditCount,
+				Vetted:                point.Vetted,
+				UnknownAuditSuspended: point.UnknownAuditSuspended,
+				OfflineSuspended:      point.OfflineSuspended,
+				Disqualified:          point.Disqualified,
+			})
+		}
+	}
+	return points
+}
+
+func writeReputationHistoryJSON(output io.Writer, results []reputation.ReplayResult) error {
+	encoder := json.NewEncoder(output)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(reputationHistoryPoints(results))
+}
+
+func writeReputationHistoryCSV(output io.Writer, results []reputation.ReplayResult) error {
+	w := csv.NewWriter(output)
+	err := w.Write([]string{
+		"nodeId",
+		"time",
+		"outcome",
+		"auditScore",
+		"unknownAuditScore",
+		"onlineScore",
+		"totalAuditCount",
+		"vetted",
+		"unknownAuditSuspended",
+		"offlineSuspended",
+		"disqualified",
+	})
+	if err != nil {
+		return err
+	}
+
+	formatFloat := func(v float64) string { return strconv.FormatFloat(v, 'f', -1, 64) }
+	for _, point := range reputationHistoryPoints(results) {
+		err := w.Write([]string{
+			point.NodeID.String(),
+			point.Time.Format(time.RFC3339Nano),
+			point.Outcome,
+			formatFloat(point.AuditScore),
+			formatFloat(point.UnknownAuditScore),
+			formatFloat(point.OnlineScore),
+			strconv.FormatInt(point.TotalAuditCount, 10),
+			strconv.FormatBool(point.Vetted),
+			strconv.FormatBool(point.UnknownAuditSuspended),
+			strconv.FormatBool(point.OfflineSuspended),
+			strconv.FormatBool(point.Disqualified),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	w.Flush()
+	return w.Error()
+}
+
+func parseNodeIDs(args []string) ([]storj.NodeID, error) {
+	nodeIDs := make([]storj.NodeID, 0, len(args))
+	for _, arg := range args {
+		nodeID, err := storj.NodeIDFromString(arg)
+		if err != nil {
+			return nil, errs.New("invalid node id %q: %v", arg, err)
+		}
+		nodeIDs = append(nodeIDs, nodeID)
+	}
+	return nodeIDs, nil
+}
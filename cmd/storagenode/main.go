@@ -86,6 +86,16 @@ var (
 		RunE:        cmdGracefulExitStatus,
 		Annotations: map[string]string{"type": "helper"},
 	}
+	migratePackstoreCmd = &cobra.Command{
+		Use:   "migrate-packstore",
+		Short: "Move the pieces into the packed blob store",
+		Long: "Move the pieces from a file per piece into the packed blob store.\n" +
+			"The storage node must be stopped while migrating and started with " +
+			"packstore.enabled afterwards. The storage node refuses to start with " +
+			"packstore.enabled until the migration has finished.",
+		RunE:        cmdMigratePackstore,
+		Annotations: map[string]string{"type": "helper"},
+	}
 	issueAPITokenCmd = &cobra.Command{
 		Use:   "issue-apikey",
 		Short: "Issue apikey for mnd",
@@ -125,6 +135,7 @@ func init() {
 	rootCmd.AddCommand(dashboardCmd)
 	rootCmd.AddCommand(gracefulExitInitCmd)
 	rootCmd.AddCommand(gracefulExitStatusCmd)
+	rootCmd.AddCommand(migratePackstoreCmd)
 	rootCmd.AddCommand(issueAPITokenCmd)
 	process.Bind(runCmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	process.Bind(setupCmd, &setupCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir), cfgstruct.SetupMode())
@@ -133,6 +144,7 @@ func init() {
 	process.Bind(dashboardCmd, &dashboardCfg, defaults, cfgstruct.ConfDir(defaultDiagDir))
 	process.Bind(gracefulExitInitCmd, &diagCfg, defaults, cfgstruct.ConfDir(defaultDiagDir))
 	process.Bind(gracefulExitStatusCmd, &diagCfg, defaults, cfgstruct.ConfDir(defaultDiagDir))
+	process.Bind(migratePackstoreCmd, &diagCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	process.Bind(issueAPITokenCmd, &diagCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 }
 
@@ -0,0 +1,47 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/private/process"
+	"storj.io/storj/storage/filestore"
+	"storj.io/storj/storage/packstore"
+)
+
+func cmdMigratePackstore(cmd *cobra.Command, args []string) (err error) {
+	ctx, _ := process.Ctx(cmd)
+	log := zap.L()
+
+	if len(diagCfg.Storage.AdditionalDirs.List) > 0 {
+		return errs.New("the packed blob store doesn't support storage.additional-dirs")
+	}
+
+	dir, err := filestore.OpenDir(log.Named("filestore"), diagCfg.Storage.Path)
+	if err != nil {
+		return errs.New("Error opening storage directory: %v", err)
+	}
+	from := filestore.New(log.Named("filestore"), dir, diagCfg.Filestore)
+	defer func() { err = errs.Combine(err, from.Close()) }()
+
+	to, err := packstore.Open(log.Named("packstore"), diagCfg.Storage.Path, diagCfg.Packstore)
+	if err != nil {
+		return errs.New("Error opening packed blob store: %v", err)
+	}
+	defer func() { err = errs.Combine(err, to.Close()) }()
+
+	migrated, err := packstore.Migrate(ctx, log, from, to)
+	fmt.Printf("Migrated %d pieces.\n", migrated)
+	if err != nil {
+		return errs.New("Error migrating pieces: %v", err)
+	}
+
+	fmt.Println("Set packstore.enabled to true before starting the storage node.")
+	return nil
+}
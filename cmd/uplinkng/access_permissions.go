@@ -65,18 +65,7 @@ func (ap *accessPermissions) Setup(params clingy.Parameters) {
 
 	now := time.Now()
 	transformHumanDate := clingy.Transform(func(date string) (time.Time, error) {
-		switch {
-		case date == "":
-			return time.Time{}, nil
-		case date == "now":
-			return now, nil
-		case date[0] == '+' || date[0] == '-':
-			d, err := time.ParseDuration(date)
-			return now.Add(d), errs.Wrap(err)
-		default:
-			t, err := time.Parse(time.RFC3339, date)
-			return t, errs.Wrap(err)
-		}
+		return parseHumanDate(date, now)
 	})
 
 	ap.notBefore = params.Flag("not-before",
@@ -13,11 +13,13 @@ import (
 	"github.com/zeebo/clingy"
 	"github.com/zeebo/errs"
 
+	"storj.io/common/memory"
 	"storj.io/common/ranger/httpranger"
 	"storj.io/common/sync2"
 	"storj.io/storj/cmd/uplinkng/ulext"
 	"storj.io/storj/cmd/uplinkng/ulfs"
 	"storj.io/storj/cmd/uplinkng/ulloc"
+	"storj.io/uplink"
 )
 
 type cmdCp struct {
@@ -29,6 +31,11 @@ type cmdCp struct {
 	dryrun      bool
 	progress    bool
 	byteRange   string
+	resume      bool
+	partSize    int64
+	partConc    int
+	metadata    []uplink.CustomMetadata
+	filter      objectFilter
 
 	source ulloc.Location
 	dest   ulloc.Location
@@ -60,6 +67,39 @@ func (c *cmdCp) Setup(params clingy.Parameters) {
 	c.progress = params.Flag("progress", "Show a progress bar when possible", true,
 		clingy.Transform(strconv.ParseBool),
 	).(bool)
+	c.resume = params.Flag("resume", "Resume an interrupted upload or download", false,
+		clingy.Transform(strconv.ParseBool),
+	).(bool)
+	c.partSize = params.Flag("part-size", "Size of the parts large files are split into when copying parts concurrently or resuming", int64(64*memory.MiB),
+		clingy.Transform(func(value string) (int64, error) {
+			var size memory.Size
+			if err := size.Set(value); err != nil {
+				return 0, errs.Wrap(err)
+			}
+			if size <= 0 {
+				return 0, errs.New("part size must be positive")
+			}
+			return size.Int64(), nil
+		}),
+		clingy.Type("size"),
+		clingy.Advanced,
+	).(int64)
+	c.partConc = params.Flag("part-concurrency", "Controls how many parts of a single large file to upload/download in parallel", 1,
+		clingy.Transform(strconv.Atoi),
+		clingy.Transform(func(n int) (int, error) {
+			if n <= 0 {
+				return 0, errs.New("part concurrency must be at least 1")
+			}
+			return n, nil
+		}),
+	).(int)
+	c.metadata = params.Flag("metadata", "Metadata to attach to uploaded objects as key=value or a JSON object", []uplink.CustomMetadata{},
+		clingy.Transform(func(value string) (uplink.CustomMetadata, error) {
+			return parseMetadata([]string{value})
+		}),
+		clingy.Repeated,
+	).([]uplink.CustomMetadata)
+	c.filter.Setup(params)
 	c.byteRange = params.Flag("range", "Downloads the specified range bytes of an object. For more information about the HTTP Range header, see https://www.w3.org/Protocols/rfc2616/rfc2616-sec14.html#sec14.35", "").(string)
 
 	c.source = params.Arg("source", "Source to copy", clingy.Transform(ulloc.Parse)).(ulloc.Location)
@@ -70,6 +110,22 @@ func (c *cmdCp) Execute(ctx clingy.Context) error {
 	if c.parallelism > 1 && c.byteRange != "" {
 		return errs.New("parallelism and range flags are mutually exclusive")
 	}
+	if c.partConc > 1 && c.byteRange != "" {
+		return errs.New("part-concurrency and range flags are mutually exclusive")
+	}
+	if len(c.metadata) > 0 && !c.dest.Remote() {
+		return errs.New("metadata can only be attached when uploading")
+	}
+	if c.resume {
+		switch {
+		case c.byteRange != "":
+			return errs.New("resume and range flags are mutually exclusive")
+		case c.source.Std() || c.dest.Std():
+			return errs.New("cannot resume copies to stdin/stdout")
+		case c.source.Remote() == c.dest.Remote():
+			return errs.New("resume is only supported when uploading or downloading")
+		}
+	}
 	fs, err := c.ex.OpenFilesystem(ctx, c.access)
 	if err != nil {
 		return err
@@ -144,6 +200,10 @@ func (c *cmdCp) copyRecursive(ctx clingy.Context, fs ulfs.Filesystem) error {
 	}
 
 	for iter.Next() {
+		if !c.filter.match(c.source, iter.Item()) {
+			continue
+		}
+
 		source := iter.Item().Loc
 		rel, err := c.source.RelativeTo(source)
 		if err != nil {
@@ -178,6 +238,25 @@ func (c *cmdCp) copyFile(ctx clingy.Context, fs ulfs.Filesystem, source, dest ul
 	if c.dryrun {
 		return nil
 	}
+	if c.resume {
+		if dest.Remote() {
+			return c.resumeUpload(ctx, fs, source, dest, progress)
+		}
+		return c.resumeDownload(ctx, fs, source, dest, progress)
+	}
+
+	if c.partConc > 1 && c.byteRange == "" && !source.Std() && !dest.Std() {
+		stat, err := fs.Stat(ctx, source)
+		if err != nil {
+			return err
+		}
+		if c.copyParts(source, dest, stat.ContentLength) {
+			if dest.Remote() {
+				return c.parallelUpload(ctx, fs, source, dest, stat.ContentLength, progress)
+			}
+			return c.parallelDownload(ctx, fs, source, dest, stat.ContentLength, progress)
+		}
+	}
 
 	var length int64
 	var openOpts *ulfs.OpenOptions
@@ -217,7 +296,7 @@ func (c *cmdCp) copyFile(ctx clingy.Context, fs ulfs.Filesystem, source, dest ul
 		length = rh.Info().ContentLength
 	}
 
-	wh, err := fs.Create(ctx, dest)
+	wh, err := fs.Create(ctx, dest, c.createOptions(dest))
 	if err != nil {
 		return err
 	}
@@ -239,6 +318,21 @@ func (c *cmdCp) copyFile(ctx clingy.Context, fs ulfs.Filesystem, source, dest ul
 	return errs.Wrap(wh.Commit())
 }
 
+// createOptions returns the options to create the destination with, attaching the
+// metadata to uploads.
+func (c *cmdCp) createOptions(dest ulloc.Location) *ulfs.CreateOptions {
+	if len(c.metadata) == 0 || !dest.Remote() {
+		return nil
+	}
+	metadata := make(uplink.CustomMetadata)
+	for _, entries := range c.metadata {
+		for key, value := range entries {
+			metadata[key] = value
+		}
+	}
+	return &ulfs.CreateOptions{Metadata: metadata}
+}
+
 func copyVerb(source, dest ulloc.Location) string {
 	switch {
 	case dest.Remote():
@@ -0,0 +1,242 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"io"
+	"sync"
+
+	progressbar "github.com/cheggaaa/pb/v3"
+	"github.com/zeebo/clingy"
+	"github.com/zeebo/errs"
+
+	"storj.io/common/sync2"
+	"storj.io/storj/cmd/uplinkng/ulfs"
+	"storj.io/storj/cmd/uplinkng/ulloc"
+)
+
+// filePart is a range of a file that is copied on its own.
+type filePart struct {
+	number uint32 // starts at 1 to match multipart upload part numbers
+	offset int64
+	length int64
+}
+
+// splitParts splits size bytes into parts of at most partSize bytes.
+func splitParts(size, partSize int64) []filePart {
+	var parts []filePart
+	for offset := int64(0); offset < size; offset += partSize {
+		length := partSize
+		if remaining := size - offset; remaining < length {
+			length = remaining
+		}
+		parts = append(parts, filePart{
+			number: uint32(len(parts) + 1),
+			offset: offset,
+			length: length,
+		})
+	}
+	return parts
+}
+
+// copyParts reports whether a copy of size bytes from source to dest should be
+// split into parts that are copied concurrently.
+func (c *cmdCp) copyParts(source, dest ulloc.Location, size int64) bool {
+	return c.partConc > 1 && size > c.partSize && (source.Remote() || dest.Remote())
+}
+
+// parallelUpload uploads the source with a multipart upload, uploading parts concurrently.
+func (c *cmdCp) parallelUpload(ctx clingy.Context, fs ulfs.Filesystem, source, dest ulloc.Location, size int64, progress bool) (err error) {
+	uploadID, err := fs.BeginUpload(ctx, dest)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			err = errs.Combine(err, fs.AbortUpload(ctx, dest, uploadID))
+		}
+	}()
+
+	var bar *progressbar.ProgressBar
+	if progress {
+		bar = progressbar.New64(size).SetWriter(ctx.Stdout())
+		bar.Start()
+		defer bar.Finish()
+	}
+
+	parts := splitParts(size, c.partSize)
+	if err := c.uploadParts(ctx, fs, source, dest, uploadID, parts, bar, nil); err != nil {
+		return err
+	}
+	return fs.CommitUpload(ctx, dest, uploadID, c.createOptions(dest))
+}
+
+// uploadParts uploads the parts of the source to the pending upload, at most
+// --part-concurrency at a time. The optional done callback is called after each part
+// is uploaded and is never called concurrently.
+func (c *cmdCp) uploadParts(ctx clingy.Context, fs ulfs.Filesystem, source, dest ulloc.Location, uploadID string, parts []filePart, bar *progressbar.ProgressBar, done func(filePart) error) error {
+	var (
+		limiter = sync2.NewLimiter(c.partConc)
+		es      errs.Group
+		mu      sync.Mutex
+		failed  bool
+	)
+
+	for _, part := range parts {
+		part := part
+
+		mu.Lock()
+		stop := failed
+		mu.Unlock()
+		if stop {
+			break
+		}
+
+		ok := limiter.Go(ctx, func() {
+			err := uploadPart(ctx, fs, source, dest, uploadID, part, bar)
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			if err == nil && done != nil {
+				err = done(part)
+			}
+			if err != nil {
+				es.Add(err)
+				failed = true
+			}
+		})
+		if !ok {
+			es.Add(ctx.Err())
+			break
+		}
+	}
+
+	limiter.Wait()
+	return es.Err()
+}
+
+// uploadPart uploads the part of the source as the part with the same number of
+// the pending upload.
+func uploadPart(ctx clingy.Context, fs ulfs.Filesystem, source, dest ulloc.Location, uploadID string, part filePart, bar *progressbar.ProgressBar) error {
+	rh, err := fs.Open(ctx, source, &ulfs.OpenOptions{
+		Offset: part.offset,
+		Length: part.length,
+	})
+	if err != nil {
+		return err
+	}
+	defer func() { _ = rh.Close() }()
+
+	wh, err := fs.UploadPart(ctx, dest, uploadID, part.number)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = wh.Abort() }()
+
+	var writer io.Writer = wh
+	if bar != nil {
+		writer = bar.NewProxyWriter(writer)
+	}
+
+	if _, err := io.Copy(writer, rh); err != nil {
+		return errs.Combine(err, wh.Abort())
+	}
+	return errs.Wrap(wh.Commit())
+}
+
+// parallelDownload downloads the parts of the source concurrently with ranged reads
+// and writes them in order to the destination. At most --part-concurrency parts are
+// held in memory at a time.
+func (c *cmdCp) parallelDownload(ctx clingy.Context, fs ulfs.Filesystem, source, dest ulloc.Location, size int64, progress bool) error {
+	wh, err := fs.Create(ctx, dest, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = wh.Abort() }()
+
+	var bar *progressbar.ProgressBar
+	var writer io.Writer = wh
+	if progress {
+		bar = progressbar.New64(size).SetWriter(ctx.Stdout())
+		writer = bar.NewProxyWriter(writer)
+		bar.Start()
+		defer bar.Finish()
+	}
+
+	type partResult struct {
+		data []byte
+		err  error
+	}
+
+	parts := splitParts(size, c.partSize)
+	results := make([]chan partResult, len(parts))
+	for i := range results {
+		results[i] = make(chan partResult, 1)
+	}
+
+	// slots limits the number of parts that are downloading or waiting to be
+	// written. a slot is released once its part is written.
+	slots := make(chan struct{}, c.partConc)
+	stop := make(chan struct{})
+
+	var wg sync.WaitGroup
+	defer wg.Wait()
+	defer close(stop)
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		for i, part := range parts {
+			select {
+			case slots <- struct{}{}:
+			case <-stop:
+				return
+			case <-ctx.Done():
+				results[i] <- partResult{err: ctx.Err()}
+				return
+			}
+
+			wg.Add(1)
+			go func(result chan<- partResult, part filePart) {
+				defer wg.Done()
+
+				data, err := downloadPart(ctx, fs, source, part)
+				result <- partResult{data: data, err: err}
+			}(results[i], part)
+		}
+	}()
+
+	for _, result := range results {
+		res := <-result
+		if res.err != nil {
+			return errs.Combine(res.err, wh.Abort())
+		}
+		if _, err := writer.Write(res.data); err != nil {
+			return errs.Combine(err, wh.Abort())
+		}
+		<-slots
+	}
+
+	return errs.Wrap(wh.Commit())
+}
+
+// downloadPart reads the part of the source into memory.
+func downloadPart(ctx clingy.Context, fs ulfs.Filesystem, source ulloc.Location, part filePart) ([]byte, error) {
+	rh, err := fs.Open(ctx, source, &ulfs.OpenOptions{
+		Offset: part.offset,
+		Length: part.length,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rh.Close() }()
+
+	data := make([]byte, part.length)
+	if _, err := io.ReadFull(rh, data); err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return data, nil
+}
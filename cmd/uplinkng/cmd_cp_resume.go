@@ -0,0 +1,252 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"fmt"
+	"io"
+
+	progressbar "github.com/cheggaaa/pb/v3"
+	"github.com/zeebo/clingy"
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/cmd/uplinkng/ulfs"
+	"storj.io/storj/cmd/uplinkng/ulloc"
+)
+
+// resumeUpload uploads the local source with a multipart upload, continuing the
+// upload recorded in the transfer state if it still matches the source.
+func (c *cmdCp) resumeUpload(ctx clingy.Context, fs ulfs.Filesystem, source, dest ulloc.Location, progress bool) error {
+	info, err := fs.Stat(ctx, source)
+	if err != nil {
+		return err
+	}
+
+	states := transferStates{dir: c.ex.TransfersDir()}
+	state, err := states.Load(source, dest)
+	if err != nil {
+		return err
+	}
+
+	if state != nil {
+		ok, err := c.validUploadState(ctx, fs, dest, state, info)
+		if err != nil {
+			return err
+		}
+		if ok {
+			fmt.Fprintln(ctx.Stdout(), "resuming upload to", dest)
+		} else {
+			fmt.Fprintln(ctx.Stdout(), "cannot resume upload to", dest, "because the source or pending upload changed")
+			state = nil
+		}
+	}
+
+	if state == nil {
+		uploadID, err := fs.BeginUpload(ctx, dest)
+		if err != nil {
+			return err
+		}
+		state = &transferState{
+			Source:         source.String(),
+			Dest:           dest.String(),
+			SourceSize:     info.ContentLength,
+			SourceModified: info.Created,
+			UploadID:       uploadID,
+			PartSize:       c.partSize,
+		}
+		if err := states.Save(source, dest, state); err != nil {
+			return err
+		}
+	}
+
+	// parts are only skipped when the satellite has them with the expected size,
+	// since a part that was committed but not recorded is complete as well.
+	parts, err := fs.ListParts(ctx, dest, state.UploadID)
+	if err != nil {
+		return err
+	}
+	uploaded := make(map[uint32]int64, len(parts))
+	for _, part := range parts {
+		uploaded[part.Number] = part.Size
+	}
+
+	var bar *progressbar.ProgressBar
+	if progress {
+		bar = progressbar.New64(info.ContentLength).SetWriter(ctx.Stdout())
+		bar.Start()
+		defer bar.Finish()
+	}
+
+	state.Parts = state.Parts[:0]
+	var remaining []filePart
+	for _, part := range splitParts(info.ContentLength, state.PartSize) {
+		if size, ok := uploaded[part.number]; ok && size == part.length {
+			state.Parts = append(state.Parts, part.number)
+			if bar != nil {
+				bar.Add64(part.length)
+			}
+			continue
+		}
+		remaining = append(remaining, part)
+	}
+
+	err = c.uploadParts(ctx, fs, source, dest, state.UploadID, remaining, bar, func(part filePart) error {
+		state.Parts = append(state.Parts, part.number)
+		return states.Save(source, dest, state)
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := fs.CommitUpload(ctx, dest, state.UploadID, c.createOptions(dest)); err != nil {
+		return err
+	}
+	return states.Delete(source, dest)
+}
+
+// validUploadState returns true if the state was recorded for the current version of
+// the source and its pending upload still exists.
+func (c *cmdCp) validUploadState(ctx clingy.Context, fs ulfs.Filesystem, dest ulloc.Location, state *transferState, info *ulfs.ObjectInfo) (bool, error) {
+	if state.UploadID == "" || state.PartSize <= 0 ||
+		state.SourceSize != info.ContentLength || !state.SourceModified.Equal(info.Created) {
+		return false, nil
+	}
+
+	iter, err := fs.List(ctx, dest, &ulfs.ListOptions{
+		Recursive: true,
+		Pending:   true,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	found := false
+	for iter.Next() {
+		item := iter.Item()
+		if item.Loc == dest && item.UploadID == state.UploadID {
+			found = true
+			break
+		}
+	}
+	return found, errs.Wrap(iter.Err())
+}
+
+// resumeDownload downloads the remote source, continuing from the offset recorded in
+// the transfer state if the source didn't change.
+func (c *cmdCp) resumeDownload(ctx clingy.Context, fs ulfs.Filesystem, source, dest ulloc.Location, progress bool) error {
+	info, err := fs.Stat(ctx, source)
+	if err != nil {
+		return err
+	}
+
+	states := transferStates{dir: c.ex.TransfersDir()}
+	state, err := states.Load(source, dest)
+	if err != nil {
+		return err
+	}
+
+	var offset int64
+	if state != nil {
+		if state.SourceSize == info.ContentLength && state.SourceModified.Equal(info.Created) {
+			offset = state.Offset
+
+			// the destination may be shorter than recorded if it was not synced
+			// to disk before the interruption.
+			local, err := fs.Stat(ctx, dest)
+			switch {
+			case err != nil:
+				offset = 0
+			case local.ContentLength < offset:
+				offset = local.ContentLength
+			}
+
+			fmt.Fprintln(ctx.Stdout(), "resuming download to", dest, "at byte", offset)
+		} else {
+			fmt.Fprintln(ctx.Stdout(), "cannot resume download to", dest, "because the source changed")
+		}
+	}
+
+	state = &transferState{
+		Source:         source.String(),
+		Dest:           dest.String(),
+		SourceSize:     info.ContentLength,
+		SourceModified: info.Created,
+		Offset:         offset,
+	}
+	if err := states.Save(source, dest, state); err != nil {
+		return err
+	}
+
+	wh, err := fs.Create(ctx, dest, &ulfs.CreateOptions{Offset: offset})
+	if err != nil {
+		return err
+	}
+	defer func() { _ = wh.Abort() }()
+
+	if offset < info.ContentLength {
+		rh, err := fs.Open(ctx, source, &ulfs.OpenOptions{
+			Offset: offset,
+			Length: info.ContentLength - offset,
+		})
+		if err != nil {
+			return err
+		}
+		defer func() { _ = rh.Close() }()
+
+		checkpoint := &checkpointWriter{
+			w:     wh,
+			every: c.partSize,
+			save: func(written int64) error {
+				state.Offset = offset + written
+				return states.Save(source, dest, state)
+			},
+		}
+
+		var writer io.Writer = checkpoint
+		if progress {
+			bar := progressbar.New64(info.ContentLength).SetWriter(ctx.Stdout())
+			bar.SetCurrent(offset)
+			writer = bar.NewProxyWriter(writer)
+			bar.Start()
+			defer bar.Finish()
+		}
+
+		if _, err := io.Copy(writer, rh); err != nil {
+			// the partially downloaded data is kept so that the next attempt
+			// can continue from it.
+			return errs.Combine(err, checkpoint.save(checkpoint.written), wh.Commit())
+		}
+	}
+
+	if err := wh.Commit(); err != nil {
+		return errs.Wrap(err)
+	}
+	return states.Delete(source, dest)
+}
+
+// checkpointWriter calls save after every so many bytes are written.
+type checkpointWriter struct {
+	w     io.Writer
+	every int64
+	save  func(written int64) error
+
+	written int64
+	saved   int64
+}
+
+func (cw *checkpointWriter) Write(p []byte) (int, error) {
+	n, err := cw.w.Write(p)
+	cw.written += int64(n)
+	if err != nil {
+		return n, err
+	}
+
+	if cw.written-cw.saved >= cw.every {
+		if err := cw.save(cw.written); err != nil {
+			return n, err
+		}
+		cw.saved = cw.written
+	}
+	return n, nil
+}
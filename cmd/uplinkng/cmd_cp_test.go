@@ -4,9 +4,16 @@
 package main
 
 import (
+	"os"
 	"testing"
 
+	"github.com/stretchr/testify/require"
+	"github.com/zeebo/clingy"
+
+	"storj.io/storj/cmd/uplinkng/ulfs"
+	"storj.io/storj/cmd/uplinkng/ulloc"
 	"storj.io/storj/cmd/uplinkng/ultest"
+	"storj.io/uplink"
 )
 
 func TestCpDownload(t *testing.T) {
@@ -70,6 +77,11 @@ func TestCpDownload(t *testing.T) {
 		)
 
 		state.Succeed(t, "cp", "sj://user/fo", "/home/user/dest", "--recursive").RequireLocalFiles(t)
+
+		state.Succeed(t, "cp", "sj://user/folder1", "/home/user/dest", "--recursive", "--exclude", "folder1/folder2/*").RequireLocalFiles(t,
+			ultest.File{Loc: "/home/user/dest/folder1/file2.txt", Contents: "data2"},
+			ultest.File{Loc: "/home/user/dest/folder1/file3.txt", Contents: "data3"},
+		)
 	})
 
 	t.Run("Range", func(t *testing.T) {
@@ -436,3 +448,214 @@ func TestCpStandard(t *testing.T) {
 		)
 	})
 }
+
+func TestCpResume(t *testing.T) {
+	source := ulloc.NewLocal("/home/user/big.txt")
+	dest := ulloc.NewRemote("user", "big.txt")
+
+	// withState saves a transfer state for the copy from source to dest after the
+	// callback adjusts it.
+	withState := func(dir string, source, dest ulloc.Location, adjust func(ctx clingy.Context, fs ulfs.Filesystem, state *transferState)) ultest.ExecuteOption {
+		return ultest.WithFilesystem(func(t *testing.T, ctx clingy.Context, fs ulfs.Filesystem) {
+			info, err := fs.Stat(ctx, source)
+			require.NoError(t, err)
+
+			state := &transferState{
+				Source:         source.String(),
+				Dest:           dest.String(),
+				SourceSize:     info.ContentLength,
+				SourceModified: info.Created,
+			}
+			adjust(ctx, fs, state)
+			require.NoError(t, transferStates{dir: dir}.Save(source, dest, state))
+		})
+	}
+
+	// withPartialUpload begins an upload to dest with "XYZ" as the first part and
+	// records it in the transfer state.
+	withPartialUpload := func(t *testing.T, dir string, adjust func(state *transferState)) ultest.ExecuteOption {
+		return withState(dir, source, dest, func(ctx clingy.Context, fs ulfs.Filesystem, state *transferState) {
+			uploadID, err := fs.BeginUpload(ctx, dest)
+			require.NoError(t, err)
+
+			wh, err := fs.UploadPart(ctx, dest, uploadID, 1)
+			require.NoError(t, err)
+			_, err = wh.Write([]byte("XYZ"))
+			require.NoError(t, err)
+			require.NoError(t, wh.Commit())
+
+			state.UploadID = uploadID
+			state.PartSize = 3
+			state.Parts = []uint32{1}
+			adjust(state)
+		})
+	}
+
+	requireNoStates := func(t *testing.T, dir string) {
+		entries, err := os.ReadDir(dir)
+		require.NoError(t, err)
+		require.Empty(t, entries)
+	}
+
+	t.Run("Upload", func(t *testing.T) {
+		dir := t.TempDir()
+		state := ultest.Setup(commands,
+			ultest.WithBucket("user"),
+			ultest.WithFile("/home/user/big.txt", "abcdefgh"),
+			withPartialUpload(t, dir, func(*transferState) {}),
+		).WithTransfersDir(dir)
+
+		// the first part is not uploaded again.
+		state.Succeed(t, "cp", "/home/user/big.txt", "sj://user/big.txt", "--resume", "--part-size", "3B").RequireRemoteFiles(t,
+			ultest.File{Loc: "sj://user/big.txt", Contents: "XYZdefgh"},
+		)
+		requireNoStates(t, dir)
+	})
+
+	t.Run("UploadSourceChanged", func(t *testing.T) {
+		dir := t.TempDir()
+		state := ultest.Setup(commands,
+			ultest.WithBucket("user"),
+			ultest.WithFile("/home/user/big.txt", "abcdefgh"),
+			withPartialUpload(t, dir, func(state *transferState) { state.SourceSize = 100 }),
+		).WithTransfersDir(dir)
+
+		state.Succeed(t, "cp", "/home/user/big.txt", "sj://user/big.txt", "--resume", "--part-size", "3B").RequireRemoteFiles(t,
+			ultest.File{Loc: "sj://user/big.txt", Contents: "abcdefgh"},
+		)
+		requireNoStates(t, dir)
+	})
+
+	t.Run("UploadMissingPendingUpload", func(t *testing.T) {
+		dir := t.TempDir()
+		state := ultest.Setup(commands,
+			ultest.WithBucket("user"),
+			ultest.WithFile("/home/user/big.txt", "abcdefgh"),
+			withState(dir, source, dest, func(ctx clingy.Context, fs ulfs.Filesystem, state *transferState) {
+				state.UploadID = "missing"
+				state.PartSize = 3
+				state.Parts = []uint32{1}
+			}),
+		).WithTransfersDir(dir)
+
+		state.Succeed(t, "cp", "/home/user/big.txt", "sj://user/big.txt", "--resume").RequireRemoteFiles(t,
+			ultest.File{Loc: "sj://user/big.txt", Contents: "abcdefgh"},
+		)
+	})
+
+	t.Run("Download", func(t *testing.T) {
+		dir := t.TempDir()
+		state := ultest.Setup(commands,
+			ultest.WithFile("sj://user/big.txt", "abcdefghij"),
+			ultest.WithFile("/home/user/big.txt", "ABCXX"),
+			withState(dir, dest, source, func(_ clingy.Context, _ ulfs.Filesystem, state *transferState) {
+				state.Offset = 3
+			}),
+		).WithTransfersDir(dir)
+
+		// the first three bytes are kept and the rest is downloaded.
+		state.Succeed(t, "cp", "sj://user/big.txt", "/home/user/big.txt", "--resume").RequireLocalFiles(t,
+			ultest.File{Loc: "/home/user/big.txt", Contents: "ABCdefghij"},
+		)
+		requireNoStates(t, dir)
+	})
+
+	t.Run("DownloadSourceChanged", func(t *testing.T) {
+		dir := t.TempDir()
+		state := ultest.Setup(commands,
+			ultest.WithFile("sj://user/big.txt", "abcdefghij"),
+			ultest.WithFile("/home/user/big.txt", "ABCXX"),
+			withState(dir, dest, source, func(_ clingy.Context, _ ulfs.Filesystem, state *transferState) {
+				state.Offset = 3
+				state.SourceModified = state.SourceModified.Add(-1)
+			}),
+		).WithTransfersDir(dir)
+
+		state.Succeed(t, "cp", "sj://user/big.txt", "/home/user/big.txt", "--resume").RequireLocalFiles(t,
+			ultest.File{Loc: "/home/user/big.txt", Contents: "abcdefghij"},
+		)
+	})
+
+	t.Run("NoState", func(t *testing.T) {
+		state := ultest.Setup(commands,
+			ultest.WithFile("sj://user/big.txt", "abcdefghij"),
+		)
+
+		state.Succeed(t, "cp", "sj://user/big.txt", "/home/user/big.txt", "--resume").RequireLocalFiles(t,
+			ultest.File{Loc: "/home/user/big.txt", Contents: "abcdefghij"},
+		)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		state := ultest.Setup(commands,
+			ultest.WithFile("sj://user/big.txt", "abcdefghij"),
+		)
+
+		state.Fail(t, "cp", "sj://user/big.txt", "/home/user/big.txt", "--resume", "--range", "bytes=0-1")
+		state.Fail(t, "cp", "sj://user/big.txt", "-", "--resume")
+		state.Fail(t, "cp", "sj://user/big.txt", "sj://user/copy.txt", "--resume")
+	})
+}
+
+func TestCpParts(t *testing.T) {
+	state := ultest.Setup(commands,
+		ultest.WithFile("/home/user/big.txt", "abcdefghij"),
+		ultest.WithFile("sj://user/big.txt", "ABCDEFGHIJ"),
+	)
+
+	t.Run("Upload", func(t *testing.T) {
+		state.Succeed(t, "cp", "/home/user/big.txt", "sj://user/copy.txt", "--part-size", "3B", "--part-concurrency", "2").RequireFiles(t,
+			ultest.File{Loc: "/home/user/big.txt", Contents: "abcdefghij"},
+			ultest.File{Loc: "sj://user/big.txt", Contents: "ABCDEFGHIJ"},
+			ultest.File{Loc: "sj://user/copy.txt", Contents: "abcdefghij"},
+		)
+	})
+
+	t.Run("Download", func(t *testing.T) {
+		state.Succeed(t, "cp", "sj://user/big.txt", "/home/user/copy.txt", "--part-size", "3B", "--part-concurrency", "2").RequireFiles(t,
+			ultest.File{Loc: "/home/user/big.txt", Contents: "abcdefghij"},
+			ultest.File{Loc: "/home/user/copy.txt", Contents: "ABCDEFGHIJ"},
+			ultest.File{Loc: "sj://user/big.txt", Contents: "ABCDEFGHIJ"},
+		)
+	})
+
+	t.Run("RemoteToRemote", func(t *testing.T) {
+		state.Succeed(t, "cp", "sj://user/big.txt", "sj://user/copy.txt", "--part-size", "4B", "--part-concurrency", "3").RequireRemoteFiles(t,
+			ultest.File{Loc: "sj://user/big.txt", Contents: "ABCDEFGHIJ"},
+			ultest.File{Loc: "sj://user/copy.txt", Contents: "ABCDEFGHIJ"},
+		)
+	})
+
+	t.Run("Recursive", func(t *testing.T) {
+		state.Succeed(t, "cp", "/home/user", "sj://user/dest", "--recursive", "--part-size", "3B", "--part-concurrency", "2").RequireRemoteFiles(t,
+			ultest.File{Loc: "sj://user/big.txt", Contents: "ABCDEFGHIJ"},
+			ultest.File{Loc: "sj://user/dest/big.txt", Contents: "abcdefghij"},
+		)
+	})
+
+	t.Run("Range", func(t *testing.T) {
+		state.Fail(t, "cp", "sj://user/big.txt", "/home/user/copy.txt", "--part-concurrency", "2", "--range", "bytes=0-1")
+	})
+}
+
+func TestCpMetadata(t *testing.T) {
+	state := ultest.Setup(commands,
+		ultest.WithBucket("user"),
+		ultest.WithFile("/home/user/file.txt", "abcdefghij"),
+	)
+
+	t.Run("Upload", func(t *testing.T) {
+		state.Succeed(t, "cp", "/home/user/file.txt", "sj://user/file.txt", "--metadata", "a=1", "--metadata", `{"b": "2"}`).
+			RequireMetadata(t, "sj://user/file.txt", uplink.CustomMetadata{"a": "1", "b": "2"})
+	})
+
+	t.Run("Parts", func(t *testing.T) {
+		state.Succeed(t, "cp", "/home/user/file.txt", "sj://user/file.txt", "--metadata", "a=1", "--part-size", "3B", "--part-concurrency", "2").
+			RequireMetadata(t, "sj://user/file.txt", uplink.CustomMetadata{"a": "1"})
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		state.Fail(t, "cp", "/home/user/file.txt", "/home/user/copy.txt", "--metadata", "a=1")
+		state.Fail(t, "cp", "/home/user/file.txt", "sj://user/file.txt", "--metadata", "a")
+	})
+}
@@ -4,6 +4,7 @@
 package main
 
 import (
+	"encoding/json"
 	"strconv"
 	"time"
 
@@ -24,6 +25,8 @@ type cmdLs struct {
 	expanded  bool
 	pending   bool
 	utc       bool
+	output    outputFormat
+	filter    objectFilter
 
 	prefix *ulloc.Location
 }
@@ -51,6 +54,8 @@ func (c *cmdLs) Setup(params clingy.Parameters) {
 	c.utc = params.Flag("utc", "Show all timestamps in UTC instead of local time", false,
 		clingy.Transform(strconv.ParseBool),
 	).(bool)
+	c.output = outputFlag(params)
+	c.filter.Setup(params)
 
 	c.prefix = params.Arg("prefix", "Prefix to list (sj://BUCKET[/KEY])", clingy.Optional,
 		clingy.Transform(ulloc.Parse),
@@ -71,10 +76,28 @@ func (c *cmdLs) listBuckets(ctx clingy.Context) error {
 	}
 	defer func() { _ = project.Close() }()
 
+	iter := project.ListBuckets(ctx, nil)
+
+	if c.output != outputText {
+		rw := newRecordWriter(ctx.Stdout(), c.output, "created", "name")
+		for iter.Next() {
+			item := iter.Item()
+			if err := rw.Write(listedBucket{
+				Created: recordTime(item.Created),
+				Name:    item.Name,
+			}); err != nil {
+				return err
+			}
+		}
+		if err := iter.Err(); err != nil {
+			return err
+		}
+		return rw.Done()
+	}
+
 	tw := newTabbedWriter(ctx.Stdout(), "CREATED", "NAME")
 	defer tw.Done()
 
-	iter := project.ListBuckets(ctx, nil)
 	for iter.Next() {
 		item := iter.Item()
 		tw.WriteLine(formatTime(c.utc, item.Created), item.Name)
@@ -93,14 +116,6 @@ func (c *cmdLs) listLocation(ctx clingy.Context, prefix ulloc.Location) error {
 		prefix = prefix.AsDirectoryish()
 	}
 
-	headers := []string{"KIND", "CREATED", "SIZE", "KEY"}
-	if c.expanded {
-		headers = append(headers, "EXPIRES", "META")
-	}
-
-	tw := newTabbedWriter(ctx.Stdout(), headers...)
-	defer tw.Done()
-
 	// create the object iterator of either existing objects or pending multipart uploads
 	iter, err := fs.List(ctx, prefix, &ulfs.ListOptions{
 		Recursive: c.recursive,
@@ -111,9 +126,24 @@ func (c *cmdLs) listLocation(ctx clingy.Context, prefix ulloc.Location) error {
 		return err
 	}
 
+	if c.output != outputText {
+		return c.writeRecords(ctx, prefix, iter)
+	}
+
+	headers := []string{"KIND", "CREATED", "SIZE", "KEY"}
+	if c.expanded {
+		headers = append(headers, "EXPIRES", "META")
+	}
+
+	tw := newTabbedWriter(ctx.Stdout(), headers...)
+	defer tw.Done()
+
 	// iterate and print the results
 	for iter.Next() {
 		obj := iter.Item()
+		if !c.filter.match(prefix, obj) {
+			continue
+		}
 
 		var parts []interface{}
 		if obj.IsPrefix {
@@ -133,6 +163,78 @@ func (c *cmdLs) listLocation(ctx clingy.Context, prefix ulloc.Location) error {
 	return iter.Err()
 }
 
+// writeRecords prints the listed objects as JSON or CSV.
+func (c *cmdLs) writeRecords(ctx clingy.Context, prefix ulloc.Location, iter ulfs.ObjectIterator) error {
+	headers := []string{"kind", "created", "size", "key"}
+	if c.expanded {
+		headers = append(headers, "expires", "metadata")
+	}
+
+	rw := newRecordWriter(ctx.Stdout(), c.output, headers...)
+	for iter.Next() {
+		obj := iter.Item()
+		if !c.filter.match(prefix, obj) {
+			continue
+		}
+
+		record := listedObject{
+			Kind:     "object",
+			Created:  recordTime(obj.Created),
+			Size:     obj.ContentLength,
+			Key:      obj.Loc.Loc(),
+			Expires:  recordTime(obj.Expires),
+			Metadata: obj.Metadata,
+			expanded: c.expanded,
+		}
+		if obj.IsPrefix {
+			record = listedObject{Kind: "prefix", Key: obj.Loc.Loc(), expanded: c.expanded}
+		}
+
+		if err := rw.Write(record); err != nil {
+			return err
+		}
+	}
+	if err := iter.Err(); err != nil {
+		return err
+	}
+	return rw.Done()
+}
+
+// listedBucket is the machine readable representation of a bucket.
+type listedBucket struct {
+	Created *time.Time `json:"created,omitempty"`
+	Name    string     `json:"name"`
+}
+
+func (b listedBucket) CSV() []string {
+	return []string{formatRecordTimePtr(b.Created), b.Name}
+}
+
+// listedObject is the machine readable representation of a listed object or prefix.
+type listedObject struct {
+	Kind     string                `json:"kind"`
+	Created  *time.Time            `json:"created,omitempty"`
+	Size     int64                 `json:"size"`
+	Key      string                `json:"key"`
+	Expires  *time.Time            `json:"expires,omitempty"`
+	Metadata uplink.CustomMetadata `json:"metadata,omitempty"`
+
+	expanded bool
+}
+
+func (o listedObject) CSV() []string {
+	fields := []string{o.Kind, formatRecordTimePtr(o.Created), strconv.FormatInt(o.Size, 10), o.Key}
+	if o.expanded {
+		metadata := ""
+		if len(o.Metadata) > 0 {
+			data, _ := json.Marshal(o.Metadata)
+			metadata = string(data)
+		}
+		fields = append(fields, formatRecordTimePtr(o.Expires), metadata)
+	}
+	return fields
+}
+
 func formatTime(utc bool, x time.Time) string {
 	if x.IsZero() {
 		return ""
@@ -392,3 +392,70 @@ func TestLsRelative(t *testing.T) {
 	})
 
 }
+
+func TestLsOutput(t *testing.T) {
+	state := ultest.Setup(commands,
+		ultest.WithFile("sj://user/foo/1"),
+		ultest.WithFile("sj://user/foo/2"),
+		ultest.WithFile("sj://user/bar"),
+	)
+
+	t.Run("JSON", func(t *testing.T) {
+		state.Succeed(t, "ls", "sj://user", "--output", "json").RequireStdout(t, `
+			[
+			{"kind":"object","created":"1970-01-01T00:00:03Z","size":0,"key":"bar"},
+			{"kind":"prefix","size":0,"key":"foo/"}
+			]
+		`)
+
+		state.Succeed(t, "ls", "sj://user/none/", "--output", "json").RequireStdout(t, `[]`)
+	})
+
+	t.Run("CSV", func(t *testing.T) {
+		state.Succeed(t, "ls", "sj://user", "--recursive", "--output", "csv").RequireStdout(t, `
+			kind,created,size,key
+			object,1970-01-01T00:00:03Z,0,bar
+			object,1970-01-01T00:00:01Z,0,foo/1
+			object,1970-01-01T00:00:02Z,0,foo/2
+		`)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		state.Fail(t, "ls", "sj://user", "--output", "xml")
+	})
+}
+
+func TestLsFilter(t *testing.T) {
+	state := ultest.Setup(commands,
+		ultest.WithFile("sj://user/foo/1.txt"),
+		ultest.WithFile("sj://user/foo/2.log"),
+		ultest.WithFile("sj://user/bar.txt"),
+	)
+
+	state.Succeed(t, "ls", "sj://user", "--recursive", "--utc", "--include", "*.txt").RequireStdout(t, `
+		KIND    CREATED                SIZE    KEY
+		OBJ     1970-01-01 00:00:03    0       bar.txt
+		OBJ     1970-01-01 00:00:01    0       foo/1.txt
+	`)
+
+	state.Succeed(t, "ls", "sj://user", "--recursive", "--utc", "--exclude", "foo/*").RequireStdout(t, `
+		KIND    CREATED                SIZE    KEY
+		OBJ     1970-01-01 00:00:03    0       bar.txt
+	`)
+
+	state.Succeed(t, "ls", "sj://user", "--recursive", "--utc", "--modified-after", "1970-01-01T00:00:01Z").RequireStdout(t, `
+		KIND    CREATED                SIZE    KEY
+		OBJ     1970-01-01 00:00:03    0       bar.txt
+		OBJ     1970-01-01 00:00:02    0       foo/2.log
+	`)
+	// patterns are matched relative to the listed prefix, like cp, mv and rm.
+	state.Succeed(t, "ls", "sj://user/foo/", "--recursive", "--utc", "--include", "1.*").RequireStdout(t, `
+		KIND    CREATED                SIZE    KEY
+		OBJ     1970-01-01 00:00:01    0       foo/1.txt
+	`)
+
+	state.Succeed(t, "ls", "sj://user/", "--recursive", "--utc", "--include", "foo/2.*").RequireStdout(t, `
+		KIND    CREATED                SIZE    KEY
+		OBJ     1970-01-01 00:00:02    0       foo/2.log
+	`)
+}
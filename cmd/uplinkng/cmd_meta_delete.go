@@ -0,0 +1,42 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"github.com/zeebo/clingy"
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/cmd/uplinkng/ulext"
+	"storj.io/uplink"
+)
+
+type cmdMetaDelete struct {
+	ex   ulext.External
+	meta metadataUpdate
+
+	keys []string
+}
+
+func newCmdMetaDelete(ex ulext.External) *cmdMetaDelete {
+	return &cmdMetaDelete{ex: ex}
+}
+
+func (c *cmdMetaDelete) Setup(params clingy.Parameters) {
+	c.meta.Setup(params)
+
+	c.keys = params.Arg("keys", "Metadata entries to delete", clingy.Repeated).([]string)
+}
+
+func (c *cmdMetaDelete) Execute(ctx clingy.Context) error {
+	if len(c.keys) == 0 {
+		return errs.New("no metadata entries provided")
+	}
+
+	return c.meta.update(ctx, c.ex, func(metadata uplink.CustomMetadata) uplink.CustomMetadata {
+		for _, key := range c.keys {
+			delete(metadata, key)
+		}
+		return metadata
+	})
+}
@@ -0,0 +1,120 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/zeebo/clingy"
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/cmd/uplinkng/ulext"
+	"storj.io/uplink"
+)
+
+type cmdMetaEdit struct {
+	ex   ulext.External
+	meta metadataUpdate
+}
+
+func newCmdMetaEdit(ex ulext.External) *cmdMetaEdit {
+	return &cmdMetaEdit{ex: ex}
+}
+
+func (c *cmdMetaEdit) Setup(params clingy.Parameters) {
+	c.meta.Setup(params)
+}
+
+func (c *cmdMetaEdit) Execute(ctx clingy.Context) error {
+	fs, err := c.meta.open(ctx, c.ex)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = fs.Close() }()
+
+	infos, err := c.meta.objects(ctx, fs)
+	if err != nil {
+		return err
+	}
+	if len(infos) == 0 {
+		return errs.New("no objects found under %q", c.meta.location)
+	}
+
+	// a single object is edited as its metadata. with --recursive, the metadata
+	// of every object is edited in one document keyed by location, and objects
+	// that are removed from the document are left unchanged.
+	var doc interface{}
+	if !c.meta.recursive {
+		doc = infos[0].Metadata.Clone()
+	} else {
+		all := make(map[string]uplink.CustomMetadata, len(infos))
+		for _, info := range infos {
+			all[info.Loc.String()] = info.Metadata.Clone()
+		}
+		doc = all
+	}
+
+	data, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return errs.Wrap(err)
+	}
+
+	edited, err := c.ex.Edit(ctx, append(data, '\n'))
+	if err != nil {
+		return err
+	}
+
+	updates := make(map[string]uplink.CustomMetadata, len(infos))
+	if !c.meta.recursive {
+		var metadata uplink.CustomMetadata
+		if err := json.Unmarshal(edited, &metadata); err != nil {
+			return errs.New("invalid metadata document: %v", err)
+		}
+		updates[infos[0].Loc.String()] = metadata
+	} else {
+		if err := json.Unmarshal(edited, &updates); err != nil {
+			return errs.New("invalid metadata document: %v", err)
+		}
+		known := make(map[string]bool, len(infos))
+		for _, info := range infos {
+			known[info.Loc.String()] = true
+		}
+		for loc := range updates {
+			if !known[loc] {
+				return errs.New("unknown object %q in metadata document", loc)
+			}
+		}
+	}
+
+	var es errs.Group
+	changed := false
+	for _, info := range infos {
+		metadata, ok := updates[info.Loc.String()]
+		if !ok || metadataEqual(metadata, info.Metadata) {
+			continue
+		}
+		changed = true
+		if err := c.meta.set(ctx, fs, info.Loc, metadata.Clone()); err != nil {
+			es.Add(err)
+		}
+	}
+	if !changed {
+		fmt.Fprintln(ctx.Stdout(), "no changes")
+	}
+	return es.Err()
+}
+
+// metadataEqual returns true if both have the same entries, treating nil as empty.
+func metadataEqual(a, b uplink.CustomMetadata) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for key, value := range a {
+		if other, ok := b[key]; !ok || other != value {
+			return false
+		}
+	}
+	return true
+}
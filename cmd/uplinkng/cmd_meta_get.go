@@ -6,6 +6,8 @@ package main
 import (
 	"encoding/json"
 	"fmt"
+	"io"
+	"sort"
 	"strconv"
 
 	"github.com/zeebo/clingy"
@@ -13,6 +15,7 @@ import (
 
 	"storj.io/storj/cmd/uplinkng/ulext"
 	"storj.io/storj/cmd/uplinkng/ulloc"
+	"storj.io/uplink"
 )
 
 type cmdMetaGet struct {
@@ -20,6 +23,7 @@ type cmdMetaGet struct {
 
 	access    string
 	encrypted bool
+	output    outputFormat
 
 	location ulloc.Location
 	entry    *string
@@ -34,6 +38,7 @@ func (c *cmdMetaGet) Setup(params clingy.Parameters) {
 	c.encrypted = params.Flag("encrypted", "Shows keys base64 encoded without decrypting", false,
 		clingy.Transform(strconv.ParseBool),
 	).(bool)
+	c.output = outputFlag(params)
 
 	c.location = params.Arg("location", "Location of object (sj://BUCKET/KEY)",
 		clingy.Transform(ulloc.Parse),
@@ -64,10 +69,25 @@ func (c *cmdMetaGet) Execute(ctx clingy.Context) error {
 			return errs.New("entry %q does not exist", *c.entry)
 		}
 
-		fmt.Fprintln(ctx.Stdout(), value)
+		switch c.output {
+		case outputJSON:
+			data, err := json.Marshal(value)
+			if err != nil {
+				return errs.Wrap(err)
+			}
+			fmt.Fprintln(ctx.Stdout(), string(data))
+		case outputCSV:
+			return writeMetadataCSV(ctx.Stdout(), uplink.CustomMetadata{*c.entry: value})
+		default:
+			fmt.Fprintln(ctx.Stdout(), value)
+		}
 		return nil
 	}
 
+	if c.output == outputCSV {
+		return writeMetadataCSV(ctx.Stdout(), object.Custom)
+	}
+
 	if object.Custom == nil {
 		fmt.Fprintln(ctx.Stdout(), "{}")
 		return nil
@@ -81,3 +101,28 @@ func (c *cmdMetaGet) Execute(ctx clingy.Context) error {
 	fmt.Fprintln(ctx.Stdout(), string(data))
 	return nil
 }
+
+// metadataEntry is a single entry of the custom metadata.
+type metadataEntry struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+func (e metadataEntry) CSV() []string { return []string{e.Key, e.Value} }
+
+// writeMetadataCSV prints the metadata entries sorted by key as CSV.
+func writeMetadataCSV(w io.Writer, metadata uplink.CustomMetadata) error {
+	keys := make([]string, 0, len(metadata))
+	for key := range metadata {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	rw := newRecordWriter(w, outputCSV, "key", "value")
+	for _, key := range keys {
+		if err := rw.Write(metadataEntry{Key: key, Value: metadata[key]}); err != nil {
+			return err
+		}
+	}
+	return rw.Done()
+}
@@ -0,0 +1,56 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"strconv"
+
+	"github.com/zeebo/clingy"
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/cmd/uplinkng/ulext"
+	"storj.io/uplink"
+)
+
+type cmdMetaSet struct {
+	ex   ulext.External
+	meta metadataUpdate
+
+	replace bool
+	entries []string
+}
+
+func newCmdMetaSet(ex ulext.External) *cmdMetaSet {
+	return &cmdMetaSet{ex: ex}
+}
+
+func (c *cmdMetaSet) Setup(params clingy.Parameters) {
+	c.replace = params.Flag("replace", "Replace all of the existing metadata instead of merging into it", false,
+		clingy.Transform(strconv.ParseBool),
+	).(bool)
+	c.meta.Setup(params)
+
+	c.entries = params.Arg("entries", "Metadata entries to set as key=value or a JSON object", clingy.Repeated).([]string)
+}
+
+func (c *cmdMetaSet) Execute(ctx clingy.Context) error {
+	if len(c.entries) == 0 && !c.replace {
+		return errs.New("no metadata entries provided")
+	}
+
+	entries, err := parseMetadata(c.entries)
+	if err != nil {
+		return err
+	}
+
+	return c.meta.update(ctx, c.ex, func(metadata uplink.CustomMetadata) uplink.CustomMetadata {
+		if c.replace {
+			return entries.Clone()
+		}
+		for key, value := range entries {
+			metadata[key] = value
+		}
+		return metadata
+	})
+}
@@ -0,0 +1,148 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zeebo/clingy"
+
+	"storj.io/storj/cmd/uplinkng/ulfs"
+	"storj.io/storj/cmd/uplinkng/ulloc"
+	"storj.io/storj/cmd/uplinkng/ultest"
+	"storj.io/uplink"
+)
+
+func withMetadataFile(location string, metadata uplink.CustomMetadata) ultest.ExecuteOption {
+	return ultest.WithFilesystem(func(t *testing.T, ctx clingy.Context, fs ulfs.Filesystem) {
+		loc, err := ulloc.Parse(location)
+		require.NoError(t, err)
+
+		wh, err := fs.Create(ctx, loc, &ulfs.CreateOptions{Metadata: metadata})
+		require.NoError(t, err)
+		_, err = wh.Write([]byte(location))
+		require.NoError(t, err)
+		require.NoError(t, wh.Commit())
+	})
+}
+
+func TestMetaSet(t *testing.T) {
+	state := ultest.Setup(commands,
+		ultest.WithBucket("user"),
+		withMetadataFile("sj://user/file.txt", uplink.CustomMetadata{"a": "1", "b": "2"}),
+		withMetadataFile("sj://user/folder/file.txt", uplink.CustomMetadata{"a": "1"}),
+		withMetadataFile("sj://user/folder/file.log", nil),
+	)
+
+	t.Run("Merge", func(t *testing.T) {
+		state.Succeed(t, "meta", "set", "sj://user/file.txt", "c=3", `{"b": "x"}`).RequireStdout(t, `
+			updated sj://user/file.txt
+		`).RequireMetadata(t, "sj://user/file.txt", uplink.CustomMetadata{"a": "1", "b": "x", "c": "3"})
+	})
+
+	t.Run("Replace", func(t *testing.T) {
+		state.Succeed(t, "meta", "set", "sj://user/file.txt", "c=a=b", "--replace").
+			RequireMetadata(t, "sj://user/file.txt", uplink.CustomMetadata{"c": "a=b"})
+
+		state.Succeed(t, "meta", "set", "sj://user/file.txt", "--replace").
+			RequireMetadata(t, "sj://user/file.txt", nil)
+	})
+
+	t.Run("Recursive", func(t *testing.T) {
+		state.Succeed(t, "meta", "set", "sj://user/folder", "c=3", "--recursive", "--include", "*.txt").RequireStdout(t, `
+			updated sj://user/folder/file.txt
+		`).
+			RequireMetadata(t, "sj://user/file.txt", uplink.CustomMetadata{"a": "1", "b": "2"}).
+			RequireMetadata(t, "sj://user/folder/file.txt", uplink.CustomMetadata{"a": "1", "c": "3"}).
+			RequireMetadata(t, "sj://user/folder/file.log", nil)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		state.Fail(t, "meta", "set", "sj://user/file.txt")
+		state.Fail(t, "meta", "set", "sj://user/file.txt", "novalue")
+		state.Fail(t, "meta", "set", "sj://user/file.txt", `{"a": 1}`)
+		state.Fail(t, "meta", "set", "sj://user/missing.txt", "a=1")
+		state.Fail(t, "meta", "set", "/home/user/file.txt", "a=1")
+	})
+}
+
+func TestMetaDelete(t *testing.T) {
+	state := ultest.Setup(commands,
+		ultest.WithBucket("user"),
+		withMetadataFile("sj://user/file.txt", uplink.CustomMetadata{"a": "1", "b": "2"}),
+		withMetadataFile("sj://user/folder/file.txt", uplink.CustomMetadata{"a": "1", "b": "2"}),
+	)
+
+	t.Run("Basic", func(t *testing.T) {
+		state.Succeed(t, "meta", "delete", "sj://user/file.txt", "a", "missing").
+			RequireMetadata(t, "sj://user/file.txt", uplink.CustomMetadata{"b": "2"}).
+			RequireMetadata(t, "sj://user/folder/file.txt", uplink.CustomMetadata{"a": "1", "b": "2"})
+	})
+
+	t.Run("Recursive", func(t *testing.T) {
+		state.Succeed(t, "meta", "delete", "sj://user/", "a", "b", "--recursive").RequireStdout(t, `
+			updated sj://user/file.txt
+			updated sj://user/folder/file.txt
+		`).
+			RequireMetadata(t, "sj://user/file.txt", nil).
+			RequireMetadata(t, "sj://user/folder/file.txt", nil)
+	})
+
+	t.Run("NoKeys", func(t *testing.T) {
+		state.Fail(t, "meta", "delete", "sj://user/file.txt")
+	})
+}
+
+func TestMetaEdit(t *testing.T) {
+	state := ultest.Setup(commands,
+		ultest.WithBucket("user"),
+		withMetadataFile("sj://user/file.txt", uplink.CustomMetadata{"a": "1"}),
+		withMetadataFile("sj://user/folder/file1.txt", uplink.CustomMetadata{"a": "1"}),
+		withMetadataFile("sj://user/folder/file2.txt", uplink.CustomMetadata{"a": "2"}),
+	)
+
+	t.Run("Single", func(t *testing.T) {
+		state.WithEditor(func(contents string) string {
+			require.JSONEq(t, `{"a": "1"}`, contents)
+			return `{"b": "2"}`
+		}).Succeed(t, "meta", "edit", "sj://user/file.txt").RequireStdout(t, `
+			updated sj://user/file.txt
+		`).RequireMetadata(t, "sj://user/file.txt", uplink.CustomMetadata{"b": "2"})
+	})
+
+	t.Run("Recursive", func(t *testing.T) {
+		state.WithEditor(func(contents string) string {
+			var doc map[string]uplink.CustomMetadata
+			require.NoError(t, json.Unmarshal([]byte(contents), &doc))
+			require.Equal(t, map[string]uplink.CustomMetadata{
+				"sj://user/folder/file1.txt": {"a": "1"},
+				"sj://user/folder/file2.txt": {"a": "2"},
+			}, doc)
+
+			// objects missing from the document are left unchanged.
+			return `{"sj://user/folder/file1.txt": {"a": "3"}}`
+		}).Succeed(t, "meta", "edit", "sj://user/folder/", "--recursive").RequireStdout(t, `
+			updated sj://user/folder/file1.txt
+		`).
+			RequireMetadata(t, "sj://user/folder/file1.txt", uplink.CustomMetadata{"a": "3"}).
+			RequireMetadata(t, "sj://user/folder/file2.txt", uplink.CustomMetadata{"a": "2"})
+	})
+
+	t.Run("NoChanges", func(t *testing.T) {
+		state.WithEditor(func(contents string) string { return contents }).
+			Succeed(t, "meta", "edit", "sj://user/file.txt").RequireStdout(t, `
+				no changes
+			`)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		state.WithEditor(func(string) string { return `not json` }).
+			Fail(t, "meta", "edit", "sj://user/file.txt")
+
+		state.WithEditor(func(string) string { return `{"sj://user/other.txt": {}}` }).
+			Fail(t, "meta", "edit", "sj://user/folder/", "--recursive")
+	})
+}
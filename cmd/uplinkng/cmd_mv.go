@@ -26,6 +26,7 @@ type cmdMv struct {
 	parallelism int
 	dryrun      bool
 	progress    bool
+	filter      objectFilter
 
 	source ulloc.Location
 	dest   ulloc.Location
@@ -58,6 +59,8 @@ func (c *cmdMv) Setup(params clingy.Parameters) {
 		clingy.Transform(strconv.ParseBool),
 	).(bool)
 
+	c.filter.Setup(params)
+
 	c.source = params.Arg("source", "Source to move", clingy.Transform(ulloc.Parse)).(ulloc.Location)
 	c.dest = params.Arg("dest", "Destination to move", clingy.Transform(ulloc.Parse)).(ulloc.Location)
 }
@@ -145,7 +148,7 @@ func (c *cmdMv) moveRecursive(ctx clingy.Context, fs ulfs.Filesystem) error {
 
 	for iter.Next() {
 		item := iter.Item()
-		if item.IsPrefix {
+		if item.IsPrefix || !c.filter.match(c.source, item) {
 			continue
 		}
 
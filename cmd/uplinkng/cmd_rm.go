@@ -26,6 +26,7 @@ type cmdRm struct {
 	parallelism int
 	encrypted   bool
 	pending     bool
+	filter      objectFilter
 
 	location ulloc.Location
 }
@@ -57,6 +58,8 @@ func (c *cmdRm) Setup(params clingy.Parameters) {
 		clingy.Transform(strconv.ParseBool),
 	).(bool)
 
+	c.filter.Setup(params)
+
 	c.location = params.Arg("location", "Location to remove (sj://BUCKET[/KEY])",
 		clingy.Transform(ulloc.Parse),
 	).(ulloc.Location)
@@ -110,6 +113,10 @@ func (c *cmdRm) Execute(ctx clingy.Context) error {
 	}
 
 	for iter.Next() {
+		if !c.filter.match(c.location, iter.Item()) {
+			continue
+		}
+
 		loc := iter.Item().Loc
 
 		ok := limiter.Go(ctx, func() {
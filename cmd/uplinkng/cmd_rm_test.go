@@ -41,6 +41,19 @@ func TestRmRemote(t *testing.T) {
 		)
 	})
 
+	t.Run("RecursiveFiltered", func(t *testing.T) {
+		state := ultest.Setup(commands,
+			ultest.WithFile("sj://user/files/file1.txt"),
+			ultest.WithFile("sj://user/files/file2.log"),
+			ultest.WithFile("sj://user/files/nested/file3.txt"),
+		)
+
+		state.Succeed(t, "rm", "sj://user/files", "-r", "--include", "*.txt", "--exclude", "files/nested/*").RequireFiles(t,
+			ultest.File{Loc: "sj://user/files/file2.log"},
+			ultest.File{Loc: "sj://user/files/nested/file3.txt"},
+		)
+	})
+
 	t.Run("Pending", func(t *testing.T) {
 		state := ultest.Setup(commands,
 			ultest.WithPendingFile("sj://user/files/file1.txt"),
@@ -0,0 +1,360 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"sync"
+
+	"github.com/zeebo/clingy"
+	"github.com/zeebo/errs"
+
+	"storj.io/common/sync2"
+	"storj.io/storj/cmd/uplinkng/ulext"
+	"storj.io/storj/cmd/uplinkng/ulfs"
+	"storj.io/storj/cmd/uplinkng/ulloc"
+	"storj.io/uplink"
+)
+
+// syncHashKey is the custom metadata key the content hash of synced objects is stored under.
+const syncHashKey = "uplink-sha256"
+
+type cmdSync struct {
+	ex ulext.External
+
+	access      string
+	parallelism int
+	dryrun      bool
+	delete      bool
+	hash        bool
+	filter      objectFilter
+
+	source ulloc.Location
+	dest   ulloc.Location
+}
+
+func newCmdSync(ex ulext.External) *cmdSync {
+	return &cmdSync{ex: ex}
+}
+
+func (c *cmdSync) Setup(params clingy.Parameters) {
+	c.access = params.Flag("access", "Access name or value to use", "").(string)
+	c.parallelism = params.Flag("parallelism", "Controls how many uploads/downloads to perform in parallel", 1,
+		clingy.Short('p'),
+		clingy.Transform(strconv.Atoi),
+		clingy.Transform(func(n int) (int, error) {
+			if n <= 0 {
+				return 0, errs.New("parallelism must be at least 1")
+			}
+			return n, nil
+		}),
+	).(int)
+	c.dryrun = params.Flag("dryrun", "Print what operations would happen but don't execute them", false,
+		clingy.Transform(strconv.ParseBool),
+	).(bool)
+	c.delete = params.Flag("delete", "Delete entries in the destination that do not exist in the source", false,
+		clingy.Transform(strconv.ParseBool),
+	).(bool)
+	c.hash = params.Flag("hash", "Compare content hashes stored in the object metadata instead of only sizes and modification times", false,
+		clingy.Transform(strconv.ParseBool),
+	).(bool)
+	c.filter.Setup(params)
+
+	c.source = params.Arg("source", "Source to sync from", clingy.Transform(ulloc.Parse)).(ulloc.Location)
+	c.dest = params.Arg("dest", "Destination to sync to", clingy.Transform(ulloc.Parse)).(ulloc.Location)
+}
+
+// syncAction is an operation that sync performs on a destination entry.
+type syncAction int
+
+const (
+	syncCreate syncAction = iota
+	syncUpdate
+	syncDelete
+)
+
+func (a syncAction) String() string {
+	switch a {
+	case syncCreate:
+		return "create"
+	case syncUpdate:
+		return "update"
+	default:
+		return "delete"
+	}
+}
+
+// syncSummary counts the entries handled by sync.
+type syncSummary struct {
+	mu      sync.Mutex
+	created int
+	updated int
+	deleted int
+}
+
+func (s *syncSummary) add(action syncAction) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	switch action {
+	case syncCreate:
+		s.created++
+	case syncUpdate:
+		s.updated++
+	case syncDelete:
+		s.deleted++
+	}
+}
+
+func (c *cmdSync) Execute(ctx clingy.Context) error {
+	if c.source.Std() || c.dest.Std() {
+		return errs.New("cannot sync to or from stdin/stdout")
+	}
+
+	fs, err := c.ex.OpenFilesystem(ctx, c.access)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = fs.Close() }()
+
+	// sync always works with the contents of the source and the destination,
+	// so both are treated as directories.
+	c.source, err = syncRoot(c.source)
+	if err != nil {
+		return err
+	}
+	c.dest, err = syncRoot(c.dest)
+	if err != nil {
+		return err
+	}
+
+	sources, err := c.list(ctx, fs, c.source)
+	if err != nil {
+		return err
+	}
+	dests, err := c.list(ctx, fs, c.dest)
+	if err != nil {
+		return err
+	}
+
+	var (
+		limiter   = sync2.NewLimiter(c.parallelism)
+		summary   syncSummary
+		unchanged int
+		es        errs.Group
+		mu        sync.Mutex
+	)
+
+	fprintln := func(w io.Writer, args ...interface{}) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		fmt.Fprintln(w, args...)
+	}
+
+	addError := func(err error) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		es.Add(err)
+	}
+
+	run := func(action syncAction, dest ulloc.Location, fn func() error) bool {
+		return limiter.Go(ctx, func() {
+			fprintln(ctx.Stdout(), action, dest)
+			if c.dryrun {
+				summary.add(action)
+				return
+			}
+			if err := fn(); err != nil {
+				fprintln(ctx.Stderr(), action, "failed:", err.Error())
+				addError(err)
+				return
+			}
+			summary.add(action)
+		})
+	}
+
+	for _, rel := range sortedKeys(sources) {
+		source := sources[rel]
+		dest, exists := dests[rel]
+
+		action := syncCreate
+		if exists {
+			changed, err := c.changed(ctx, fs, source, dest)
+			if err != nil {
+				addError(err)
+				continue
+			}
+			if !changed {
+				unchanged++
+				continue
+			}
+			action = syncUpdate
+		}
+
+		destLoc := joinDestWith(c.dest, rel)
+		if !run(action, destLoc, func() error { return c.transfer(ctx, fs, source, destLoc) }) {
+			break
+		}
+	}
+
+	limiter.Wait()
+
+	if c.delete {
+		for _, rel := range sortedKeys(dests) {
+			if _, ok := sources[rel]; ok {
+				continue
+			}
+
+			dest := dests[rel]
+			if !run(syncDelete, dest.Loc, func() error { return fs.Remove(ctx, dest.Loc, nil) }) {
+				break
+			}
+		}
+
+		limiter.Wait()
+	}
+
+	fmt.Fprintf(ctx.Stdout(), "created %d, updated %d, deleted %d, unchanged %d\n",
+		summary.created, summary.updated, summary.deleted, unchanged)
+
+	if len(es) > 0 {
+		return es.Err()
+	}
+	return nil
+}
+
+// syncRoot returns the location as a directory, with local paths made absolute
+// so that they match the locations returned when listing.
+func syncRoot(loc ulloc.Location) (ulloc.Location, error) {
+	if path, ok := loc.LocalParts(); ok {
+		abs, err := filepath.Abs(path)
+		if err != nil {
+			return ulloc.Location{}, errs.Wrap(err)
+		}
+		loc = ulloc.NewLocal(abs)
+	}
+	return loc.AsDirectoryish(), nil
+}
+
+// list returns the objects under the root, keyed by the path relative to it, that
+// pass the filter.
+func (c *cmdSync) list(ctx clingy.Context, fs ulfs.Filesystem, root ulloc.Location) (map[string]ulfs.ObjectInfo, error) {
+	iter, err := fs.List(ctx, root, &ulfs.ListOptions{
+		Recursive: true,
+		Expanded:  c.hash,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	infos := make(map[string]ulfs.ObjectInfo)
+	for iter.Next() {
+		info := iter.Item()
+		if info.IsPrefix {
+			continue
+		}
+
+		rel, err := root.RelativeTo(info.Loc)
+		if err != nil {
+			return nil, err
+		}
+		if c.filter.Match(rel, info) {
+			infos[rel] = info
+		}
+	}
+	return infos, errs.Wrap(iter.Err())
+}
+
+// changed returns true if the destination needs to be updated from the source.
+func (c *cmdSync) changed(ctx clingy.Context, fs ulfs.Filesystem, source, dest ulfs.ObjectInfo) (bool, error) {
+	if c.hash {
+		sourceHash, err := c.contentHash(ctx, fs, source)
+		if err != nil {
+			return false, err
+		}
+		destHash, err := c.contentHash(ctx, fs, dest)
+		if err != nil {
+			return false, err
+		}
+		if sourceHash != "" && destHash != "" {
+			return sourceHash != destHash, nil
+		}
+	}
+
+	return source.ContentLength != dest.ContentLength || source.Created.After(dest.Created), nil
+}
+
+// contentHash returns the content hash of the entry. For remote objects it's only
+// known when it was stored in the metadata by an earlier sync.
+func (c *cmdSync) contentHash(ctx clingy.Context, fs ulfs.Filesystem, info ulfs.ObjectInfo) (string, error) {
+	if hash, ok := info.Metadata[syncHashKey]; ok {
+		return hash, nil
+	}
+	if !info.Loc.Local() {
+		return "", nil
+	}
+
+	rh, err := fs.Open(ctx, info.Loc, nil)
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = rh.Close() }()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, rh); err != nil {
+		return "", errs.Wrap(err)
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// transfer copies the source to the destination, storing the content hash of the
+// source in the metadata of remote destinations when hashes are compared.
+func (c *cmdSync) transfer(ctx clingy.Context, fs ulfs.Filesystem, source ulfs.ObjectInfo, dest ulloc.Location) error {
+	var opts *ulfs.CreateOptions
+	if c.hash && dest.Remote() {
+		hash, err := c.contentHash(ctx, fs, source)
+		if err != nil {
+			return err
+		}
+		if hash != "" {
+			opts = &ulfs.CreateOptions{
+				Metadata: uplink.CustomMetadata{syncHashKey: hash},
+			}
+		}
+	}
+
+	rh, err := fs.Open(ctx, source.Loc, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = rh.Close() }()
+
+	wh, err := fs.Create(ctx, dest, opts)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = wh.Abort() }()
+
+	if _, err := io.Copy(wh, rh); err != nil {
+		return errs.Combine(err, wh.Abort())
+	}
+	return errs.Wrap(wh.Commit())
+}
+
+func sortedKeys(infos map[string]ulfs.ObjectInfo) []string {
+	keys := make([]string, 0, len(infos))
+	for key := range infos {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,143 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zeebo/clingy"
+
+	"storj.io/storj/cmd/uplinkng/ulfs"
+	"storj.io/storj/cmd/uplinkng/ulloc"
+	"storj.io/storj/cmd/uplinkng/ultest"
+	"storj.io/uplink"
+)
+
+func TestSyncUpload(t *testing.T) {
+	state := ultest.Setup(commands,
+		ultest.WithBucket("user"),
+		ultest.WithFile("sj://user/dst/changed.txt", "old"),
+		ultest.WithFile("sj://user/dst/extra.txt", "extra"),
+		ultest.WithFile("/home/user/src/same.txt", "same"),
+		ultest.WithFile("/home/user/src/changed.txt", "new"),
+		ultest.WithFile("/home/user/src/folder/new.txt", "new"),
+		ultest.WithFile("/home/user/src/skip.log", "log"),
+		// uploaded again after the local file was modified.
+		ultest.WithFile("sj://user/dst/same.txt", "same"),
+	)
+
+	t.Run("Basic", func(t *testing.T) {
+		state.Succeed(t, "sync", "/home/user/src", "sj://user/dst").RequireStdout(t, `
+			update sj://user/dst/changed.txt
+			create sj://user/dst/folder/new.txt
+			create sj://user/dst/skip.log
+			created 2, updated 1, deleted 0, unchanged 1
+		`).RequireRemoteFiles(t,
+			ultest.File{Loc: "sj://user/dst/changed.txt", Contents: "new"},
+			ultest.File{Loc: "sj://user/dst/extra.txt", Contents: "extra"},
+			ultest.File{Loc: "sj://user/dst/folder/new.txt", Contents: "new"},
+			ultest.File{Loc: "sj://user/dst/same.txt", Contents: "same"},
+			ultest.File{Loc: "sj://user/dst/skip.log", Contents: "log"},
+		)
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		state.Succeed(t, "sync", "/home/user/src/", "sj://user/dst/", "--delete", "--exclude", "*.log").RequireStdout(t, `
+			update sj://user/dst/changed.txt
+			create sj://user/dst/folder/new.txt
+			delete sj://user/dst/extra.txt
+			created 1, updated 1, deleted 1, unchanged 1
+		`).RequireRemoteFiles(t,
+			ultest.File{Loc: "sj://user/dst/changed.txt", Contents: "new"},
+			ultest.File{Loc: "sj://user/dst/folder/new.txt", Contents: "new"},
+			ultest.File{Loc: "sj://user/dst/same.txt", Contents: "same"},
+		)
+	})
+
+	t.Run("Include", func(t *testing.T) {
+		state.Succeed(t, "sync", "/home/user/src", "sj://user/dst", "--delete", "--include", "folder/*").RequireStdout(t, `
+			create sj://user/dst/folder/new.txt
+			created 1, updated 0, deleted 0, unchanged 0
+		`)
+	})
+
+	t.Run("Dryrun", func(t *testing.T) {
+		state.Succeed(t, "sync", "/home/user/src", "sj://user/dst", "--delete", "--dryrun").RequireStdout(t, `
+			update sj://user/dst/changed.txt
+			create sj://user/dst/folder/new.txt
+			create sj://user/dst/skip.log
+			delete sj://user/dst/extra.txt
+			created 2, updated 1, deleted 1, unchanged 1
+		`).RequireRemoteFiles(t,
+			ultest.File{Loc: "sj://user/dst/changed.txt", Contents: "old"},
+			ultest.File{Loc: "sj://user/dst/extra.txt", Contents: "extra"},
+			ultest.File{Loc: "sj://user/dst/same.txt", Contents: "same"},
+		)
+	})
+
+	t.Run("InvalidPattern", func(t *testing.T) {
+		state.Fail(t, "sync", "/home/user/src", "sj://user/dst", "--include", "[")
+	})
+}
+
+func TestSyncDownload(t *testing.T) {
+	state := ultest.Setup(commands,
+		ultest.WithFile("/home/user/dst/old.txt", "old"),
+		ultest.WithFile("sj://user/src/old.txt", "new"),
+		ultest.WithFile("sj://user/src/folder/file.txt", "file"),
+	)
+
+	state.Succeed(t, "sync", "sj://user/src", "/home/user/dst", "--parallelism", "2").RequireLocalFiles(t,
+		ultest.File{Loc: "/home/user/dst/folder/file.txt", Contents: "file"},
+		ultest.File{Loc: "/home/user/dst/old.txt", Contents: "new"},
+	)
+}
+
+func TestSyncHash(t *testing.T) {
+	hash := func(data string) string {
+		sum := sha256.Sum256([]byte(data))
+		return hex.EncodeToString(sum[:])
+	}
+
+	withHashedFile := func(location, contents, contentHash string) ultest.ExecuteOption {
+		return ultest.WithFilesystem(func(t *testing.T, ctx clingy.Context, fs ulfs.Filesystem) {
+			loc, err := ulloc.Parse(location)
+			require.NoError(t, err)
+
+			wh, err := fs.Create(ctx, loc, &ulfs.CreateOptions{
+				Metadata: uplink.CustomMetadata{syncHashKey: contentHash},
+			})
+			require.NoError(t, err)
+			_, err = wh.Write([]byte(contents))
+			require.NoError(t, err)
+			require.NoError(t, wh.Commit())
+		})
+	}
+
+	state := ultest.Setup(commands,
+		ultest.WithBucket("user"),
+		withHashedFile("sj://user/dst/same.txt", "same", hash("same")),
+		withHashedFile("sj://user/dst/changed.txt", "old", hash("old")),
+		ultest.WithFile("/home/user/src/same.txt", "same"),
+		ultest.WithFile("/home/user/src/changed.txt", "new"),
+	)
+
+	// without hashes the local files are newer and get uploaded again.
+	state.Succeed(t, "sync", "/home/user/src", "sj://user/dst").RequireStdout(t, `
+		update sj://user/dst/changed.txt
+		update sj://user/dst/same.txt
+		created 0, updated 2, deleted 0, unchanged 0
+	`)
+
+	state.Succeed(t, "sync", "/home/user/src", "sj://user/dst", "--hash").RequireStdout(t, `
+		update sj://user/dst/changed.txt
+		created 0, updated 1, deleted 0, unchanged 1
+	`).RequireRemoteFiles(t,
+		ultest.File{Loc: "sj://user/dst/changed.txt", Contents: "new"},
+		ultest.File{Loc: "sj://user/dst/same.txt", Contents: "same"},
+	)
+}
@@ -5,7 +5,9 @@ package main
 
 import (
 	"fmt"
+	"io/ioutil"
 	"os"
+	"os/exec"
 	"path/filepath"
 	"runtime"
 	"strconv"
@@ -69,6 +71,7 @@ func (ex *external) Setup(f clingy.Flags) {
 
 func (ex *external) AccessInfoFile() string   { return filepath.Join(ex.dirs.current, "access.json") }
 func (ex *external) ConfigFile() string       { return filepath.Join(ex.dirs.current, "config.ini") }
+func (ex *external) TransfersDir() string     { return filepath.Join(ex.dirs.current, "transfers") }
 func (ex *external) legacyConfigFile() string { return filepath.Join(ex.dirs.legacy, "config.yaml") }
 
 // Dynamic is called by clingy to look up values for global flags not specified on the command
@@ -165,6 +168,48 @@ func (ex *external) PromptSecret(ctx clingy.Context, prompt string) (secret stri
 	}
 }
 
+// Edit opens the contents in the editor named by $VISUAL or $EDITOR and returns
+// the contents once the editor exits, and returns an error if interactive mode
+// is disabled.
+func (ex *external) Edit(ctx clingy.Context, contents []byte) (_ []byte, err error) {
+	if !ex.interactive {
+		return nil, errs.New("required editor in non-interactive setting")
+	}
+
+	editor := os.Getenv("VISUAL")
+	if editor == "" {
+		editor = os.Getenv("EDITOR")
+	}
+	if editor == "" {
+		editor = "vi"
+		if runtime.GOOS == "windows" {
+			editor = "notepad"
+		}
+	}
+
+	fh, err := ioutil.TempFile("", "uplink-edit-*.json")
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, os.Remove(fh.Name())) }()
+
+	_, err = fh.Write(contents)
+	if err := errs.Combine(err, fh.Close()); err != nil {
+		return nil, errs.Wrap(err)
+	}
+
+	// the editor may be a command with arguments, like "code --wait".
+	args := strings.Fields(editor)
+	cmd := exec.CommandContext(ctx, args[0], append(args[1:], fh.Name())...)
+	cmd.Stdin, cmd.Stdout, cmd.Stderr = ctx.Stdin(), ctx.Stdout(), ctx.Stderr()
+	if err := cmd.Run(); err != nil {
+		return nil, errs.New("editor %q failed: %w", editor, err)
+	}
+
+	edited, err := ioutil.ReadFile(fh.Name())
+	return edited, errs.Wrap(err)
+}
+
 // appDir returns best base directory for the currently running operating system. It
 // has a legacy bool to have it return the same values that storj.io/common/fpath.ApplicationDir
 // would have returned.
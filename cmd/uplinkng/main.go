@@ -50,10 +50,14 @@ func commands(cmds clingy.Commands, ex ulext.External) {
 	cmds.New("rb", "Remove a bucket bucket", newCmdRb(ex))
 	cmds.New("cp", "Copies files or objects into or out of storj", newCmdCp(ex))
 	cmds.New("mv", "Moves files or objects", newCmdMv(ex))
+	cmds.New("sync", "Synchronizes a destination with a source", newCmdSync(ex))
 	cmds.New("ls", "Lists buckets, prefixes, or objects", newCmdLs(ex))
 	cmds.New("rm", "Remove an object", newCmdRm(ex))
 	cmds.Group("meta", "Object metadata related commands", func() {
 		cmds.New("get", "Get an object's metadata", newCmdMetaGet(ex))
+		cmds.New("set", "Set entries of an object's metadata", newCmdMetaSet(ex))
+		cmds.New("delete", "Delete entries of an object's metadata", newCmdMetaDelete(ex))
+		cmds.New("edit", "Edit an object's metadata in an editor", newCmdMetaEdit(ex))
 	})
 	cmds.New("version", "Prints version information", newCmdVersion())
 }
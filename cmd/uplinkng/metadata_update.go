@@ -0,0 +1,140 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/zeebo/clingy"
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/cmd/uplinkng/ulext"
+	"storj.io/storj/cmd/uplinkng/ulfs"
+	"storj.io/storj/cmd/uplinkng/ulloc"
+	"storj.io/uplink"
+)
+
+// metadataUpdate holds flags and provides a Setup method for commands that change
+// the custom metadata of an object or of every object under a prefix.
+type metadataUpdate struct {
+	access    string
+	recursive bool
+	encrypted bool
+	filter    objectFilter
+
+	location ulloc.Location
+}
+
+func (mu *metadataUpdate) Setup(params clingy.Parameters) {
+	mu.access = params.Flag("access", "Access name or value to use", "").(string)
+	mu.recursive = params.Flag("recursive", "Update the metadata of every object under the prefix", false,
+		clingy.Short('r'),
+		clingy.Transform(strconv.ParseBool),
+	).(bool)
+	mu.encrypted = params.Flag("encrypted", "Interprets keys base64 encoded without decrypting", false,
+		clingy.Transform(strconv.ParseBool),
+	).(bool)
+
+	mu.filter.Setup(params)
+
+	mu.location = params.Arg("location", "Location of object or prefix (sj://BUCKET/KEY)",
+		clingy.Transform(ulloc.Parse),
+	).(ulloc.Location)
+}
+
+// open returns the filesystem to update the metadata with.
+func (mu *metadataUpdate) open(ctx clingy.Context, ex ulext.External) (ulfs.Filesystem, error) {
+	if !mu.location.Remote() {
+		return nil, errs.New("location must be remote")
+	}
+	return ex.OpenFilesystem(ctx, mu.access, ulext.BypassEncryption(mu.encrypted))
+}
+
+// objects returns the object at the location, or the objects under it when
+// recursive, along with their metadata.
+func (mu *metadataUpdate) objects(ctx clingy.Context, fs ulfs.Filesystem) ([]ulfs.ObjectInfo, error) {
+	if !mu.recursive {
+		info, err := fs.Stat(ctx, mu.location)
+		if err != nil {
+			return nil, err
+		}
+		return []ulfs.ObjectInfo{*info}, nil
+	}
+
+	iter, err := fs.List(ctx, mu.location, &ulfs.ListOptions{
+		Recursive: true,
+		Expanded:  true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var infos []ulfs.ObjectInfo
+	for iter.Next() {
+		if mu.filter.match(mu.location, iter.Item()) {
+			infos = append(infos, iter.Item())
+		}
+	}
+	return infos, errs.Wrap(iter.Err())
+}
+
+// update replaces the metadata of every object with the result of fn.
+func (mu *metadataUpdate) update(ctx clingy.Context, ex ulext.External, fn func(metadata uplink.CustomMetadata) uplink.CustomMetadata) error {
+	fs, err := mu.open(ctx, ex)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = fs.Close() }()
+
+	infos, err := mu.objects(ctx, fs)
+	if err != nil {
+		return err
+	}
+
+	var es errs.Group
+	for _, info := range infos {
+		if err := mu.set(ctx, fs, info.Loc, fn(info.Metadata.Clone())); err != nil {
+			es.Add(err)
+		}
+	}
+	return es.Err()
+}
+
+// set replaces the metadata of the object and reports the result.
+func (mu *metadataUpdate) set(ctx clingy.Context, fs ulfs.Filesystem, loc ulloc.Location, metadata uplink.CustomMetadata) error {
+	if err := fs.SetMetadata(ctx, loc, metadata); err != nil {
+		fmt.Fprintln(ctx.Stderr(), "update", loc, "failed:", err.Error())
+		return err
+	}
+	fmt.Fprintln(ctx.Stdout(), "updated", loc)
+	return nil
+}
+
+// parseMetadata parses metadata from values that are either key=value entries or
+// JSON objects with string values. Later values override earlier ones.
+func parseMetadata(values []string) (uplink.CustomMetadata, error) {
+	metadata := make(uplink.CustomMetadata)
+	for _, value := range values {
+		if strings.HasPrefix(strings.TrimSpace(value), "{") {
+			var entries map[string]string
+			if err := json.Unmarshal([]byte(value), &entries); err != nil {
+				return nil, errs.New("invalid metadata document %q: %v", value, err)
+			}
+			for key, value := range entries {
+				metadata[key] = value
+			}
+			continue
+		}
+
+		idx := strings.IndexByte(value, '=')
+		if idx <= 0 {
+			return nil, errs.New("invalid metadata entry %q: must be key=value or a JSON object", value)
+		}
+		metadata[value[:idx]] = value[idx+1:]
+	}
+	return metadata, nil
+}
@@ -0,0 +1,89 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"time"
+
+	"github.com/zeebo/clingy"
+	"github.com/zeebo/errs"
+
+	"storj.io/common/memory"
+	"storj.io/storj/cmd/uplinkng/ulfs"
+	"storj.io/storj/cmd/uplinkng/ulloc"
+)
+
+// objectFilter holds flags and provides a Setup method for commands that operate
+// on a subset of the objects under a location.
+type objectFilter struct {
+	ulfs.Filter
+}
+
+func (of *objectFilter) Setup(params clingy.Parameters) {
+	transformGlob := clingy.Transform(func(pattern string) (string, error) {
+		return pattern, ulfs.ValidateGlob(pattern)
+	})
+	transformSize := clingy.Transform(func(value string) (int64, error) {
+		var size memory.Size
+		if err := size.Set(value); err != nil {
+			return 0, errs.Wrap(err)
+		}
+		if size < 0 {
+			return 0, errs.New("size must not be negative")
+		}
+		return size.Int64(), nil
+	})
+
+	now := time.Now()
+	transformHumanDate := clingy.Transform(func(date string) (time.Time, error) {
+		return parseHumanDate(date, now)
+	})
+
+	of.Include = params.Flag("include", "Only operate on objects matching the glob pattern", []string{},
+		transformGlob, clingy.Repeated,
+	).([]string)
+	of.Exclude = params.Flag("exclude", "Do not operate on objects matching the glob pattern", []string{},
+		transformGlob, clingy.Repeated,
+	).([]string)
+	of.MinSize = params.Flag("min-size", "Only operate on objects of at least this size (e.g. '10MiB')", int64(0),
+		transformSize, clingy.Type("size"),
+	).(int64)
+	of.MaxSize = params.Flag("max-size", "Only operate on objects of at most this size (e.g. '1GB')", int64(0),
+		transformSize, clingy.Type("size"),
+	).(int64)
+	of.ModifiedAfter = params.Flag("modified-after",
+		"Only operate on objects modified after this time (e.g. '-24h', '2020-01-02T15:04:05Z0700')",
+		time.Time{}, transformHumanDate, clingy.Type("relative_date"),
+	).(time.Time)
+	of.ModifiedBefore = params.Flag("modified-before",
+		"Only operate on objects modified before this time (e.g. '-24h', '2020-01-02T15:04:05Z0700')",
+		time.Time{}, transformHumanDate, clingy.Type("relative_date"),
+	).(time.Time)
+}
+
+// parseHumanDate parses an absolute date or a date relative to now.
+func parseHumanDate(date string, now time.Time) (time.Time, error) {
+	switch {
+	case date == "":
+		return time.Time{}, nil
+	case date == "now":
+		return now, nil
+	case date[0] == '+' || date[0] == '-':
+		d, err := time.ParseDuration(date)
+		return now.Add(d), errs.Wrap(err)
+	default:
+		t, err := time.Parse(time.RFC3339, date)
+		return t, errs.Wrap(err)
+	}
+}
+
+// match returns true if the object under root passes the filter. Globs are matched
+// against the name of the object relative to root when it can be determined.
+func (of *objectFilter) match(root ulloc.Location, info ulfs.ObjectInfo) bool {
+	name, err := root.RelativeTo(info.Loc)
+	if err != nil {
+		name = info.Loc.Loc()
+	}
+	return of.Filter.Match(name, info)
+}
@@ -0,0 +1,134 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/zeebo/clingy"
+	"github.com/zeebo/errs"
+)
+
+// outputFormat is the format commands print their results in.
+type outputFormat string
+
+const (
+	outputText outputFormat = "text"
+	outputJSON outputFormat = "json"
+	outputCSV  outputFormat = "csv"
+)
+
+func parseOutputFormat(value string) (outputFormat, error) {
+	switch format := outputFormat(value); format {
+	case outputText, outputJSON, outputCSV:
+		return format, nil
+	default:
+		return "", errs.New("invalid output format %q: must be one of text, json or csv", value)
+	}
+}
+
+func outputFlag(params clingy.Parameters) outputFormat {
+	return params.Flag("output", "Output format, one of text, json or csv", outputText,
+		clingy.Short('o'),
+		clingy.Transform(parseOutputFormat),
+	).(outputFormat)
+}
+
+// outputRecord is a result that can be printed as JSON or CSV.
+type outputRecord interface {
+	// CSV returns the fields of the record in the order of the headers.
+	CSV() []string
+}
+
+// recordWriter prints records as a JSON array or as CSV rows with a header.
+type recordWriter struct {
+	w       io.Writer
+	format  outputFormat
+	headers []string
+
+	csv   *csv.Writer
+	count int
+}
+
+func newRecordWriter(w io.Writer, format outputFormat, headers ...string) *recordWriter {
+	return &recordWriter{
+		w:       w,
+		format:  format,
+		headers: headers,
+	}
+}
+
+// Write prints the record.
+func (rw *recordWriter) Write(record outputRecord) error {
+	defer func() { rw.count++ }()
+
+	if rw.format == outputCSV {
+		if err := rw.startCSV(); err != nil {
+			return err
+		}
+		return errs.Wrap(rw.csv.Write(record.CSV()))
+	}
+
+	data, err := json.Marshal(record)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+
+	separator := ",\n"
+	if rw.count == 0 {
+		separator = "[\n"
+	}
+	_, err = fmt.Fprintf(rw.w, "%s%s", separator, data)
+	return errs.Wrap(err)
+}
+
+// Done finishes the output.
+func (rw *recordWriter) Done() error {
+	if rw.format == outputCSV {
+		// the header is printed even when there are no records.
+		if err := rw.startCSV(); err != nil {
+			return err
+		}
+		rw.csv.Flush()
+		return errs.Wrap(rw.csv.Error())
+	}
+
+	if rw.count == 0 {
+		_, err := fmt.Fprintln(rw.w, "[]")
+		return errs.Wrap(err)
+	}
+	_, err := fmt.Fprintln(rw.w, "\n]")
+	return errs.Wrap(err)
+}
+
+func (rw *recordWriter) startCSV() error {
+	if rw.csv != nil {
+		return nil
+	}
+	rw.csv = csv.NewWriter(rw.w)
+	return errs.Wrap(rw.csv.Write(rw.headers))
+}
+
+// formatRecordTimePtr formats the time for machine readable output, returning an
+// empty string for nil.
+func formatRecordTimePtr(x *time.Time) string {
+	if x == nil {
+		return ""
+	}
+	return x.UTC().Format(time.RFC3339)
+}
+
+// recordTime returns a pointer to the time or nil for the zero time, so that it
+// can be omitted from JSON output.
+func recordTime(x time.Time) *time.Time {
+	if x.IsZero() {
+		return nil
+	}
+	x = x.UTC()
+	return &x
+}
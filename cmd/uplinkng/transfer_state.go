@@ -0,0 +1,128 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/cmd/uplinkng/ulloc"
+)
+
+// transferState is the persisted state of a resumable copy.
+type transferState struct {
+	Source string `json:"source"`
+	Dest   string `json:"dest"`
+
+	// SourceSize and SourceModified identify the version of the source that
+	// is being copied, so that a changed source is copied from the start.
+	SourceSize     int64     `json:"sourceSize"`
+	SourceModified time.Time `json:"sourceModified"`
+
+	// UploadID, PartSize and Parts are the multipart upload of an upload.
+	UploadID string   `json:"uploadId,omitempty"`
+	PartSize int64    `json:"partSize,omitempty"`
+	Parts    []uint32 `json:"parts,omitempty"`
+
+	// Offset is the number of bytes written by a download.
+	Offset int64 `json:"offset,omitempty"`
+}
+
+// transferStates stores the state of resumable copies as files in a directory.
+type transferStates struct {
+	dir string
+}
+
+// path returns the file storing the state of the copy from source to dest.
+func (ts transferStates) path(source, dest ulloc.Location) (string, error) {
+	sourceKey, err := transferKey(source)
+	if err != nil {
+		return "", err
+	}
+	destKey, err := transferKey(dest)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256([]byte(sourceKey + "\x00" + destKey))
+	return filepath.Join(ts.dir, hex.EncodeToString(sum[:16])+".json"), nil
+}
+
+// transferKey returns the string identifying the location, with local paths made
+// absolute so that the working directory doesn't matter.
+func transferKey(loc ulloc.Location) (string, error) {
+	if path, ok := loc.LocalParts(); ok {
+		abs, err := filepath.Abs(path)
+		if err != nil {
+			return "", errs.Wrap(err)
+		}
+		return ulloc.NewLocal(abs).String(), nil
+	}
+	return loc.String(), nil
+}
+
+// Load returns the state of the copy from source to dest or nil if there is none.
+func (ts transferStates) Load(source, dest ulloc.Location) (*transferState, error) {
+	path, err := ts.path(source, dest)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, errs.Wrap(err)
+	}
+
+	var state transferState
+	if err := json.Unmarshal(data, &state); err != nil {
+		return nil, errs.New("invalid transfer state %q: %v", path, err)
+	}
+	return &state, nil
+}
+
+// Save stores the state of a copy, replacing any earlier state.
+func (ts transferStates) Save(source, dest ulloc.Location, state *transferState) error {
+	path, err := ts.path(source, dest)
+	if err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(state)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+
+	if err := os.MkdirAll(ts.dir, 0700); err != nil {
+		return errs.Wrap(err)
+	}
+
+	// write to a temporary file first so that an interrupted write doesn't
+	// lose the earlier state.
+	tmp := path + ".tmp"
+	if err := ioutil.WriteFile(tmp, data, 0600); err != nil {
+		return errs.Wrap(err)
+	}
+	return errs.Wrap(os.Rename(tmp, path))
+}
+
+// Delete removes the state of the copy from source to dest.
+func (ts transferStates) Delete(source, dest ulloc.Location) error {
+	path, err := ts.path(source, dest)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return errs.Wrap(err)
+	}
+	return nil
+}
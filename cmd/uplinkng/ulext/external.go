@@ -28,8 +28,11 @@ type External interface {
 	ConfigFile() string
 	SaveConfig(values map[string]string) error
 
+	TransfersDir() string
+
 	PromptInput(ctx clingy.Context, prompt string) (input string, err error)
 	PromptSecret(ctx clingy.Context, prompt string) (secret string, err error)
+	Edit(ctx clingy.Context, contents []byte) (edited []byte, err error)
 }
 
 // Options contains all of the possible options for opening a filesystem or project.
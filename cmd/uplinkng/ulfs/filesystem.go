@@ -39,16 +39,52 @@ type OpenOptions struct {
 	Length int64
 }
 
+// CreateOptions describes options for Filesystem.Create.
+type CreateOptions struct {
+	Metadata uplink.CustomMetadata
+
+	// Offset keeps that many bytes of an existing local file and continues
+	// writing after them. It is used to resume downloads.
+	Offset int64
+}
+
+func (co *CreateOptions) metadata() uplink.CustomMetadata {
+	if co == nil {
+		return nil
+	}
+	return co.Metadata
+}
+
+func (co *CreateOptions) offset() int64 {
+	if co == nil {
+		return 0
+	}
+	return co.Offset
+}
+
+// PartInfo describes an uploaded part of a multipart upload.
+type PartInfo struct {
+	Number uint32
+	Size   int64
+}
+
 // Filesystem represents either the local Filesystem or the data backed by a project.
 type Filesystem interface {
 	Close() error
 	Open(ctx clingy.Context, loc ulloc.Location, opts *OpenOptions) (ReadHandle, error)
-	Create(ctx clingy.Context, loc ulloc.Location) (WriteHandle, error)
+	Create(ctx clingy.Context, loc ulloc.Location, opts *CreateOptions) (WriteHandle, error)
 	Move(ctx clingy.Context, source, dest ulloc.Location) error
 	Remove(ctx context.Context, loc ulloc.Location, opts *RemoveOptions) error
 	List(ctx context.Context, prefix ulloc.Location, opts *ListOptions) (ObjectIterator, error)
 	IsLocalDir(ctx context.Context, loc ulloc.Location) bool
 	Stat(ctx context.Context, loc ulloc.Location) (*ObjectInfo, error)
+	SetMetadata(ctx context.Context, loc ulloc.Location, metadata uplink.CustomMetadata) error
+
+	BeginUpload(ctx context.Context, loc ulloc.Location) (uploadID string, err error)
+	UploadPart(ctx context.Context, loc ulloc.Location, uploadID string, number uint32) (WriteHandle, error)
+	ListParts(ctx context.Context, loc ulloc.Location, uploadID string) ([]PartInfo, error)
+	CommitUpload(ctx context.Context, loc ulloc.Location, uploadID string, opts *CreateOptions) error
+	AbortUpload(ctx context.Context, loc ulloc.Location, uploadID string) error
 }
 
 //
@@ -64,6 +100,9 @@ type ObjectInfo struct {
 	ContentLength int64
 	Expires       time.Time
 	Metadata      uplink.CustomMetadata
+
+	// UploadID is set for pending uploads.
+	UploadID string
 }
 
 // uplinkObjectToObjectInfo returns an objectInfo converted from an *uplink.Object.
@@ -87,6 +126,7 @@ func uplinkUploadInfoToObjectInfo(bucket string, upl *uplink.UploadInfo) ObjectI
 		ContentLength: upl.System.ContentLength,
 		Expires:       upl.System.Expires,
 		Metadata:      upl.Custom,
+		UploadID:      upl.UploadID,
 	}
 }
 
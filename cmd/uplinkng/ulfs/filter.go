@@ -0,0 +1,92 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package ulfs
+
+import (
+	"path"
+	"strings"
+	"time"
+
+	"github.com/zeebo/errs"
+)
+
+// Filter describes which objects a command operates on. The zero value matches
+// every object.
+type Filter struct {
+	// Include and Exclude are glob patterns matched against the name of an object
+	// relative to the location the command operates on. Patterns without a slash
+	// are also matched against the last element of the name.
+	Include []string
+	Exclude []string
+
+	// MinSize and MaxSize limit the size of objects, zero means no limit.
+	MinSize int64
+	MaxSize int64
+
+	// ModifiedAfter and ModifiedBefore limit the creation or modification time of
+	// objects, the zero time means no limit.
+	ModifiedAfter  time.Time
+	ModifiedBefore time.Time
+}
+
+// ValidateGlob returns an error if the pattern is malformed.
+func ValidateGlob(pattern string) error {
+	if _, err := path.Match(pattern, ""); err != nil {
+		return errs.New("invalid pattern %q: %v", pattern, err)
+	}
+	return nil
+}
+
+// Match returns true if the object with the relative name passes the filter.
+// Prefixes are always matched, since they have no size or time.
+func (f *Filter) Match(name string, info ObjectInfo) bool {
+	if f == nil || info.IsPrefix {
+		return true
+	}
+
+	for _, pattern := range f.Exclude {
+		if matchGlob(pattern, name) {
+			return false
+		}
+	}
+	if len(f.Include) > 0 && !matchAnyGlob(f.Include, name) {
+		return false
+	}
+
+	if f.MinSize > 0 && info.ContentLength < f.MinSize {
+		return false
+	}
+	if f.MaxSize > 0 && info.ContentLength > f.MaxSize {
+		return false
+	}
+
+	if !f.ModifiedAfter.IsZero() && !info.Created.After(f.ModifiedAfter) {
+		return false
+	}
+	if !f.ModifiedBefore.IsZero() && !info.Created.Before(f.ModifiedBefore) {
+		return false
+	}
+
+	return true
+}
+
+func matchAnyGlob(patterns []string, name string) bool {
+	for _, pattern := range patterns {
+		if matchGlob(pattern, name) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchGlob(pattern, name string) bool {
+	if ok, _ := path.Match(pattern, name); ok {
+		return true
+	}
+	if !strings.Contains(pattern, "/") {
+		ok, _ := path.Match(pattern, path.Base(name))
+		return ok
+	}
+	return false
+}
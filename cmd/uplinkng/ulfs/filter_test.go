@@ -0,0 +1,50 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package ulfs_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/cmd/uplinkng/ulfs"
+)
+
+func TestFilter(t *testing.T) {
+	now := time.Now()
+	info := ulfs.ObjectInfo{ContentLength: 100, Created: now}
+
+	var nilFilter *ulfs.Filter
+	require.True(t, nilFilter.Match("a.txt", info))
+	require.True(t, (&ulfs.Filter{}).Match("a.txt", info))
+
+	for _, tt := range []struct {
+		filter ulfs.Filter
+		name   string
+		match  bool
+	}{
+		{filter: ulfs.Filter{Include: []string{"*.txt"}}, name: "dir/a.txt", match: true},
+		{filter: ulfs.Filter{Include: []string{"*.txt"}}, name: "dir/a.log", match: false},
+		{filter: ulfs.Filter{Include: []string{"dir/*"}}, name: "dir/a.txt", match: true},
+		{filter: ulfs.Filter{Include: []string{"dir/*"}}, name: "other/a.txt", match: false},
+		{filter: ulfs.Filter{Include: []string{"*.txt"}, Exclude: []string{"a.*"}}, name: "dir/a.txt", match: false},
+		{filter: ulfs.Filter{MinSize: 100}, name: "a", match: true},
+		{filter: ulfs.Filter{MinSize: 101}, name: "a", match: false},
+		{filter: ulfs.Filter{MaxSize: 100}, name: "a", match: true},
+		{filter: ulfs.Filter{MaxSize: 99}, name: "a", match: false},
+		{filter: ulfs.Filter{ModifiedAfter: now.Add(-time.Hour)}, name: "a", match: true},
+		{filter: ulfs.Filter{ModifiedAfter: now}, name: "a", match: false},
+		{filter: ulfs.Filter{ModifiedBefore: now.Add(time.Hour)}, name: "a", match: true},
+		{filter: ulfs.Filter{ModifiedBefore: now}, name: "a", match: false},
+	} {
+		require.Equal(t, tt.match, tt.filter.Match(tt.name, info), "%+v %q", tt.filter, tt.name)
+	}
+
+	// prefixes don't have a size or time, so they are always matched.
+	require.True(t, (&ulfs.Filter{MinSize: 1000}).Match("dir/", ulfs.ObjectInfo{IsPrefix: true}))
+
+	require.NoError(t, ulfs.ValidateGlob("*.txt"))
+	require.Error(t, ulfs.ValidateGlob("["))
+}
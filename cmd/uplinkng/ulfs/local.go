@@ -41,7 +41,8 @@ func (l *Local) Open(ctx context.Context, path string, opts *OpenOptions) (ReadH
 }
 
 // Create makes any directories necessary to create a file at path and returns a WriteHandle.
-func (l *Local) Create(ctx context.Context, path string) (WriteHandle, error) {
+// With an offset, the existing file is kept up to the offset and written after it.
+func (l *Local) Create(ctx context.Context, path string, opts *CreateOptions) (WriteHandle, error) {
 	fi, err := os.Stat(path)
 	if err != nil && !os.IsNotExist(err) {
 		return nil, errs.Wrap(err)
@@ -53,6 +54,20 @@ func (l *Local) Create(ctx context.Context, path string) (WriteHandle, error) {
 		return nil, errs.Wrap(err)
 	}
 
+	if offset := opts.offset(); offset > 0 {
+		fh, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE, 0666)
+		if err != nil {
+			return nil, errs.Wrap(err)
+		}
+		if err := fh.Truncate(offset); err != nil {
+			return nil, errs.Combine(errs.Wrap(err), fh.Close())
+		}
+		if _, err := fh.Seek(offset, io.SeekStart); err != nil {
+			return nil, errs.Combine(errs.Wrap(err), fh.Close())
+		}
+		return newOSWriteHandle(fh), nil
+	}
+
 	// TODO: atomic rename
 	fh, err := os.Create(path)
 	if err != nil {
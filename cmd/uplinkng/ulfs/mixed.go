@@ -10,6 +10,7 @@ import (
 	"github.com/zeebo/errs"
 
 	"storj.io/storj/cmd/uplinkng/ulloc"
+	"storj.io/uplink"
 )
 
 // Mixed dispatches to either the local or remote filesystem depending on the location.
@@ -42,15 +43,63 @@ func (m *Mixed) Open(ctx clingy.Context, loc ulloc.Location, opts *OpenOptions)
 }
 
 // Create returns a WriteHandle to either a local file, remote object, or stdout.
-func (m *Mixed) Create(ctx clingy.Context, loc ulloc.Location) (WriteHandle, error) {
+func (m *Mixed) Create(ctx clingy.Context, loc ulloc.Location, opts *CreateOptions) (WriteHandle, error) {
 	if bucket, key, ok := loc.RemoteParts(); ok {
-		return m.remote.Create(ctx, bucket, key)
+		return m.remote.Create(ctx, bucket, key, opts)
 	} else if path, ok := loc.LocalParts(); ok {
-		return m.local.Create(ctx, path)
+		return m.local.Create(ctx, path, opts)
 	}
 	return newGenericWriteHandle(ctx.Stdout()), nil
 }
 
+// SetMetadata replaces the custom metadata of a remote object.
+func (m *Mixed) SetMetadata(ctx context.Context, loc ulloc.Location, metadata uplink.CustomMetadata) error {
+	if bucket, key, ok := loc.RemoteParts(); ok {
+		return m.remote.SetMetadata(ctx, bucket, key, metadata)
+	}
+	return errs.New("metadata can only be set on remote objects")
+}
+
+// BeginUpload starts a multipart upload to a remote object.
+func (m *Mixed) BeginUpload(ctx context.Context, loc ulloc.Location) (string, error) {
+	if bucket, key, ok := loc.RemoteParts(); ok {
+		return m.remote.BeginUpload(ctx, bucket, key)
+	}
+	return "", errs.New("multipart uploads are only supported for remote objects")
+}
+
+// UploadPart returns a WriteHandle for a part of a multipart upload to a remote object.
+func (m *Mixed) UploadPart(ctx context.Context, loc ulloc.Location, uploadID string, number uint32) (WriteHandle, error) {
+	if bucket, key, ok := loc.RemoteParts(); ok {
+		return m.remote.UploadPart(ctx, bucket, key, uploadID, number)
+	}
+	return nil, errs.New("multipart uploads are only supported for remote objects")
+}
+
+// ListParts returns the parts uploaded to a multipart upload to a remote object.
+func (m *Mixed) ListParts(ctx context.Context, loc ulloc.Location, uploadID string) ([]PartInfo, error) {
+	if bucket, key, ok := loc.RemoteParts(); ok {
+		return m.remote.ListParts(ctx, bucket, key, uploadID)
+	}
+	return nil, errs.New("multipart uploads are only supported for remote objects")
+}
+
+// CommitUpload commits a multipart upload to a remote object.
+func (m *Mixed) CommitUpload(ctx context.Context, loc ulloc.Location, uploadID string, opts *CreateOptions) error {
+	if bucket, key, ok := loc.RemoteParts(); ok {
+		return m.remote.CommitUpload(ctx, bucket, key, uploadID, opts)
+	}
+	return errs.New("multipart uploads are only supported for remote objects")
+}
+
+// AbortUpload aborts a multipart upload to a remote object.
+func (m *Mixed) AbortUpload(ctx context.Context, loc ulloc.Location, uploadID string) error {
+	if bucket, key, ok := loc.RemoteParts(); ok {
+		return m.remote.AbortUpload(ctx, bucket, key, uploadID)
+	}
+	return errs.New("multipart uploads are only supported for remote objects")
+}
+
 // Move moves either a local file or remote object.
 func (m *Mixed) Move(ctx clingy.Context, source, dest ulloc.Location) error {
 	if oldbucket, oldkey, ok := source.RemoteParts(); ok {
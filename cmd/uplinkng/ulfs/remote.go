@@ -57,14 +57,77 @@ func (r *Remote) Stat(ctx context.Context, bucket, key string) (*ObjectInfo, err
 }
 
 // Create returns a WriteHandle for the object identified by a given bucket and key.
-func (r *Remote) Create(ctx context.Context, bucket, key string) (WriteHandle, error) {
+func (r *Remote) Create(ctx context.Context, bucket, key string, opts *CreateOptions) (WriteHandle, error) {
+	if opts.offset() > 0 {
+		return nil, errs.New("unable to continue writing a remote object")
+	}
+
 	fh, err := r.project.UploadObject(ctx, bucket, key, nil)
 	if err != nil {
 		return nil, errs.Wrap(err)
 	}
+	if metadata := opts.metadata(); len(metadata) > 0 {
+		if err := fh.SetCustomMetadata(ctx, metadata); err != nil {
+			return nil, errs.Combine(errs.Wrap(err), fh.Abort())
+		}
+	}
 	return newUplinkWriteHandle(fh), nil
 }
 
+// BeginUpload starts a multipart upload to the given bucket and key.
+func (r *Remote) BeginUpload(ctx context.Context, bucket, key string) (string, error) {
+	info, err := r.project.BeginUpload(ctx, bucket, key, nil)
+	if err != nil {
+		return "", errs.Wrap(err)
+	}
+	return info.UploadID, nil
+}
+
+// UploadPart returns a WriteHandle for a part of the multipart upload.
+func (r *Remote) UploadPart(ctx context.Context, bucket, key, uploadID string, number uint32) (WriteHandle, error) {
+	part, err := r.project.UploadPart(ctx, bucket, key, uploadID, number)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return part, nil
+}
+
+// ListParts returns the parts uploaded to the multipart upload.
+func (r *Remote) ListParts(ctx context.Context, bucket, key, uploadID string) ([]PartInfo, error) {
+	var parts []PartInfo
+
+	iter := r.project.ListUploadParts(ctx, bucket, key, uploadID, nil)
+	for iter.Next() {
+		part := iter.Item()
+		parts = append(parts, PartInfo{
+			Number: part.PartNumber,
+			Size:   part.Size,
+		})
+	}
+	if err := iter.Err(); err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return parts, nil
+}
+
+// CommitUpload commits the multipart upload.
+func (r *Remote) CommitUpload(ctx context.Context, bucket, key, uploadID string, opts *CreateOptions) error {
+	_, err := r.project.CommitUpload(ctx, bucket, key, uploadID, &uplink.CommitUploadOptions{
+		CustomMetadata: opts.metadata(),
+	})
+	return errs.Wrap(err)
+}
+
+// AbortUpload aborts the multipart upload and deletes its parts.
+func (r *Remote) AbortUpload(ctx context.Context, bucket, key, uploadID string) error {
+	return errs.Wrap(r.project.AbortUpload(ctx, bucket, key, uploadID))
+}
+
+// SetMetadata replaces the custom metadata of the object.
+func (r *Remote) SetMetadata(ctx context.Context, bucket, key string, metadata uplink.CustomMetadata) error {
+	return errs.Wrap(r.project.UpdateObjectMetadata(ctx, bucket, key, metadata, nil))
+}
+
 // Move moves object to provided key and bucket.
 func (r *Remote) Move(ctx context.Context, oldbucket, oldkey, newbucket, newkey string) error {
 	return errs.Wrap(r.project.MoveObject(ctx, oldbucket, oldkey, newbucket, newkey, nil))
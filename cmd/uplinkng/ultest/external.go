@@ -6,6 +6,9 @@ package ultest
 import (
 	"context"
 
+	"github.com/zeebo/clingy"
+	"github.com/zeebo/errs"
+
 	"storj.io/storj/cmd/uplinkng/ulext"
 	"storj.io/storj/cmd/uplinkng/ulfs"
 	"storj.io/uplink"
@@ -14,15 +17,30 @@ import (
 type external struct {
 	ulext.External
 
-	fs      ulfs.Filesystem
-	project *uplink.Project
+	fs           ulfs.Filesystem
+	project      *uplink.Project
+	transfersDir string
+	editor       func(contents string) string
 }
 
-func newExternal(fs ulfs.Filesystem, project *uplink.Project) *external {
+func newExternal(fs ulfs.Filesystem, project *uplink.Project, transfersDir string, editor func(string) string) *external {
 	return &external{
-		fs:      fs,
-		project: project,
+		fs:           fs,
+		project:      project,
+		transfersDir: transfersDir,
+		editor:       editor,
+	}
+}
+
+func (ex *external) TransfersDir() string {
+	return ex.transfersDir
+}
+
+func (ex *external) Edit(ctx clingy.Context, contents []byte) ([]byte, error) {
+	if ex.editor == nil {
+		return nil, errs.New("no editor configured")
 	}
+	return []byte(ex.editor(string(contents))), nil
 }
 
 func (ex *external) OpenFilesystem(ctx context.Context, access string, options ...ulext.Option) (ulfs.Filesystem, error) {
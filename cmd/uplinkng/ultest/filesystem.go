@@ -6,6 +6,7 @@ package ultest
 import (
 	"bytes"
 	"context"
+	"fmt"
 	"path/filepath"
 	"sort"
 	"strings"
@@ -17,6 +18,7 @@ import (
 
 	"storj.io/storj/cmd/uplinkng/ulfs"
 	"storj.io/storj/cmd/uplinkng/ulloc"
+	"storj.io/uplink"
 )
 
 //
@@ -28,6 +30,7 @@ type testFilesystem struct {
 	created int64
 	files   map[ulloc.Location]memFileData
 	pending map[ulloc.Location][]*memWriteHandle
+	uploads map[string]*memUpload
 	locals  map[string]bool // true means path is a directory
 	buckets map[string]struct{}
 
@@ -38,14 +41,37 @@ func newTestFilesystem() *testFilesystem {
 	return &testFilesystem{
 		files:   make(map[ulloc.Location]memFileData),
 		pending: make(map[ulloc.Location][]*memWriteHandle),
+		uploads: make(map[string]*memUpload),
 		locals:  make(map[string]bool),
 		buckets: make(map[string]struct{}),
 	}
 }
 
+type memUpload struct {
+	loc   ulloc.Location
+	cre   int64
+	parts map[uint32]string
+}
+
+// contents returns the contents of the parts in the order of their numbers.
+func (mu *memUpload) contents() string {
+	numbers := make([]uint32, 0, len(mu.parts))
+	for number := range mu.parts {
+		numbers = append(numbers, number)
+	}
+	sort.Slice(numbers, func(i, j int) bool { return numbers[i] < numbers[j] })
+
+	var contents strings.Builder
+	for _, number := range numbers {
+		contents.WriteString(mu.parts[number])
+	}
+	return contents.String()
+}
+
 type memFileData struct {
 	contents string
 	created  int64
+	metadata uplink.CustomMetadata
 }
 
 func (tfs *testFilesystem) ensureBucket(name string) {
@@ -63,6 +89,16 @@ func (tfs *testFilesystem) Files() (files []File) {
 	return files
 }
 
+func (tfs *testFilesystem) Metadata() map[string]uplink.CustomMetadata {
+	metadata := make(map[string]uplink.CustomMetadata)
+	for loc, mf := range tfs.files {
+		if len(mf.metadata) > 0 {
+			metadata[loc.String()] = mf.metadata
+		}
+	}
+	return metadata
+}
+
 func (tfs *testFilesystem) Pending() (files []File) {
 	for loc, mh := range tfs.pending {
 		for _, h := range mh {
@@ -72,6 +108,12 @@ func (tfs *testFilesystem) Pending() (files []File) {
 			})
 		}
 	}
+	for _, upload := range tfs.uploads {
+		files = append(files, File{
+			Loc:      upload.loc.String(),
+			Contents: upload.contents(),
+		})
+	}
 	sort.Slice(files, func(i, j int) bool { return files[i].less(files[j]) })
 	return files
 }
@@ -100,7 +142,7 @@ func (tfs *testFilesystem) Open(ctx clingy.Context, loc ulloc.Location, opts *ul
 	return &byteReadHandle{Buffer: bytes.NewBufferString(mf.contents)}, nil
 }
 
-func (tfs *testFilesystem) Create(ctx clingy.Context, loc ulloc.Location) (_ ulfs.WriteHandle, err error) {
+func (tfs *testFilesystem) Create(ctx clingy.Context, loc ulloc.Location, opts *ulfs.CreateOptions) (_ ulfs.WriteHandle, err error) {
 	tfs.mu.Lock()
 	defer tfs.mu.Unlock()
 
@@ -131,6 +173,19 @@ func (tfs *testFilesystem) Create(ctx clingy.Context, loc ulloc.Location) (_ ulf
 		tfs: tfs,
 		cre: tfs.created,
 	}
+	if opts != nil {
+		wh.metadata = opts.Metadata
+		if opts.Offset > 0 {
+			if loc.Remote() {
+				return nil, errs.New("unable to continue writing a remote object")
+			}
+			contents := tfs.files[loc].contents
+			if int64(len(contents)) > opts.Offset {
+				contents = contents[:opts.Offset]
+			}
+			wh.buf.WriteString(contents)
+		}
+	}
 
 	if loc.Remote() {
 		tfs.pending[loc] = append(tfs.pending[loc], wh)
@@ -161,10 +216,128 @@ func (tfs *testFilesystem) Remove(ctx context.Context, loc ulloc.Location, opts
 	} else {
 		// TODO: Remove needs an API that understands that multiple pending files may exist
 		delete(tfs.pending, loc)
+		for id, upload := range tfs.uploads {
+			if upload.loc == loc {
+				delete(tfs.uploads, id)
+			}
+		}
+	}
+	return nil
+}
+
+func (tfs *testFilesystem) SetMetadata(ctx context.Context, loc ulloc.Location, metadata uplink.CustomMetadata) error {
+	tfs.mu.Lock()
+	defer tfs.mu.Unlock()
+
+	if !loc.Remote() {
+		return errs.New("metadata can only be set on remote objects")
+	}
+	mf, ok := tfs.files[loc]
+	if !ok {
+		return errs.New("file does not exist %q", loc)
+	}
+	mf.metadata = metadata
+	tfs.files[loc] = mf
+	return nil
+}
+
+func (tfs *testFilesystem) BeginUpload(ctx context.Context, loc ulloc.Location) (string, error) {
+	tfs.mu.Lock()
+	defer tfs.mu.Unlock()
+
+	bucket, _, ok := loc.RemoteParts()
+	if !ok {
+		return "", errs.New("multipart uploads are only supported for remote objects")
+	}
+	if _, ok := tfs.buckets[bucket]; !ok {
+		return "", errs.New("bucket %q does not exist", bucket)
+	}
+
+	tfs.created++
+	uploadID := fmt.Sprintf("upload-%d", tfs.created)
+	tfs.uploads[uploadID] = &memUpload{
+		loc:   loc,
+		cre:   tfs.created,
+		parts: make(map[uint32]string),
+	}
+	return uploadID, nil
+}
+
+func (tfs *testFilesystem) UploadPart(ctx context.Context, loc ulloc.Location, uploadID string, number uint32) (ulfs.WriteHandle, error) {
+	tfs.mu.Lock()
+	defer tfs.mu.Unlock()
+
+	if _, err := tfs.upload(loc, uploadID); err != nil {
+		return nil, err
+	}
+	return &memPartHandle{
+		buf:      bytes.NewBuffer(nil),
+		tfs:      tfs,
+		uploadID: uploadID,
+		number:   number,
+	}, nil
+}
+
+func (tfs *testFilesystem) ListParts(ctx context.Context, loc ulloc.Location, uploadID string) ([]ulfs.PartInfo, error) {
+	tfs.mu.Lock()
+	defer tfs.mu.Unlock()
+
+	upload, err := tfs.upload(loc, uploadID)
+	if err != nil {
+		return nil, err
+	}
+
+	var parts []ulfs.PartInfo
+	for number, contents := range upload.parts {
+		parts = append(parts, ulfs.PartInfo{
+			Number: number,
+			Size:   int64(len(contents)),
+		})
+	}
+	sort.Slice(parts, func(i, j int) bool { return parts[i].Number < parts[j].Number })
+	return parts, nil
+}
+
+func (tfs *testFilesystem) CommitUpload(ctx context.Context, loc ulloc.Location, uploadID string, opts *ulfs.CreateOptions) error {
+	tfs.mu.Lock()
+	defer tfs.mu.Unlock()
+
+	upload, err := tfs.upload(loc, uploadID)
+	if err != nil {
+		return err
 	}
+	delete(tfs.uploads, uploadID)
+
+	file := memFileData{
+		contents: upload.contents(),
+		created:  upload.cre,
+	}
+	if opts != nil {
+		file.metadata = opts.Metadata
+	}
+	tfs.files[loc] = file
 	return nil
 }
 
+func (tfs *testFilesystem) AbortUpload(ctx context.Context, loc ulloc.Location, uploadID string) error {
+	tfs.mu.Lock()
+	defer tfs.mu.Unlock()
+
+	if _, err := tfs.upload(loc, uploadID); err != nil {
+		return err
+	}
+	delete(tfs.uploads, uploadID)
+	return nil
+}
+
+func (tfs *testFilesystem) upload(loc ulloc.Location, uploadID string) (*memUpload, error) {
+	upload, ok := tfs.uploads[uploadID]
+	if !ok || upload.loc != loc {
+		return nil, errs.New("upload %q does not exist for %q", uploadID, loc)
+	}
+	return upload, nil
+}
+
 func (tfs *testFilesystem) List(ctx context.Context, prefix ulloc.Location, opts *ulfs.ListOptions) (ulfs.ObjectIterator, error) {
 	tfs.mu.Lock()
 	defer tfs.mu.Unlock()
@@ -178,10 +351,14 @@ func (tfs *testFilesystem) List(ctx context.Context, prefix ulloc.Location, opts
 	var infos []ulfs.ObjectInfo
 	for loc, mf := range tfs.files {
 		if loc.HasPrefix(prefixDir) || loc == prefix {
-			infos = append(infos, ulfs.ObjectInfo{
+			info := ulfs.ObjectInfo{
 				Loc:     loc,
 				Created: time.Unix(mf.created, 0),
-			})
+			}
+			if opts != nil && opts.Expanded {
+				info.Metadata = mf.metadata
+			}
+			infos = append(infos, info)
 		}
 	}
 
@@ -212,6 +389,15 @@ func (tfs *testFilesystem) listPending(ctx context.Context, prefix ulloc.Locatio
 			}
 		}
 	}
+	for uploadID, upload := range tfs.uploads {
+		if upload.loc.HasPrefix(prefixDir) || upload.loc == prefix {
+			infos = append(infos, ulfs.ObjectInfo{
+				Loc:      upload.loc,
+				Created:  time.Unix(upload.cre, 0),
+				UploadID: uploadID,
+			})
+		}
+	}
 
 	sort.Sort(objectInfos(infos))
 
@@ -248,6 +434,7 @@ func (tfs *testFilesystem) Stat(ctx context.Context, loc ulloc.Location) (*ulfs.
 		Loc:           loc,
 		Created:       time.Unix(mf.created, 0),
 		ContentLength: int64(len(mf.contents)),
+		Metadata:      mf.metadata,
 	}, nil
 }
 
@@ -293,11 +480,12 @@ func (b *byteReadHandle) Info() ulfs.ObjectInfo { return ulfs.ObjectInfo{} }
 //
 
 type memWriteHandle struct {
-	buf  *bytes.Buffer
-	loc  ulloc.Location
-	tfs  *testFilesystem
-	cre  int64
-	done bool
+	buf      *bytes.Buffer
+	loc      ulloc.Location
+	tfs      *testFilesystem
+	cre      int64
+	metadata uplink.CustomMetadata
+	done     bool
 }
 
 func (b *memWriteHandle) Write(p []byte) (int, error) {
@@ -319,6 +507,7 @@ func (b *memWriteHandle) Commit() error {
 	b.tfs.files[b.loc] = memFileData{
 		contents: b.buf.String(),
 		created:  b.cre,
+		metadata: b.metadata,
 	}
 	return nil
 }
@@ -357,6 +546,40 @@ func (b *memWriteHandle) close() error {
 	return nil
 }
 
+type memPartHandle struct {
+	buf      *bytes.Buffer
+	tfs      *testFilesystem
+	uploadID string
+	number   uint32
+	done     bool
+}
+
+func (p *memPartHandle) Write(data []byte) (int, error) {
+	return p.buf.Write(data)
+}
+
+func (p *memPartHandle) Commit() error {
+	p.tfs.mu.Lock()
+	defer p.tfs.mu.Unlock()
+
+	if p.done {
+		return errs.New("already done")
+	}
+	p.done = true
+
+	upload, ok := p.tfs.uploads[p.uploadID]
+	if !ok {
+		return errs.New("upload %q does not exist", p.uploadID)
+	}
+	upload.parts[p.number] = p.buf.String()
+	return nil
+}
+
+func (p *memPartHandle) Abort() error {
+	p.done = true
+	return nil
+}
+
 type discardWriteHandle struct{}
 
 func (discardWriteHandle) Write(p []byte) (int, error) { return len(p), nil }
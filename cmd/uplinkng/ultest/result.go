@@ -11,6 +11,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"storj.io/storj/cmd/uplinkng/ulloc"
+	"storj.io/uplink"
 )
 
 // Result captures all the output of running a command for inspection.
@@ -21,6 +22,9 @@ type Result struct {
 	Err     error
 	Files   []File
 	Pending []File
+
+	// Metadata is the custom metadata of the files that have any, keyed by location.
+	Metadata map[string]uplink.CustomMetadata
 }
 
 // RequireSuccess fails if the Result did not observe a successful execution.
@@ -84,6 +88,17 @@ func (r Result) RequireRemoteFiles(t *testing.T, files ...File) Result {
 	return r
 }
 
+// RequireMetadata requires that the file at the location had the provided custom
+// metadata at the end of the execution.
+func (r Result) RequireMetadata(t *testing.T, location string, metadata uplink.CustomMetadata) Result {
+	if len(metadata) == 0 {
+		require.Empty(t, r.Metadata[location])
+	} else {
+		require.Equal(t, metadata, r.Metadata[location])
+	}
+	return r
+}
+
 func filterFiles(files []File, match func(File) bool) (out []File) {
 	for _, file := range files {
 		if match(file) {
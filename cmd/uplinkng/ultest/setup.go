@@ -32,6 +32,23 @@ func Setup(cmds Commands, opts ...ExecuteOption) State {
 type State struct {
 	cmds Commands
 	opts []ExecuteOption
+
+	transfersDir string
+	editor       func(contents string) string
+}
+
+// WithTransfersDir returns a new State where commands store the state of
+// transfers in dir instead of a new temporary directory for every run.
+func (st State) WithTransfersDir(dir string) State {
+	st.transfersDir = dir
+	return st
+}
+
+// WithEditor returns a new State where commands that open an editor receive
+// the contents returned by edit instead.
+func (st State) WithEditor(edit func(contents string) string) State {
+	st.editor = edit
+	return st
 }
 
 // With appends the provided options and returns a new State.
@@ -64,6 +81,11 @@ func (st State) Run(t *testing.T, args ...string) Result {
 
 	tfs := newTestFilesystem()
 
+	transfersDir := st.transfersDir
+	if transfersDir == "" {
+		transfersDir = t.TempDir()
+	}
+
 	ok, err := clingy.Environment{
 		Name: "uplink-test",
 		Args: args,
@@ -85,7 +107,7 @@ func (st State) Run(t *testing.T, args ...string) Result {
 			return cmd.Execute(ctx)
 		},
 	}.Run(context.Background(), func(cmds clingy.Commands) {
-		st.cmds(cmds, newExternal(tfs, nil))
+		st.cmds(cmds, newExternal(tfs, nil, transfersDir, st.editor))
 	})
 
 	if ok && err == nil {
@@ -99,6 +121,8 @@ func (st State) Run(t *testing.T, args ...string) Result {
 		Err:     err,
 		Files:   tfs.Files(),
 		Pending: tfs.Pending(),
+
+		Metadata: tfs.Metadata(),
 	}
 }
 
@@ -139,7 +163,7 @@ func WithFile(location string, contents ...string) ExecuteOption {
 			tfs.ensureBucket(bucket)
 		}
 
-		wh, err := tfs.Create(ctx, loc)
+		wh, err := tfs.Create(ctx, loc, nil)
 		require.NoError(t, err)
 		defer func() { _ = wh.Abort() }()
 
@@ -169,7 +193,7 @@ func WithPendingFile(location string) ExecuteOption {
 			t.Fatalf("Invalid pending local file: %s", loc)
 		}
 
-		_, err = tfs.Create(ctx, loc)
+		_, err = tfs.Create(ctx, loc, nil)
 		require.NoError(t, err)
 	}}
 }
@@ -0,0 +1,115 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package controllers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/storj"
+	"storj.io/storj/multinode/nodes"
+	"storj.io/storj/multinode/retain"
+)
+
+var (
+	// ErrRetain is an error type for retain web api controller.
+	ErrRetain = errs.Class("retain web api controller")
+)
+
+// Retain is a retain web api controller.
+type Retain struct {
+	log     *zap.Logger
+	service *retain.Service
+}
+
+// NewRetain is a constructor of retain controller.
+func NewRetain(log *zap.Logger, service *retain.Service) *Retain {
+	return &Retain{
+		log:     log,
+		service: service,
+	}
+}
+
+// Reports handles retrieval of the retain reports of all nodes.
+func (controller *Retain) Reports(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Add("Content-Type", "application/json")
+
+	reports, err := controller.service.Reports(ctx)
+	if err != nil {
+		controller.log.Error("retain reports internal error", zap.Error(ErrRetain.Wrap(err)))
+		controller.serveError(w, http.StatusInternalServerError, ErrRetain.Wrap(err))
+		return
+	}
+
+	controller.serveReports(w, reports)
+}
+
+// NodeReports handles retrieval of the retain reports of a node.
+func (controller *Retain) NodeReports(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Add("Content-Type", "application/json")
+	segments := mux.Vars(r)
+
+	nodeIDEnc, ok := segments["nodeID"]
+	if !ok {
+		controller.serveError(w, http.StatusBadRequest, ErrRetain.New("could not receive node id segment"))
+		return
+	}
+	nodeID, err := storj.NodeIDFromString(nodeIDEnc)
+	if err != nil {
+		controller.serveError(w, http.StatusBadRequest, ErrRetain.Wrap(err))
+		return
+	}
+
+	reports, err := controller.service.NodeReports(ctx, nodeID)
+	if err != nil {
+		if nodes.ErrNoNode.Has(err) {
+			controller.serveError(w, http.StatusNotFound, ErrRetain.Wrap(err))
+			return
+		}
+
+		controller.log.Error("retain node reports internal error", zap.Error(ErrRetain.Wrap(err)))
+		controller.serveError(w, http.StatusInternalServerError, ErrRetain.Wrap(err))
+		return
+	}
+
+	controller.serveReports(w, reports)
+}
+
+// serveReports sends the reports as json.
+func (controller *Retain) serveReports(w http.ResponseWriter, reports []retain.Report) {
+	if len(reports) == 0 {
+		reports = make([]retain.Report, 0)
+	}
+	if err := json.NewEncoder(w).Encode(reports); err != nil {
+		controller.log.Error("failed to write json response", zap.Error(ErrRetain.Wrap(err)))
+		return
+	}
+}
+
+// serveError set http statuses and send json error.
+func (controller *Retain) serveError(w http.ResponseWriter, status int, err error) {
+	w.WriteHeader(status)
+
+	var response struct {
+		Error string `json:"error"`
+	}
+	response.Error = err.Error()
+
+	err = json.NewEncoder(w).Encode(response)
+	if err != nil {
+		controller.log.Error("failed to write json error response", zap.Error(err))
+	}
+}
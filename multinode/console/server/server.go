@@ -23,6 +23,7 @@ import (
 	"storj.io/storj/multinode/operators"
 	"storj.io/storj/multinode/payouts"
 	"storj.io/storj/multinode/reputation"
+	"storj.io/storj/multinode/retain"
 	"storj.io/storj/multinode/storage"
 )
 
@@ -45,6 +46,7 @@ type Services struct {
 	Storage    *storage.Service
 	Bandwidth  *bandwidth.Service
 	Reputation *reputation.Service
+	Retain     *retain.Service
 }
 
 // Server represents Multinode Dashboard http server.
@@ -62,6 +64,7 @@ type Server struct {
 	bandwidth  *bandwidth.Service
 	storage    *storage.Service
 	reputation *reputation.Service
+	retain     *retain.Service
 
 	index *template.Template
 }
@@ -78,6 +81,7 @@ func NewServer(log *zap.Logger, listener net.Listener, assets http.FileSystem, s
 		storage:    services.Storage,
 		bandwidth:  services.Bandwidth,
 		reputation: services.Reputation,
+		retain:     services.Retain,
 	}
 
 	router := mux.NewRouter()
@@ -134,6 +138,11 @@ func NewServer(log *zap.Logger, listener net.Listener, assets http.FileSystem, s
 	reputationRouter := apiRouter.PathPrefix("/reputation").Subrouter()
 	reputationRouter.HandleFunc("/satellites/{satelliteID}", reputationController.Stats)
 
+	retainController := controllers.NewRetain(server.log, server.retain)
+	retainRouter := apiRouter.PathPrefix("/retain").Subrouter()
+	retainRouter.HandleFunc("/reports", retainController.Reports).Methods(http.MethodGet)
+	retainRouter.HandleFunc("/reports/{nodeID}", retainController.NodeReports).Methods(http.MethodGet)
+
 	if server.assets != nil {
 		fs := http.FileServer(server.assets)
 		router.PathPrefix("/static/").Handler(http.StripPrefix("/static", fs))
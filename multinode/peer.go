@@ -23,6 +23,7 @@ import (
 	"storj.io/storj/multinode/operators"
 	"storj.io/storj/multinode/payouts"
 	"storj.io/storj/multinode/reputation"
+	"storj.io/storj/multinode/retain"
 	"storj.io/storj/multinode/storage"
 	"storj.io/storj/private/lifecycle"
 )
@@ -91,6 +92,10 @@ type Peer struct {
 		Service *reputation.Service
 	}
 
+	Retain struct {
+		Service *retain.Service
+	}
+
 	// Web server with web UI.
 	Console struct {
 		Listener net.Listener
@@ -169,6 +174,14 @@ func New(log *zap.Logger, full *identity.FullIdentity, config Config, db DB) (_
 		)
 	}
 
+	{ // retain setup
+		peer.Retain.Service = retain.NewService(
+			peer.Log.Named("retain:service"),
+			peer.Dialer,
+			peer.DB.Nodes(),
+		)
+	}
+
 	{ // console setup
 		peer.Console.Listener, err = net.Listen("tcp", config.Console.Address)
 		if err != nil {
@@ -192,6 +205,7 @@ func New(log *zap.Logger, full *identity.FullIdentity, config Config, db DB) (_
 				Storage:    peer.Storage.Service,
 				Bandwidth:  peer.Bandwidth.Service,
 				Reputation: peer.Reputation.Service,
+				Retain:     peer.Retain.Service,
 			},
 		)
 		if err != nil {
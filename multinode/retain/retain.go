@@ -0,0 +1,28 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package retain
+
+import (
+	"time"
+
+	"storj.io/common/storj"
+)
+
+// Report describes the outcome of a retain request processed by a node.
+type Report struct {
+	NodeID         storj.NodeID    `json:"nodeId"`
+	NodeName       string          `json:"nodeName"`
+	SatelliteID    storj.NodeID    `json:"satelliteId"`
+	CreatedBefore  time.Time       `json:"createdBefore"`
+	FilterSize     int64           `json:"filterSize"`
+	DryRun         bool            `json:"dryRun"`
+	PiecesCount    int64           `json:"piecesCount"`
+	PiecesSkipped  int64           `json:"piecesSkipped"`
+	PiecesToTrash  int64           `json:"piecesToTrash"`
+	BytesToTrash   int64           `json:"bytesToTrash"`
+	PiecesTrashed  int64           `json:"piecesTrashed"`
+	SamplePieceIDs []storj.PieceID `json:"samplePieceIds"`
+	StartedAt      time.Time       `json:"startedAt"`
+	FinishedAt     time.Time       `json:"finishedAt"`
+}
@@ -0,0 +1,133 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package retain
+
+import (
+	"context"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/rpc"
+	"storj.io/common/storj"
+	"storj.io/storj/multinode/nodes"
+	"storj.io/storj/private/multinodepb"
+)
+
+var (
+	mon = monkit.Package()
+	// Error is an error class for retain service error.
+	Error = errs.Class("retain")
+)
+
+// Service exposes the retain reports of the nodes.
+//
+// architecture: Service
+type Service struct {
+	log    *zap.Logger
+	dialer rpc.Dialer
+	nodes  nodes.DB
+}
+
+// NewService creates new instance of retain Service.
+func NewService(log *zap.Logger, dialer rpc.Dialer, nodes nodes.DB) *Service {
+	return &Service{
+		log:    log,
+		dialer: dialer,
+		nodes:  nodes,
+	}
+}
+
+// Reports retrieves the retain reports of all reachable nodes.
+func (service *Service) Reports(ctx context.Context) (_ []Report, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	nodeList, err := service.nodes.List(ctx)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	var reports []Report
+	for _, node := range nodeList {
+		nodeReports, err := service.dialReports(ctx, node)
+		if err != nil {
+			if nodes.ErrNodeNotReachable.Has(err) {
+				continue
+			}
+
+			return nil, Error.Wrap(err)
+		}
+
+		reports = append(reports, nodeReports...)
+	}
+
+	return reports, nil
+}
+
+// NodeReports retrieves the retain reports of a node.
+func (service *Service) NodeReports(ctx context.Context, nodeID storj.NodeID) (_ []Report, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	node, err := service.nodes.Get(ctx, nodeID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	reports, err := service.dialReports(ctx, node)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return reports, nil
+}
+
+// dialReports dials node and retrieves its retain reports.
+func (service *Service) dialReports(ctx context.Context, node nodes.Node) (_ []Report, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	conn, err := service.dialer.DialNodeURL(ctx, storj.NodeURL{
+		ID:      node.ID,
+		Address: node.PublicAddress,
+	})
+	if err != nil {
+		return nil, nodes.ErrNodeNotReachable.Wrap(err)
+	}
+	defer func() {
+		err = errs.Combine(err, conn.Close())
+	}()
+
+	retainClient := multinodepb.NewDRPCRetainClient(conn)
+
+	resp, err := retainClient.Reports(ctx, &multinodepb.RetainReportsRequest{
+		Header: &multinodepb.RequestHeader{
+			ApiKey: node.APISecret,
+		},
+	})
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	var reports []Report
+	for _, report := range resp.Reports {
+		reports = append(reports, Report{
+			NodeID:         node.ID,
+			NodeName:       node.Name,
+			SatelliteID:    report.SatelliteId,
+			CreatedBefore:  report.CreatedBefore,
+			FilterSize:     report.FilterSize,
+			DryRun:         report.DryRun,
+			PiecesCount:    report.PiecesCount,
+			PiecesSkipped:  report.PiecesSkipped,
+			PiecesToTrash:  report.PiecesToTrash,
+			BytesToTrash:   report.BytesToTrash,
+			PiecesTrashed:  report.PiecesTrashed,
+			SamplePieceIDs: report.SamplePieceIds,
+			StartedAt:      report.StartedAt,
+			FinishedAt:     report.FinishedAt,
+		})
+	}
+
+	return reports, nil
+}
@@ -4089,6 +4089,194 @@ func (m *SatellitePeriodPaystubResponse) GetPaystub() *Paystub {
 	return nil
 }
 
+type RetainReportsRequest struct {
+	Header               *RequestHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *RetainReportsRequest) Reset()         { *m = RetainReportsRequest{} }
+func (m *RetainReportsRequest) String() string { return proto.CompactTextString(m) }
+func (*RetainReportsRequest) ProtoMessage()    {}
+func (*RetainReportsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a45fd79b06f3a1b, []int{89}
+}
+func (m *RetainReportsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RetainReportsRequest.Unmarshal(m, b)
+}
+func (m *RetainReportsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RetainReportsRequest.Marshal(b, m, deterministic)
+}
+func (m *RetainReportsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetainReportsRequest.Merge(m, src)
+}
+func (m *RetainReportsRequest) XXX_Size() int {
+	return xxx_messageInfo_RetainReportsRequest.Size(m)
+}
+func (m *RetainReportsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetainReportsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RetainReportsRequest proto.InternalMessageInfo
+
+func (m *RetainReportsRequest) GetHeader() *RequestHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+type RetainReportsResponse struct {
+	Reports              []*RetainReport `protobuf:"bytes,1,rep,name=reports,proto3" json:"reports,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *RetainReportsResponse) Reset()         { *m = RetainReportsResponse{} }
+func (m *RetainReportsResponse) String() string { return proto.CompactTextString(m) }
+func (*RetainReportsResponse) ProtoMessage()    {}
+func (*RetainReportsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a45fd79b06f3a1b, []int{90}
+}
+func (m *RetainReportsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RetainReportsResponse.Unmarshal(m, b)
+}
+func (m *RetainReportsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RetainReportsResponse.Marshal(b, m, deterministic)
+}
+func (m *RetainReportsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetainReportsResponse.Merge(m, src)
+}
+func (m *RetainReportsResponse) XXX_Size() int {
+	return xxx_messageInfo_RetainReportsResponse.Size(m)
+}
+func (m *RetainReportsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetainReportsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RetainReportsResponse proto.InternalMessageInfo
+
+func (m *RetainReportsResponse) GetReports() []*RetainReport {
+	if m != nil {
+		return m.Reports
+	}
+	return nil
+}
+
+type RetainReport struct {
+	SatelliteId          NodeID    `protobuf:"bytes,1,opt,name=satellite_id,json=satelliteId,proto3,customtype=NodeID" json:"satellite_id"`
+	CreatedBefore        time.Time `protobuf:"bytes,2,opt,name=created_before,json=createdBefore,proto3,stdtime" json:"created_before"`
+	FilterSize           int64     `protobuf:"varint,3,opt,name=filter_size,json=filterSize,proto3" json:"filter_size,omitempty"`
+	DryRun               bool      `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	PiecesCount          int64     `protobuf:"varint,5,opt,name=pieces_count,json=piecesCount,proto3" json:"pieces_count,omitempty"`
+	PiecesSkipped        int64     `protobuf:"varint,6,opt,name=pieces_skipped,json=piecesSkipped,proto3" json:"pieces_skipped,omitempty"`
+	PiecesToTrash        int64     `protobuf:"varint,7,opt,name=pieces_to_trash,json=piecesToTrash,proto3" json:"pieces_to_trash,omitempty"`
+	BytesToTrash         int64     `protobuf:"varint,8,opt,name=bytes_to_trash,json=bytesToTrash,proto3" json:"bytes_to_trash,omitempty"`
+	PiecesTrashed        int64     `protobuf:"varint,9,opt,name=pieces_trashed,json=piecesTrashed,proto3" json:"pieces_trashed,omitempty"`
+	SamplePieceIds       []PieceID `protobuf:"bytes,10,rep,name=sample_piece_ids,json=samplePieceIds,proto3,customtype=PieceID" json:"sample_piece_ids"`
+	StartedAt            time.Time `protobuf:"bytes,11,opt,name=started_at,json=startedAt,proto3,stdtime" json:"started_at"`
+	FinishedAt           time.Time `protobuf:"bytes,12,opt,name=finished_at,json=finishedAt,proto3,stdtime" json:"finished_at"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *RetainReport) Reset()         { *m = RetainReport{} }
+func (m *RetainReport) String() string { return proto.CompactTextString(m) }
+func (*RetainReport) ProtoMessage()    {}
+func (*RetainReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a45fd79b06f3a1b, []int{91}
+}
+func (m *RetainReport) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RetainReport.Unmarshal(m, b)
+}
+func (m *RetainReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RetainReport.Marshal(b, m, deterministic)
+}
+func (m *RetainReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetainReport.Merge(m, src)
+}
+func (m *RetainReport) XXX_Size() int {
+	return xxx_messageInfo_RetainReport.Size(m)
+}
+func (m *RetainReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetainReport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RetainReport proto.InternalMessageInfo
+
+func (m *RetainReport) GetCreatedBefore() time.Time {
+	if m != nil {
+		return m.CreatedBefore
+	}
+	return time.Time{}
+}
+
+func (m *RetainReport) GetFilterSize() int64 {
+	if m != nil {
+		return m.FilterSize
+	}
+	return 0
+}
+
+func (m *RetainReport) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+func (m *RetainReport) GetPiecesCount() int64 {
+	if m != nil {
+		return m.PiecesCount
+	}
+	return 0
+}
+
+func (m *RetainReport) GetPiecesSkipped() int64 {
+	if m != nil {
+		return m.PiecesSkipped
+	}
+	return 0
+}
+
+func (m *RetainReport) GetPiecesToTrash() int64 {
+	if m != nil {
+		return m.PiecesToTrash
+	}
+	return 0
+}
+
+func (m *RetainReport) GetBytesToTrash() int64 {
+	if m != nil {
+		return m.BytesToTrash
+	}
+	return 0
+}
+
+func (m *RetainReport) GetPiecesTrashed() int64 {
+	if m != nil {
+		return m.PiecesTrashed
+	}
+	return 0
+}
+
+func (m *RetainReport) GetStartedAt() time.Time {
+	if m != nil {
+		return m.StartedAt
+	}
+	return time.Time{}
+}
+
+func (m *RetainReport) GetFinishedAt() time.Time {
+	if m != nil {
+		return m.FinishedAt
+	}
+	return time.Time{}
+}
+
 func init() {
 	proto.RegisterType((*RequestHeader)(nil), "multinode.RequestHeader")
 	proto.RegisterType((*DiskSpaceRequest)(nil), "multinode.DiskSpaceRequest")
@@ -4184,188 +4372,207 @@ func init() {
 	proto.RegisterType((*PeriodPaystubResponse)(nil), "multinode.PeriodPaystubResponse")
 	proto.RegisterType((*SatellitePeriodPaystubRequest)(nil), "multinode.SatellitePeriodPaystubRequest")
 	proto.RegisterType((*SatellitePeriodPaystubResponse)(nil), "multinode.SatellitePeriodPaystubResponse")
+	proto.RegisterType((*RetainReportsRequest)(nil), "multinode.RetainReportsRequest")
+	proto.RegisterType((*RetainReportsResponse)(nil), "multinode.RetainReportsResponse")
+	proto.RegisterType((*RetainReport)(nil), "multinode.RetainReport")
 }
 
 func init() { proto.RegisterFile("multinode.proto", fileDescriptor_9a45fd79b06f3a1b) }
 
 var fileDescriptor_9a45fd79b06f3a1b = []byte{
-	// 2847 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0xcd, 0x73, 0xdb, 0xc6,
-	0x15, 0x2f, 0x45, 0x89, 0x14, 0x1f, 0x49, 0x51, 0xda, 0xe8, 0x83, 0x82, 0xf5, 0x65, 0xc8, 0xb5,
-	0xe5, 0xc6, 0x91, 0x13, 0x25, 0x93, 0x36, 0x69, 0x32, 0x0d, 0x65, 0x3b, 0x91, 0x12, 0x39, 0x56,
-	0x21, 0x3b, 0xcd, 0xc4, 0x9d, 0x30, 0x10, 0xb1, 0x92, 0x90, 0x80, 0x00, 0x03, 0x2c, 0xa5, 0x6a,
-	0xa6, 0x93, 0xf6, 0xd0, 0xa6, 0xa7, 0xce, 0xf4, 0x9c, 0xe9, 0x5f, 0xd1, 0x4b, 0x8f, 0xbd, 0x75,
-	0x32, 0xd3, 0xff, 0xa0, 0x87, 0x74, 0xa6, 0xb7, 0x5e, 0x7a, 0xe9, 0xad, 0xa7, 0xce, 0x7e, 0x00,
-	0xc4, 0xe2, 0x8b, 0x12, 0x68, 0x8f, 0x7a, 0xc3, 0xee, 0xbe, 0xf7, 0xdb, 0xf7, 0xde, 0xee, 0x3e,
-	0xbc, 0xf7, 0x76, 0xa1, 0xd1, 0xed, 0x5b, 0xc4, 0xb4, 0x1d, 0x03, 0x6f, 0xf6, 0x5c, 0x87, 0x38,
-	0xa8, 0x12, 0x74, 0x28, 0x70, 0xec, 0x1c, 0x3b, 0xbc, 0x5b, 0x59, 0x3d, 0x76, 0x9c, 0x63, 0x0b,
-	0xdf, 0x65, 0xad, 0xc3, 0xfe, 0xd1, 0x5d, 0x62, 0x76, 0xb1, 0x47, 0xf4, 0x6e, 0x8f, 0x13, 0xa8,
-	0x1b, 0x50, 0xd7, 0xf0, 0x97, 0x7d, 0xec, 0x91, 0x1d, 0xac, 0x1b, 0xd8, 0x45, 0x0b, 0x50, 0xd6,
-	0x7b, 0x66, 0xfb, 0x0b, 0x7c, 0xde, 0x2c, 0xac, 0x15, 0x36, 0x6a, 0x5a, 0x49, 0xef, 0x99, 0x1f,
-	0xe0, 0x73, 0xf5, 0x3e, 0x4c, 0xdf, 0x37, 0xbd, 0x2f, 0x0e, 0x7a, 0x7a, 0x07, 0x0b, 0x16, 0xf4,
-	0x32, 0x94, 0x4e, 0x18, 0x1b, 0xa3, 0xad, 0x6e, 0x35, 0x37, 0x07, 0x72, 0x49, 0xb0, 0x9a, 0xa0,
-	0x53, 0xff, 0x52, 0x80, 0x99, 0x10, 0x8c, 0xd7, 0x73, 0x6c, 0x0f, 0xa3, 0x25, 0xa8, 0xe8, 0x96,
-	0xe5, 0x74, 0x74, 0x82, 0x0d, 0x06, 0x55, 0xd4, 0x06, 0x1d, 0x68, 0x15, 0xaa, 0x7d, 0x0f, 0x1b,
-	0xed, 0x9e, 0x89, 0x3b, 0xd8, 0x6b, 0x8e, 0xb1, 0x71, 0xa0, 0x5d, 0xfb, 0xac, 0x07, 0x2d, 0x03,
-	0x6b, 0xb5, 0x89, 0xab, 0x7b, 0x27, 0xcd, 0x22, 0xe7, 0xa7, 0x3d, 0x8f, 0x69, 0x07, 0x42, 0x30,
-	0x7e, 0xe4, 0x62, 0xdc, 0x1c, 0x67, 0x03, 0xec, 0x9b, 0xcd, 0x78, 0xaa, 0x9b, 0x96, 0x7e, 0x68,
-	0xe1, 0xe6, 0x84, 0x98, 0xd1, 0xef, 0x40, 0x0a, 0x4c, 0x3a, 0xa7, 0xd8, 0xa5, 0x10, 0xcd, 0x12,
-	0x1b, 0x0c, 0xda, 0xea, 0xaf, 0xa0, 0x76, 0x40, 0x1c, 0x57, 0x3f, 0xc6, 0x4f, 0x3c, 0xfd, 0x18,
-	0x23, 0x15, 0xea, 0x3a, 0x69, 0xbb, 0xd8, 0x23, 0x6d, 0xe2, 0x10, 0xdd, 0x62, 0xf2, 0x17, 0xb4,
-	0xaa, 0x4e, 0x34, 0xec, 0x91, 0xc7, 0xb4, 0x0b, 0x7d, 0x00, 0x53, 0xa6, 0x4d, 0xb0, 0x7b, 0xaa,
-	0x5b, 0x6d, 0x8f, 0xe8, 0x2e, 0x61, 0x4a, 0x54, 0xb7, 0x94, 0x4d, 0xbe, 0x3e, 0x9b, 0xfe, 0xfa,
-	0x6c, 0x3e, 0xf6, 0xd7, 0x67, 0x7b, 0xf2, 0xdb, 0xef, 0x56, 0xbf, 0xf7, 0x87, 0x7f, 0xac, 0x16,
-	0xb4, 0xba, 0xcf, 0x7b, 0x40, 0x59, 0xd5, 0x3f, 0x17, 0xe0, 0x85, 0xb0, 0x04, 0xb9, 0x17, 0x03,
-	0xfd, 0x88, 0x1a, 0xc6, 0xe9, 0x5e, 0x4a, 0x18, 0xc6, 0x81, 0x5e, 0x83, 0x31, 0xe2, 0x30, 0x4b,
-	0x5f, 0x94, 0x6f, 0x8c, 0x38, 0xaa, 0x0d, 0xb3, 0xb2, 0xe0, 0x62, 0xf9, 0xdf, 0x82, 0xba, 0xc7,
-	0xfb, 0xdb, 0x7d, 0x3a, 0xd0, 0x2c, 0xac, 0x15, 0x37, 0xaa, 0x5b, 0x0b, 0x21, 0x05, 0x24, 0xbe,
-	0x9a, 0x17, 0x5e, 0x80, 0x26, 0x94, 0xbd, 0x7e, 0xb7, 0xab, 0xbb, 0xe7, 0x4c, 0x91, 0x82, 0xe6,
-	0x37, 0xd5, 0xff, 0x14, 0x60, 0x29, 0xcc, 0x78, 0xa0, 0x13, 0x6c, 0x59, 0x26, 0x19, 0xc1, 0x64,
-	0xaf, 0x40, 0xcd, 0xf3, 0x51, 0xda, 0xa6, 0xc1, 0x66, 0xac, 0x6d, 0x4f, 0x51, 0x35, 0xff, 0xfe,
-	0xdd, 0x6a, 0xe9, 0x43, 0xc7, 0xc0, 0xbb, 0xf7, 0xb5, 0x6a, 0x40, 0xb3, 0x6b, 0x04, 0x56, 0x2e,
-	0xe6, 0xb4, 0xf2, 0xf8, 0x25, 0xad, 0x7c, 0x06, 0xcb, 0x29, 0x4a, 0x3f, 0x67, 0x73, 0xef, 0xc3,
-	0xd2, 0xb6, 0x6e, 0x1b, 0x67, 0xa6, 0x41, 0x4e, 0x1e, 0x3a, 0x36, 0x39, 0x39, 0xe0, 0x03, 0xf9,
-	0xbd, 0xc5, 0xab, 0xb0, 0x9c, 0x82, 0x28, 0x54, 0x41, 0x30, 0xce, 0x0e, 0x29, 0xf7, 0x19, 0xec,
-	0x5b, 0xfd, 0x5d, 0x01, 0xd6, 0x02, 0x2e, 0xc1, 0x70, 0x25, 0x2b, 0xaf, 0xbe, 0x0d, 0xd7, 0x33,
-	0x04, 0x11, 0x2a, 0x84, 0xec, 0xc9, 0xb5, 0x08, 0xec, 0xf9, 0x01, 0x2c, 0x44, 0xd9, 0xf3, 0x9b,
-	0xf2, 0x35, 0x68, 0xc6, 0xc1, 0x86, 0x8a, 0xf0, 0x9b, 0x02, 0x2c, 0x3f, 0x38, 0x76, 0xb1, 0xe7,
-	0x5d, 0xa9, 0x21, 0xdf, 0x84, 0x95, 0x34, 0x29, 0x86, 0xaa, 0xb0, 0x03, 0xb3, 0x12, 0x6f, 0x7e,
-	0x13, 0xbe, 0x02, 0x73, 0x11, 0xa4, 0xa1, 0x93, 0xff, 0xb6, 0x00, 0x2b, 0xbb, 0xf6, 0xd5, 0x1b,
-	0xf0, 0xc7, 0xb0, 0x9a, 0x2a, 0xc6, 0x50, 0x25, 0x76, 0x61, 0x4e, 0x66, 0xce, 0x6f, 0xc2, 0x2d,
-	0x98, 0x8f, 0x42, 0x0d, 0x9d, 0xfe, 0x97, 0x30, 0x77, 0x5f, 0x37, 0xad, 0x2b, 0xb2, 0xdc, 0x01,
-	0xcc, 0x47, 0x67, 0x17, 0x12, 0xbf, 0x01, 0x35, 0xe6, 0x3e, 0xdb, 0xae, 0x63, 0x59, 0xfd, 0x9e,
-	0xf0, 0xa2, 0xf3, 0x21, 0x21, 0xb8, 0xfb, 0x64, 0xa3, 0x5a, 0xb5, 0x3f, 0x68, 0xa8, 0xef, 0x40,
-	0x8d, 0x81, 0xe6, 0x37, 0xe4, 0xfb, 0x50, 0x17, 0x08, 0xa3, 0x4b, 0xf3, 0xb7, 0x02, 0x54, 0x43,
-	0x83, 0xe8, 0x36, 0x94, 0x30, 0x5b, 0x23, 0x21, 0xcd, 0x4c, 0x08, 0x84, 0x1f, 0x00, 0x4d, 0x10,
-	0xa0, 0x3b, 0x50, 0x36, 0xf9, 0x7a, 0x8a, 0x20, 0x02, 0x85, 0x68, 0xc5, 0x4a, 0x6b, 0x3e, 0x09,
-	0x9a, 0x87, 0x92, 0x81, 0x2d, 0x4c, 0xb0, 0x88, 0xd1, 0x44, 0x2b, 0x21, 0x3c, 0x1a, 0xcf, 0x1f,
-	0x1e, 0xed, 0x41, 0xe9, 0x41, 0x30, 0x9d, 0x8b, 0x7b, 0xba, 0xe9, 0x8a, 0x1d, 0x25, 0x5a, 0x68,
-	0x16, 0x26, 0xf4, 0xbe, 0x61, 0x12, 0x11, 0x49, 0xf2, 0x06, 0xed, 0xe5, 0x7f, 0x43, 0x2e, 0x1b,
-	0x6f, 0xa8, 0x3f, 0x84, 0xf2, 0xae, 0x2d, 0xc3, 0x19, 0x12, 0x9c, 0x31, 0x60, 0x1c, 0x0b, 0x33,
-	0x6e, 0xc3, 0xd4, 0x47, 0xd8, 0xf5, 0x4c, 0xc7, 0xce, 0xbf, 0xc8, 0x2f, 0x42, 0x23, 0xc0, 0x18,
-	0x1c, 0x93, 0x53, 0xde, 0xc5, 0x50, 0x2a, 0x9a, 0xdf, 0x54, 0xdf, 0x05, 0xb4, 0xa7, 0x7b, 0xe4,
-	0x9e, 0x63, 0x13, 0xbd, 0x43, 0xf2, 0x4f, 0xfa, 0x29, 0xbc, 0x20, 0xe1, 0x88, 0x89, 0xdf, 0x83,
-	0x9a, 0xa5, 0x7b, 0xa4, 0xdd, 0xe1, 0xfd, 0x02, 0xee, 0x62, 0x2b, 0x54, 0xb5, 0x06, 0x80, 0xea,
-	0x2f, 0x60, 0x46, 0xc3, 0xbd, 0x3e, 0xd1, 0xc9, 0x28, 0xb6, 0xc9, 0x73, 0x94, 0xbf, 0x29, 0x40,
-	0xb5, 0x45, 0xd7, 0xfa, 0x67, 0xa6, 0x6d, 0x38, 0x67, 0x54, 0xa5, 0x33, 0xf6, 0x25, 0x36, 0xdd,
-	0xa5, 0x54, 0xe2, 0x9c, 0x6c, 0xcb, 0xa1, 0xeb, 0x50, 0x73, 0x6c, 0xcb, 0xb4, 0x71, 0xbb, 0xe3,
-	0xf4, 0x6d, 0xbe, 0xaf, 0x26, 0xb4, 0x2a, 0xef, 0xbb, 0x47, 0xbb, 0x68, 0x0e, 0xc3, 0xb2, 0x03,
-	0x41, 0x51, 0x64, 0x14, 0xc0, 0xba, 0x18, 0x81, 0xfa, 0xdf, 0x32, 0xa0, 0xb0, 0x5d, 0x82, 0x58,
-	0xad, 0xc4, 0x61, 0x84, 0x74, 0x37, 0x24, 0xc3, 0x44, 0xc9, 0x37, 0x1f, 0x31, 0x5a, 0x4d, 0xf0,
-	0xa0, 0x37, 0xc2, 0x3b, 0xbd, 0xba, 0xb5, 0x9e, 0xcd, 0xcc, 0x6c, 0xe3, 0x1f, 0x87, 0x87, 0xd0,
-	0x30, 0x4c, 0xef, 0xcb, 0xbe, 0x6e, 0x99, 0x47, 0x26, 0x36, 0xda, 0x3a, 0xb9, 0x60, 0x00, 0x5b,
-	0x60, 0xf6, 0x99, 0x0a, 0x33, 0xb7, 0x08, 0xb5, 0xb5, 0xd7, 0xf7, 0x7a, 0xd8, 0x36, 0x38, 0xd6,
-	0xf8, 0x25, 0xb0, 0xaa, 0x01, 0x67, 0x8b, 0xa0, 0x8f, 0x60, 0xd6, 0x39, 0x3a, 0x62, 0xc6, 0x96,
-	0x00, 0x27, 0x2e, 0x01, 0x88, 0x04, 0xc2, 0x41, 0x08, 0xf7, 0x29, 0x2c, 0xf8, 0xb8, 0x7d, 0xdb,
-	0xc0, 0x6e, 0xdb, 0xc5, 0xa7, 0x26, 0x3e, 0xa3, 0xd0, 0xa5, 0x4b, 0x40, 0xfb, 0xc2, 0x3d, 0xa1,
-	0x18, 0x1a, 0x83, 0x68, 0x11, 0xd4, 0x82, 0xca, 0x29, 0x26, 0x84, 0x4b, 0x5a, 0xb9, 0x04, 0xdc,
-	0x24, 0x67, 0x6b, 0x11, 0x74, 0x0f, 0xa0, 0xdf, 0x33, 0x74, 0x81, 0x51, 0xbe, 0xc4, 0x56, 0xad,
-	0x08, 0x3e, 0x2e, 0xc7, 0xe7, 0x8e, 0x69, 0x73, 0x8c, 0xc9, 0x4b, 0x60, 0x4c, 0x72, 0xb6, 0x16,
-	0x51, 0x56, 0xa0, 0xc4, 0x37, 0x19, 0xf5, 0x7b, 0x5e, 0xc7, 0x71, 0xb1, 0x48, 0x78, 0x79, 0x43,
-	0xf9, 0xd3, 0x18, 0x4c, 0xb4, 0x7c, 0x87, 0x1a, 0x1f, 0x47, 0xb7, 0x61, 0x9a, 0xaf, 0x1b, 0x75,
-	0x5a, 0x6d, 0x4e, 0xc0, 0xf3, 0x88, 0xc6, 0xa0, 0xff, 0x80, 0x91, 0x26, 0x9c, 0x99, 0x62, 0xf8,
-	0xcc, 0xa0, 0x75, 0xa8, 0x7b, 0xfd, 0x4e, 0x07, 0x7b, 0x9e, 0x20, 0xe1, 0x19, 0x7e, 0x4d, 0x74,
-	0x72, 0x22, 0xea, 0xed, 0xad, 0xde, 0x89, 0xce, 0x76, 0x48, 0x41, 0xe3, 0x0d, 0x9a, 0x38, 0x1c,
-	0x62, 0xa2, 0xb3, 0xb5, 0x2d, 0x68, 0xec, 0x9b, 0xc2, 0xf5, 0xed, 0x2f, 0x6c, 0xe7, 0xcc, 0x6e,
-	0x73, 0x8e, 0x32, 0x1b, 0xac, 0x89, 0xce, 0x16, 0x63, 0xbc, 0x0e, 0x7e, 0xbb, 0xcd, 0x00, 0x26,
-	0x79, 0xb6, 0x2f, 0xfa, 0xb6, 0x29, 0xce, 0xcb, 0x50, 0x3e, 0x31, 0x69, 0xce, 0x74, 0xde, 0xac,
-	0xc4, 0xfe, 0xc2, 0x21, 0x07, 0xa4, 0xf9, 0x64, 0xea, 0x1e, 0x34, 0x1f, 0xbb, 0x7d, 0x8f, 0x60,
-	0x23, 0x08, 0x33, 0xbc, 0xfc, 0x1e, 0xfc, 0xaf, 0x05, 0x58, 0x4c, 0x80, 0x13, 0x1e, 0xe5, 0x29,
-	0x20, 0xc2, 0x07, 0xdb, 0x81, 0x73, 0xf4, 0x44, 0xb8, 0x70, 0x27, 0x84, 0x9d, 0x8a, 0xb0, 0x49,
-	0x7d, 0xeb, 0x13, 0x6d, 0x4f, 0x9b, 0x21, 0x51, 0x12, 0x65, 0x0f, 0xca, 0x62, 0x14, 0xdd, 0x82,
-	0x32, 0xc5, 0x69, 0x8b, 0xff, 0x65, 0xdc, 0x37, 0x97, 0xe8, 0xf0, 0xae, 0x41, 0x7f, 0x69, 0xba,
-	0x61, 0x04, 0x31, 0x44, 0x45, 0xf3, 0x9b, 0xea, 0x3d, 0x68, 0x3c, 0xea, 0x61, 0x57, 0x27, 0x8e,
-	0x9b, 0xdf, 0x1a, 0x26, 0x4c, 0x0f, 0x40, 0x84, 0x0d, 0x66, 0x61, 0x02, 0x77, 0x75, 0xd3, 0x12,
-	0xff, 0x50, 0xde, 0xa0, 0x3f, 0xf8, 0x33, 0xdd, 0xb2, 0x30, 0x11, 0x72, 0x88, 0x16, 0xba, 0x05,
-	0x0d, 0xfe, 0xd5, 0x3e, 0xc2, 0x3a, 0xe9, 0xbb, 0xd8, 0x6b, 0x16, 0xd7, 0x8a, 0x1b, 0x15, 0x6d,
-	0x8a, 0x77, 0xbf, 0x2b, 0x7a, 0xd5, 0xaf, 0x0b, 0xb0, 0xfa, 0xc0, 0x23, 0x66, 0x97, 0x1e, 0xb7,
-	0x7d, 0xfd, 0xdc, 0xe9, 0x93, 0xab, 0x09, 0x5a, 0x7f, 0x0a, 0x6b, 0xe9, 0x72, 0x08, 0x1b, 0xbc,
-	0x04, 0x08, 0xfb, 0x34, 0x6d, 0xac, 0xbb, 0xb6, 0x69, 0x1f, 0x7b, 0x22, 0xb4, 0x99, 0x09, 0x46,
-	0x1e, 0x88, 0x01, 0xf5, 0x7d, 0x98, 0x8f, 0x40, 0xe6, 0x5f, 0x92, 0x1d, 0x58, 0x88, 0x61, 0xe5,
-	0x93, 0x6a, 0x1b, 0xa6, 0x46, 0xce, 0x49, 0x76, 0xa1, 0x11, 0x4d, 0x46, 0x5e, 0x87, 0x6a, 0x8f,
-	0xc9, 0xd5, 0x36, 0xed, 0x23, 0x47, 0x20, 0xcd, 0x85, 0x90, 0xb8, 0xd4, 0xbb, 0xf6, 0x91, 0xa3,
-	0x41, 0x2f, 0xf8, 0x56, 0x3f, 0x83, 0x59, 0x01, 0xb5, 0x8f, 0x5d, 0xd3, 0x31, 0xf2, 0x2f, 0xfa,
-	0x3c, 0x94, 0x7a, 0x0c, 0xc2, 0xdf, 0x8b, 0xbc, 0xa5, 0x3e, 0x82, 0xb9, 0xc8, 0x0c, 0x23, 0x8a,
-	0xfc, 0x15, 0x2c, 0x5c, 0x69, 0x66, 0xaa, 0x41, 0x33, 0x35, 0x25, 0xcd, 0xab, 0xd3, 0x1f, 0x0b,
-	0xb0, 0x1c, 0x05, 0x1d, 0x75, 0x41, 0x72, 0x14, 0xfe, 0x06, 0x6b, 0x58, 0x94, 0xd6, 0xf0, 0x63,
-	0x58, 0x49, 0x93, 0x6e, 0x44, 0xc5, 0x5b, 0x50, 0xa7, 0x47, 0x03, 0xe7, 0xd7, 0x53, 0xbd, 0x09,
-	0x53, 0x3e, 0xc4, 0xc0, 0x59, 0x0e, 0x0a, 0xdb, 0x45, 0x8d, 0x37, 0x98, 0x3f, 0x60, 0x74, 0xa3,
-	0x6f, 0x1b, 0xf5, 0x33, 0x58, 0x88, 0x61, 0x89, 0xc9, 0x1f, 0xc0, 0x34, 0x66, 0x43, 0x83, 0x9f,
-	0x95, 0xf8, 0x57, 0x29, 0xe1, 0xac, 0x34, 0xc2, 0xdd, 0xc0, 0x72, 0x87, 0xfa, 0x09, 0x34, 0x22,
-	0x34, 0xc9, 0x6a, 0xe5, 0xd9, 0xc1, 0x3b, 0x30, 0xfb, 0xc4, 0x36, 0x4c, 0x8f, 0xb8, 0xe6, 0x61,
-	0x9f, 0x8c, 0x62, 0xfb, 0x97, 0x60, 0x2e, 0x82, 0x94, 0xb9, 0x04, 0x5f, 0xc1, 0xc2, 0xbe, 0x7e,
-	0xee, 0x91, 0xfe, 0xe1, 0xd5, 0x1c, 0xdd, 0x1d, 0x68, 0xc6, 0xe7, 0x17, 0x12, 0xdf, 0x81, 0x72,
-	0x8f, 0x8f, 0x09, 0x09, 0x90, 0xbc, 0x7b, 0xe9, 0x88, 0xe6, 0x93, 0x50, 0x37, 0xee, 0xf7, 0xe5,
-	0x36, 0xde, 0x4f, 0xa0, 0x11, 0x60, 0xe4, 0x12, 0xe2, 0x33, 0x98, 0x15, 0x7d, 0xcf, 0xcb, 0x79,
-	0x3f, 0x80, 0xb9, 0xc8, 0x0c, 0xb9, 0x04, 0xa5, 0xee, 0x2d, 0x6a, 0xf8, 0xff, 0x23, 0xf7, 0xf6,
-	0x21, 0xac, 0xa4, 0x49, 0x97, 0x4b, 0xdd, 0xd7, 0x00, 0x06, 0xee, 0x8e, 0x06, 0xee, 0x27, 0xd8,
-	0x0a, 0x2a, 0xfe, 0xf4, 0x9b, 0xf6, 0xb1, 0xba, 0x0c, 0x2f, 0xc0, 0xb0, 0x6f, 0xf5, 0xf7, 0x45,
-	0x28, 0x0b, 0x28, 0xa4, 0x42, 0x9d, 0xd7, 0xc6, 0xc4, 0x45, 0x9d, 0x7f, 0x45, 0xc7, 0x3a, 0x5b,
-	0xec, 0x9e, 0x0e, 0x5d, 0x83, 0x0a, 0xa7, 0x39, 0xc6, 0x7e, 0x61, 0x68, 0x92, 0x75, 0xbc, 0x87,
-	0x09, 0xda, 0x80, 0xe9, 0x60, 0xb0, 0x2d, 0x6a, 0x4a, 0x3c, 0x1d, 0x99, 0xf2, 0x69, 0x34, 0x5e,
-	0x5b, 0xba, 0x09, 0x8d, 0x01, 0x25, 0xcf, 0xbd, 0x79, 0x52, 0x52, 0xf7, 0x09, 0x79, 0x72, 0xb4,
-	0x06, 0xb5, 0x8e, 0xd3, 0xed, 0x05, 0x12, 0xf1, 0x2b, 0x48, 0xa0, 0x7d, 0x42, 0xa0, 0x45, 0x98,
-	0x64, 0x14, 0x54, 0x1e, 0x7e, 0x07, 0x59, 0xa6, 0x6d, 0x2a, 0xce, 0x4d, 0x68, 0xf8, 0x43, 0xbe,
-	0x34, 0x65, 0x3e, 0x89, 0xa0, 0x10, 0xc2, 0xdc, 0x80, 0xa9, 0x80, 0x8e, 0xcb, 0x32, 0xc9, 0x13,
-	0x24, 0x41, 0xc6, 0x45, 0xf1, 0x2d, 0x5a, 0x49, 0xb0, 0x28, 0x0c, 0x2c, 0x8a, 0xd6, 0xa0, 0x1a,
-	0xf2, 0x4d, 0xcd, 0x2a, 0x1b, 0x0a, 0x77, 0x21, 0x05, 0x26, 0x0d, 0xd3, 0xeb, 0x39, 0x1e, 0x36,
-	0x9a, 0x35, 0x6e, 0x42, 0xbf, 0x4d, 0x53, 0x9c, 0x1d, 0x6c, 0x19, 0xad, 0x2e, 0x4d, 0xca, 0x76,
-	0x78, 0xde, 0x93, 0xff, 0xb0, 0x7f, 0x3b, 0x06, 0x8b, 0x09, 0x70, 0x62, 0x7f, 0xed, 0x0f, 0x12,
-	0x30, 0xfe, 0xaf, 0x78, 0x3d, 0x04, 0x98, 0xca, 0x96, 0x30, 0xe2, 0xc3, 0x28, 0x6f, 0x01, 0x0c,
-	0x46, 0x43, 0x3b, 0xbf, 0x10, 0xde, 0xf9, 0xb4, 0x5f, 0xef, 0x06, 0x15, 0xa0, 0xa2, 0x26, 0x5a,
-	0xca, 0x37, 0x05, 0x98, 0x89, 0x81, 0xc7, 0x8e, 0x5c, 0x61, 0xf8, 0x91, 0xd3, 0xa0, 0x46, 0x97,
-	0xa7, 0xcd, 0x71, 0x69, 0xbe, 0x44, 0xb5, 0xbb, 0x7b, 0x49, 0xed, 0xb4, 0xea, 0x49, 0xf0, 0xed,
-	0xa9, 0x8f, 0xe0, 0x5a, 0x24, 0x18, 0x67, 0x77, 0xd6, 0xf9, 0xd7, 0xe6, 0x21, 0x2c, 0x25, 0x03,
-	0xe6, 0x0b, 0xf1, 0x1f, 0xc1, 0xb5, 0x96, 0x65, 0x0d, 0x72, 0xcc, 0x91, 0xe3, 0xfd, 0x8f, 0x60,
-	0x29, 0x19, 0x70, 0xc4, 0xe0, 0xab, 0x0b, 0xd7, 0x25, 0x5c, 0xee, 0xf4, 0x46, 0x15, 0x37, 0xf5,
-	0x67, 0xf2, 0x73, 0x50, 0xb3, 0xa6, 0x7b, 0x06, 0x69, 0x81, 0x0f, 0x3d, 0xb2, 0x0a, 0x39, 0xd3,
-	0x82, 0xd8, 0xfc, 0xcf, 0x22, 0x2d, 0x90, 0x7f, 0x49, 0x57, 0xa0, 0x5a, 0x66, 0x5a, 0x90, 0x22,
-	0xdd, 0x88, 0x8a, 0x3f, 0x84, 0x45, 0x1e, 0xfd, 0xee, 0x63, 0xf7, 0x19, 0x84, 0xeb, 0x1d, 0x50,
-	0x92, 0xe0, 0x9e, 0x6d, 0xc4, 0x1e, 0xde, 0x80, 0xa3, 0xc6, 0x86, 0x39, 0x83, 0xdb, 0xf8, 0xfc,
-	0xb9, 0xe3, 0x4a, 0xb6, 0x9c, 0x23, 0xab, 0x91, 0x15, 0x57, 0xca, 0x33, 0xe4, 0x8e, 0x2b, 0x23,
-	0x3b, 0xf0, 0x0a, 0x2c, 0x9f, 0x15, 0x57, 0xa6, 0x49, 0x97, 0x47, 0xdd, 0xad, 0x5f, 0x8f, 0x41,
-	0x59, 0xbc, 0x73, 0x41, 0xef, 0x42, 0x25, 0x78, 0x95, 0x86, 0xae, 0x85, 0xb8, 0xa2, 0x4f, 0xde,
-	0x94, 0xa5, 0xe4, 0x41, 0x21, 0xc1, 0x0e, 0x4c, 0xf0, 0x57, 0x32, 0x2b, 0x69, 0x8f, 0x69, 0x04,
-	0xcc, 0x6a, 0xea, 0xb8, 0x40, 0xea, 0xc0, 0x94, 0xfc, 0x7c, 0x07, 0xdd, 0x4a, 0x61, 0x89, 0x9e,
-	0x68, 0x65, 0x63, 0x38, 0x21, 0x9f, 0x64, 0xeb, 0x9f, 0x25, 0xa8, 0x04, 0xaf, 0x42, 0x90, 0x0e,
-	0xb5, 0xf0, 0x23, 0x1b, 0x69, 0xc2, 0xac, 0x87, 0x3d, 0xd2, 0x84, 0xd9, 0xef, 0x75, 0x4e, 0x61,
-	0x31, 0xf5, 0x45, 0x0c, 0x7a, 0x31, 0x09, 0x26, 0xa5, 0x38, 0xa5, 0xdc, 0xb9, 0x18, 0x71, 0x50,
-	0xf4, 0x9e, 0x8e, 0x12, 0x21, 0x35, 0x03, 0xc1, 0x9f, 0x65, 0x3d, 0x93, 0x46, 0x80, 0x77, 0x61,
-	0x3e, 0xf9, 0x75, 0x0a, 0xda, 0x88, 0xdd, 0x9c, 0xa7, 0xa9, 0x73, 0xfb, 0x02, 0x94, 0x62, 0x3a,
-	0x0d, 0xea, 0x12, 0x05, 0x5a, 0x4d, 0xe3, 0xf5, 0xc1, 0xd7, 0xd2, 0x09, 0x04, 0x66, 0x0f, 0x16,
-	0x52, 0xde, 0x87, 0xa0, 0xdb, 0xf1, 0x1b, 0xfd, 0x34, 0x25, 0x7e, 0x70, 0x11, 0x52, 0x31, 0xe3,
-	0x13, 0x98, 0x92, 0x49, 0xd0, 0x5a, 0x2a, 0xb7, 0x8f, 0x7f, 0x3d, 0x83, 0x62, 0x00, 0x2b, 0x3f,
-	0xd7, 0x90, 0x60, 0x13, 0xdf, 0x91, 0x48, 0xb0, 0x29, 0x6f, 0x3d, 0xde, 0x84, 0x09, 0x36, 0x82,
-	0x16, 0xa2, 0xb4, 0x3e, 0x48, 0x33, 0x3e, 0x20, 0x0e, 0xd9, 0xd7, 0x45, 0x18, 0xa7, 0x7e, 0x0e,
-	0xbd, 0x03, 0x65, 0x71, 0x9d, 0x8f, 0x16, 0x43, 0xd4, 0xf2, 0x33, 0x01, 0x45, 0x49, 0x1a, 0x12,
-	0x62, 0xec, 0x41, 0x35, 0x74, 0x37, 0x8f, 0x96, 0x43, 0xa4, 0xf1, 0xbb, 0x7f, 0x65, 0x25, 0x6d,
-	0x58, 0xa0, 0xed, 0x02, 0x0c, 0x6e, 0x81, 0xd1, 0x52, 0xca, 0xe5, 0x30, 0xc7, 0x5a, 0xce, 0xbc,
-	0x3a, 0x46, 0x9f, 0xc2, 0x4c, 0xec, 0xbe, 0x08, 0xad, 0x67, 0xdf, 0x26, 0x71, 0xe0, 0x1b, 0x17,
-	0xb9, 0x72, 0x42, 0xf7, 0x60, 0xd2, 0xbf, 0xc4, 0x41, 0x61, 0x03, 0x45, 0xae, 0x87, 0x94, 0x6b,
-	0x89, 0x63, 0x62, 0x21, 0xfe, 0x55, 0x61, 0x25, 0x01, 0xa7, 0x4f, 0x3c, 0xba, 0x16, 0xfe, 0xbe,
-	0x0b, 0xaf, 0x45, 0x64, 0xc3, 0x29, 0x49, 0x43, 0x83, 0x63, 0x28, 0x55, 0xe2, 0xa5, 0x63, 0x98,
-	0x74, 0x0b, 0x20, 0x1d, 0xc3, 0xe4, 0x22, 0xfe, 0x53, 0x98, 0x8e, 0x9d, 0x3f, 0x35, 0xce, 0x15,
-	0xdb, 0xc1, 0xeb, 0x99, 0x34, 0x03, 0x37, 0x95, 0x5c, 0x76, 0x96, 0xdc, 0x54, 0x66, 0xdd, 0x5c,
-	0x72, 0x53, 0x43, 0x6a, 0xd8, 0x6f, 0x43, 0x89, 0x07, 0x79, 0xa8, 0x19, 0x8b, 0xfb, 0x7c, 0xb8,
-	0xc5, 0x84, 0x11, 0xc1, 0xfe, 0x71, 0xbc, 0x62, 0x7b, 0x3d, 0x23, 0x7e, 0x14, 0x80, 0x6a, 0x16,
-	0x89, 0x40, 0xf6, 0xa0, 0x99, 0x76, 0x39, 0x86, 0xc2, 0x1e, 0x6c, 0xc8, 0x4d, 0x9e, 0xf2, 0xe2,
-	0x85, 0x68, 0x43, 0xea, 0xc8, 0x34, 0xb2, 0x3a, 0x89, 0x57, 0x6b, 0xb2, 0x3a, 0x29, 0x37, 0x66,
-	0x1a, 0xd4, 0xa5, 0xa2, 0xb1, 0xb4, 0x0f, 0x93, 0x0a, 0xd3, 0xd2, 0x3e, 0x4c, 0xae, 0x37, 0x3f,
-	0x85, 0xe9, 0x68, 0x09, 0x4f, 0xda, 0x87, 0x29, 0x65, 0x67, 0x69, 0x1f, 0xa6, 0x96, 0x86, 0xdf,
-	0x19, 0x14, 0xe6, 0x16, 0x13, 0xe2, 0xb3, 0x84, 0xa3, 0x17, 0x8d, 0xf3, 0x34, 0xa8, 0x4b, 0x75,
-	0x54, 0x49, 0xe5, 0xa4, 0x1a, 0xae, 0xa4, 0x72, 0x72, 0x09, 0xb6, 0x0b, 0xf3, 0xc9, 0x55, 0x4b,
-	0xe9, 0x74, 0x64, 0x96, 0x5d, 0xa5, 0xd3, 0x31, 0xa4, 0x04, 0xfa, 0x69, 0x52, 0x45, 0x68, 0x3d,
-	0xbb, 0x90, 0x13, 0x77, 0x98, 0xa9, 0xd5, 0x9e, 0xad, 0x7f, 0x57, 0xa0, 0x24, 0xf6, 0xd9, 0x31,
-	0xcc, 0x26, 0xd5, 0x3b, 0xd0, 0xcd, 0xf0, 0xab, 0x84, 0xf4, 0x0a, 0x8b, 0x72, 0x6b, 0x28, 0x9d,
-	0xd0, 0xe9, 0x1c, 0x94, 0xf4, 0x8a, 0x04, 0xba, 0x93, 0x06, 0x93, 0x94, 0x89, 0x2b, 0x2f, 0x5d,
-	0x90, 0x3a, 0xe4, 0x38, 0x23, 0xe5, 0x02, 0xd9, 0x71, 0x26, 0xd7, 0x32, 0x64, 0xc7, 0x99, 0x56,
-	0x6f, 0xa0, 0x8e, 0x33, 0x31, 0x31, 0x97, 0x1d, 0x67, 0x56, 0x65, 0x41, 0x76, 0x9c, 0xd9, 0x59,
-	0xfe, 0x88, 0x8e, 0x53, 0x07, 0x14, 0xcf, 0xce, 0xd1, 0x8d, 0x18, 0x43, 0x42, 0x2d, 0x40, 0xf9,
-	0xfe, 0x10, 0xaa, 0xab, 0xf4, 0xa0, 0xc7, 0x30, 0x9b, 0x54, 0x56, 0x94, 0xb6, 0x71, 0x46, 0x21,
-	0x53, 0xda, 0xc6, 0x99, 0xf5, 0xc9, 0xe7, 0xe4, 0x50, 0xa3, 0xd5, 0x84, 0xe4, 0xfd, 0x19, 0xf1,
-	0x82, 0xeb, 0x99, 0x34, 0xcf, 0xd4, 0xa1, 0x86, 0x33, 0x6a, 0xd9, 0xa1, 0x26, 0x54, 0x02, 0x64,
-	0x87, 0x9a, 0x98, 0x8c, 0xc7, 0x4f, 0x8d, 0x0f, 0x9e, 0x71, 0x6a, 0x22, 0xb3, 0xdc, 0xbe, 0x00,
-	0x25, 0x9f, 0x6e, 0xfb, 0xc6, 0x27, 0x2a, 0xf5, 0x80, 0x9f, 0x6f, 0x9a, 0xce, 0x5d, 0xf6, 0x71,
-	0xb7, 0xe7, 0x9a, 0xa7, 0x3a, 0xc1, 0x77, 0x03, 0x88, 0xde, 0xe1, 0x61, 0x89, 0xbd, 0x72, 0x7b,
-	0xf5, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xda, 0x95, 0x8e, 0x70, 0x40, 0x37, 0x00, 0x00,
+	// 3093 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0x4b, 0x6f, 0x1c, 0xc7,
+	0xb5, 0xbe, 0xa3, 0x21, 0x67, 0x38, 0x67, 0x86, 0x43, 0xb1, 0xcc, 0xc7, 0xb0, 0xc5, 0xc7, 0xb0,
+	0x29, 0x4b, 0xe4, 0xb5, 0x4c, 0xd9, 0xb4, 0xe1, 0x7b, 0xed, 0xd8, 0x88, 0x87, 0x12, 0x6d, 0xd2,
+	0xa2, 0x2c, 0xa6, 0x29, 0x29, 0x86, 0x1d, 0x78, 0xdc, 0x64, 0x17, 0xc9, 0xb6, 0x7a, 0xba, 0xdb,
+	0xdd, 0x35, 0x64, 0x68, 0x04, 0x4e, 0x16, 0x89, 0xb3, 0x0a, 0x90, 0xb5, 0x91, 0x5f, 0x91, 0x4d,
+	0x96, 0xd9, 0x05, 0x06, 0x02, 0xe4, 0x07, 0x64, 0xe1, 0x00, 0xd9, 0x65, 0x93, 0x4d, 0x76, 0x59,
+	0x05, 0xf5, 0xe8, 0xf7, 0x83, 0x9c, 0x1e, 0x19, 0xcc, 0xae, 0xeb, 0xd4, 0x77, 0xbe, 0x3a, 0x75,
+	0xaa, 0xea, 0x74, 0xd5, 0xa9, 0x82, 0x89, 0x5e, 0xdf, 0x20, 0xba, 0x69, 0x69, 0x78, 0xdd, 0x76,
+	0x2c, 0x62, 0xa1, 0x9a, 0x2f, 0x90, 0xe0, 0xd8, 0x3a, 0xb6, 0xb8, 0x58, 0x5a, 0x3a, 0xb6, 0xac,
+	0x63, 0x03, 0xdf, 0x65, 0xa5, 0x83, 0xfe, 0xd1, 0x5d, 0xa2, 0xf7, 0xb0, 0x4b, 0xd4, 0x9e, 0xcd,
+	0x01, 0xf2, 0x2a, 0x8c, 0x2b, 0xf8, 0x8b, 0x3e, 0x76, 0xc9, 0x36, 0x56, 0x35, 0xec, 0xa0, 0x59,
+	0xa8, 0xaa, 0xb6, 0xde, 0x7d, 0x86, 0xcf, 0x5b, 0xa5, 0x76, 0x69, 0xb5, 0xa1, 0x54, 0x54, 0x5b,
+	0x7f, 0x80, 0xcf, 0xe5, 0xfb, 0x70, 0xfd, 0xbe, 0xee, 0x3e, 0xdb, 0xb7, 0xd5, 0x43, 0x2c, 0x54,
+	0xd0, 0x2b, 0x50, 0x39, 0x61, 0x6a, 0x0c, 0x5b, 0xdf, 0x68, 0xad, 0x07, 0x76, 0x45, 0x68, 0x15,
+	0x81, 0x93, 0xff, 0x58, 0x82, 0xc9, 0x10, 0x8d, 0x6b, 0x5b, 0xa6, 0x8b, 0xd1, 0x3c, 0xd4, 0x54,
+	0xc3, 0xb0, 0x0e, 0x55, 0x82, 0x35, 0x46, 0x55, 0x56, 0x02, 0x01, 0x5a, 0x82, 0x7a, 0xdf, 0xc5,
+	0x5a, 0xd7, 0xd6, 0xf1, 0x21, 0x76, 0x5b, 0xd7, 0x58, 0x3d, 0x50, 0xd1, 0x1e, 0x93, 0xa0, 0x05,
+	0x60, 0xa5, 0x2e, 0x71, 0x54, 0xf7, 0xa4, 0x55, 0xe6, 0xfa, 0x54, 0xf2, 0x98, 0x0a, 0x10, 0x82,
+	0x91, 0x23, 0x07, 0xe3, 0xd6, 0x08, 0xab, 0x60, 0xdf, 0xac, 0xc5, 0x53, 0x55, 0x37, 0xd4, 0x03,
+	0x03, 0xb7, 0x46, 0x45, 0x8b, 0x9e, 0x00, 0x49, 0x30, 0x66, 0x9d, 0x62, 0x87, 0x52, 0xb4, 0x2a,
+	0xac, 0xd2, 0x2f, 0xcb, 0x3f, 0x87, 0xc6, 0x3e, 0xb1, 0x1c, 0xf5, 0x18, 0x3f, 0x71, 0xd5, 0x63,
+	0x8c, 0x64, 0x18, 0x57, 0x49, 0xd7, 0xc1, 0x2e, 0xe9, 0x12, 0x8b, 0xa8, 0x06, 0xb3, 0xbf, 0xa4,
+	0xd4, 0x55, 0xa2, 0x60, 0x97, 0x3c, 0xa6, 0x22, 0xf4, 0x00, 0x9a, 0xba, 0x49, 0xb0, 0x73, 0xaa,
+	0x1a, 0x5d, 0x97, 0xa8, 0x0e, 0x61, 0x9d, 0xa8, 0x6f, 0x48, 0xeb, 0x7c, 0x7c, 0xd6, 0xbd, 0xf1,
+	0x59, 0x7f, 0xec, 0x8d, 0xcf, 0xe6, 0xd8, 0xb7, 0xdf, 0x2d, 0xfd, 0xcf, 0x6f, 0xff, 0xb6, 0x54,
+	0x52, 0xc6, 0x3d, 0xdd, 0x7d, 0xaa, 0x2a, 0xff, 0xa1, 0x04, 0x2f, 0x84, 0x2d, 0x28, 0x3c, 0x18,
+	0xe8, 0xff, 0xa9, 0x63, 0xac, 0xde, 0x40, 0xc6, 0x30, 0x0d, 0xf4, 0x3a, 0x5c, 0x23, 0x56, 0xab,
+	0x3c, 0x80, 0xde, 0x35, 0x62, 0xc9, 0x26, 0x4c, 0x45, 0x0d, 0x17, 0xc3, 0xff, 0x36, 0x8c, 0xbb,
+	0x5c, 0xde, 0xed, 0xd3, 0x8a, 0x56, 0xa9, 0x5d, 0x5e, 0xad, 0x6f, 0xcc, 0x86, 0x3a, 0x10, 0xd1,
+	0x6b, 0xb8, 0xe1, 0x01, 0x68, 0x41, 0xd5, 0xed, 0xf7, 0x7a, 0xaa, 0x73, 0xce, 0x3a, 0x52, 0x52,
+	0xbc, 0xa2, 0xfc, 0xaf, 0x12, 0xcc, 0x87, 0x15, 0xf7, 0x55, 0x82, 0x0d, 0x43, 0x27, 0x43, 0xb8,
+	0xec, 0x55, 0x68, 0xb8, 0x1e, 0x4b, 0x57, 0xd7, 0x58, 0x8b, 0x8d, 0xcd, 0x26, 0xed, 0xe6, 0x5f,
+	0xbf, 0x5b, 0xaa, 0x7c, 0x68, 0x69, 0x78, 0xe7, 0xbe, 0x52, 0xf7, 0x31, 0x3b, 0x9a, 0xef, 0xe5,
+	0x72, 0x41, 0x2f, 0x8f, 0x0c, 0xe8, 0xe5, 0x33, 0x58, 0xc8, 0xe8, 0xf4, 0xf7, 0xec, 0xee, 0x3d,
+	0x98, 0xdf, 0x54, 0x4d, 0xed, 0x4c, 0xd7, 0xc8, 0xc9, 0x43, 0xcb, 0x24, 0x27, 0xfb, 0xbc, 0xa2,
+	0x78, 0xb4, 0x78, 0x0d, 0x16, 0x32, 0x18, 0x45, 0x57, 0x10, 0x8c, 0xb0, 0x45, 0xca, 0x63, 0x06,
+	0xfb, 0x96, 0x7f, 0x5d, 0x82, 0xb6, 0xaf, 0x25, 0x14, 0xae, 0x64, 0xe4, 0xe5, 0x77, 0x60, 0x39,
+	0xc7, 0x10, 0xd1, 0x85, 0x90, 0x3f, 0x79, 0x2f, 0x7c, 0x7f, 0x3e, 0x80, 0xd9, 0xb8, 0x7a, 0x71,
+	0x57, 0xbe, 0x0e, 0xad, 0x24, 0xd9, 0x85, 0x26, 0xfc, 0xb2, 0x04, 0x0b, 0x5b, 0xc7, 0x0e, 0x76,
+	0xdd, 0x2b, 0x75, 0xe4, 0x5b, 0xb0, 0x98, 0x65, 0xc5, 0x85, 0x5d, 0xd8, 0x86, 0xa9, 0x88, 0x6e,
+	0x71, 0x17, 0xbe, 0x0a, 0xd3, 0x31, 0xa6, 0x0b, 0x1b, 0xff, 0x55, 0x09, 0x16, 0x77, 0xcc, 0xab,
+	0x77, 0xe0, 0x0f, 0x60, 0x29, 0xd3, 0x8c, 0x0b, 0x3b, 0xb1, 0x03, 0xd3, 0x51, 0xe5, 0xe2, 0x2e,
+	0xdc, 0x80, 0x99, 0x38, 0xd5, 0x85, 0xcd, 0xff, 0x0c, 0xa6, 0xef, 0xab, 0xba, 0x71, 0x45, 0x9e,
+	0xdb, 0x87, 0x99, 0x78, 0xeb, 0xc2, 0xe2, 0x37, 0xa1, 0xc1, 0xc2, 0x67, 0xd7, 0xb1, 0x0c, 0xa3,
+	0x6f, 0x8b, 0x28, 0x3a, 0x13, 0x32, 0x82, 0x87, 0x4f, 0x56, 0xab, 0xd4, 0xfb, 0x41, 0x41, 0x7e,
+	0x17, 0x1a, 0x8c, 0xb4, 0xb8, 0x23, 0x3f, 0x80, 0x71, 0xc1, 0x30, 0xbc, 0x35, 0x7f, 0x2e, 0x41,
+	0x3d, 0x54, 0x89, 0xd6, 0xa0, 0x82, 0xd9, 0x18, 0x09, 0x6b, 0x26, 0x43, 0x24, 0x7c, 0x01, 0x28,
+	0x02, 0x80, 0xee, 0x40, 0x55, 0xe7, 0xe3, 0x29, 0x36, 0x11, 0x28, 0x84, 0x15, 0x23, 0xad, 0x78,
+	0x10, 0x34, 0x03, 0x15, 0x0d, 0x1b, 0x98, 0x60, 0xb1, 0x47, 0x13, 0xa5, 0x94, 0xed, 0xd1, 0x48,
+	0xf1, 0xed, 0xd1, 0x2e, 0x54, 0xb6, 0xfc, 0xe6, 0x1c, 0x6c, 0xab, 0xba, 0x23, 0x66, 0x94, 0x28,
+	0xa1, 0x29, 0x18, 0x55, 0xfb, 0x9a, 0x4e, 0xc4, 0x4e, 0x92, 0x17, 0xa8, 0x94, 0xff, 0x0d, 0xb9,
+	0x6d, 0xbc, 0x20, 0xff, 0x1f, 0x54, 0x77, 0xcc, 0x28, 0x9d, 0x16, 0xa1, 0xd3, 0x02, 0xc5, 0x6b,
+	0x61, 0xc5, 0x4d, 0x68, 0x3e, 0xc5, 0x8e, 0xab, 0x5b, 0x66, 0xf1, 0x41, 0x7e, 0x09, 0x26, 0x7c,
+	0x8e, 0x60, 0x99, 0x9c, 0x72, 0x11, 0x63, 0xa9, 0x29, 0x5e, 0x51, 0x7e, 0x0f, 0xd0, 0xae, 0xea,
+	0x92, 0x7b, 0x96, 0x49, 0xd4, 0x43, 0x52, 0xbc, 0xd1, 0x4f, 0xe1, 0x85, 0x08, 0x8f, 0x68, 0xf8,
+	0x7d, 0x68, 0x18, 0xaa, 0x4b, 0xba, 0x87, 0x5c, 0xde, 0x2a, 0x0d, 0x30, 0x42, 0x75, 0x23, 0x20,
+	0x94, 0x7f, 0x0a, 0x93, 0x0a, 0xb6, 0xfb, 0x44, 0x25, 0xc3, 0xf8, 0xa6, 0xc8, 0x52, 0xfe, 0xa6,
+	0x04, 0xf5, 0x0e, 0x1d, 0xeb, 0x1f, 0xeb, 0xa6, 0x66, 0x9d, 0xd1, 0x2e, 0x9d, 0xb1, 0x2f, 0x31,
+	0xe9, 0x06, 0xea, 0x12, 0xd7, 0x64, 0x53, 0x0e, 0x2d, 0x43, 0xc3, 0x32, 0x0d, 0xdd, 0xc4, 0xdd,
+	0x43, 0xab, 0x6f, 0xf2, 0x79, 0x35, 0xaa, 0xd4, 0xb9, 0xec, 0x1e, 0x15, 0xd1, 0x33, 0x0c, 0x3b,
+	0x1d, 0x08, 0x44, 0x99, 0x21, 0x80, 0x89, 0x18, 0x40, 0xfe, 0x77, 0x15, 0x50, 0xd8, 0x2f, 0xfe,
+	0x5e, 0xad, 0xc2, 0x69, 0x84, 0x75, 0x37, 0x23, 0x8e, 0x89, 0xc3, 0xd7, 0x1f, 0x31, 0xac, 0x22,
+	0x74, 0xd0, 0x9b, 0xe1, 0x99, 0x5e, 0xdf, 0x58, 0xc9, 0x57, 0x66, 0xbe, 0xf1, 0x96, 0xc3, 0x43,
+	0x98, 0xd0, 0x74, 0xf7, 0x8b, 0xbe, 0x6a, 0xe8, 0x47, 0x3a, 0xd6, 0xba, 0x2a, 0xb9, 0xe4, 0x06,
+	0xb6, 0xc4, 0xfc, 0xd3, 0x0c, 0x2b, 0x77, 0x08, 0xf5, 0xb5, 0xdb, 0x77, 0x6d, 0x6c, 0x6a, 0x9c,
+	0x6b, 0x64, 0x00, 0xae, 0xba, 0xaf, 0xd9, 0x21, 0xe8, 0x29, 0x4c, 0x59, 0x47, 0x47, 0xcc, 0xd9,
+	0x11, 0xc2, 0xd1, 0x01, 0x08, 0x91, 0x60, 0xd8, 0x0f, 0xf1, 0x7e, 0x02, 0xb3, 0x1e, 0x6f, 0xdf,
+	0xd4, 0xb0, 0xd3, 0x75, 0xf0, 0xa9, 0x8e, 0xcf, 0x28, 0x75, 0x65, 0x00, 0x6a, 0xcf, 0xb8, 0x27,
+	0x94, 0x43, 0x61, 0x14, 0x1d, 0x82, 0x3a, 0x50, 0x3b, 0xc5, 0x84, 0x70, 0x4b, 0x6b, 0x03, 0xd0,
+	0x8d, 0x71, 0xb5, 0x0e, 0x41, 0xf7, 0x00, 0xfa, 0xb6, 0xa6, 0x0a, 0x8e, 0xea, 0x00, 0x53, 0xb5,
+	0x26, 0xf4, 0xb8, 0x1d, 0x9f, 0x5b, 0xba, 0xc9, 0x39, 0xc6, 0x06, 0xe0, 0x18, 0xe3, 0x6a, 0x1d,
+	0x22, 0x2d, 0x42, 0x85, 0x4f, 0x32, 0x1a, 0xf7, 0xdc, 0x43, 0xcb, 0xc1, 0xe2, 0xc0, 0xcb, 0x0b,
+	0xd2, 0xef, 0xaf, 0xc1, 0x68, 0xc7, 0x0b, 0xa8, 0xc9, 0x7a, 0xb4, 0x06, 0xd7, 0xf9, 0xb8, 0xd1,
+	0xa0, 0xd5, 0xe5, 0x00, 0x7e, 0x8e, 0x98, 0x08, 0xe4, 0xfb, 0x0c, 0x9a, 0xb2, 0x66, 0xca, 0xe1,
+	0x35, 0x83, 0x56, 0x60, 0xdc, 0xed, 0x1f, 0x1e, 0x62, 0xd7, 0x15, 0x10, 0x7e, 0xc2, 0x6f, 0x08,
+	0x21, 0x07, 0xd1, 0x68, 0x6f, 0xd8, 0x27, 0x2a, 0x9b, 0x21, 0x25, 0x85, 0x17, 0xe8, 0xc1, 0xe1,
+	0x00, 0x13, 0x95, 0x8d, 0x6d, 0x49, 0x61, 0xdf, 0x94, 0xae, 0x6f, 0x3e, 0x33, 0xad, 0x33, 0xb3,
+	0xcb, 0x35, 0xaa, 0xac, 0xb2, 0x21, 0x84, 0x1d, 0xa6, 0xb8, 0x0c, 0x5e, 0xb9, 0xcb, 0x08, 0xc6,
+	0xf8, 0x69, 0x5f, 0xc8, 0x36, 0x29, 0xcf, 0x2b, 0x50, 0x3d, 0xd1, 0x5d, 0x62, 0x39, 0xe7, 0xad,
+	0x5a, 0xe2, 0x2f, 0x1c, 0x0a, 0x40, 0x8a, 0x07, 0x93, 0x77, 0xa1, 0xf5, 0xd8, 0xe9, 0xbb, 0x04,
+	0x6b, 0xfe, 0x36, 0xc3, 0x2d, 0x1e, 0xc1, 0xff, 0x54, 0x82, 0xb9, 0x14, 0x3a, 0x11, 0x51, 0x3e,
+	0x01, 0x44, 0x78, 0x65, 0xd7, 0x0f, 0x8e, 0xae, 0xd8, 0x2e, 0xdc, 0x09, 0x71, 0x67, 0x32, 0xac,
+	0xd3, 0xd8, 0xfa, 0x44, 0xd9, 0x55, 0x26, 0x49, 0x1c, 0x22, 0xed, 0x42, 0x55, 0xd4, 0xa2, 0xdb,
+	0x50, 0xa5, 0x3c, 0x5d, 0xf1, 0xbf, 0x4c, 0xc6, 0xe6, 0x0a, 0xad, 0xde, 0xd1, 0xe8, 0x2f, 0x4d,
+	0xd5, 0x34, 0x7f, 0x0f, 0x51, 0x53, 0xbc, 0xa2, 0x7c, 0x0f, 0x26, 0x1e, 0xd9, 0xd8, 0x51, 0x89,
+	0xe5, 0x14, 0xf7, 0x86, 0x0e, 0xd7, 0x03, 0x12, 0xe1, 0x83, 0x29, 0x18, 0xc5, 0x3d, 0x55, 0x37,
+	0xc4, 0x3f, 0x94, 0x17, 0xe8, 0x0f, 0xfe, 0x4c, 0x35, 0x0c, 0x4c, 0x84, 0x1d, 0xa2, 0x84, 0x6e,
+	0xc3, 0x04, 0xff, 0xea, 0x1e, 0x61, 0x95, 0xf4, 0x1d, 0xec, 0xb6, 0xca, 0xed, 0xf2, 0x6a, 0x4d,
+	0x69, 0x72, 0xf1, 0x7b, 0x42, 0x2a, 0x7f, 0x5d, 0x82, 0xa5, 0x2d, 0x97, 0xe8, 0x3d, 0xba, 0xdc,
+	0xf6, 0xd4, 0x73, 0xab, 0x4f, 0xae, 0x66, 0xd3, 0xfa, 0x23, 0x68, 0x67, 0xdb, 0x21, 0x7c, 0xf0,
+	0x32, 0x20, 0xec, 0x61, 0xba, 0x58, 0x75, 0x4c, 0xdd, 0x3c, 0x76, 0xc5, 0xd6, 0x66, 0xd2, 0xaf,
+	0xd9, 0x12, 0x15, 0xf2, 0x07, 0x30, 0x13, 0xa3, 0x2c, 0x3e, 0x24, 0xdb, 0x30, 0x9b, 0xe0, 0x2a,
+	0x66, 0xd5, 0x26, 0x34, 0x87, 0x3e, 0x93, 0xec, 0xc0, 0x44, 0xfc, 0x30, 0xf2, 0x06, 0xd4, 0x6d,
+	0x66, 0x57, 0x57, 0x37, 0x8f, 0x2c, 0xc1, 0x34, 0x1d, 0x62, 0xe2, 0x56, 0xef, 0x98, 0x47, 0x96,
+	0x02, 0xb6, 0xff, 0x2d, 0x7f, 0x06, 0x53, 0x82, 0x6a, 0x0f, 0x3b, 0xba, 0xa5, 0x15, 0x1f, 0xf4,
+	0x19, 0xa8, 0xd8, 0x8c, 0xc2, 0x9b, 0x8b, 0xbc, 0x24, 0x3f, 0x82, 0xe9, 0x58, 0x0b, 0x43, 0x9a,
+	0xfc, 0x15, 0xcc, 0x5e, 0xe9, 0xc9, 0x54, 0x81, 0x56, 0xe6, 0x91, 0xb4, 0x68, 0x9f, 0x7e, 0x57,
+	0x82, 0x85, 0x38, 0xe9, 0xb0, 0x03, 0x52, 0x20, 0xf1, 0x17, 0x8c, 0x61, 0x39, 0x32, 0x86, 0x1f,
+	0xc1, 0x62, 0x96, 0x75, 0x43, 0x76, 0xbc, 0x03, 0xe3, 0x74, 0x69, 0xe0, 0xe2, 0xfd, 0x94, 0x6f,
+	0x41, 0xd3, 0xa3, 0x08, 0x82, 0x65, 0x90, 0xd8, 0x2e, 0x2b, 0xbc, 0xc0, 0xe2, 0x01, 0xc3, 0x0d,
+	0x3f, 0x6d, 0xe4, 0xcf, 0x60, 0x36, 0xc1, 0x25, 0x1a, 0xdf, 0x82, 0xeb, 0x98, 0x55, 0x05, 0x3f,
+	0x2b, 0xf1, 0xaf, 0x92, 0xc2, 0xa7, 0xd2, 0x98, 0xf6, 0x04, 0x8e, 0x0a, 0xe4, 0x8f, 0x61, 0x22,
+	0x86, 0x49, 0xef, 0x56, 0x91, 0x19, 0xbc, 0x0d, 0x53, 0x4f, 0x4c, 0x4d, 0x77, 0x89, 0xa3, 0x1f,
+	0xf4, 0xc9, 0x30, 0xbe, 0x7f, 0x19, 0xa6, 0x63, 0x4c, 0xb9, 0x43, 0xf0, 0x15, 0xcc, 0xee, 0xa9,
+	0xe7, 0x2e, 0xe9, 0x1f, 0x5c, 0xcd, 0xd2, 0xdd, 0x86, 0x56, 0xb2, 0x7d, 0x61, 0xf1, 0x1d, 0xa8,
+	0xda, 0xbc, 0xae, 0x55, 0x4a, 0x24, 0x06, 0x84, 0x96, 0xe2, 0x41, 0x68, 0x18, 0xf7, 0x64, 0x85,
+	0x9d, 0xf7, 0x43, 0x98, 0xf0, 0x39, 0x0a, 0x19, 0xf1, 0x19, 0x4c, 0x09, 0xd9, 0xf7, 0x15, 0xbc,
+	0xb7, 0x60, 0x3a, 0xd6, 0x42, 0x21, 0x43, 0x69, 0x78, 0x8b, 0x3b, 0xfe, 0xbf, 0x28, 0xbc, 0x7d,
+	0x08, 0x8b, 0x59, 0xd6, 0x15, 0xea, 0xee, 0xeb, 0x00, 0x41, 0xb8, 0xa3, 0x1b, 0xf7, 0x13, 0x6c,
+	0xf8, 0x19, 0x7f, 0xfa, 0x4d, 0x65, 0xb6, 0x2a, 0x8c, 0x2e, 0x2b, 0xec, 0x5b, 0xfe, 0x4d, 0x19,
+	0xaa, 0x82, 0x8a, 0x5e, 0xd1, 0xf1, 0xdc, 0x98, 0xb8, 0xa8, 0xf3, 0xae, 0xe8, 0x98, 0xb0, 0xc3,
+	0xee, 0xe9, 0xd0, 0x0d, 0xa8, 0x71, 0xcc, 0x31, 0xf6, 0x12, 0x43, 0x63, 0x4c, 0xf0, 0x3e, 0x26,
+	0x68, 0x15, 0xae, 0xfb, 0x95, 0x5d, 0x91, 0x53, 0xe2, 0xc7, 0x91, 0xa6, 0x87, 0x51, 0x98, 0x14,
+	0xdd, 0x82, 0x89, 0x00, 0xc9, 0xcf, 0xde, 0xfc, 0x50, 0x32, 0xee, 0x01, 0xf9, 0xe1, 0xa8, 0x0d,
+	0x8d, 0x43, 0xab, 0x67, 0xfb, 0x16, 0xf1, 0x2b, 0x48, 0xa0, 0x32, 0x61, 0xd0, 0x1c, 0x8c, 0x31,
+	0x04, 0xb5, 0x87, 0xdf, 0x41, 0x56, 0x69, 0x99, 0x9a, 0x73, 0x0b, 0x26, 0xbc, 0x2a, 0xcf, 0x9a,
+	0x2a, 0x6f, 0x44, 0x20, 0x84, 0x31, 0x37, 0xa1, 0xe9, 0xe3, 0xb8, 0x2d, 0x63, 0xfc, 0x80, 0x24,
+	0x60, 0xdc, 0x14, 0xcf, 0xa3, 0xb5, 0x14, 0x8f, 0x42, 0xe0, 0x51, 0xd4, 0x86, 0x7a, 0x28, 0x36,
+	0xb5, 0xea, 0xac, 0x2a, 0x2c, 0xa2, 0xd7, 0xa6, 0x9a, 0xee, 0xda, 0x96, 0x8b, 0xb5, 0x56, 0x83,
+	0xbb, 0xd0, 0x2b, 0xd3, 0x23, 0xce, 0x36, 0x36, 0xb4, 0x4e, 0x8f, 0x1e, 0xca, 0xb6, 0xf9, 0xb9,
+	0xa7, 0xf8, 0x62, 0xff, 0xf6, 0x1a, 0xcc, 0xa5, 0xd0, 0x89, 0xf9, 0xb5, 0x17, 0x1c, 0xc0, 0xf8,
+	0xbf, 0xe2, 0x8d, 0x10, 0x61, 0xa6, 0x5a, 0x4a, 0x8d, 0x47, 0x23, 0xbd, 0x0d, 0x10, 0xd4, 0x86,
+	0x66, 0x7e, 0x29, 0x3c, 0xf3, 0xa9, 0x5c, 0xed, 0xf9, 0x19, 0xa0, 0xb2, 0x22, 0x4a, 0xd2, 0x37,
+	0x25, 0x98, 0x4c, 0x90, 0x27, 0x96, 0x5c, 0xe9, 0xe2, 0x25, 0xa7, 0x40, 0x83, 0x0e, 0x4f, 0x97,
+	0xf3, 0xd2, 0xf3, 0x12, 0xed, 0xdd, 0xdd, 0x01, 0x7b, 0xa7, 0xd4, 0x4f, 0xfc, 0x6f, 0x57, 0x7e,
+	0x04, 0x37, 0x62, 0x9b, 0x71, 0x76, 0x67, 0x5d, 0x7c, 0x6c, 0x1e, 0xc2, 0x7c, 0x3a, 0x61, 0xb1,
+	0x2d, 0xfe, 0x23, 0xb8, 0xd1, 0x31, 0x8c, 0xe0, 0x8c, 0x39, 0xf4, 0x7e, 0xff, 0x29, 0xcc, 0xa7,
+	0x13, 0x0e, 0xb9, 0xf9, 0xea, 0xc1, 0x72, 0x84, 0x97, 0x07, 0xbd, 0x61, 0xcd, 0xcd, 0xfc, 0x99,
+	0xfc, 0x04, 0xe4, 0xbc, 0xe6, 0x9e, 0xc3, 0xb1, 0xc0, 0xa3, 0x1e, 0xba, 0x0b, 0x05, 0x8f, 0x05,
+	0x89, 0xf6, 0x9f, 0xc7, 0xb1, 0x20, 0xfa, 0x4b, 0xba, 0x82, 0xae, 0xe5, 0x1e, 0x0b, 0x32, 0xac,
+	0x1b, 0xb2, 0xe3, 0x0f, 0x61, 0x8e, 0xef, 0x7e, 0xf7, 0xb0, 0xf3, 0x1c, 0xb6, 0xeb, 0x87, 0x20,
+	0xa5, 0xd1, 0x3d, 0xdf, 0x1d, 0x7b, 0x78, 0x02, 0x0e, 0xbb, 0x37, 0x2c, 0xb8, 0xb9, 0x4d, 0xb6,
+	0x5f, 0x78, 0x5f, 0xc9, 0x86, 0x73, 0xe8, 0x6e, 0xe4, 0xed, 0x2b, 0xa3, 0x2d, 0x14, 0xde, 0x57,
+	0xc6, 0x66, 0xe0, 0x15, 0x78, 0x3e, 0x6f, 0x5f, 0x99, 0x65, 0x5d, 0xa1, 0xee, 0x6e, 0xc3, 0x94,
+	0x82, 0x89, 0xaa, 0x9b, 0x0a, 0xb6, 0x2d, 0x87, 0xb8, 0xc3, 0x5c, 0xc6, 0x4e, 0xc7, 0x98, 0x84,
+	0x41, 0xaf, 0x42, 0xd5, 0xe1, 0xa2, 0x94, 0x37, 0x36, 0x61, 0x15, 0xc5, 0xc3, 0xc9, 0x7f, 0x19,
+	0x81, 0x46, 0xb8, 0xa6, 0xc8, 0x36, 0xe1, 0x01, 0x34, 0x0f, 0x1d, 0xcc, 0xfe, 0xaf, 0x07, 0xf8,
+	0xc8, 0xcb, 0xb0, 0x5f, 0xfa, 0x3e, 0x55, 0xe8, 0x6e, 0xe2, 0x23, 0x91, 0x85, 0x3f, 0xd2, 0x0d,
+	0x82, 0x9d, 0xae, 0xab, 0x7f, 0xe9, 0xdd, 0x8e, 0x02, 0x17, 0xed, 0xeb, 0x5f, 0x62, 0xfa, 0x62,
+	0x50, 0x73, 0xce, 0xbb, 0x4e, 0xdf, 0x64, 0x5b, 0xdd, 0x31, 0xa5, 0xa2, 0x39, 0xe7, 0x4a, 0xdf,
+	0xa4, 0xa9, 0x72, 0xfe, 0x64, 0x4f, 0x64, 0xe7, 0xf9, 0x1e, 0xb7, 0xce, 0x65, 0x3c, 0x39, 0xff,
+	0x22, 0x34, 0x05, 0xc4, 0x7d, 0xa6, 0xdb, 0xb6, 0xff, 0xdc, 0x6e, 0x9c, 0x4b, 0xf7, 0xb9, 0x90,
+	0x6e, 0x78, 0x05, 0x8c, 0x58, 0xe2, 0x95, 0x5f, 0x35, 0x8c, 0x7b, 0x6c, 0xf1, 0x97, 0x7e, 0x37,
+	0xa1, 0x79, 0x70, 0x4e, 0xc2, 0x30, 0xb1, 0xe1, 0x65, 0x52, 0x0f, 0x15, 0x34, 0xca, 0x30, 0xd8,
+	0xdb, 0xfa, 0x7a, 0x64, 0x5c, 0x88, 0xde, 0x84, 0xeb, 0xae, 0xda, 0xb3, 0x0d, 0xcc, 0x1f, 0x1e,
+	0x76, 0x75, 0xcd, 0x6d, 0x41, 0xbb, 0xbc, 0xda, 0xd8, 0x9c, 0x10, 0xce, 0xaf, 0xb2, 0xf7, 0x87,
+	0x3b, 0xf7, 0x95, 0x26, 0x07, 0xf2, 0xa2, 0xe6, 0xd2, 0xcb, 0x1a, 0x76, 0xa5, 0xc8, 0x2f, 0x5a,
+	0xea, 0x83, 0x5c, 0xd6, 0x08, 0xbd, 0x0e, 0x41, 0x5b, 0xd4, 0xf1, 0xa6, 0xee, 0x9e, 0x70, 0x96,
+	0xc6, 0x00, 0x2c, 0xe0, 0x29, 0x76, 0xc8, 0xc6, 0x2f, 0xae, 0x41, 0x55, 0x3c, 0xe7, 0x42, 0xef,
+	0x41, 0xcd, 0x7f, 0x7c, 0x89, 0x6e, 0x84, 0xe6, 0x62, 0xfc, 0x65, 0xa7, 0x34, 0x9f, 0x5e, 0x29,
+	0xe6, 0xf5, 0x36, 0x8c, 0xf2, 0xc7, 0x60, 0x8b, 0x59, 0x6f, 0xc6, 0x04, 0xcd, 0x52, 0x66, 0xbd,
+	0x60, 0x3a, 0x84, 0x66, 0xf4, 0x95, 0x1a, 0xba, 0x9d, 0xa1, 0x12, 0xff, 0x71, 0x49, 0xab, 0x17,
+	0x03, 0x79, 0x23, 0x1b, 0x7f, 0xaf, 0x40, 0xcd, 0x7f, 0xfc, 0x84, 0x54, 0x68, 0x84, 0xdf, 0x92,
+	0x45, 0x1a, 0xcc, 0x7b, 0xbf, 0x26, 0xad, 0x5e, 0x0c, 0x14, 0xbd, 0x3a, 0x85, 0xb9, 0xcc, 0x87,
+	0x5f, 0xe8, 0xa5, 0x34, 0x9a, 0x8c, 0x1c, 0xac, 0x74, 0xe7, 0x72, 0x60, 0xff, 0x6e, 0xe7, 0x7a,
+	0x1c, 0x84, 0xe4, 0x1c, 0x06, 0xaf, 0x95, 0x95, 0x5c, 0x8c, 0x20, 0xef, 0xc1, 0x4c, 0xfa, 0x23,
+	0x2c, 0xb4, 0x9a, 0x78, 0x20, 0x92, 0xd5, 0x9d, 0xb5, 0x4b, 0x20, 0x45, 0x73, 0x0a, 0x8c, 0x47,
+	0x10, 0x68, 0x29, 0x4b, 0xd7, 0x23, 0x6f, 0x67, 0x03, 0x04, 0xa7, 0x0d, 0xb3, 0x19, 0xcf, 0xa0,
+	0xd0, 0x5a, 0xf2, 0xe1, 0x4a, 0x56, 0x27, 0xfe, 0xf7, 0x32, 0x50, 0xd1, 0xe2, 0x13, 0x68, 0x46,
+	0x21, 0xa8, 0x9d, 0xa9, 0xed, 0xf1, 0x2f, 0xe7, 0x20, 0x02, 0xda, 0xe8, 0xab, 0xa4, 0x08, 0x6d,
+	0xea, 0x73, 0x29, 0x69, 0x39, 0x07, 0x21, 0x68, 0xdf, 0x82, 0x51, 0x56, 0x83, 0x66, 0xe3, 0x58,
+	0x8f, 0xa4, 0x95, 0xac, 0x10, 0x8b, 0xec, 0xeb, 0x32, 0x8c, 0xd0, 0x9f, 0x11, 0x7a, 0x17, 0xaa,
+	0xe2, 0xd5, 0x0a, 0x9a, 0x0b, 0xa1, 0xa3, 0xaf, 0x61, 0x24, 0x29, 0xad, 0x4a, 0x98, 0xb1, 0x0b,
+	0xf5, 0xd0, 0x13, 0x14, 0xb4, 0x10, 0x82, 0x26, 0x9f, 0xb8, 0x48, 0x8b, 0x59, 0xd5, 0x82, 0x6d,
+	0x07, 0x20, 0x78, 0xec, 0x80, 0xe6, 0x33, 0xde, 0x40, 0x70, 0xae, 0x85, 0xdc, 0x17, 0x12, 0xe8,
+	0x53, 0x98, 0x4c, 0x5c, 0x8b, 0xa2, 0x95, 0xfc, 0x4b, 0x53, 0x4e, 0x7c, 0xf3, 0x32, 0x37, 0xab,
+	0xe8, 0x1e, 0x8c, 0x79, 0x77, 0x95, 0x28, 0xec, 0xa0, 0xd8, 0x2d, 0xa8, 0x74, 0x23, 0xb5, 0x4e,
+	0x0c, 0xc4, 0x3f, 0x6a, 0x2c, 0xf3, 0x65, 0xf5, 0x89, 0x4b, 0xc7, 0xc2, 0x9b, 0x77, 0xe1, 0xb1,
+	0x88, 0x4d, 0x38, 0x29, 0xad, 0x2a, 0x58, 0x86, 0x91, 0x0b, 0xa7, 0xc8, 0x32, 0x4c, 0xbb, 0xec,
+	0x92, 0xda, 0xd9, 0x80, 0x20, 0x4c, 0x25, 0xd6, 0x9f, 0x9c, 0xd4, 0x4a, 0xcc, 0xe0, 0x95, 0x5c,
+	0x4c, 0x10, 0xa6, 0xd2, 0x6f, 0x57, 0x22, 0x61, 0x2a, 0xf7, 0x7a, 0x48, 0x5a, 0xbb, 0x04, 0x52,
+	0x34, 0xf7, 0x0e, 0x54, 0xf8, 0x59, 0x06, 0xb5, 0x12, 0xc7, 0x1b, 0x8f, 0x6e, 0x2e, 0xa5, 0x46,
+	0xa8, 0x7f, 0x94, 0xbc, 0x98, 0x58, 0xce, 0x39, 0x26, 0x09, 0x42, 0x39, 0x0f, 0x22, 0x98, 0x5d,
+	0x68, 0x65, 0xdd, 0x01, 0xa3, 0x70, 0x04, 0xbb, 0xe0, 0xc2, 0x5a, 0x7a, 0xe9, 0x52, 0xd8, 0x50,
+	0x77, 0xa2, 0x98, 0x68, 0x77, 0x52, 0x6f, 0x90, 0x25, 0x39, 0x0f, 0x12, 0xcc, 0xc3, 0xc8, 0xdd,
+	0x48, 0x64, 0x1e, 0xa6, 0xdd, 0xbf, 0x48, 0xed, 0x6c, 0x40, 0x30, 0x0f, 0xe3, 0x99, 0xea, 0xc8,
+	0x3c, 0xcc, 0xb8, 0x5d, 0x91, 0x56, 0x72, 0x31, 0x82, 0xfc, 0xdd, 0x20, 0xff, 0x3c, 0x97, 0xc4,
+	0xa7, 0x2d, 0xbd, 0xf8, 0x71, 0x46, 0x81, 0xf1, 0xc8, 0x75, 0x41, 0xa4, 0xcb, 0x69, 0x57, 0x15,
+	0x52, 0x3b, 0x1b, 0x10, 0xac, 0x8e, 0xf4, 0xe4, 0x7c, 0x64, 0x75, 0xe4, 0xde, 0x2e, 0x48, 0x6b,
+	0x97, 0x40, 0x06, 0x01, 0x33, 0x99, 0xf8, 0x5c, 0xc9, 0xcf, 0x57, 0x26, 0x03, 0x66, 0x66, 0x52,
+	0x73, 0xe3, 0x9f, 0x35, 0xa8, 0x88, 0x79, 0x76, 0x0c, 0x53, 0x69, 0x69, 0x3d, 0x74, 0x2b, 0xfc,
+	0xf8, 0x26, 0x3b, 0x91, 0x28, 0xdd, 0xbe, 0x10, 0x27, 0xfa, 0x74, 0x0e, 0x52, 0x76, 0xe2, 0x0d,
+	0xdd, 0xc9, 0xa2, 0x49, 0x4b, 0x38, 0x49, 0x2f, 0x5f, 0x12, 0x1d, 0x0a, 0x9c, 0xb1, 0xac, 0x58,
+	0x34, 0x70, 0xa6, 0xa7, 0xec, 0xa4, 0x95, 0x5c, 0x4c, 0x28, 0x70, 0xa6, 0xe6, 0x9f, 0xa2, 0x81,
+	0x33, 0x2f, 0x81, 0x26, 0xad, 0x5d, 0x02, 0xf9, 0x7c, 0x02, 0xa7, 0x0a, 0x28, 0x99, 0x84, 0x42,
+	0x37, 0x13, 0x0a, 0x29, 0x29, 0x2f, 0xe9, 0xc5, 0x0b, 0x50, 0x57, 0x19, 0x41, 0x8f, 0x61, 0x2a,
+	0x2d, 0x7b, 0x1e, 0x99, 0xc6, 0x39, 0xf9, 0x7a, 0xe9, 0xf6, 0x85, 0xb8, 0xef, 0x37, 0xa0, 0xc6,
+	0x93, 0x66, 0xe9, 0xf3, 0x33, 0x16, 0x05, 0x57, 0x72, 0x31, 0xcf, 0x35, 0xa0, 0x86, 0x13, 0x47,
+	0xd1, 0x80, 0x9a, 0x92, 0xf0, 0x92, 0xda, 0xd9, 0x80, 0xcc, 0x55, 0xe3, 0x91, 0xe7, 0xac, 0x9a,
+	0x58, 0x2b, 0x6b, 0x97, 0x40, 0x8a, 0x80, 0xf7, 0x14, 0x2a, 0x3c, 0x3b, 0x84, 0x76, 0xa1, 0x2a,
+	0xd2, 0x4d, 0x91, 0x6e, 0xa4, 0xa5, 0xb4, 0xa4, 0x76, 0x36, 0x80, 0xf3, 0x6e, 0xde, 0xfc, 0x58,
+	0xa6, 0x91, 0xf5, 0xf3, 0x75, 0xdd, 0xba, 0xcb, 0x3e, 0xee, 0xda, 0x8e, 0x7e, 0xaa, 0x12, 0x7c,
+	0xd7, 0xd7, 0xb4, 0x0f, 0x0e, 0x2a, 0x2c, 0xeb, 0xf0, 0xda, 0x7f, 0x06, 0x00, 0xdb, 0xd9, 0x96,
+	0x26, 0x7f, 0x3a, 0x00, 0x00,
 }
@@ -1973,3 +1973,78 @@ func (x *drpcPayout_SatellitePeriodPaystubStream) SendAndClose(m *SatellitePerio
 	}
 	return x.CloseSend()
 }
+
+type DRPCRetainClient interface {
+	DRPCConn() drpc.Conn
+
+	Reports(ctx context.Context, in *RetainReportsRequest) (*RetainReportsResponse, error)
+}
+
+type drpcRetainClient struct {
+	cc drpc.Conn
+}
+
+func NewDRPCRetainClient(cc drpc.Conn) DRPCRetainClient {
+	return &drpcRetainClient{cc}
+}
+
+func (c *drpcRetainClient) DRPCConn() drpc.Conn { return c.cc }
+
+func (c *drpcRetainClient) Reports(ctx context.Context, in *RetainReportsRequest) (*RetainReportsResponse, error) {
+	out := new(RetainReportsResponse)
+	err := c.cc.Invoke(ctx, "/multinode.Retain/Reports", drpcEncoding_File_multinode_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+type DRPCRetainServer interface {
+	Reports(context.Context, *RetainReportsRequest) (*RetainReportsResponse, error)
+}
+
+type DRPCRetainUnimplementedServer struct{}
+
+func (s *DRPCRetainUnimplementedServer) Reports(context.Context, *RetainReportsRequest) (*RetainReportsResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), 12)
+}
+
+type DRPCRetainDescription struct{}
+
+func (DRPCRetainDescription) NumMethods() int { return 1 }
+
+func (DRPCRetainDescription) Method(n int) (string, drpc.Encoding, drpc.Receiver, interface{}, bool) {
+	switch n {
+	case 0:
+		return "/multinode.Retain/Reports", drpcEncoding_File_multinode_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCRetainServer).
+					Reports(
+						ctx,
+						in1.(*RetainReportsRequest),
+					)
+			}, DRPCRetainServer.Reports, true
+	default:
+		return "", nil, nil, nil, false
+	}
+}
+
+func DRPCRegisterRetain(mux drpc.Mux, impl DRPCRetainServer) error {
+	return mux.Register(impl, DRPCRetainDescription{})
+}
+
+type DRPCRetain_ReportsStream interface {
+	drpc.Stream
+	SendAndClose(*RetainReportsResponse) error
+}
+
+type drpcRetain_ReportsStream struct {
+	drpc.Stream
+}
+
+func (x *drpcRetain_ReportsStream) SendAndClose(m *RetainReportsResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_multinode_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
@@ -0,0 +1,113 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package nodeload implements reporting the load of storage nodes to satellites.
+//
+// The check-in request defined in storj.io/common doesn't have a field for the load,
+// so it's sent as an additional field of the request, which isn't known by older
+// satellites and is ignored by them.
+//
+// The additional field is a stopgap until the load is part of the request. To get rid
+// of it, add a load field to CheckInRequest in storj.io/common with field number 1000
+// and the layout of loadMessage. The generated field is wire compatible with the one
+// sent here, so after bumping storj.io/common, Encode and Decode can set and read it
+// instead of XXX_unrecognized, without coordinating the release of satellites and
+// storage nodes.
+package nodeload
+
+import (
+	"math"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/zeebo/errs"
+
+	"storj.io/common/pb"
+)
+
+// Error is the default error class for nodeload package.
+var Error = errs.Class("nodeload")
+
+// Load describes how busy a storage node is.
+//
+// Ingress and Egress are the ratios of the upload and the download traffic in the last
+// load interval to the traffic the node is able to take. Zero means that the node
+// is idle or the load is unknown, one or more means that the node is overloaded.
+type Load struct {
+	Ingress float64
+	Egress  float64
+}
+
+// ChangeThreshold is the smallest change of the ingress or the egress ratio, which is
+// worth reporting to the satellites before the next regular check-in.
+const ChangeThreshold = 0.05
+
+// Changed returns whether the load differs from the old one by at least ChangeThreshold.
+func Changed(old, new *Load) bool {
+	if old == nil || new == nil {
+		return (old == nil) != (new == nil)
+	}
+	return math.Abs(old.Ingress-new.Ingress) >= ChangeThreshold ||
+		math.Abs(old.Egress-new.Egress) >= ChangeThreshold
+}
+
+// checkInLoad is the check-in request containing only the load field. The field number
+// is far from the fields of the request, so that it doesn't conflict with fields added later.
+type checkInLoad struct {
+	Load *loadMessage `protobuf:"bytes,1000,opt,name=load,proto3"`
+}
+
+func (m *checkInLoad) Reset()         { *m = checkInLoad{} }
+func (m *checkInLoad) String() string { return proto.CompactTextString(m) }
+func (*checkInLoad) ProtoMessage()    {}
+
+// loadMessage is the wire format of Load.
+type loadMessage struct {
+	Ingress float64 `protobuf:"fixed64,1,opt,name=ingress,proto3"`
+	Egress  float64 `protobuf:"fixed64,2,opt,name=egress,proto3"`
+}
+
+func (m *loadMessage) Reset()         { *m = loadMessage{} }
+func (m *loadMessage) String() string { return proto.CompactTextString(m) }
+func (*loadMessage) ProtoMessage()    {}
+
+// Encode adds the load to the check-in request.
+func Encode(req *pb.CheckInRequest, load Load) error {
+	data, err := proto.Marshal(&checkInLoad{
+		Load: &loadMessage{
+			Ingress: load.Ingress,
+			Egress:  load.Egress,
+		},
+	})
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	req.XXX_unrecognized = append(req.XXX_unrecognized, data...)
+	return nil
+}
+
+// Decode returns the load sent in the check-in request. It returns nil, when the
+// request doesn't contain the load.
+func Decode(req *pb.CheckInRequest) (*Load, error) {
+	if len(req.XXX_unrecognized) == 0 {
+		return nil, nil
+	}
+
+	var msg checkInLoad
+	if err := proto.Unmarshal(req.XXX_unrecognized, &msg); err != nil {
+		return nil, Error.Wrap(err)
+	}
+	if msg.Load == nil {
+		return nil, nil
+	}
+	if !validRatio(msg.Load.Ingress) || !validRatio(msg.Load.Egress) {
+		return nil, Error.New("invalid load: ingress %v, egress %v", msg.Load.Ingress, msg.Load.Egress)
+	}
+	return &Load{
+		Ingress: msg.Load.Ingress,
+		Egress:  msg.Load.Egress,
+	}, nil
+}
+
+func validRatio(v float64) bool {
+	return v >= 0 && !math.IsInf(v, 0) && !math.IsNaN(v)
+}
@@ -0,0 +1,60 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package nodeload_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/pb"
+	"storj.io/storj/private/nodeload"
+)
+
+func TestEncodeDecode(t *testing.T) {
+	send := func(req *pb.CheckInRequest) *pb.CheckInRequest {
+		data, err := pb.Marshal(req)
+		require.NoError(t, err)
+
+		var received pb.CheckInRequest
+		require.NoError(t, pb.Unmarshal(data, &received))
+		return &received
+	}
+
+	req := &pb.CheckInRequest{
+		Address:  "127.0.0.1:28967",
+		Capacity: &pb.NodeCapacity{FreeDisk: 1000},
+	}
+
+	load, err := nodeload.Decode(send(req))
+	require.NoError(t, err)
+	require.Nil(t, load)
+
+	require.NoError(t, nodeload.Encode(req, nodeload.Load{Ingress: 0.25, Egress: 1.5}))
+
+	received := send(req)
+	require.Equal(t, "127.0.0.1:28967", received.Address)
+	require.EqualValues(t, 1000, received.Capacity.FreeDisk)
+
+	load, err = nodeload.Decode(received)
+	require.NoError(t, err)
+	require.Equal(t, &nodeload.Load{Ingress: 0.25, Egress: 1.5}, load)
+
+	invalid := &pb.CheckInRequest{Address: "127.0.0.1:28967"}
+	require.NoError(t, nodeload.Encode(invalid, nodeload.Load{Ingress: -1}))
+	_, err = nodeload.Decode(send(invalid))
+	require.Error(t, err)
+}
+
+func TestChanged(t *testing.T) {
+	load := &nodeload.Load{Ingress: 0.5, Egress: 0.5}
+
+	require.False(t, nodeload.Changed(nil, nil))
+	require.True(t, nodeload.Changed(nil, load))
+	require.True(t, nodeload.Changed(load, nil))
+	require.False(t, nodeload.Changed(load, load))
+	require.False(t, nodeload.Changed(load, &nodeload.Load{Ingress: 0.54, Egress: 0.46}))
+	require.True(t, nodeload.Changed(load, &nodeload.Load{Ingress: 0.6, Egress: 0.5}))
+	require.True(t, nodeload.Changed(load, &nodeload.Load{Ingress: 0.5, Egress: 0.4}))
+}
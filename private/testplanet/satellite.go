@@ -51,6 +51,8 @@ import (
 	"storj.io/storj/satellite/metabase/segmentloop"
 	"storj.io/storj/satellite/metabase/zombiedeletion"
 	"storj.io/storj/satellite/metainfo"
+	"storj.io/storj/satellite/metainfo/bucketevents"
+	"storj.io/storj/satellite/metainfo/bucketlifecycle"
 	"storj.io/storj/satellite/metainfo/expireddeletion"
 	"storj.io/storj/satellite/metrics"
 	"storj.io/storj/satellite/nodestats"
@@ -109,7 +111,8 @@ type Satellite struct {
 	}
 
 	Inspector struct {
-		Endpoint *inspector.Endpoint
+		Endpoint      *inspector.Endpoint
+		AuditEndpoint *inspector.AuditEndpoint
 	}
 
 	Orders struct {
@@ -125,15 +128,19 @@ type Satellite struct {
 	}
 
 	Audit struct {
-		Queues   *audit.Queues
-		Worker   *audit.Worker
-		Chore    *audit.Chore
-		Verifier *audit.Verifier
-		Reporter *audit.Reporter
+		Queues        *audit.Queues
+		Worker        *audit.Worker
+		Chore         *audit.Chore
+		Verifier      *audit.Verifier
+		Reporter      *audit.Reporter
+		PieceQueue    *audit.PieceQueue
+		PieceWorker   *audit.PieceWorker
+		RequestWorker *audit.RequestWorker
 	}
 
 	Reputation struct {
-		Service *reputation.Service
+		Service       *reputation.Service
+		OutcomesChore *reputation.OutcomesChore
 	}
 
 	GarbageCollection struct {
@@ -148,6 +155,14 @@ type Satellite struct {
 		Chore *zombiedeletion.Chore
 	}
 
+	BucketLifecycle struct {
+		Chore *bucketlifecycle.Chore
+	}
+
+	BucketEvents struct {
+		Chore *bucketevents.Chore
+	}
+
 	Accounting struct {
 		Tally            *tally.Service
 		NodeTally        *nodetally.Service
@@ -470,6 +485,7 @@ func (planet *Planet) newSatellite(ctx context.Context, prefix string, index int
 	config.LiveAccounting.StorageBackend = "redis://" + redis.Addr() + "?db=0"
 	config.Mail.TemplatePath = filepath.Join(developmentRoot, "web/satellite/static/emails")
 	config.Console.StaticDir = filepath.Join(developmentRoot, "web/satellite")
+	config.BucketEvents.FileSinkDir = storageDir
 
 	if planet.config.Reconfigure.Satellite != nil {
 		planet.config.Reconfigure.Satellite(log, index, &config)
@@ -577,6 +593,7 @@ func createNewSystem(name string, log *zap.Logger, config satellite.Config, peer
 	system.Overlay.DQStrayNodes = peer.Overlay.DQStrayNodes
 
 	system.Reputation.Service = peer.Reputation.Service
+	system.Reputation.OutcomesChore = peer.Reputation.OutcomesChore
 
 	// system.Metainfo.Metabase = api.Metainfo.Metabase
 	system.Metainfo.Endpoint = api.Metainfo.Endpoint
@@ -586,6 +603,7 @@ func createNewSystem(name string, log *zap.Logger, config satellite.Config, peer
 	system.Metabase.SegmentLoop = peer.Metainfo.SegmentLoop
 
 	system.Inspector.Endpoint = api.Inspector.Endpoint
+	system.Inspector.AuditEndpoint = api.Inspector.AuditEndpoint
 
 	system.Orders.DB = api.Orders.DB
 	system.Orders.Endpoint = api.Orders.Endpoint
@@ -600,11 +618,16 @@ func createNewSystem(name string, log *zap.Logger, config satellite.Config, peer
 	system.Audit.Chore = peer.Audit.Chore
 	system.Audit.Verifier = peer.Audit.Verifier
 	system.Audit.Reporter = peer.Audit.Reporter
+	system.Audit.PieceQueue = peer.Audit.PieceQueue
+	system.Audit.PieceWorker = peer.Audit.PieceWorker
+	system.Audit.RequestWorker = peer.Audit.RequestWorker
 
 	system.GarbageCollection.Service = gcPeer.GarbageCollection.Service
 
 	system.ExpiredDeletion.Chore = peer.ExpiredDeletion.Chore
 	system.ZombieDeletion.Chore = peer.ZombieDeletion.Chore
+	system.BucketLifecycle.Chore = peer.BucketLifecycle.Chore
+	system.BucketEvents.Chore = peer.BucketEvents.Chore
 
 	system.Accounting.Tally = peer.Accounting.Tally
 	system.Accounting.NodeTally = peer.Accounting.NodeTally
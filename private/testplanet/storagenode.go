@@ -39,6 +39,7 @@ import (
 	"storj.io/storj/storagenode/piecestore"
 	"storj.io/storj/storagenode/preflight"
 	"storj.io/storj/storagenode/retain"
+	"storj.io/storj/storagenode/scrubber"
 	"storj.io/storj/storagenode/storagenodedb"
 	"storj.io/storj/storagenode/trust"
 )
@@ -196,13 +197,17 @@ func (planet *Planet) newStorageNode(ctx context.Context, prefix string, index,
 			MaxTimeSkew: 10 * time.Second,
 			Status:      retain.Enabled,
 			Concurrency: 5,
+
+			ReportSamples:   20,
+			ReportRetention: 720 * time.Hour,
 		},
 		Version: planet.NewVersionConfig(),
 		Bandwidth: bandwidth.Config{
 			Interval: defaultInterval,
 		},
 		Contact: contact.Config{
-			Interval: defaultInterval,
+			Interval:     defaultInterval,
+			LoadInterval: defaultInterval,
 		},
 		GracefulExit: gracefulexit.Config{
 			ChoreInterval:          defaultInterval,
@@ -211,6 +216,12 @@ func (planet *Planet) newStorageNode(ctx context.Context, prefix string, index,
 			MinBytesPerSecond:      128 * memory.B,
 			MinDownloadTimeout:     2 * time.Minute,
 		},
+		Scrubber: scrubber.Config{
+			Enabled:   false,
+			Interval:  defaultInterval,
+			ReadRate:  memory.MiB,
+			BatchSize: 100,
+		},
 	}
 	if planet.config.Reconfigure.StorageNode != nil {
 		planet.config.Reconfigure.StorageNode(index, &config)
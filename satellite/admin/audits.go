@@ -0,0 +1,246 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/audit"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/overlay"
+)
+
+// auditRequest is the JSON representation of an on-demand audit request.
+type auditRequest struct {
+	ID           string     `json:"id"`
+	NodeID       string     `json:"nodeId,omitempty"`
+	Limit        int        `json:"limit,omitempty"`
+	ProjectID    string     `json:"projectId,omitempty"`
+	Bucket       string     `json:"bucket,omitempty"`
+	EncryptedKey string     `json:"encryptedKey,omitempty"`
+	Version      int64      `json:"version,omitempty"`
+	Status       string     `json:"status"`
+	Error        string     `json:"error,omitempty"`
+	CreatedAt    time.Time  `json:"createdAt"`
+	FinishedAt   *time.Time `json:"finishedAt"`
+}
+
+// auditResult is the JSON representation of the outcome of a piece audit.
+type auditResult struct {
+	StreamID string `json:"streamId"`
+	Position uint64 `json:"position"`
+	NodeID   string `json:"nodeId"`
+	Outcome  string `json:"outcome"`
+}
+
+// auditReport is the JSON representation of an on-demand audit request with its results.
+type auditReport struct {
+	auditRequest
+	Summary map[string]int `json:"summary"`
+	Results []auditResult  `json:"results"`
+}
+
+func newAuditRequest(request audit.Request) auditRequest {
+	output := auditRequest{
+		ID:         request.ID.String(),
+		Status:     request.Status.String(),
+		Error:      request.Error,
+		CreatedAt:  request.CreatedAt,
+		FinishedAt: request.FinishedAt,
+	}
+	if request.IsNodeRequest() {
+		output.NodeID = request.NodeID.String()
+		output.Limit = request.Limit
+	} else {
+		output.ProjectID = request.Object.ProjectID.String()
+		output.Bucket = request.Object.BucketName
+		output.EncryptedKey = hex.EncodeToString([]byte(request.Object.ObjectKey))
+		output.Version = int64(request.Version)
+	}
+	return output
+}
+
+func (server *Server) auditNode(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	nodeID, err := validateNodePathParameters(mux.Vars(r))
+	if err != nil {
+		sendJSONError(w, err.Error(), "", http.StatusBadRequest)
+		return
+	}
+
+	limit := audit.DefaultRequestLimit
+	if value := r.URL.Query().Get("limit"); value != "" {
+		limit, err = strconv.Atoi(value)
+		if err != nil || limit <= 0 || limit > audit.MaxRequestLimit {
+			sendJSONError(w, "invalid limit",
+				fmt.Sprintf("limit must be between 1 and %d", audit.MaxRequestLimit), http.StatusBadRequest)
+			return
+		}
+	}
+
+	_, err = server.db.OverlayCache().Get(ctx, nodeID)
+	if err != nil {
+		if overlay.ErrNodeNotFound.Has(err) {
+			sendJSONError(w, "node does not exist", "", http.StatusNotFound)
+		} else {
+			sendJSONError(w, "unable to get node", err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	server.createAuditRequest(w, r, audit.Request{
+		NodeID: nodeID,
+		Limit:  limit,
+	})
+}
+
+func (server *Server) auditObject(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	location, version, err := validateObjectPathParameters(r)
+	if err != nil {
+		sendJSONError(w, err.Error(), "", http.StatusBadRequest)
+		return
+	}
+
+	_, err = server.metabase.GetObjectExactVersion(ctx, metabase.GetObjectExactVersion{
+		ObjectLocation: location,
+		Version:        version,
+	})
+	if err != nil {
+		if storj.ErrObjectNotFound.Has(err) {
+			sendJSONError(w, "object does not exist", "", http.StatusNotFound)
+		} else {
+			sendJSONError(w, "unable to get object", err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	server.createAuditRequest(w, r, audit.Request{
+		Object:  location,
+		Version: version,
+	})
+}
+
+func (server *Server) createAuditRequest(w http.ResponseWriter, r *http.Request, request audit.Request) {
+	ctx := r.Context()
+
+	var err error
+	request.ID, err = uuid.New()
+	if err != nil {
+		sendJSONError(w, "unable to create audit request", err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	err = server.db.AuditRequests().Create(ctx, request)
+	if err != nil {
+		sendJSONError(w, "unable to create audit request", err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	data, err := json.Marshal(struct {
+		ID string `json:"id"`
+	}{request.ID.String()})
+	if err != nil {
+		sendJSONError(w, "failed to marshal response", err.Error(), http.StatusInternalServerError)
+	} else {
+		sendJSONData(w, http.StatusOK, data)
+	}
+}
+
+func (server *Server) listAuditRequests(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	limit := 100
+	if value := r.URL.Query().Get("limit"); value != "" {
+		var err error
+		limit, err = strconv.Atoi(value)
+		if err != nil || limit <= 0 {
+			sendJSONError(w, "invalid limit", "", http.StatusBadRequest)
+			return
+		}
+	}
+
+	requests, err := server.db.AuditRequests().List(ctx, limit)
+	if err != nil {
+		sendJSONError(w, "unable to list audit requests", err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	output := make([]auditRequest, 0, len(requests))
+	for _, request := range requests {
+		output = append(output, newAuditRequest(request))
+	}
+
+	data, err := json.Marshal(output)
+	if err != nil {
+		sendJSONError(w, "failed to marshal audit requests", err.Error(), http.StatusInternalServerError)
+	} else {
+		sendJSONData(w, http.StatusOK, data)
+	}
+}
+
+func (server *Server) getAuditReport(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id, err := uuid.FromString(mux.Vars(r)["id"])
+	if err != nil {
+		sendJSONError(w, "invalid audit request id", err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	request, err := server.db.AuditRequests().Get(ctx, id)
+	if err != nil {
+		if audit.ErrRequestNotFound.Has(err) {
+			sendJSONError(w, "audit request does not exist", "", http.StatusNotFound)
+		} else {
+			sendJSONError(w, "unable to get audit request", err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	results, err := server.db.AuditRequests().Results(ctx, id)
+	if err != nil {
+		sendJSONError(w, "unable to get audit results", err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	report := auditReport{
+		auditRequest: newAuditRequest(request),
+		Summary: map[string]int{
+			audit.PieceAuditSuccess.String():   0,
+			audit.PieceAuditFailure.String():   0,
+			audit.PieceAuditOffline.String():   0,
+			audit.PieceAuditContained.String(): 0,
+			audit.PieceAuditUnknown.String():   0,
+		},
+		Results: make([]auditResult, 0, len(results)),
+	}
+	for _, result := range results {
+		report.Summary[result.Outcome.String()]++
+		report.Results = append(report.Results, auditResult{
+			StreamID: result.StreamID.String(),
+			Position: result.Position.Encode(),
+			NodeID:   result.NodeID.String(),
+			Outcome:  result.Outcome.String(),
+		})
+	}
+
+	data, err := json.Marshal(report)
+	if err != nil {
+		sendJSONError(w, "failed to marshal audit report", err.Error(), http.StatusInternalServerError)
+	} else {
+		sendJSONData(w, http.StatusOK, data)
+	}
+}
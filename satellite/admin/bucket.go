@@ -7,6 +7,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strconv"
 
 	"github.com/gorilla/mux"
 
@@ -96,10 +97,16 @@ func (server *Server) updateBucket(w http.ResponseWriter, r *http.Request, place
 }
 
 func (server *Server) createGeofenceForBucket(w http.ResponseWriter, r *http.Request) {
-	placement, err := parsePlacementConstraint(r.URL.Query().Get("region"))
+	region := r.URL.Query().Get("region")
+	placement, err := parsePlacementConstraint(region)
 	if err != nil {
-		sendJSONError(w, err.Error(), "available: EU, EEA, US, DE", http.StatusBadRequest)
-		return
+		// operator defined placements are referenced by their id.
+		id, parseErr := strconv.ParseUint(region, 10, 16)
+		if parseErr != nil || !server.placements.Defined(storj.PlacementConstraint(id)) {
+			sendJSONError(w, err.Error(), "available: EU, EEA, US, DE or the id of a configured placement", http.StatusBadRequest)
+			return
+		}
+		placement = storj.PlacementConstraint(id)
 	}
 
 	server.updateBucket(w, r, placement)
@@ -0,0 +1,88 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"storj.io/common/storj"
+	"storj.io/storj/satellite/buckets"
+)
+
+func (server *Server) getBucketLifecycle(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	project, bucket, err := validateGeofencePathParameters(mux.Vars(r))
+	if err != nil {
+		sendJSONError(w, err.Error(), "", http.StatusBadRequest)
+		return
+	}
+
+	lifecycle, err := server.buckets.GetBucketLifecycle(ctx, bucket, project.UUID)
+	if err != nil {
+		if storj.ErrBucketNotFound.Has(err) {
+			sendJSONError(w, "bucket does not exist", "", http.StatusBadRequest)
+		} else {
+			sendJSONError(w, "unable to get bucket lifecycle", err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+	if lifecycle.Rules == nil {
+		lifecycle.Rules = []buckets.LifecycleRule{}
+	}
+
+	data, err := json.Marshal(lifecycle)
+	if err != nil {
+		sendJSONError(w, "failed to marshal bucket lifecycle", err.Error(), http.StatusInternalServerError)
+	} else {
+		sendJSONData(w, http.StatusOK, data)
+	}
+}
+
+func (server *Server) putBucketLifecycle(w http.ResponseWriter, r *http.Request) {
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		sendJSONError(w, "failed to read body", err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	var lifecycle buckets.Lifecycle
+	if err := json.Unmarshal(body, &lifecycle); err != nil {
+		sendJSONError(w, "failed to unmarshal request", err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	server.updateBucketLifecycle(w, r, lifecycle)
+}
+
+func (server *Server) deleteBucketLifecycle(w http.ResponseWriter, r *http.Request) {
+	server.updateBucketLifecycle(w, r, buckets.Lifecycle{})
+}
+
+func (server *Server) updateBucketLifecycle(w http.ResponseWriter, r *http.Request, lifecycle buckets.Lifecycle) {
+	ctx := r.Context()
+
+	project, bucket, err := validateGeofencePathParameters(mux.Vars(r))
+	if err != nil {
+		sendJSONError(w, err.Error(), "", http.StatusBadRequest)
+		return
+	}
+
+	err = server.buckets.UpdateBucketLifecycle(ctx, bucket, project.UUID, lifecycle)
+	if err != nil {
+		switch {
+		case storj.ErrBucketNotFound.Has(err):
+			sendJSONError(w, "bucket does not exist", "", http.StatusBadRequest)
+		case buckets.ErrInvalidLifecycle.Has(err):
+			sendJSONError(w, "invalid bucket lifecycle", err.Error(), http.StatusBadRequest)
+		default:
+			sendJSONError(w, "unable to update bucket lifecycle", err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+}
@@ -0,0 +1,107 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"storj.io/common/storj"
+	"storj.io/storj/satellite/overlay"
+)
+
+// maxNodeTags is the maximum number of tags of a single node.
+const maxNodeTags = 32
+
+func validateNodePathParameters(vars map[string]string) (nodeID storj.NodeID, err error) {
+	nodeIDString, ok := vars["nodeid"]
+	if !ok {
+		return nodeID, fmt.Errorf("node-id missing")
+	}
+
+	nodeID, err = storj.NodeIDFromString(nodeIDString)
+	if err != nil {
+		return nodeID, fmt.Errorf("invalid node-id")
+	}
+
+	return nodeID, nil
+}
+
+func (server *Server) getNodeTags(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	nodeID, err := validateNodePathParameters(mux.Vars(r))
+	if err != nil {
+		sendJSONError(w, err.Error(), "", http.StatusBadRequest)
+		return
+	}
+
+	node, err := server.db.OverlayCache().Get(ctx, nodeID)
+	if err != nil {
+		if overlay.ErrNodeNotFound.Has(err) {
+			sendJSONError(w, "node does not exist", "", http.StatusNotFound)
+		} else {
+			sendJSONError(w, "unable to get node", err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	tags := node.Tags
+	if tags == nil {
+		tags = map[string]string{}
+	}
+
+	data, err := json.Marshal(tags)
+	if err != nil {
+		sendJSONError(w, "failed to marshal node tags", err.Error(), http.StatusInternalServerError)
+	} else {
+		sendJSONData(w, http.StatusOK, data)
+	}
+}
+
+func (server *Server) putNodeTags(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	nodeID, err := validateNodePathParameters(mux.Vars(r))
+	if err != nil {
+		sendJSONError(w, err.Error(), "", http.StatusBadRequest)
+		return
+	}
+
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		sendJSONError(w, "failed to read body", err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	var tags map[string]string
+	if err := json.Unmarshal(body, &tags); err != nil {
+		sendJSONError(w, "failed to unmarshal request", err.Error(), http.StatusBadRequest)
+		return
+	}
+	if len(tags) > maxNodeTags {
+		sendJSONError(w, "too many tags", fmt.Sprintf("maximum allowed is %d", maxNodeTags), http.StatusBadRequest)
+		return
+	}
+	for key := range tags {
+		if key == "" {
+			sendJSONError(w, "tag key is empty", "", http.StatusBadRequest)
+			return
+		}
+	}
+
+	err = server.db.OverlayCache().UpdateNodeTags(ctx, nodeID, tags)
+	if err != nil {
+		if overlay.ErrNodeNotFound.Has(err) {
+			sendJSONError(w, "node does not exist", "", http.StatusNotFound)
+		} else {
+			sendJSONError(w, "unable to update node tags", err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+}
@@ -0,0 +1,88 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"storj.io/common/storj"
+	"storj.io/storj/satellite/buckets"
+)
+
+func (server *Server) getBucketNotifications(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	project, bucket, err := validateGeofencePathParameters(mux.Vars(r))
+	if err != nil {
+		sendJSONError(w, err.Error(), "", http.StatusBadRequest)
+		return
+	}
+
+	notifications, err := server.buckets.GetBucketNotifications(ctx, bucket, project.UUID)
+	if err != nil {
+		if storj.ErrBucketNotFound.Has(err) {
+			sendJSONError(w, "bucket does not exist", "", http.StatusBadRequest)
+		} else {
+			sendJSONError(w, "unable to get bucket notifications", err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+	if notifications.Sinks == nil {
+		notifications.Sinks = []buckets.NotificationSink{}
+	}
+
+	data, err := json.Marshal(notifications)
+	if err != nil {
+		sendJSONError(w, "failed to marshal bucket notifications", err.Error(), http.StatusInternalServerError)
+	} else {
+		sendJSONData(w, http.StatusOK, data)
+	}
+}
+
+func (server *Server) putBucketNotifications(w http.ResponseWriter, r *http.Request) {
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		sendJSONError(w, "failed to read body", err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	var notifications buckets.Notifications
+	if err := json.Unmarshal(body, &notifications); err != nil {
+		sendJSONError(w, "failed to unmarshal request", err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	server.updateBucketNotifications(w, r, notifications)
+}
+
+func (server *Server) deleteBucketNotifications(w http.ResponseWriter, r *http.Request) {
+	server.updateBucketNotifications(w, r, buckets.Notifications{})
+}
+
+func (server *Server) updateBucketNotifications(w http.ResponseWriter, r *http.Request, notifications buckets.Notifications) {
+	ctx := r.Context()
+
+	project, bucket, err := validateGeofencePathParameters(mux.Vars(r))
+	if err != nil {
+		sendJSONError(w, err.Error(), "", http.StatusBadRequest)
+		return
+	}
+
+	err = server.buckets.UpdateBucketNotifications(ctx, bucket, project.UUID, notifications)
+	if err != nil {
+		switch {
+		case storj.ErrBucketNotFound.Has(err):
+			sendJSONError(w, "bucket does not exist", "", http.StatusBadRequest)
+		case buckets.ErrInvalidNotifications.Has(err):
+			sendJSONError(w, "invalid bucket notifications", err.Error(), http.StatusBadRequest)
+		default:
+			sendJSONError(w, "unable to update bucket notifications", err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+}
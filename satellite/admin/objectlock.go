@@ -0,0 +1,204 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/buckets"
+	"storj.io/storj/satellite/metabase"
+)
+
+// validateObjectPathParameters validates the project, bucket and the hex encoded
+// encrypted object key path parameters and the version query parameter.
+func validateObjectPathParameters(r *http.Request) (location metabase.ObjectLocation, version metabase.Version, err error) {
+	vars := mux.Vars(r)
+
+	project, bucket, err := validateGeofencePathParameters(vars)
+	if err != nil {
+		return location, version, err
+	}
+
+	key, err := hex.DecodeString(vars["key"])
+	if err != nil {
+		return location, version, fmt.Errorf("object key is not hex encoded")
+	}
+	if len(key) == 0 {
+		return location, version, fmt.Errorf("object key is missing")
+	}
+
+	v, err := strconv.ParseInt(r.URL.Query().Get("version"), 10, 64)
+	if err != nil || v <= 0 {
+		return location, version, fmt.Errorf("version is missing or invalid")
+	}
+
+	return metabase.ObjectLocation{
+		ProjectID:  project.UUID,
+		BucketName: string(bucket),
+		ObjectKey:  metabase.ObjectKey(key),
+	}, metabase.Version(v), nil
+}
+
+func (server *Server) enableObjectLockForBucket(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	project, bucket, err := validateGeofencePathParameters(mux.Vars(r))
+	if err != nil {
+		sendJSONError(w, err.Error(), "", http.StatusBadRequest)
+		return
+	}
+
+	err = server.buckets.UpdateBucketObjectLockEnabled(ctx, bucket, project.UUID, true)
+	if err != nil {
+		switch {
+		case storj.ErrBucketNotFound.Has(err):
+			sendJSONError(w, "bucket does not exist", "", http.StatusBadRequest)
+		case buckets.ErrInvalidObjectLock.Has(err):
+			sendJSONError(w, "unable to enable object lock for bucket", err.Error(), http.StatusBadRequest)
+		default:
+			sendJSONError(w, "unable to enable object lock for bucket", err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	server.sendObjectLockForBucket(ctx, w, project.UUID, bucket)
+}
+
+func (server *Server) checkObjectLockForBucket(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	project, bucket, err := validateGeofencePathParameters(mux.Vars(r))
+	if err != nil {
+		sendJSONError(w, err.Error(), "", http.StatusBadRequest)
+		return
+	}
+
+	server.sendObjectLockForBucket(ctx, w, project.UUID, bucket)
+}
+
+func (server *Server) sendObjectLockForBucket(ctx context.Context, w http.ResponseWriter, projectID uuid.UUID, bucket []byte) {
+	enabled, err := server.buckets.GetBucketObjectLockEnabled(ctx, bucket, projectID)
+	if err != nil {
+		if storj.ErrBucketNotFound.Has(err) {
+			sendJSONError(w, "bucket does not exist", "", http.StatusBadRequest)
+		} else {
+			sendJSONError(w, "unable to check bucket", err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	data, err := json.Marshal(struct {
+		ObjectLockEnabled bool `json:"objectLockEnabled"`
+	}{enabled})
+	if err != nil {
+		sendJSONError(w, "failed to marshal object lock", err.Error(), http.StatusInternalServerError)
+	} else {
+		sendJSONData(w, http.StatusOK, data)
+	}
+}
+
+// ensureObjectLockEnabled sends an error to the client and returns false when
+// object lock isn't enabled for the bucket of the object.
+func (server *Server) ensureObjectLockEnabled(ctx context.Context, w http.ResponseWriter, location metabase.ObjectLocation) bool {
+	enabled, err := server.buckets.GetBucketObjectLockEnabled(ctx, []byte(location.BucketName), location.ProjectID)
+	if err != nil {
+		if storj.ErrBucketNotFound.Has(err) {
+			sendJSONError(w, "bucket does not exist", "", http.StatusBadRequest)
+		} else {
+			sendJSONError(w, "unable to check bucket", err.Error(), http.StatusInternalServerError)
+		}
+		return false
+	}
+	if !enabled {
+		sendJSONError(w, "object lock is not enabled for bucket", "", http.StatusBadRequest)
+		return false
+	}
+	return true
+}
+
+func (server *Server) setObjectRetention(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	location, version, err := validateObjectPathParameters(r)
+	if err != nil {
+		sendJSONError(w, err.Error(), "", http.StatusBadRequest)
+		return
+	}
+
+	retainUntil, err := time.Parse(time.RFC3339, r.URL.Query().Get("retainUntil"))
+	if err != nil {
+		sendJSONError(w, "retainUntil is missing or invalid", "expected RFC3339 timestamp", http.StatusBadRequest)
+		return
+	}
+
+	if !server.ensureObjectLockEnabled(ctx, w, location) {
+		return
+	}
+
+	err = server.metabase.SetObjectRetention(ctx, metabase.SetObjectRetention{
+		ObjectLocation: location,
+		Version:        version,
+		RetainUntil:    retainUntil,
+	})
+	if err != nil {
+		switch {
+		case storj.ErrObjectNotFound.Has(err):
+			sendJSONError(w, "object does not exist", "", http.StatusNotFound)
+		case metabase.ErrObjectLocked.Has(err):
+			sendJSONError(w, "unable to set object retention", err.Error(), http.StatusConflict)
+		case metabase.ErrInvalidRequest.Has(err):
+			sendJSONError(w, "unable to set object retention", err.Error(), http.StatusBadRequest)
+		default:
+			sendJSONError(w, "unable to set object retention", err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+}
+
+func (server *Server) setObjectLegalHold(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	location, version, err := validateObjectPathParameters(r)
+	if err != nil {
+		sendJSONError(w, err.Error(), "", http.StatusBadRequest)
+		return
+	}
+
+	legalHold, err := strconv.ParseBool(r.URL.Query().Get("enabled"))
+	if err != nil {
+		sendJSONError(w, "enabled is missing or invalid", "expected true or false", http.StatusBadRequest)
+		return
+	}
+
+	if !server.ensureObjectLockEnabled(ctx, w, location) {
+		return
+	}
+
+	err = server.metabase.SetObjectLegalHold(ctx, metabase.SetObjectLegalHold{
+		ObjectLocation: location,
+		Version:        version,
+		LegalHold:      legalHold,
+	})
+	if err != nil {
+		switch {
+		case storj.ErrObjectNotFound.Has(err):
+			sendJSONError(w, "object does not exist", "", http.StatusNotFound)
+		case metabase.ErrInvalidRequest.Has(err):
+			sendJSONError(w, "unable to set object legal hold", err.Error(), http.StatusBadRequest)
+		default:
+			sendJSONError(w, "unable to set object legal hold", err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+}
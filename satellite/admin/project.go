@@ -21,6 +21,7 @@ import (
 	"storj.io/common/storj"
 	"storj.io/common/uuid"
 	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/metabase"
 	"storj.io/storj/satellite/payments/stripecoinpayments"
 )
 
@@ -469,6 +470,11 @@ func (server *Server) deleteProject(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// locked objects must outlive the project, see object lock in metabase
+	if server.checkLockedObjects(ctx, w, projectUUID) {
+		return
+	}
+
 	// if usage exist, return error to client and exit
 	if server.checkUsage(ctx, w, projectUUID) {
 		return
@@ -482,6 +488,19 @@ func (server *Server) deleteProject(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+func (server *Server) checkLockedObjects(ctx context.Context, w http.ResponseWriter, projectID uuid.UUID) (hasLocked bool) {
+	locked, err := server.metabase.HasLockedObjects(ctx, metabase.HasLockedObjects{ProjectID: projectID})
+	if err != nil {
+		sendJSONError(w, "unable to check for locked objects", err.Error(), http.StatusInternalServerError)
+		return true
+	}
+	if locked {
+		sendJSONError(w, "project has locked objects", projectID.String(), http.StatusConflict)
+		return true
+	}
+	return false
+}
+
 func (server *Server) checkUsage(ctx context.Context, w http.ResponseWriter, projectID uuid.UUID) (hasUsage bool) {
 	// do not delete projects that have usage for the current month.
 	year, month, _ := server.nowFn().UTC().Date()
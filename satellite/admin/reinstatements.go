@@ -0,0 +1,208 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/overlay"
+	"storj.io/storj/satellite/reputation"
+)
+
+// penalizedNode is the JSON representation of a disqualified or suspended node.
+type penalizedNode struct {
+	ID                     string     `json:"id"`
+	Address                string     `json:"address"`
+	Email                  string     `json:"email"`
+	Disqualified           *time.Time `json:"disqualified"`
+	DisqualificationReason string     `json:"disqualificationReason,omitempty"`
+	UnknownAuditSuspended  *time.Time `json:"unknownAuditSuspended"`
+	OfflineSuspended       *time.Time `json:"offlineSuspended"`
+	LastContactSuccess     time.Time  `json:"lastContactSuccess"`
+}
+
+// nodeReinstatement is the JSON representation of a node reinstatement record.
+type nodeReinstatement struct {
+	ID                             string     `json:"id"`
+	NodeID                         string     `json:"nodeId"`
+	ReinstatedBy                   string     `json:"reinstatedBy"`
+	Reason                         string     `json:"reason"`
+	Probation                      bool       `json:"probation"`
+	PreviousDisqualified           *time.Time `json:"previousDisqualified"`
+	PreviousDisqualificationReason string     `json:"previousDisqualificationReason,omitempty"`
+	PreviousUnknownAuditSuspended  *time.Time `json:"previousUnknownAuditSuspended"`
+	PreviousOfflineSuspended       *time.Time `json:"previousOfflineSuspended"`
+	CreatedAt                      time.Time  `json:"createdAt"`
+}
+
+func newNodeReinstatement(reinstatement reputation.Reinstatement) nodeReinstatement {
+	output := nodeReinstatement{
+		ID:                            reinstatement.ID.String(),
+		NodeID:                        reinstatement.NodeID.String(),
+		ReinstatedBy:                  reinstatement.ReinstatedBy,
+		Reason:                        reinstatement.Reason,
+		Probation:                     reinstatement.Probation,
+		PreviousDisqualified:          reinstatement.PreviousDisqualified,
+		PreviousUnknownAuditSuspended: reinstatement.PreviousUnknownAuditSuspended,
+		PreviousOfflineSuspended:      reinstatement.PreviousOfflineSuspended,
+		CreatedAt:                     reinstatement.CreatedAt,
+	}
+	if reinstatement.PreviousDisqualified != nil {
+		output.PreviousDisqualificationReason = reinstatement.PreviousDisqualificationReason.String()
+	}
+	return output
+}
+
+func (server *Server) listPenalizedNodes(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	limit := 100
+	if value := r.URL.Query().Get("limit"); value != "" {
+		var err error
+		limit, err = strconv.Atoi(value)
+		if err != nil || limit <= 0 {
+			sendJSONError(w, "invalid limit", "", http.StatusBadRequest)
+			return
+		}
+	}
+
+	disqualified, suspended := true, true
+	switch r.URL.Query().Get("status") {
+	case "":
+	case "disqualified":
+		suspended = false
+	case "suspended":
+		disqualified = false
+	default:
+		sendJSONError(w, "invalid status", "allowed values are disqualified and suspended", http.StatusBadRequest)
+		return
+	}
+
+	nodes, err := server.db.OverlayCache().ListPenalizedNodes(ctx, disqualified, suspended, limit)
+	if err != nil {
+		sendJSONError(w, "unable to list nodes", err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	output := make([]penalizedNode, 0, len(nodes))
+	for _, node := range nodes {
+		item := penalizedNode{
+			ID:                    node.ID.String(),
+			Address:               node.Address,
+			Email:                 node.Email,
+			Disqualified:          node.Disqualified,
+			UnknownAuditSuspended: node.UnknownAuditSuspended,
+			OfflineSuspended:      node.OfflineSuspended,
+			LastContactSuccess:    node.LastContactSuccess,
+		}
+		if node.Disqualified != nil {
+			item.DisqualificationReason = node.DisqualificationReason.String()
+		}
+		output = append(output, item)
+	}
+
+	data, err := json.Marshal(output)
+	if err != nil {
+		sendJSONError(w, "failed to marshal nodes", err.Error(), http.StatusInternalServerError)
+	} else {
+		sendJSONData(w, http.StatusOK, data)
+	}
+}
+
+func (server *Server) reinstateNode(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	nodeID, err := validateNodePathParameters(mux.Vars(r))
+	if err != nil {
+		sendJSONError(w, err.Error(), "", http.StatusBadRequest)
+		return
+	}
+
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		sendJSONError(w, "failed to read body", err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	var input struct {
+		ReinstatedBy string `json:"reinstatedBy"`
+		Reason       string `json:"reason"`
+		Probation    bool   `json:"probation"`
+	}
+	if err := json.Unmarshal(body, &input); err != nil {
+		sendJSONError(w, "failed to unmarshal request", err.Error(), http.StatusBadRequest)
+		return
+	}
+	if input.ReinstatedBy == "" || input.Reason == "" {
+		sendJSONError(w, "reinstatedBy and reason are required", "", http.StatusBadRequest)
+		return
+	}
+
+	id, err := uuid.New()
+	if err != nil {
+		sendJSONError(w, "unable to reinstate node", err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	reinstatement, err := server.db.Reputation().Reinstate(ctx, reputation.Reinstatement{
+		ID:           id,
+		NodeID:       nodeID,
+		ReinstatedBy: input.ReinstatedBy,
+		Reason:       input.Reason,
+		Probation:    input.Probation,
+	})
+	if err != nil {
+		switch {
+		case reputation.ErrNodeNotFound.Has(err), overlay.ErrNodeNotFound.Has(err):
+			sendJSONError(w, "node does not exist", "", http.StatusNotFound)
+		case reputation.ErrNodeNotPenalized.Has(err):
+			sendJSONError(w, "node is neither disqualified nor suspended", "", http.StatusConflict)
+		default:
+			sendJSONError(w, "unable to reinstate node", err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	data, err := json.Marshal(newNodeReinstatement(reinstatement))
+	if err != nil {
+		sendJSONError(w, "failed to marshal reinstatement", err.Error(), http.StatusInternalServerError)
+	} else {
+		sendJSONData(w, http.StatusOK, data)
+	}
+}
+
+func (server *Server) listNodeReinstatements(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	nodeID, err := validateNodePathParameters(mux.Vars(r))
+	if err != nil {
+		sendJSONError(w, err.Error(), "", http.StatusBadRequest)
+		return
+	}
+
+	reinstatements, err := server.db.Reputation().Reinstatements(ctx, nodeID)
+	if err != nil {
+		sendJSONError(w, "unable to list reinstatements", err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	output := make([]nodeReinstatement, 0, len(reinstatements))
+	for _, reinstatement := range reinstatements {
+		output = append(output, newNodeReinstatement(reinstatement))
+	}
+
+	data, err := json.Marshal(output)
+	if err != nil {
+		sendJSONError(w, "failed to marshal reinstatements", err.Error(), http.StatusInternalServerError)
+	} else {
+		sendJSONData(w, http.StatusOK, data)
+	}
+}
@@ -0,0 +1,294 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/repair/queue"
+)
+
+// repairQueueSegment is the JSON representation of a segment in the repair queue.
+type repairQueueSegment struct {
+	StreamID      string     `json:"streamId"`
+	Position      uint64     `json:"position"`
+	Reason        string     `json:"reason"`
+	SegmentHealth *float64   `json:"segmentHealth"`
+	AttemptedAt   *time.Time `json:"attemptedAt"`
+	UpdatedAt     time.Time  `json:"updatedAt"`
+}
+
+// repairQueueStat is the JSON representation of a repair queue statistic.
+type repairQueueStat struct {
+	Reason       string `json:"reason"`
+	HealthBucket string `json:"healthBucket"`
+	Count        int    `json:"count"`
+}
+
+func validateRepairQueuePathParameters(vars map[string]string) (streamID uuid.UUID, position metabase.SegmentPosition, err error) {
+	streamID, err = uuid.FromString(vars["streamid"])
+	if err != nil {
+		return streamID, position, fmt.Errorf("invalid stream-id")
+	}
+
+	encoded, err := strconv.ParseUint(vars["position"], 10, 64)
+	if err != nil {
+		return streamID, position, fmt.Errorf("invalid position")
+	}
+
+	return streamID, metabase.SegmentPositionFromEncoded(encoded), nil
+}
+
+// parseOperatorReason parses the reason of manually enqueued segments.
+func parseOperatorReason(name string) (queue.Reason, error) {
+	if name == "" {
+		return queue.ReasonOperatorRequested, nil
+	}
+	reason, err := queue.ParseReason(name)
+	if err != nil {
+		return 0, err
+	}
+	if !reason.Forced() {
+		return 0, fmt.Errorf("reason %q can only be used by the checker", name)
+	}
+	return reason, nil
+}
+
+func (server *Server) listRepairQueue(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	limit := 100
+	if value := r.URL.Query().Get("limit"); value != "" {
+		var err error
+		limit, err = strconv.Atoi(value)
+		if err != nil || limit <= 0 {
+			sendJSONError(w, "invalid limit", "", http.StatusBadRequest)
+			return
+		}
+	}
+
+	var reasons []queue.Reason
+	if value := r.URL.Query().Get("reason"); value != "" {
+		reason, err := queue.ParseReason(value)
+		if err != nil {
+			sendJSONError(w, "invalid reason", err.Error(), http.StatusBadRequest)
+			return
+		}
+		reasons = append(reasons, reason)
+	}
+
+	segments, err := server.db.RepairQueue().SelectN(ctx, limit, reasons...)
+	if err != nil {
+		sendJSONError(w, "unable to list repair queue", err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	output := make([]repairQueueSegment, 0, len(segments))
+	for _, segment := range segments {
+		var health *float64
+		if !math.IsInf(segment.SegmentHealth, 0) && !math.IsNaN(segment.SegmentHealth) {
+			value := segment.SegmentHealth
+			health = &value
+		}
+		output = append(output, repairQueueSegment{
+			StreamID:      segment.StreamID.String(),
+			Position:      segment.Position.Encode(),
+			Reason:        segment.Reason.String(),
+			SegmentHealth: health,
+			AttemptedAt:   segment.AttemptedAt,
+			UpdatedAt:     segment.UpdatedAt,
+		})
+	}
+
+	data, err := json.Marshal(output)
+	if err != nil {
+		sendJSONError(w, "failed to marshal repair queue", err.Error(), http.StatusInternalServerError)
+	} else {
+		sendJSONData(w, http.StatusOK, data)
+	}
+}
+
+func (server *Server) getRepairQueueStats(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	stats, err := server.db.RepairQueue().Stats(ctx)
+	if err != nil {
+		sendJSONError(w, "unable to get repair queue stats", err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	output := make([]repairQueueStat, 0, len(stats))
+	for _, stat := range stats {
+		output = append(output, repairQueueStat{
+			Reason:       stat.Reason.String(),
+			HealthBucket: queue.HealthBucketName(stat.HealthBucket),
+			Count:        stat.Count,
+		})
+	}
+
+	data, err := json.Marshal(output)
+	if err != nil {
+		sendJSONError(w, "failed to marshal repair queue stats", err.Error(), http.StatusInternalServerError)
+	} else {
+		sendJSONData(w, http.StatusOK, data)
+	}
+}
+
+func (server *Server) enqueueSegment(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		sendJSONError(w, "failed to read body", err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	var input struct {
+		StreamID string `json:"streamId"`
+		Position uint64 `json:"position"`
+		Reason   string `json:"reason"`
+	}
+	if err := json.Unmarshal(body, &input); err != nil {
+		sendJSONError(w, "failed to unmarshal request", err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	streamID, err := uuid.FromString(input.StreamID)
+	if err != nil {
+		sendJSONError(w, "invalid stream-id", err.Error(), http.StatusBadRequest)
+		return
+	}
+	reason, err := parseOperatorReason(input.Reason)
+	if err != nil {
+		sendJSONError(w, "invalid reason", err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	segment, err := server.metabase.GetSegmentByPosition(ctx, metabase.GetSegmentByPosition{
+		StreamID: streamID,
+		Position: metabase.SegmentPositionFromEncoded(input.Position),
+	})
+	if err != nil {
+		if metabase.ErrSegmentNotFound.Has(err) {
+			sendJSONError(w, "segment does not exist", "", http.StatusNotFound)
+		} else {
+			sendJSONError(w, "unable to get segment", err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+	if segment.Inline() {
+		sendJSONError(w, "inline segments can not be repaired", "", http.StatusBadRequest)
+		return
+	}
+
+	_, err = server.db.RepairQueue().Insert(ctx, &queue.InjuredSegment{
+		StreamID:      streamID,
+		Position:      segment.Position,
+		SegmentHealth: queue.UnknownHealth,
+		UpdatedAt:     time.Now().UTC(),
+		Reason:        reason,
+	})
+	if err != nil {
+		sendJSONError(w, "unable to enqueue segment", err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+func (server *Server) dequeueSegment(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	streamID, position, err := validateRepairQueuePathParameters(mux.Vars(r))
+	if err != nil {
+		sendJSONError(w, err.Error(), "", http.StatusBadRequest)
+		return
+	}
+
+	err = server.db.RepairQueue().Delete(ctx, &queue.InjuredSegment{
+		StreamID: streamID,
+		Position: position,
+	})
+	if err != nil {
+		sendJSONError(w, "unable to dequeue segment", err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+func (server *Server) repairObject(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	location, version, err := validateObjectPathParameters(r)
+	if err != nil {
+		sendJSONError(w, err.Error(), "", http.StatusBadRequest)
+		return
+	}
+
+	object, err := server.metabase.GetObjectExactVersion(ctx, metabase.GetObjectExactVersion{
+		ObjectLocation: location,
+		Version:        version,
+	})
+	if err != nil {
+		if storj.ErrObjectNotFound.Has(err) {
+			sendJSONError(w, "object does not exist", "", http.StatusNotFound)
+		} else {
+			sendJSONError(w, "unable to get object", err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	var enqueued int
+	var cursor metabase.SegmentPosition
+	for {
+		result, err := server.metabase.ListSegments(ctx, metabase.ListSegments{
+			StreamID: object.StreamID,
+			Cursor:   cursor,
+		})
+		if err != nil {
+			sendJSONError(w, "unable to list object segments", err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		for _, segment := range result.Segments {
+			cursor = segment.Position
+			if segment.Inline() {
+				continue
+			}
+			_, err = server.db.RepairQueue().Insert(ctx, &queue.InjuredSegment{
+				StreamID:      object.StreamID,
+				Position:      segment.Position,
+				SegmentHealth: queue.UnknownHealth,
+				UpdatedAt:     time.Now().UTC(),
+				Reason:        queue.ReasonOperatorRequested,
+			})
+			if err != nil {
+				sendJSONError(w, "unable to enqueue segment", err.Error(), http.StatusInternalServerError)
+				return
+			}
+			enqueued++
+		}
+
+		if !result.More {
+			break
+		}
+	}
+
+	data, err := json.Marshal(struct {
+		Enqueued int `json:"enqueued"`
+	}{enqueued})
+	if err != nil {
+		sendJSONError(w, "failed to marshal response", err.Error(), http.StatusInternalServerError)
+	} else {
+		sendJSONData(w, http.StatusOK, data)
+	}
+}
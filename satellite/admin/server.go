@@ -20,10 +20,16 @@ import (
 
 	"storj.io/common/errs2"
 	"storj.io/storj/satellite/accounting"
+	"storj.io/storj/satellite/audit"
 	"storj.io/storj/satellite/buckets"
 	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/nodeselection/uploadselection"
+	"storj.io/storj/satellite/overlay"
 	"storj.io/storj/satellite/payments"
 	"storj.io/storj/satellite/payments/stripecoinpayments"
+	"storj.io/storj/satellite/repair/queue"
+	"storj.io/storj/satellite/reputation"
 )
 
 //go:embed ui/public
@@ -47,6 +53,14 @@ type DB interface {
 	Console() console.DB
 	// StripeCoinPayments returns database for satellite stripe coin payments
 	StripeCoinPayments() stripecoinpayments.DB
+	// OverlayCache returns database for storage node information
+	OverlayCache() overlay.DB
+	// RepairQueue returns the queue of segments which need repair
+	RepairQueue() queue.RepairQueue
+	// AuditRequests returns database for on-demand audit requests
+	AuditRequests() audit.Requests
+	// Reputation returns database for audit reputation information
+	Reputation() reputation.DB
 }
 
 // Server provides endpoints for administrative tasks.
@@ -59,6 +73,9 @@ type Server struct {
 	db       DB
 	payments payments.Accounts
 	buckets  *buckets.Service
+	metabase *metabase.DB
+
+	placements uploadselection.PlacementRules
 
 	nowFn func() time.Time
 
@@ -66,7 +83,7 @@ type Server struct {
 }
 
 // NewServer returns a new administration Server.
-func NewServer(log *zap.Logger, listener net.Listener, db DB, buckets *buckets.Service, accounts payments.Accounts, config Config) *Server {
+func NewServer(log *zap.Logger, listener net.Listener, db DB, buckets *buckets.Service, metabase *metabase.DB, accounts payments.Accounts, placements uploadselection.PlacementRules, config Config) *Server {
 	server := &Server{
 		log: log,
 
@@ -75,6 +92,9 @@ func NewServer(log *zap.Logger, listener net.Listener, db DB, buckets *buckets.S
 		db:       db,
 		payments: accounts,
 		buckets:  buckets,
+		metabase: metabase,
+
+		placements: placements,
 
 		nowFn: time.Now,
 
@@ -104,7 +124,33 @@ func NewServer(log *zap.Logger, listener net.Listener, db DB, buckets *buckets.S
 	api.HandleFunc("/projects/{project}/buckets/{bucket}/geofence", server.createGeofenceForBucket).Methods("POST")
 	api.HandleFunc("/projects/{project}/buckets/{bucket}/geofence", server.deleteGeofenceForBucket).Methods("DELETE")
 	api.HandleFunc("/projects/{project}/buckets/{bucket}/geofence", server.checkGeofenceForBucket).Methods("GET")
+	api.HandleFunc("/projects/{project}/buckets/{bucket}/lifecycle", server.getBucketLifecycle).Methods("GET")
+	api.HandleFunc("/projects/{project}/buckets/{bucket}/lifecycle", server.putBucketLifecycle).Methods("PUT")
+	api.HandleFunc("/projects/{project}/buckets/{bucket}/lifecycle", server.deleteBucketLifecycle).Methods("DELETE")
+	api.HandleFunc("/projects/{project}/buckets/{bucket}/notifications", server.getBucketNotifications).Methods("GET")
+	api.HandleFunc("/projects/{project}/buckets/{bucket}/notifications", server.putBucketNotifications).Methods("PUT")
+	api.HandleFunc("/projects/{project}/buckets/{bucket}/notifications", server.deleteBucketNotifications).Methods("DELETE")
+	api.HandleFunc("/projects/{project}/buckets/{bucket}/versioning", server.getBucketVersioning).Methods("GET")
+	api.HandleFunc("/projects/{project}/buckets/{bucket}/versioning", server.putBucketVersioning).Methods("PUT")
+	api.HandleFunc("/projects/{project}/buckets/{bucket}/objectlock", server.enableObjectLockForBucket).Methods("POST")
+	api.HandleFunc("/projects/{project}/buckets/{bucket}/objectlock", server.checkObjectLockForBucket).Methods("GET")
+	api.HandleFunc("/projects/{project}/buckets/{bucket}/objects/{key}/retention", server.setObjectRetention).Methods("PUT")
+	api.HandleFunc("/projects/{project}/buckets/{bucket}/objects/{key}/legalhold", server.setObjectLegalHold).Methods("PUT")
+	api.HandleFunc("/projects/{project}/buckets/{bucket}/objects/{key}/repair", server.repairObject).Methods("POST")
+	api.HandleFunc("/projects/{project}/buckets/{bucket}/objects/{key}/audit", server.auditObject).Methods("POST")
 	api.HandleFunc("/apikeys/{apikey}", server.deleteAPIKey).Methods("DELETE")
+	api.HandleFunc("/nodes/{nodeid}/tags", server.getNodeTags).Methods("GET")
+	api.HandleFunc("/nodes/{nodeid}/tags", server.putNodeTags).Methods("PUT")
+	api.HandleFunc("/nodes/{nodeid}/audit", server.auditNode).Methods("POST")
+	api.HandleFunc("/nodes/penalized", server.listPenalizedNodes).Methods("GET")
+	api.HandleFunc("/nodes/{nodeid}/reinstate", server.reinstateNode).Methods("POST")
+	api.HandleFunc("/nodes/{nodeid}/reinstatements", server.listNodeReinstatements).Methods("GET")
+	api.HandleFunc("/repair-queue", server.listRepairQueue).Methods("GET")
+	api.HandleFunc("/repair-queue", server.enqueueSegment).Methods("POST")
+	api.HandleFunc("/repair-queue/stats", server.getRepairQueueStats).Methods("GET")
+	api.HandleFunc("/repair-queue/{streamid}/{position}", server.dequeueSegment).Methods("DELETE")
+	api.HandleFunc("/audits", server.listAuditRequests).Methods("GET")
+	api.HandleFunc("/audits/{id}", server.getAuditReport).Methods("GET")
 
 	// This handler must be the last one because it uses the root as prefix,
 	// otherwise will try to serve all the handlers set after this one.
@@ -0,0 +1,85 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/buckets"
+)
+
+func (server *Server) putBucketVersioning(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	project, bucket, err := validateGeofencePathParameters(mux.Vars(r))
+	if err != nil {
+		sendJSONError(w, err.Error(), "", http.StatusBadRequest)
+		return
+	}
+
+	var versioning buckets.Versioning
+	switch state := r.URL.Query().Get("state"); state {
+	case buckets.VersioningEnabled.String():
+		versioning = buckets.VersioningEnabled
+	case buckets.VersioningSuspended.String():
+		versioning = buckets.VersioningSuspended
+	default:
+		sendJSONError(w, "invalid versioning state", "state must be enabled or suspended", http.StatusBadRequest)
+		return
+	}
+
+	err = server.buckets.UpdateBucketVersioning(ctx, bucket, project.UUID, versioning)
+	if err != nil {
+		switch {
+		case storj.ErrBucketNotFound.Has(err):
+			sendJSONError(w, "bucket does not exist", "", http.StatusBadRequest)
+		case buckets.ErrInvalidVersioning.Has(err):
+			sendJSONError(w, "unable to update versioning for bucket", err.Error(), http.StatusBadRequest)
+		default:
+			sendJSONError(w, "unable to update versioning for bucket", err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	server.sendBucketVersioning(ctx, w, project.UUID, bucket)
+}
+
+func (server *Server) getBucketVersioning(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	project, bucket, err := validateGeofencePathParameters(mux.Vars(r))
+	if err != nil {
+		sendJSONError(w, err.Error(), "", http.StatusBadRequest)
+		return
+	}
+
+	server.sendBucketVersioning(ctx, w, project.UUID, bucket)
+}
+
+func (server *Server) sendBucketVersioning(ctx context.Context, w http.ResponseWriter, projectID uuid.UUID, bucket []byte) {
+	versioning, err := server.buckets.GetBucketVersioning(ctx, bucket, projectID)
+	if err != nil {
+		if storj.ErrBucketNotFound.Has(err) {
+			sendJSONError(w, "bucket does not exist", "", http.StatusBadRequest)
+		} else {
+			sendJSONError(w, "unable to check bucket", err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	data, err := json.Marshal(struct {
+		Versioning string `json:"versioning"`
+	}{versioning.String()})
+	if err != nil {
+		sendJSONError(w, "failed to marshal versioning", err.Error(), http.StatusInternalServerError)
+	} else {
+		sendJSONData(w, http.StatusOK, data)
+	}
+}
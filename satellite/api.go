@@ -109,7 +109,8 @@ type API struct {
 	}
 
 	Inspector struct {
-		Endpoint *inspector.Endpoint
+		Endpoint      *inspector.Endpoint
+		AuditEndpoint *inspector.AuditEndpoint
 	}
 
 	Accounting struct {
@@ -442,6 +443,17 @@ func NewAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 		if err := internalpb.DRPCRegisterHealthInspector(peer.Server.PrivateDRPC(), peer.Inspector.Endpoint); err != nil {
 			return nil, errs.Combine(err, peer.Close())
 		}
+
+		peer.Inspector.AuditEndpoint = inspector.NewAuditEndpoint(
+			peer.Log.Named("inspector:audit"),
+			peer.Overlay.Service,
+			peer.Metainfo.Metabase,
+			peer.DB.PieceAuditRequests(),
+			peer.DB.AuditRequests(),
+		)
+		if err := internalpb.DRPCRegisterAuditInspector(peer.Server.PrivateDRPC(), peer.Inspector.AuditEndpoint); err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
 	}
 
 	{ // setup mailservice
@@ -562,6 +574,8 @@ func NewAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 
 	{ // setup console
 		consoleConfig := config.Console
+		consoleConfig.Config.RedundancyProfiles = config.Metainfo.RedundancyProfiles
+		consoleConfig.Config.DefaultMaxBuckets = config.Metainfo.ProjectLimits.MaxBuckets
 		peer.Console.Listener, err = net.Listen("tcp", consoleConfig.Address)
 		if err != nil {
 			return nil, errs.Combine(err, peer.Close())
@@ -651,6 +665,7 @@ func NewAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 				peer.Reputation.Service,
 				peer.Metainfo.Metabase,
 				peer.Orders.Service,
+				peer.DB.RepairQueue(),
 				peer.DB.PeerIdentities(),
 				config.GracefulExit)
 
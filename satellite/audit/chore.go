@@ -8,6 +8,7 @@ import (
 	"math/rand"
 	"time"
 
+	"github.com/zeebo/errs"
 	"go.uber.org/zap"
 
 	"storj.io/common/sync2"
@@ -23,18 +24,24 @@ type Chore struct {
 	queues *Queues
 	Loop   *sync2.Cycle
 
+	pieceQueue    *PieceQueue
+	pieceRequests PieceAuditRequests
+
 	segmentLoop *segmentloop.Service
 	config      Config
 }
 
 // NewChore instantiates Chore.
-func NewChore(log *zap.Logger, queues *Queues, loop *segmentloop.Service, config Config) *Chore {
+func NewChore(log *zap.Logger, queues *Queues, pieceQueue *PieceQueue, pieceRequests PieceAuditRequests, loop *segmentloop.Service, config Config) *Chore {
 	return &Chore{
 		log:    log,
 		rand:   rand.New(rand.NewSource(time.Now().Unix())),
 		queues: queues,
 		Loop:   sync2.NewCycle(config.ChoreInterval),
 
+		pieceQueue:    pieceQueue,
+		pieceRequests: pieceRequests,
+
 		segmentLoop: loop,
 		config:      config,
 	}
@@ -80,11 +87,74 @@ func (chore *Chore) Run(ctx context.Context) (err error) {
 			}
 		}
 
+		if chore.config.PieceAuditsPerInterval > 0 {
+			err = chore.pushPieceAudits(ctx, collector)
+			if err != nil {
+				chore.log.Error("error populating piece audit queue", zap.Error(err))
+			}
+		}
+
 		// Push new queue to queues struct so it can be fetched by worker.
 		return chore.queues.Push(newQueue)
 	})
 }
 
+// pushPieceAudits populates the piece audit queue with the reservoir segments
+// of the nodes requested by operators and with a random sample of pieces of
+// the other nodes.
+func (chore *Chore) pushPieceAudits(ctx context.Context, collector *Collector) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var sampled []FullPiece
+	for nodeID, res := range collector.Reservoirs {
+		if res.Segments[0] == (Segment{}) {
+			continue
+		}
+		sampled = append(sampled, FullPiece{Segment: res.Segments[0], NodeID: nodeID})
+	}
+	chore.rand.Shuffle(len(sampled), func(i, j int) {
+		sampled[i], sampled[j] = sampled[j], sampled[i]
+	})
+	if len(sampled) > chore.config.PieceQueueSize {
+		sampled = sampled[:chore.config.PieceQueueSize]
+	}
+	chore.pieceQueue.Replace(sampled)
+
+	requests, err := chore.pieceRequests.List(ctx)
+	if err != nil {
+		return err
+	}
+
+	// requests for nodes without any segments in this run's reservoirs are
+	// kept, so that they are audited once the nodes get reservoir segments.
+	var requested []FullPiece
+	var matched []PieceAuditRequest
+	for _, request := range requests {
+		res, ok := collector.Reservoirs[request.NodeID]
+		if !ok {
+			continue
+		}
+		queued := false
+		for _, segment := range res.Segments {
+			if segment == (Segment{}) {
+				continue
+			}
+			requested = append(requested, FullPiece{Segment: segment, NodeID: request.NodeID})
+			queued = true
+		}
+		if queued {
+			matched = append(matched, request)
+		}
+	}
+	chore.pieceQueue.Push(requested)
+
+	var errlist errs.Group
+	for _, request := range matched {
+		errlist.Add(chore.pieceRequests.Delete(ctx, request))
+	}
+	return errlist.Err()
+}
+
 // Close closes chore.
 func (chore *Chore) Close() error {
 	chore.Loop.Close()
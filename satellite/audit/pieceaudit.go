@@ -0,0 +1,91 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package audit
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"storj.io/common/storj"
+)
+
+// FullPiece is the whole piece of a segment stored on a node, which is to be audited.
+type FullPiece struct {
+	Segment Segment
+	NodeID  storj.NodeID
+}
+
+// PieceAuditRequest is a request of an operator to audit whole pieces stored on a node.
+type PieceAuditRequest struct {
+	NodeID      storj.NodeID
+	RequestedAt time.Time
+}
+
+// PieceAuditRequests keeps track of the nodes for which full piece audits were requested.
+//
+// architecture: Database
+type PieceAuditRequests interface {
+	// Request requests full piece audits of the node.
+	Request(ctx context.Context, nodeID storj.NodeID) error
+	// List returns all pending requests.
+	List(ctx context.Context) ([]PieceAuditRequest, error)
+	// Delete removes the request, unless the node has been requested again since.
+	Delete(ctx context.Context, request PieceAuditRequest) error
+}
+
+// PieceQueue is a list of whole pieces to audit, shared between the reservoir chore
+// and the piece audit worker. Pieces requested by operators are audited first.
+type PieceQueue struct {
+	mu        sync.Mutex
+	requested []FullPiece
+	sampled   []FullPiece
+}
+
+// NewPieceQueue creates a new piece audit queue.
+func NewPieceQueue() *PieceQueue {
+	return &PieceQueue{}
+}
+
+// Next gets the next piece in the queue.
+func (queue *PieceQueue) Next() (FullPiece, error) {
+	queue.mu.Lock()
+	defer queue.mu.Unlock()
+
+	if len(queue.requested) > 0 {
+		next := queue.requested[0]
+		queue.requested = queue.requested[1:]
+		return next, nil
+	}
+	if len(queue.sampled) > 0 {
+		next := queue.sampled[0]
+		queue.sampled = queue.sampled[1:]
+		return next, nil
+	}
+	return FullPiece{}, ErrEmptyQueue.New("")
+}
+
+// Push adds pieces requested by operators to the queue.
+func (queue *PieceQueue) Push(pieces []FullPiece) {
+	queue.mu.Lock()
+	defer queue.mu.Unlock()
+
+	queue.requested = append(queue.requested, pieces...)
+}
+
+// Replace replaces the randomly sampled pieces of the queue.
+func (queue *PieceQueue) Replace(pieces []FullPiece) {
+	queue.mu.Lock()
+	defer queue.mu.Unlock()
+
+	queue.sampled = pieces
+}
+
+// Size returns the size of the queue.
+func (queue *PieceQueue) Size() int {
+	queue.mu.Lock()
+	defer queue.mu.Unlock()
+
+	return len(queue.requested) + len(queue.sampled)
+}
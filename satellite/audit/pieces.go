@@ -30,6 +30,22 @@ const (
 	PieceAuditSuccess
 )
 
+// String returns a string representation of the piece audit status.
+func (pieceAudit PieceAudit) String() string {
+	switch pieceAudit {
+	case PieceAuditFailure:
+		return "fail"
+	case PieceAuditOffline:
+		return "offline"
+	case PieceAuditContained:
+		return "contained"
+	case PieceAuditSuccess:
+		return "success"
+	default:
+		return "unknown"
+	}
+}
+
 // Pieces contains pieces structured by piece audit.
 type Pieces struct {
 	Successful metabase.Pieces
@@ -0,0 +1,305 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package audit
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/errs2"
+	"storj.io/common/pb"
+	"storj.io/common/pkcrypto"
+	"storj.io/common/rpc"
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/common/signing"
+	"storj.io/common/storj"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/overlay"
+	"storj.io/uplink/private/eestream"
+)
+
+// ErrPieceVerifyFailed is the errs class used when a downloaded piece doesn't match its piece hash.
+var ErrPieceVerifyFailed = errs.Class("piece verification failed")
+
+// VerifyPiece downloads the whole piece of the segment stored on the node and
+// verifies it against the piece hash signed by the uplink. Then it compares a
+// random stripe of the piece with the erasure-coded reconstruction from the
+// other pieces of the segment.
+//
+// The returned report contains only the audited node.
+func (verifier *Verifier) VerifyPiece(ctx context.Context, fullPiece FullPiece) (report Report, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	segment, nodeID := fullPiece.Segment, fullPiece.NodeID
+
+	if segment.Expired(verifier.nowFn()) {
+		verifier.log.Debug("segment expired before VerifyPiece")
+		return Report{}, nil
+	}
+
+	segmentInfo, err := verifier.metabase.GetSegmentByPosition(ctx, metabase.GetSegmentByPosition{
+		StreamID: segment.StreamID,
+		Position: segment.Position,
+	})
+	if err != nil {
+		if metabase.ErrSegmentNotFound.Has(err) {
+			verifier.log.Debug("segment deleted before VerifyPiece")
+			return Report{}, nil
+		}
+		return Report{}, err
+	}
+
+	var piece metabase.Piece
+	found := false
+	for _, p := range segmentInfo.Pieces {
+		if p.StorageNode == nodeID {
+			piece = p
+			found = true
+			break
+		}
+	}
+	if !found {
+		verifier.log.Debug("VerifyPiece: node doesn't hold a piece of the segment anymore",
+			zap.Stringer("Node ID", nodeID),
+			zap.String("Segment", segmentInfoString(segment)))
+		return Report{}, nil
+	}
+
+	redundancy, err := eestream.NewRedundancyStrategyFromStorj(segmentInfo.Redundancy)
+	if err != nil {
+		return Report{}, Error.Wrap(err)
+	}
+	pieceSize := eestream.CalcPieceSize(int64(segmentInfo.EncryptedSize), redundancy)
+
+	limit, privateKey, cachedIPAndPort, err := verifier.orders.CreateAuditOrderLimit(ctx, nodeID, piece.Number, segmentInfo.RootPieceID, int32(pieceSize))
+	if err != nil {
+		if overlay.ErrNodeDisqualified.Has(err) {
+			verifier.log.Debug("VerifyPiece: order limit not created (disqualified)", zap.Stringer("Node ID", nodeID))
+			return Report{}, nil
+		}
+		if overlay.ErrNodeFinishedGE.Has(err) {
+			verifier.log.Debug("VerifyPiece: order limit not created (completed graceful exit)", zap.Stringer("Node ID", nodeID))
+			return Report{}, nil
+		}
+		if overlay.ErrNodeOffline.Has(err) {
+			verifier.log.Debug("VerifyPiece: order limit not created (offline)", zap.Stringer("Node ID", nodeID))
+			return Report{Offlines: storj.NodeIDList{nodeID}}, nil
+		}
+		return Report{}, Error.Wrap(err)
+	}
+
+	pieceData, downloadErr := verifier.GetPiece(ctx, limit, privateKey, cachedIPAndPort, pieceSize)
+
+	err = verifier.checkIfSegmentAltered(ctx, segmentInfo)
+	if err != nil {
+		if ErrSegmentDeleted.Has(err) {
+			verifier.log.Debug("segment deleted during VerifyPiece")
+			return Report{}, nil
+		}
+		if ErrSegmentModified.Has(err) {
+			verifier.log.Debug("segment modified during VerifyPiece")
+			return Report{}, nil
+		}
+		return Report{}, err
+	}
+
+	if downloadErr != nil {
+		return verifier.classifyPieceError(segment, nodeID, downloadErr), nil
+	}
+
+	altered, err := verifier.verifyPieceStripe(ctx, segmentInfo, piece, pieceData)
+	if err != nil {
+		// the piece matches the hash signed by the uplink, which is
+		// enough to consider the audit successful.
+		verifier.log.Debug("VerifyPiece: could not compare the piece with the erasure-coded reconstruction",
+			zap.Stringer("Node ID", nodeID),
+			zap.String("Segment", segmentInfoString(segment)),
+			zap.Error(err))
+		return Report{Successes: storj.NodeIDList{nodeID}}, nil
+	}
+	if altered {
+		verifier.log.Info("VerifyPiece: piece data doesn't match the erasure-coded reconstruction (audit failed)",
+			zap.Stringer("Node ID", nodeID),
+			zap.String("Segment", segmentInfoString(segment)))
+		return Report{Fails: storj.NodeIDList{nodeID}}, nil
+	}
+
+	return Report{Successes: storj.NodeIDList{nodeID}}, nil
+}
+
+// classifyPieceError converts the error of downloading a whole piece into an audit outcome.
+func (verifier *Verifier) classifyPieceError(segment Segment, nodeID storj.NodeID, err error) Report {
+	log := verifier.log.With(
+		zap.Stringer("Node ID", nodeID),
+		zap.String("Segment", segmentInfoString(segment)),
+		zap.Error(err))
+
+	if ErrPieceVerifyFailed.Has(err) {
+		log.Info("VerifyPiece: piece doesn't match the piece hash (audit failed)")
+		return Report{Fails: storj.NodeIDList{nodeID}}
+	}
+
+	if rpc.Error.Has(err) {
+		if errs.Is(err, context.DeadlineExceeded) {
+			log.Debug("VerifyPiece: dial timeout (offline)")
+			return Report{Offlines: storj.NodeIDList{nodeID}}
+		}
+		if errs2.IsRPC(err, rpcstatus.Unknown) {
+			log.Debug("VerifyPiece: dial failed (offline)")
+			return Report{Offlines: storj.NodeIDList{nodeID}}
+		}
+		log.Info("VerifyPiece: unknown transport error (skipped)")
+		return Report{Unknown: storj.NodeIDList{nodeID}}
+	}
+
+	if errs2.IsRPC(err, rpcstatus.NotFound) {
+		log.Info("VerifyPiece: piece not found (audit failed)")
+		return Report{Fails: storj.NodeIDList{nodeID}}
+	}
+
+	// pending audits are tracked for single shares, so nodes can't be
+	// contained for a whole piece which they failed to send in time.
+	if errs2.IsRPC(err, rpcstatus.DeadlineExceeded) {
+		log.Info("VerifyPiece: download timeout (skipped)")
+		return Report{Unknown: storj.NodeIDList{nodeID}}
+	}
+
+	log.Info("VerifyPiece: unknown error (skipped)")
+	return Report{Unknown: storj.NodeIDList{nodeID}}
+}
+
+// verifyPieceStripe downloads a random stripe from the other nodes of the
+// segment and checks whether the corresponding share of the piece data has
+// been altered.
+func (verifier *Verifier) verifyPieceStripe(ctx context.Context, segmentInfo metabase.Segment, piece metabase.Piece, pieceData []byte) (altered bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	stripeIndex, err := GetRandomStripe(ctx, segmentInfo)
+	if err != nil {
+		return false, err
+	}
+
+	shareSize := segmentInfo.Redundancy.ShareSize
+	offset := int64(stripeIndex) * int64(shareSize)
+	if offset+int64(shareSize) > int64(len(pieceData)) {
+		return false, Error.New("stripe %d is out of the piece of size %d", stripeIndex, len(pieceData))
+	}
+
+	skip := map[storj.NodeID]bool{piece.StorageNode: true}
+	limits, privateKey, cachedIPsAndPorts, err := verifier.orders.CreateAuditOrderLimits(ctx, segmentInfo, skip)
+	if err != nil {
+		return false, err
+	}
+
+	shares, err := verifier.DownloadShares(ctx, limits, privateKey, cachedIPsAndPorts, stripeIndex, shareSize)
+	if err != nil {
+		return false, err
+	}
+
+	sharesToAudit := make(map[int]Share, len(shares)+1)
+	for pieceNum, share := range shares {
+		if share.Error == nil {
+			sharesToAudit[pieceNum] = share
+		}
+	}
+	sharesToAudit[int(piece.Number)] = Share{
+		PieceNum: int(piece.Number),
+		NodeID:   piece.StorageNode,
+		Data:     pieceData[offset : offset+int64(shareSize)],
+	}
+
+	// detecting an altered share requires more than the minimum number of shares.
+	required := segmentInfo.Redundancy.RequiredShares
+	if len(sharesToAudit) <= int(required) {
+		return false, ErrNotEnoughShares.New("got: %d, required more than: %d", len(sharesToAudit), required)
+	}
+
+	pieceNums, _, err := auditShares(ctx, required, segmentInfo.Redundancy.TotalShares, sharesToAudit)
+	if err != nil {
+		return false, err
+	}
+
+	for _, pieceNum := range pieceNums {
+		if pieceNum == int(piece.Number) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// GetPiece uses piece store client to download the whole piece from the node and
+// verifies it against the piece hash signed by the uplink.
+func (verifier *Verifier) GetPiece(ctx context.Context, limit *pb.AddressedOrderLimit, piecePrivateKey storj.PiecePrivateKey, cachedIPAndPort string, pieceSize int64) (data []byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	// determines number of seconds allotted for receiving data from a storage node
+	timedCtx := ctx
+	if verifier.minBytesPerSecond > 0 {
+		maxTransferTime := time.Duration(int64(time.Second) * pieceSize / verifier.minBytesPerSecond.Int64())
+		if maxTransferTime < verifier.minDownloadTimeout {
+			maxTransferTime = verifier.minDownloadTimeout
+		}
+		var cancel func()
+		timedCtx, cancel = context.WithTimeout(ctx, maxTransferTime)
+		defer cancel()
+	}
+
+	ps, err := verifier.dialNode(timedCtx, limit, cachedIPAndPort)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() {
+		err := ps.Close()
+		if err != nil {
+			verifier.log.Error("audit verifier failed to close conn to node: %+v", zap.Error(err))
+		}
+	}()
+
+	downloader, err := ps.Download(timedCtx, limit.GetLimit(), piecePrivateKey, 0, pieceSize)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = errs.Combine(err, downloader.Close()) }()
+
+	data = make([]byte, pieceSize)
+	_, err = io.ReadFull(downloader, data)
+	if err != nil {
+		if errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, io.EOF) {
+			return nil, ErrPieceVerifyFailed.New("piece is shorter than %d bytes", pieceSize)
+		}
+		return nil, err
+	}
+
+	// get signed piece hash and original order limit
+	hash, originalLimit := downloader.GetHashAndLimit()
+	if hash == nil {
+		return nil, ErrPieceVerifyFailed.New("hash was not sent from storagenode")
+	}
+	if originalLimit == nil {
+		return nil, ErrPieceVerifyFailed.New("original order limit was not sent from storagenode")
+	}
+
+	if err := signing.VerifyOrderLimitSignature(ctx, signing.SigneeFromPeerIdentity(verifier.auditor), originalLimit); err != nil {
+		return nil, ErrPieceVerifyFailed.New("invalid order limit signature: %v", err)
+	}
+	if originalLimit.PieceId != hash.PieceId {
+		return nil, ErrPieceVerifyFailed.New("piece id changed")
+	}
+	if err := signing.VerifyUplinkPieceHashSignature(ctx, originalLimit.UplinkPublicKey, hash); err != nil {
+		return nil, ErrPieceVerifyFailed.New("invalid piece hash signature: %v", err)
+	}
+
+	calculatedHash := pkcrypto.SHA256Hash(data)
+	if !bytes.Equal(hash.Hash, calculatedHash) {
+		return nil, ErrPieceVerifyFailed.New("hash from storage node, %x, does not match calculated hash, %x", hash.Hash, calculatedHash)
+	}
+
+	return data, nil
+}
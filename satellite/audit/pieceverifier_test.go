@@ -0,0 +1,225 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package audit_test
+
+import (
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"storj.io/common/memory"
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/audit"
+	"storj.io/storj/satellite/internalpb"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/storage"
+)
+
+func TestVerifyPieceHappyPath(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		audits := satellite.Audit
+
+		audits.Worker.Loop.Pause()
+		audits.Chore.Loop.Pause()
+
+		ul := planet.Uplinks[0]
+		testData := testrand.Bytes(8 * memory.KiB)
+
+		err := ul.Upload(ctx, satellite, "testbucket", "test/path", testData)
+		require.NoError(t, err)
+
+		segment := auditSegment(ctx, t, planet)
+
+		for _, piece := range segment.Pieces {
+			report, err := audits.Verifier.VerifyPiece(ctx, audit.FullPiece{
+				Segment: audit.Segment{StreamID: segment.StreamID, Position: segment.Position},
+				NodeID:  piece.StorageNode,
+			})
+			require.NoError(t, err)
+
+			assert.Equal(t, storj.NodeIDList{piece.StorageNode}, report.Successes)
+			assert.Len(t, report.Fails, 0)
+			assert.Len(t, report.Offlines, 0)
+			assert.Len(t, report.Unknown, 0)
+		}
+	})
+}
+
+func TestVerifyPieceMissingPiece(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		audits := satellite.Audit
+
+		audits.Worker.Loop.Pause()
+		audits.Chore.Loop.Pause()
+
+		ul := planet.Uplinks[0]
+		testData := testrand.Bytes(8 * memory.KiB)
+
+		err := ul.Upload(ctx, satellite, "testbucket", "test/path", testData)
+		require.NoError(t, err)
+
+		segment := auditSegment(ctx, t, planet)
+
+		// delete the piece from the first node
+		piece := segment.Pieces[0]
+		pieceID := segment.RootPieceID.Derive(piece.StorageNode, int32(piece.Number))
+		node := planet.FindNode(piece.StorageNode)
+		err = node.Storage2.Store.Delete(ctx, satellite.ID(), pieceID)
+		require.NoError(t, err)
+
+		report, err := audits.Verifier.VerifyPiece(ctx, audit.FullPiece{
+			Segment: audit.Segment{StreamID: segment.StreamID, Position: segment.Position},
+			NodeID:  piece.StorageNode,
+		})
+		require.NoError(t, err)
+
+		assert.Len(t, report.Successes, 0)
+		assert.Equal(t, storj.NodeIDList{piece.StorageNode}, report.Fails)
+		assert.Len(t, report.Offlines, 0)
+		assert.Len(t, report.Unknown, 0)
+	})
+}
+
+func TestVerifyPieceCorruptedPiece(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		audits := satellite.Audit
+
+		audits.Worker.Loop.Pause()
+		audits.Chore.Loop.Pause()
+
+		ul := planet.Uplinks[0]
+		testData := testrand.Bytes(8 * memory.KiB)
+
+		err := ul.Upload(ctx, satellite, "testbucket", "test/path", testData)
+		require.NoError(t, err)
+
+		segment := auditSegment(ctx, t, planet)
+
+		// corrupt the last byte of the piece of the first node, which a
+		// random stripe audit would most likely miss.
+		piece := segment.Pieces[0]
+		node := planet.FindNode(piece.StorageNode)
+		blobRef := storage.BlobRef{
+			Namespace: satellite.ID().Bytes(),
+			Key:       segment.RootPieceID.Derive(piece.StorageNode, int32(piece.Number)).Bytes(),
+		}
+
+		reader, err := node.Storage2.BlobsCache.Open(ctx, blobRef)
+		require.NoError(t, err)
+		pieceSize, err := reader.Size()
+		require.NoError(t, err)
+		pieceData := make([]byte, pieceSize)
+		_, err = io.ReadFull(reader, pieceData)
+		require.NoError(t, err)
+		require.NoError(t, reader.Close())
+
+		require.NoError(t, node.Storage2.BlobsCache.Delete(ctx, blobRef))
+
+		pieceData[pieceSize-1]++
+		writer, err := node.Storage2.BlobsCache.Create(ctx, blobRef, pieceSize)
+		require.NoError(t, err)
+		_, err = writer.Write(pieceData)
+		require.NoError(t, err)
+		require.NoError(t, writer.Commit(ctx))
+
+		report, err := audits.Verifier.VerifyPiece(ctx, audit.FullPiece{
+			Segment: audit.Segment{StreamID: segment.StreamID, Position: segment.Position},
+			NodeID:  piece.StorageNode,
+		})
+		require.NoError(t, err)
+
+		assert.Len(t, report.Successes, 0)
+		assert.Equal(t, storj.NodeIDList{piece.StorageNode}, report.Fails)
+		assert.Len(t, report.Offlines, 0)
+		assert.Len(t, report.Unknown, 0)
+	})
+}
+
+func TestPieceAuditRequest(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Audit.PieceAuditsPerInterval = 1
+				config.Audit.PieceQueueSize = 0
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		audits := satellite.Audit
+
+		audits.Worker.Loop.Pause()
+		audits.PieceWorker.Loop.Pause()
+		audits.Chore.Loop.Pause()
+
+		ul := planet.Uplinks[0]
+		testData := testrand.Bytes(8 * memory.KiB)
+
+		err := ul.Upload(ctx, satellite, "testbucket", "test/path", testData)
+		require.NoError(t, err)
+
+		nodeID := planet.StorageNodes[0].ID()
+		_, err = satellite.Inspector.AuditEndpoint.PieceAudit(ctx, &internalpb.PieceAuditRequest{
+			NodeId: nodeID,
+		})
+		require.NoError(t, err)
+
+		// a node without any segments in the reservoirs.
+		unknownID := testrand.NodeID()
+		require.NoError(t, satellite.DB.PieceAuditRequests().Request(ctx, unknownID))
+
+		audits.Chore.Loop.TriggerWait()
+
+		// only the requested node is queued, as no sampled pieces are kept.
+		require.Equal(t, 1, audits.PieceQueue.Size())
+		piece, err := audits.PieceQueue.Next()
+		require.NoError(t, err)
+		require.Equal(t, nodeID, piece.NodeID)
+
+		// the request has been processed and the unmatched one is kept for
+		// the next run.
+		requests, err := satellite.DB.PieceAuditRequests().List(ctx)
+		require.NoError(t, err)
+		require.Len(t, requests, 1)
+		require.Equal(t, unknownID, requests[0].NodeID)
+
+		report, err := audits.Verifier.VerifyPiece(ctx, piece)
+		require.NoError(t, err)
+		require.Equal(t, storj.NodeIDList{nodeID}, report.Successes)
+	})
+}
+
+// auditSegment returns the segment of the first item of the audit queue.
+func auditSegment(ctx *testcontext.Context, t *testing.T, planet *testplanet.Planet) metabase.Segment {
+	satellite := planet.Satellites[0]
+
+	satellite.Audit.Chore.Loop.TriggerWait()
+	queue := satellite.Audit.Queues.Fetch()
+	queueSegment, err := queue.Next()
+	require.NoError(t, err)
+
+	segment, err := satellite.Metabase.DB.GetSegmentByPosition(ctx, metabase.GetSegmentByPosition{
+		StreamID: queueSegment.StreamID,
+		Position: queueSegment.Position,
+	})
+	require.NoError(t, err)
+
+	return segment
+}
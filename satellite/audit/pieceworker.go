@@ -0,0 +1,103 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package audit
+
+import (
+	"context"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/sync2"
+)
+
+// PieceWorker audits whole pieces from the piece audit queue at a low rate.
+//
+// architecture: Worker
+type PieceWorker struct {
+	log      *zap.Logger
+	queue    *PieceQueue
+	verifier *Verifier
+	reporter *Reporter
+	Loop     *sync2.Cycle
+
+	piecesPerInterval int
+}
+
+// NewPieceWorker instantiates PieceWorker.
+func NewPieceWorker(log *zap.Logger, queue *PieceQueue, verifier *Verifier, reporter *Reporter, config Config) *PieceWorker {
+	return &PieceWorker{
+		log: log,
+
+		queue:    queue,
+		verifier: verifier,
+		reporter: reporter,
+		Loop:     sync2.NewCycle(config.PieceAuditInterval),
+
+		piecesPerInterval: config.PieceAuditsPerInterval,
+	}
+}
+
+// Run runs the piece audit worker.
+func (worker *PieceWorker) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return worker.Loop.Run(ctx, func(ctx context.Context) (err error) {
+		defer mon.Task()(&ctx)(&err)
+		err = worker.process(ctx)
+		if err != nil {
+			worker.log.Error("process", zap.Error(Error.Wrap(err)))
+		}
+		return nil
+	})
+}
+
+// Close halts the worker.
+func (worker *PieceWorker) Close() error {
+	worker.Loop.Close()
+	return nil
+}
+
+// process audits up to the configured number of pieces from the queue.
+func (worker *PieceWorker) process(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	for i := 0; i < worker.piecesPerInterval; i++ {
+		piece, err := worker.queue.Next()
+		if err != nil {
+			if ErrEmptyQueue.Has(err) {
+				return nil
+			}
+			return err
+		}
+
+		err = worker.work(ctx, piece)
+		if err != nil {
+			worker.log.Error("error(s) during piece audit",
+				zap.Stringer("Node ID", piece.NodeID),
+				zap.String("Segment StreamID", piece.Segment.StreamID.String()),
+				zap.Uint64("Segment Position", piece.Segment.Position.Encode()),
+				zap.Error(err))
+		}
+	}
+	return nil
+}
+
+func (worker *PieceWorker) work(ctx context.Context, piece FullPiece) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var errlist errs.Group
+
+	report, err := worker.verifier.VerifyPiece(ctx, piece)
+	if err != nil {
+		errlist.Add(err)
+	}
+
+	_, err = worker.reporter.RecordPieceAudits(ctx, report)
+	if err != nil {
+		errlist.Add(err)
+	}
+
+	return errlist.Err()
+}
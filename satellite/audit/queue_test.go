@@ -122,3 +122,36 @@ func testSegment() Segment {
 		},
 	}
 }
+
+func TestPieceQueue(t *testing.T) {
+	queue := NewPieceQueue()
+
+	_, err := queue.Next()
+	require.True(t, ErrEmptyQueue.Has(err), "required ErrEmptyQueue error")
+
+	sampled := []FullPiece{
+		{Segment: testSegment(), NodeID: testrand.NodeID()},
+		{Segment: testSegment(), NodeID: testrand.NodeID()},
+	}
+	requested := []FullPiece{
+		{Segment: testSegment(), NodeID: testrand.NodeID()},
+	}
+
+	queue.Replace(sampled)
+	queue.Push(requested)
+	require.Equal(t, 3, queue.Size())
+
+	// replacing the sampled pieces keeps the requested ones.
+	queue.Replace(sampled[1:])
+	require.Equal(t, 2, queue.Size())
+
+	// requested pieces are audited first.
+	for _, expected := range []FullPiece{requested[0], sampled[1]} {
+		actual, err := queue.Next()
+		require.NoError(t, err)
+		require.Equal(t, expected, actual)
+	}
+
+	_, err = queue.Next()
+	require.True(t, ErrEmptyQueue.Has(err), "required ErrEmptyQueue error")
+}
@@ -125,6 +125,52 @@ func (reporter *Reporter) RecordAudits(ctx context.Context, req Report) (_ Repor
 	return Report{}, nil
 }
 
+// RecordPieceAudits saves the results of full piece audits to overlay, using
+// the reputation weight of full piece audits. Like RecordAudits, it returns
+// the report with the nodes which failed to be updated and the error.
+func (reporter *Reporter) RecordPieceAudits(ctx context.Context, req Report) (_ Report, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	outcomes := []struct {
+		nodes  *storj.NodeIDList
+		result reputation.AuditType
+	}{
+		{&req.Successes, reputation.AuditSuccess},
+		{&req.Fails, reputation.AuditFailure},
+		{&req.Unknown, reputation.AuditUnknown},
+		{&req.Offlines, reputation.AuditOffline},
+	}
+
+	var errlist errs.Group
+	for tries := 0; tries <= reporter.maxRetries; tries++ {
+		errlist = errs.Group{}
+
+		pending := 0
+		for _, outcome := range outcomes {
+			var failed storj.NodeIDList
+			for _, nodeID := range *outcome.nodes {
+				if err := reporter.reputations.ApplyPieceAudit(ctx, nodeID, outcome.result); err != nil {
+					failed = append(failed, nodeID)
+					errlist.Add(err)
+				}
+			}
+			*outcome.nodes = failed
+			pending += len(failed)
+		}
+
+		if pending == 0 {
+			return Report{}, nil
+		}
+	}
+
+	return Report{
+		Successes: req.Successes,
+		Fails:     req.Fails,
+		Offlines:  req.Offlines,
+		Unknown:   req.Unknown,
+	}, errs.Combine(Error.New("some nodes failed to be updated in overlay"), errlist.Err())
+}
+
 // recordAuditFailStatus updates nodeIDs in overlay with isup=true, auditoutcome=fail.
 func (reporter *Reporter) recordAuditFailStatus(ctx context.Context, failedAuditNodeIDs storj.NodeIDList) (failed storj.NodeIDList, err error) {
 	defer mon.Task()(&ctx)(&err)
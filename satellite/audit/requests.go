@@ -0,0 +1,233 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package audit
+
+import (
+	"context"
+	"math/rand"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/segmentloop"
+)
+
+// ErrRequestNotFound is returned when an on-demand audit request doesn't exist.
+var ErrRequestNotFound = errs.Class("audit request not found")
+
+const (
+	// DefaultRequestLimit is the number of segments of a node which are audited
+	// when the request doesn't specify a limit.
+	DefaultRequestLimit = 1000
+	// MaxRequestLimit is the maximum number of segments of a node which can be
+	// audited with a single request.
+	MaxRequestLimit = 10000
+)
+
+// RequestStatus is the status of an on-demand audit request.
+type RequestStatus int
+
+const (
+	// RequestPending is a request which hasn't been processed yet.
+	RequestPending RequestStatus = 0
+	// RequestFinished is a request whose segments have all been audited.
+	RequestFinished RequestStatus = 1
+	// RequestFailed is a request which couldn't be processed.
+	RequestFailed RequestStatus = 2
+)
+
+// String returns a string representation of the request status.
+func (status RequestStatus) String() string {
+	switch status {
+	case RequestPending:
+		return "pending"
+	case RequestFinished:
+		return "finished"
+	case RequestFailed:
+		return "failed"
+	default:
+		return "unknown"
+	}
+}
+
+// Request is a request of an operator to audit the segments held by a node, or
+// the segments of an object.
+type Request struct {
+	ID uuid.UUID
+
+	// NodeID is set when the segments held by the node are audited.
+	NodeID storj.NodeID
+	// Limit is the number of randomly sampled segments of the node which are
+	// audited. It must be between 1 and MaxRequestLimit.
+	Limit int
+
+	// Object is set when the segments of the object are audited.
+	Object metabase.ObjectLocation
+	// Version is the version of the object, zero is the latest version.
+	Version metabase.Version
+
+	Status     RequestStatus
+	Error      string
+	CreatedAt  time.Time
+	FinishedAt *time.Time
+}
+
+// IsNodeRequest returns whether the request audits the segments held by a node.
+func (request Request) IsNodeRequest() bool {
+	return !request.NodeID.IsZero()
+}
+
+// sampleSize returns the number of segments to audit for the node request, using
+// DefaultRequestLimit for requests stored without a valid limit.
+func (request Request) sampleSize() int {
+	if request.Limit <= 0 || request.Limit > MaxRequestLimit {
+		return DefaultRequestLimit
+	}
+	return request.Limit
+}
+
+// RequestResult is the outcome of auditing a piece of a segment for a request.
+type RequestResult struct {
+	StreamID uuid.UUID
+	Position metabase.SegmentPosition
+	NodeID   storj.NodeID
+	Outcome  PieceAudit
+}
+
+// Requests stores on-demand audit requests and their results.
+//
+// architecture: Database
+type Requests interface {
+	// Create stores a new pending request.
+	Create(ctx context.Context, request Request) error
+	// Get returns the request with the given id.
+	Get(ctx context.Context, id uuid.UUID) (Request, error)
+	// List returns the most recently created requests.
+	List(ctx context.Context, limit int) ([]Request, error)
+	// ListPending returns all pending requests, oldest first.
+	ListPending(ctx context.Context) ([]Request, error)
+	// Finish marks the request as finished, or as failed when failure isn't empty.
+	Finish(ctx context.Context, id uuid.UUID, failure string) error
+	// AddResults stores results of the request, replacing earlier results of the same pieces.
+	AddResults(ctx context.Context, id uuid.UUID, results []RequestResult) error
+	// Results returns all results of the request.
+	Results(ctx context.Context, id uuid.UUID) ([]RequestResult, error)
+}
+
+// auditedSegment identifies a segment which has been audited for a request.
+type auditedSegment struct {
+	StreamID uuid.UUID
+	Position metabase.SegmentPosition
+}
+
+// requestProgress is the set of segments of a request which already have
+// results, so that they aren't audited again when a request is resumed.
+type requestProgress map[auditedSegment]struct{}
+
+// newRequestProgress returns the progress recorded by the results of a request.
+func newRequestProgress(results []RequestResult) requestProgress {
+	progress := make(requestProgress, len(results))
+	for _, result := range results {
+		progress[auditedSegment{StreamID: result.StreamID, Position: result.Position}] = struct{}{}
+	}
+	return progress
+}
+
+// audited returns whether the segment already has results.
+func (progress requestProgress) audited(segment Segment) bool {
+	_, ok := progress[auditedSegment{StreamID: segment.StreamID, Position: segment.Position}]
+	return ok
+}
+
+var _ segmentloop.Observer = (*RequestCollector)(nil)
+
+// RequestCollector uses the segment loop to gather the segments held by the
+// nodes of on-demand audit requests.
+type RequestCollector struct {
+	rand    *rand.Rand
+	samples map[uuid.UUID]*requestSample
+	byNode  map[storj.NodeID][]*requestSample
+}
+
+// requestSample holds a random sample of the segments of a node, which haven't
+// been audited for the request yet.
+type requestSample struct {
+	limit    int
+	progress requestProgress
+	seen     int64
+	segments []Segment
+}
+
+// add adds the segment to the sample using reservoir sampling.
+func (sample *requestSample) add(r *rand.Rand, segment Segment) {
+	if sample.progress.audited(segment) {
+		return
+	}
+	sample.seen++
+	if len(sample.segments) < sample.limit {
+		sample.segments = append(sample.segments, segment)
+		return
+	}
+	if random := r.Int63n(sample.seen); random < int64(sample.limit) {
+		sample.segments[random] = segment
+	}
+}
+
+// NewRequestCollector instantiates a segment collector for the node requests.
+// Segments in the progress of a request are skipped and count towards its limit.
+func NewRequestCollector(requests []Request, progress map[uuid.UUID]requestProgress, r *rand.Rand) *RequestCollector {
+	collector := &RequestCollector{
+		rand:    r,
+		samples: make(map[uuid.UUID]*requestSample),
+		byNode:  make(map[storj.NodeID][]*requestSample),
+	}
+	for _, request := range requests {
+		if !request.IsNodeRequest() {
+			continue
+		}
+		sample := &requestSample{
+			limit:    request.sampleSize() - len(progress[request.ID]),
+			progress: progress[request.ID],
+		}
+		if sample.limit <= 0 {
+			continue
+		}
+		collector.samples[request.ID] = sample
+		collector.byNode[request.NodeID] = append(collector.byNode[request.NodeID], sample)
+	}
+	return collector
+}
+
+// Segments returns the segments collected for the request.
+func (collector *RequestCollector) Segments(id uuid.UUID) []Segment {
+	if sample, ok := collector.samples[id]; ok {
+		return sample.segments
+	}
+	return nil
+}
+
+// LoopStarted is called at each start of a loop.
+func (collector *RequestCollector) LoopStarted(context.Context, segmentloop.LoopInfo) (err error) {
+	return nil
+}
+
+// RemoteSegment adds the segment to the samples of the requested nodes holding its pieces.
+func (collector *RequestCollector) RemoteSegment(ctx context.Context, segment *segmentloop.Segment) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	for _, piece := range segment.Pieces {
+		for _, sample := range collector.byNode[piece.StorageNode] {
+			sample.add(collector.rand, NewSegment(segment))
+		}
+	}
+	return nil
+}
+
+// InlineSegment returns nil because inline segments aren't stored on nodes.
+func (collector *RequestCollector) InlineSegment(ctx context.Context, segment *segmentloop.Segment) (err error) {
+	return nil
+}
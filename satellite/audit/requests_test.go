@@ -0,0 +1,64 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package audit
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/segmentloop"
+)
+
+func TestRequestCollector(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	nodeA, nodeB, nodeC := testrand.NodeID(), testrand.NodeID(), testrand.NodeID()
+
+	all := Request{ID: testrand.UUID(), NodeID: nodeA}
+	limited := Request{ID: testrand.UUID(), NodeID: nodeB, Limit: 2}
+	resumed := Request{ID: testrand.UUID(), NodeID: nodeB, Limit: 3}
+	finished := Request{ID: testrand.UUID(), NodeID: nodeB, Limit: 1}
+	object := Request{ID: testrand.UUID(), Object: metabase.ObjectLocation{ProjectID: testrand.UUID()}}
+
+	audited := RequestResult{StreamID: uuid.UUID{0: 0}, NodeID: nodeB, Outcome: PieceAuditSuccess}
+	progress := map[uuid.UUID]requestProgress{
+		resumed.ID:  newRequestProgress([]RequestResult{audited}),
+		finished.ID: newRequestProgress([]RequestResult{audited}),
+	}
+
+	collector := NewRequestCollector([]Request{all, limited, resumed, finished, object}, progress, rand.New(rand.NewSource(0)))
+
+	var expected []Segment
+	for i := 0; i < 10; i++ {
+		segment := &segmentloop.Segment{
+			StreamID: uuid.UUID{0: byte(i)},
+			Pieces: metabase.Pieces{
+				{Number: 0, StorageNode: nodeA},
+				{Number: 1, StorageNode: nodeB},
+				{Number: 2, StorageNode: nodeC},
+			},
+		}
+		require.NoError(t, collector.RemoteSegment(ctx, segment))
+		expected = append(expected, NewSegment(segment))
+	}
+
+	// requests without a limit get up to the default number of segments.
+	require.Equal(t, expected, collector.Segments(all.ID))
+	// limited requests get a sample of the segments.
+	require.Len(t, collector.Segments(limited.ID), 2)
+	require.Subset(t, expected, collector.Segments(limited.ID))
+	// resumed requests skip audited segments, which count towards the limit.
+	require.Len(t, collector.Segments(resumed.ID), 2)
+	require.Subset(t, expected[1:], collector.Segments(resumed.ID))
+	require.Empty(t, collector.Segments(finished.ID))
+	// object requests don't collect segments.
+	require.Empty(t, collector.Segments(object.ID))
+}
@@ -0,0 +1,262 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package audit
+
+import (
+	"context"
+	"math/rand"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storj.io/common/storj"
+	"storj.io/common/sync2"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/segmentloop"
+)
+
+// RequestWorker processes on-demand audit requests of operators. Segments held
+// by the requested nodes are gathered with a single segment loop pass for all
+// pending requests; segments of requested objects are listed from the metabase.
+// Every segment is audited the same way as by the audit worker and the outcomes
+// are stored as results of the request.
+//
+// architecture: Worker
+type RequestWorker struct {
+	log         *zap.Logger
+	rand        *rand.Rand
+	requests    Requests
+	metabase    *metabase.DB
+	segmentLoop *segmentloop.Service
+	verifier    *Verifier
+	reporter    *Reporter
+	Loop        *sync2.Cycle
+}
+
+// NewRequestWorker instantiates RequestWorker.
+func NewRequestWorker(log *zap.Logger, requests Requests, metabaseDB *metabase.DB, segmentLoop *segmentloop.Service, verifier *Verifier, reporter *Reporter, config Config) *RequestWorker {
+	return &RequestWorker{
+		log:  log,
+		rand: rand.New(rand.NewSource(time.Now().Unix())),
+
+		requests:    requests,
+		metabase:    metabaseDB,
+		segmentLoop: segmentLoop,
+		verifier:    verifier,
+		reporter:    reporter,
+		Loop:        sync2.NewCycle(config.RequestInterval),
+	}
+}
+
+// Run runs the request worker.
+func (worker *RequestWorker) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return worker.Loop.Run(ctx, func(ctx context.Context) (err error) {
+		defer mon.Task()(&ctx)(&err)
+		err = worker.process(ctx)
+		if err != nil {
+			worker.log.Error("process", zap.Error(Error.Wrap(err)))
+		}
+		return nil
+	})
+}
+
+// Close halts the worker.
+func (worker *RequestWorker) Close() error {
+	worker.Loop.Close()
+	return nil
+}
+
+// process audits the segments of all pending requests.
+func (worker *RequestWorker) process(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	requests, err := worker.requests.ListPending(ctx)
+	if err != nil {
+		return err
+	}
+	if len(requests) == 0 {
+		return nil
+	}
+
+	// results stored before an interruption are kept, so that a resumed request
+	// only audits the remaining segments.
+	progress := make(map[uuid.UUID]requestProgress, len(requests))
+	for _, request := range requests {
+		results, err := worker.requests.Results(ctx, request.ID)
+		if err != nil {
+			return err
+		}
+		progress[request.ID] = newRequestProgress(results)
+	}
+
+	var collector *RequestCollector
+	for _, request := range requests {
+		if request.IsNodeRequest() {
+			collector = NewRequestCollector(requests, progress, worker.rand)
+			break
+		}
+	}
+	if collector != nil {
+		err = worker.segmentLoop.Join(ctx, collector)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, request := range requests {
+		segments, err := worker.requestSegments(ctx, request, collector)
+		if err == nil {
+			err = worker.work(ctx, request, segments, progress[request.ID])
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		var failure string
+		if err != nil {
+			worker.log.Error("on-demand audit request failed",
+				zap.Stringer("Request ID", request.ID),
+				zap.Error(err))
+			failure = err.Error()
+		}
+
+		err = worker.requests.Finish(ctx, request.ID, failure)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// requestSegments returns the segments to audit for the request.
+func (worker *RequestWorker) requestSegments(ctx context.Context, request Request, collector *RequestCollector) ([]Segment, error) {
+	if request.IsNodeRequest() {
+		return collector.Segments(request.ID), nil
+	}
+	return worker.objectSegments(ctx, request)
+}
+
+// objectSegments lists the remote segments of the requested object.
+func (worker *RequestWorker) objectSegments(ctx context.Context, request Request) (_ []Segment, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var object metabase.Object
+	if request.Version == 0 {
+		object, err = worker.metabase.GetObjectLatestVersion(ctx, metabase.GetObjectLatestVersion{
+			ObjectLocation: request.Object,
+		})
+	} else {
+		object, err = worker.metabase.GetObjectExactVersion(ctx, metabase.GetObjectExactVersion{
+			ObjectLocation: request.Object,
+			Version:        request.Version,
+		})
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var segments []Segment
+	var cursor metabase.SegmentPosition
+	for {
+		result, err := worker.metabase.ListSegments(ctx, metabase.ListSegments{
+			StreamID: object.StreamID,
+			Cursor:   cursor,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, segment := range result.Segments {
+			cursor = segment.Position
+			if segment.Inline() {
+				continue
+			}
+			segments = append(segments, Segment{
+				StreamID:  segment.StreamID,
+				Position:  segment.Position,
+				ExpiresAt: segment.ExpiresAt,
+			})
+		}
+
+		if !result.More {
+			return segments, nil
+		}
+	}
+}
+
+// work audits the segments of the request, which aren't in its progress yet, and
+// stores the results.
+func (worker *RequestWorker) work(ctx context.Context, request Request, segments []Segment, progress requestProgress) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	for _, segment := range segments {
+		if progress.audited(segment) {
+			continue
+		}
+
+		report, verifyErr := verifySegment(ctx, worker.verifier, worker.reporter, segment)
+		if verifyErr != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			worker.log.Error("error(s) during on-demand audit",
+				zap.Stringer("Request ID", request.ID),
+				zap.String("Segment StreamID", segment.StreamID.String()),
+				zap.Uint64("Segment Position", segment.Position.Encode()),
+				zap.Error(verifyErr))
+		}
+
+		results := requestResults(request, segment, report, verifyErr)
+		if len(results) == 0 {
+			continue
+		}
+		err = worker.requests.AddResults(ctx, request.ID, results)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// requestResults converts the report of auditing the segment into results of
+// the request. Node requests keep only the outcome of the requested node, which
+// is unknown when it's missing from the report of a failed audit.
+func requestResults(request Request, segment Segment, report Report, verifyErr error) []RequestResult {
+	var results []RequestResult
+	add := func(nodeID storj.NodeID, outcome PieceAudit) {
+		if request.IsNodeRequest() && nodeID != request.NodeID {
+			return
+		}
+		results = append(results, RequestResult{
+			StreamID: segment.StreamID,
+			Position: segment.Position,
+			NodeID:   nodeID,
+			Outcome:  outcome,
+		})
+	}
+
+	for _, nodeID := range report.Successes {
+		add(nodeID, PieceAuditSuccess)
+	}
+	for _, nodeID := range report.Fails {
+		add(nodeID, PieceAuditFailure)
+	}
+	for _, nodeID := range report.Offlines {
+		add(nodeID, PieceAuditOffline)
+	}
+	for _, pending := range report.PendingAudits {
+		add(pending.NodeID, PieceAuditContained)
+	}
+	for _, nodeID := range report.Unknown {
+		add(nodeID, PieceAuditUnknown)
+	}
+
+	if request.IsNodeRequest() && len(results) == 0 && verifyErr != nil {
+		add(request.NodeID, PieceAuditUnknown)
+	}
+	return results
+}
@@ -0,0 +1,254 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package audit_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/memory"
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/common/uuid"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite/audit"
+	"storj.io/storj/satellite/internalpb"
+	"storj.io/storj/satellite/metabase"
+)
+
+func TestNodeAuditRequest(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		audits := satellite.Audit
+
+		audits.Worker.Loop.Pause()
+		audits.Chore.Loop.Pause()
+		audits.RequestWorker.Loop.Pause()
+
+		ul := planet.Uplinks[0]
+		for _, path := range []string{"test/path1", "test/path2"} {
+			err := ul.Upload(ctx, satellite, "testbucket", path, testrand.Bytes(8*memory.KiB))
+			require.NoError(t, err)
+		}
+
+		segments, err := satellite.Metabase.DB.TestingAllSegments(ctx)
+		require.NoError(t, err)
+		require.Len(t, segments, 2)
+
+		// delete the piece of the first segment from a node holding pieces of both segments.
+		piece := segments[0].Pieces[0]
+		for _, p := range segments[0].Pieces {
+			if containsNode(segments[1].Pieces, p.StorageNode) {
+				piece = p
+				break
+			}
+		}
+		require.True(t, containsNode(segments[1].Pieces, piece.StorageNode))
+
+		node := planet.FindNode(piece.StorageNode)
+		pieceID := segments[0].RootPieceID.Derive(piece.StorageNode, int32(piece.Number))
+		require.NoError(t, node.Storage2.Store.Delete(ctx, satellite.ID(), pieceID))
+
+		resp, err := satellite.Inspector.AuditEndpoint.NodeAudit(ctx, &internalpb.NodeAuditRequest{
+			NodeId: piece.StorageNode,
+		})
+		require.NoError(t, err)
+
+		report, err := satellite.Inspector.AuditEndpoint.AuditReport(ctx, &internalpb.AuditReportRequest{
+			RequestId: resp.RequestId,
+		})
+		require.NoError(t, err)
+		require.Equal(t, "pending", report.Status)
+		require.Empty(t, report.Results)
+
+		audits.RequestWorker.Loop.TriggerWait()
+
+		report, err = satellite.Inspector.AuditEndpoint.AuditReport(ctx, &internalpb.AuditReportRequest{
+			RequestId: resp.RequestId,
+		})
+		require.NoError(t, err)
+		require.Equal(t, "finished", report.Status)
+		require.Empty(t, report.Error)
+		require.Len(t, report.Results, 2)
+
+		outcomes := map[uuid.UUID]string{}
+		for _, result := range report.Results {
+			require.Equal(t, piece.StorageNode, result.NodeId)
+			streamID, err := uuid.FromBytes(result.StreamId)
+			require.NoError(t, err)
+			outcomes[streamID] = result.Outcome
+		}
+		require.Equal(t, "fail", outcomes[segments[0].StreamID])
+		require.Equal(t, "success", outcomes[segments[1].StreamID])
+	})
+}
+
+func TestNodeAuditRequestLimit(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		audits := satellite.Audit
+
+		audits.Worker.Loop.Pause()
+		audits.Chore.Loop.Pause()
+		audits.RequestWorker.Loop.Pause()
+
+		ul := planet.Uplinks[0]
+		for _, path := range []string{"test/path1", "test/path2", "test/path3"} {
+			err := ul.Upload(ctx, satellite, "testbucket", path, testrand.Bytes(8*memory.KiB))
+			require.NoError(t, err)
+		}
+
+		segments, err := satellite.Metabase.DB.TestingAllSegments(ctx)
+		require.NoError(t, err)
+		nodeID := segments[0].Pieces[0].StorageNode
+
+		resp, err := satellite.Inspector.AuditEndpoint.NodeAudit(ctx, &internalpb.NodeAuditRequest{
+			NodeId: nodeID,
+			Limit:  1,
+		})
+		require.NoError(t, err)
+
+		audits.RequestWorker.Loop.TriggerWait()
+
+		report, err := satellite.Inspector.AuditEndpoint.AuditReport(ctx, &internalpb.AuditReportRequest{
+			RequestId: resp.RequestId,
+		})
+		require.NoError(t, err)
+		require.Equal(t, "finished", report.Status)
+		require.Len(t, report.Results, 1)
+		require.Equal(t, nodeID, report.Results[0].NodeId)
+		require.Equal(t, "success", report.Results[0].Outcome)
+	})
+}
+
+func TestObjectAuditRequest(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		audits := satellite.Audit
+
+		audits.Worker.Loop.Pause()
+		audits.Chore.Loop.Pause()
+		audits.RequestWorker.Loop.Pause()
+
+		ul := planet.Uplinks[0]
+		err := ul.Upload(ctx, satellite, "testbucket", "test/path", testrand.Bytes(8*memory.KiB))
+		require.NoError(t, err)
+
+		objects, err := satellite.Metabase.DB.TestingAllObjects(ctx)
+		require.NoError(t, err)
+		require.Len(t, objects, 1)
+		object := objects[0]
+
+		segments, err := satellite.Metabase.DB.TestingAllSegments(ctx)
+		require.NoError(t, err)
+		require.Len(t, segments, 1)
+
+		resp, err := satellite.Inspector.AuditEndpoint.ObjectAudit(ctx, &internalpb.ObjectAuditRequest{
+			ProjectId:     object.ProjectID.Bytes(),
+			Bucket:        []byte(object.BucketName),
+			EncryptedPath: []byte(object.ObjectKey),
+		})
+		require.NoError(t, err)
+
+		audits.RequestWorker.Loop.TriggerWait()
+
+		report, err := satellite.Inspector.AuditEndpoint.AuditReport(ctx, &internalpb.AuditReportRequest{
+			RequestId: resp.RequestId,
+		})
+		require.NoError(t, err)
+		require.Equal(t, "finished", report.Status)
+		require.Len(t, report.Results, len(segments[0].Pieces))
+		for _, result := range report.Results {
+			require.True(t, containsNode(segments[0].Pieces, result.NodeId))
+			require.Equal(t, "success", result.Outcome)
+		}
+
+		// requests of missing objects are rejected.
+		_, err = satellite.Inspector.AuditEndpoint.ObjectAudit(ctx, &internalpb.ObjectAuditRequest{
+			ProjectId:     object.ProjectID.Bytes(),
+			Bucket:        []byte(object.BucketName),
+			EncryptedPath: []byte("missing"),
+		})
+		require.Error(t, err)
+	})
+}
+
+func TestAuditRequestResume(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		audits := satellite.Audit
+
+		audits.Worker.Loop.Pause()
+		audits.Chore.Loop.Pause()
+		audits.RequestWorker.Loop.Pause()
+
+		ul := planet.Uplinks[0]
+		for _, path := range []string{"test/path1", "test/path2"} {
+			err := ul.Upload(ctx, satellite, "testbucket", path, testrand.Bytes(8*memory.KiB))
+			require.NoError(t, err)
+		}
+
+		segments, err := satellite.Metabase.DB.TestingAllSegments(ctx)
+		require.NoError(t, err)
+		require.Len(t, segments, 2)
+		nodeID := segments[0].Pieces[0].StorageNode
+		require.True(t, containsNode(segments[1].Pieces, nodeID))
+
+		resp, err := satellite.Inspector.AuditEndpoint.NodeAudit(ctx, &internalpb.NodeAuditRequest{
+			NodeId: nodeID,
+			Limit:  2,
+		})
+		require.NoError(t, err)
+		requestID, err := uuid.FromBytes(resp.RequestId)
+		require.NoError(t, err)
+
+		// simulate a request, which was interrupted after auditing the first segment.
+		err = satellite.DB.AuditRequests().AddResults(ctx, requestID, []audit.RequestResult{{
+			StreamID: segments[0].StreamID,
+			Position: segments[0].Position,
+			NodeID:   nodeID,
+			Outcome:  audit.PieceAuditUnknown,
+		}})
+		require.NoError(t, err)
+
+		audits.RequestWorker.Loop.TriggerWait()
+
+		report, err := satellite.Inspector.AuditEndpoint.AuditReport(ctx, &internalpb.AuditReportRequest{
+			RequestId: resp.RequestId,
+		})
+		require.NoError(t, err)
+		require.Equal(t, "finished", report.Status)
+
+		// the first segment isn't audited again, only the remaining one is.
+		outcomes := make(map[uuid.UUID]string)
+		for _, result := range report.Results {
+			streamID, err := uuid.FromBytes(result.StreamId)
+			require.NoError(t, err)
+			outcomes[streamID] = result.Outcome
+		}
+		require.Equal(t, map[uuid.UUID]string{
+			segments[0].StreamID: "unknown",
+			segments[1].StreamID: "success",
+		}, outcomes)
+	})
+}
+
+func containsNode(pieces metabase.Pieces, nodeID storj.NodeID) bool {
+	for _, piece := range pieces {
+		if piece.StorageNode == nodeID {
+			return true
+		}
+	}
+	return false
+}
@@ -431,6 +431,19 @@ func (verifier *Verifier) Reverify(ctx context.Context, segment Segment) (report
 				return
 			}
 
+			// pieces on nodes which are not allowed by the placement anymore are moved by repair.
+			allowed, err := verifier.overlay.AllowedByPlacement(ctx, pending.NodeID, pendingSegment.Placement)
+			if err != nil {
+				ch <- result{nodeID: pending.NodeID, status: erred, err: err}
+				verifier.log.Debug("Reverify: error checking placement", zap.Stringer("Node ID", pending.NodeID), zap.Error(err))
+				return
+			}
+			if !allowed {
+				ch <- result{nodeID: pending.NodeID, status: skipped, release: true}
+				verifier.log.Debug("Reverify: node is not allowed by placement", zap.Stringer("Node ID", pending.NodeID), zap.Uint16("Placement", uint16(pendingSegment.Placement)))
+				return
+			}
+
 			limit, piecePrivateKey, cachedIPAndPort, err := verifier.orders.CreateAuditOrderLimit(ctx, pending.NodeID, pieceNum, pending.PieceID, pending.ShareSize)
 			if err != nil {
 				if overlay.ErrNodeDisqualified.Has(err) {
@@ -593,33 +606,11 @@ func (verifier *Verifier) GetShare(ctx context.Context, limit *pb.AddressedOrder
 	}
 
 	targetNodeID := limit.GetLimit().StorageNodeId
-	log := verifier.log.Named(targetNodeID.String())
-	var ps *piecestore.Client
-
-	// if cached IP is given, try connecting there first
-	if cachedIPAndPort != "" {
-		nodeAddr := storj.NodeURL{
-			ID:      targetNodeID,
-			Address: cachedIPAndPort,
-		}
-		ps, err = piecestore.Dial(timedCtx, verifier.dialer, nodeAddr, piecestore.DefaultConfig)
-		if err != nil {
-			log.Debug("failed to connect to audit target node at cached IP", zap.String("cached-ip-and-port", cachedIPAndPort), zap.Error(err))
-		}
-	}
 
-	// if no cached IP was given, or connecting to cached IP failed, use node address
-	if ps == nil {
-		nodeAddr := storj.NodeURL{
-			ID:      targetNodeID,
-			Address: limit.GetStorageNodeAddress().Address,
-		}
-		ps, err = piecestore.Dial(timedCtx, verifier.dialer, nodeAddr, piecestore.DefaultConfig)
-		if err != nil {
-			return Share{}, Error.Wrap(err)
-		}
+	ps, err := verifier.dialNode(timedCtx, limit, cachedIPAndPort)
+	if err != nil {
+		return Share{}, Error.Wrap(err)
 	}
-
 	defer func() {
 		err := ps.Close()
 		if err != nil {
@@ -649,6 +640,31 @@ func (verifier *Verifier) GetShare(ctx context.Context, limit *pb.AddressedOrder
 	}, nil
 }
 
+// dialNode connects to the piecestore of the node of the order limit. If the
+// cached IP is given, it tries connecting there first.
+func (verifier *Verifier) dialNode(ctx context.Context, limit *pb.AddressedOrderLimit, cachedIPAndPort string) (ps *piecestore.Client, err error) {
+	targetNodeID := limit.GetLimit().StorageNodeId
+
+	if cachedIPAndPort != "" {
+		nodeAddr := storj.NodeURL{
+			ID:      targetNodeID,
+			Address: cachedIPAndPort,
+		}
+		ps, err = piecestore.Dial(ctx, verifier.dialer, nodeAddr, piecestore.DefaultConfig)
+		if err == nil {
+			return ps, nil
+		}
+		verifier.log.Named(targetNodeID.String()).Debug("failed to connect to audit target node at cached IP", zap.String("cached-ip-and-port", cachedIPAndPort), zap.Error(err))
+	}
+
+	// if no cached IP was given, or connecting to cached IP failed, use node address
+	nodeAddr := storj.NodeURL{
+		ID:      targetNodeID,
+		Address: limit.GetStorageNodeAddress().Address,
+	}
+	return piecestore.Dial(ctx, verifier.dialer, nodeAddr, piecestore.DefaultConfig)
+}
+
 // checkIfSegmentAltered checks if oldSegment has been altered since it was selected for audit.
 func (verifier *Verifier) checkIfSegmentAltered(ctx context.Context, oldSegment metabase.Segment) (err error) {
 	defer mon.Task()(&ctx)(&err)
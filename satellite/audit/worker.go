@@ -29,6 +29,12 @@ type Config struct {
 	QueueInterval     time.Duration `help:"how often to recheck an empty audit queue" releaseDefault:"1h" devDefault:"1m" testDefault:"$TESTINTERVAL"`
 	Slots             int           `help:"number of reservoir slots allotted for nodes, currently capped at 3" default:"3"`
 	WorkerConcurrency int           `help:"number of workers to run audits on segments" default:"2"`
+
+	PieceAuditInterval     time.Duration `help:"how often to audit whole pieces from the piece audit queue" releaseDefault:"1h" devDefault:"1m" testDefault:"$TESTINTERVAL"`
+	PieceAuditsPerInterval int           `help:"number of whole pieces audited every piece audit interval, zero disables full piece audits" default:"1" testDefault:"0"`
+	PieceQueueSize         int           `help:"maximum number of randomly sampled whole pieces in the piece audit queue" default:"100"`
+
+	RequestInterval time.Duration `help:"how often to process pending on-demand audit requests" releaseDefault:"5m" devDefault:"1m" testDefault:"$TESTINTERVAL"`
 }
 
 // Worker contains information for populating audit queue and processing audits.
@@ -114,49 +120,65 @@ func (worker *Worker) process(ctx context.Context) (err error) {
 func (worker *Worker) work(ctx context.Context, segment Segment) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	_, err = verifySegment(ctx, worker.verifier, worker.reporter, segment)
+	return err
+}
+
+// verifySegment reverifies the nodes of the segment that are in containment
+// mode, audits the remaining nodes and records the outcomes of both. The
+// returned report combines the reverification and the audit.
+func verifySegment(ctx context.Context, verifier *Verifier, reporter *Reporter, segment Segment) (_ Report, err error) {
+	defer mon.Task()(&ctx)(&err)
+
 	var errlist errs.Group
 
 	// First, attempt to reverify nodes for this segment that are in containment mode.
-	report, err := worker.verifier.Reverify(ctx, segment)
+	reverified, err := verifier.Reverify(ctx, segment)
 	if err != nil {
 		errlist.Add(err)
 	}
 
 	// TODO(moby) we need to decide if we want to do something with nodes that the reporter failed to update
-	_, err = worker.reporter.RecordAudits(ctx, report)
+	_, err = reporter.RecordAudits(ctx, reverified)
 	if err != nil {
 		errlist.Add(err)
 	}
 
 	// Skip all reverified nodes in the next Verify step.
 	skip := make(map[storj.NodeID]bool)
-	for _, nodeID := range report.Successes {
+	for _, nodeID := range reverified.Successes {
 		skip[nodeID] = true
 	}
-	for _, nodeID := range report.Offlines {
+	for _, nodeID := range reverified.Offlines {
 		skip[nodeID] = true
 	}
-	for _, nodeID := range report.Fails {
+	for _, nodeID := range reverified.Fails {
 		skip[nodeID] = true
 	}
-	for _, pending := range report.PendingAudits {
+	for _, pending := range reverified.PendingAudits {
 		skip[pending.NodeID] = true
 	}
-	for _, nodeID := range report.Unknown {
+	for _, nodeID := range reverified.Unknown {
 		skip[nodeID] = true
 	}
 
 	// Next, audit the the remaining nodes that are not in containment mode.
-	report, err = worker.verifier.Verify(ctx, segment, skip)
+	report, err := verifier.Verify(ctx, segment, skip)
 	if err != nil {
 		errlist.Add(err)
 	}
 
 	// TODO(moby) we need to decide if we want to do something with nodes that the reporter failed to update
-	_, err = worker.reporter.RecordAudits(ctx, report)
+	_, err = reporter.RecordAudits(ctx, report)
 	if err != nil {
 		errlist.Add(err)
 	}
 
-	return errlist.Err()
+	return Report{
+		Successes:     append(reverified.Successes, report.Successes...),
+		Fails:         append(reverified.Fails, report.Fails...),
+		Offlines:      append(reverified.Offlines, report.Offlines...),
+		PendingAudits: append(reverified.PendingAudits, report.PendingAudits...),
+		Unknown:       append(reverified.Unknown, report.Unknown...),
+	}, errlist.Err()
 }
@@ -29,6 +29,26 @@ type DB interface {
 	GetBucket(ctx context.Context, bucketName []byte, projectID uuid.UUID) (bucket storj.Bucket, err error)
 	// GetBucketPlacement returns with the placement constraint identifier.
 	GetBucketPlacement(ctx context.Context, bucketName []byte, projectID uuid.UUID) (placement storj.PlacementConstraint, err error)
+	// GetBucketRedundancy returns the default redundancy scheme of a bucket. It's zero when the bucket uses the satellite default.
+	GetBucketRedundancy(ctx context.Context, bucketName []byte, projectID uuid.UUID) (scheme storj.RedundancyScheme, err error)
+	// GetBucketVersioning returns the versioning state of a bucket.
+	GetBucketVersioning(ctx context.Context, bucketName []byte, projectID uuid.UUID) (versioning Versioning, err error)
+	// UpdateBucketVersioning updates the versioning state of a bucket.
+	UpdateBucketVersioning(ctx context.Context, bucketName []byte, projectID uuid.UUID, versioning Versioning) (err error)
+	// GetBucketObjectLockEnabled returns whether object lock is enabled for a bucket.
+	GetBucketObjectLockEnabled(ctx context.Context, bucketName []byte, projectID uuid.UUID) (enabled bool, err error)
+	// UpdateBucketObjectLockEnabled updates whether object lock is enabled for a bucket.
+	UpdateBucketObjectLockEnabled(ctx context.Context, bucketName []byte, projectID uuid.UUID, enabled bool) (err error)
+	// GetBucketLifecycle returns the lifecycle configuration of a bucket.
+	GetBucketLifecycle(ctx context.Context, bucketName []byte, projectID uuid.UUID) (lifecycle Lifecycle, err error)
+	// UpdateBucketLifecycle updates the lifecycle configuration of a bucket.
+	UpdateBucketLifecycle(ctx context.Context, bucketName []byte, projectID uuid.UUID, lifecycle Lifecycle) (err error)
+	// GetBucketNotifications returns the event notification configuration of a bucket.
+	GetBucketNotifications(ctx context.Context, bucketName []byte, projectID uuid.UUID) (notifications Notifications, err error)
+	// UpdateBucketNotifications updates the event notification configuration of a bucket.
+	UpdateBucketNotifications(ctx context.Context, bucketName []byte, projectID uuid.UUID, notifications Notifications) (err error)
+	// ListBucketLifecycles returns the lifecycle configurations of buckets after the cursor, which have any rules.
+	ListBucketLifecycles(ctx context.Context, cursor metabase.BucketLocation, limit int) (lifecycles []BucketLifecycle, err error)
 	// GetMinimalBucket returns existing bucket with minimal number of fields.
 	GetMinimalBucket(ctx context.Context, bucketName []byte, projectID uuid.UUID) (bucket Bucket, err error)
 	// HasBucket returns if a bucket exists.
@@ -0,0 +1,59 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package buckets
+
+import (
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/satellite/metabase"
+)
+
+// MaxLifecycleRules is the maximum number of lifecycle rules of a single bucket.
+const MaxLifecycleRules = 100
+
+// ErrInvalidLifecycle is returned when a lifecycle configuration is not valid.
+var ErrInvalidLifecycle = errs.Class("invalid bucket lifecycle")
+
+// LifecycleRule defines which objects of a bucket are deleted once they reach a certain age.
+type LifecycleRule struct {
+	// Prefix limits the rule to objects whose encrypted key starts with the prefix.
+	// Since object keys are encrypted per path component, the prefix should end
+	// with a path separator.
+	Prefix []byte `json:"prefix,omitempty"`
+	// ExpireAfterDays is the number of days after which committed objects are deleted.
+	// Zero disables expiration.
+	ExpireAfterDays int `json:"expireAfterDays,omitempty"`
+	// AbortIncompleteUploadAfterDays is the number of days after which pending
+	// objects are deleted. Zero disables aborting incomplete uploads.
+	AbortIncompleteUploadAfterDays int `json:"abortIncompleteUploadAfterDays,omitempty"`
+}
+
+// Lifecycle is the lifecycle configuration of a bucket.
+type Lifecycle struct {
+	Rules []LifecycleRule `json:"rules"`
+}
+
+// Verify verifies the lifecycle configuration.
+func (lifecycle Lifecycle) Verify() error {
+	if len(lifecycle.Rules) > MaxLifecycleRules {
+		return ErrInvalidLifecycle.New("too many rules: %d, maximum allowed is %d", len(lifecycle.Rules), MaxLifecycleRules)
+	}
+	for i, rule := range lifecycle.Rules {
+		switch {
+		case rule.ExpireAfterDays < 0:
+			return ErrInvalidLifecycle.New("rule %d: ExpireAfterDays is negative", i)
+		case rule.AbortIncompleteUploadAfterDays < 0:
+			return ErrInvalidLifecycle.New("rule %d: AbortIncompleteUploadAfterDays is negative", i)
+		case rule.ExpireAfterDays == 0 && rule.AbortIncompleteUploadAfterDays == 0:
+			return ErrInvalidLifecycle.New("rule %d: no action specified", i)
+		}
+	}
+	return nil
+}
+
+// BucketLifecycle is the lifecycle configuration of a specific bucket.
+type BucketLifecycle struct {
+	Bucket    metabase.BucketLocation
+	Lifecycle Lifecycle
+}
@@ -0,0 +1,123 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package buckets
+
+import (
+	"net/url"
+	"regexp"
+
+	"github.com/zeebo/errs"
+)
+
+// MaxNotificationSinks is the maximum number of notification sinks of a single bucket.
+const MaxNotificationSinks = 10
+
+// ErrInvalidNotifications is returned when a notification configuration is not valid.
+var ErrInvalidNotifications = errs.Class("invalid bucket notifications")
+
+// EventType is the type of an event emitted for objects of a bucket.
+type EventType string
+
+const (
+	// EventObjectCommitted is emitted when an object is committed.
+	EventObjectCommitted = EventType("object:committed")
+	// EventObjectDeleted is emitted when an object is deleted or a delete marker is created.
+	EventObjectDeleted = EventType("object:deleted")
+	// EventObjectMoved is emitted when an object is moved to a new key or bucket.
+	EventObjectMoved = EventType("object:moved")
+)
+
+// Valid returns whether the event type is known.
+func (eventType EventType) Valid() bool {
+	switch eventType {
+	case EventObjectCommitted, EventObjectDeleted, EventObjectMoved:
+		return true
+	default:
+		return false
+	}
+}
+
+// NotificationSinkType is the type of a notification sink.
+type NotificationSinkType string
+
+const (
+	// WebhookSink delivers events with HMAC signed HTTP POST requests.
+	WebhookSink = NotificationSinkType("webhook")
+	// FileSink appends events to a local file of the satellite. It's meant for testing.
+	FileSink = NotificationSinkType("file")
+)
+
+var fileSinkName = regexp.MustCompile(`^[a-zA-Z0-9_-]{1,64}$`)
+
+// NotificationSink is the destination of bucket events.
+type NotificationSink struct {
+	Type NotificationSinkType `json:"type"`
+	// URL is the address webhook sinks send events to.
+	URL string `json:"url,omitempty"`
+	// Secret is the key used for signing webhook requests.
+	Secret string `json:"secret,omitempty"`
+	// Name is the name of the file of file sinks.
+	Name string `json:"name,omitempty"`
+	// Events limits the sink to specific event types. All events are
+	// sent to the sink when empty.
+	Events []EventType `json:"events,omitempty"`
+}
+
+// Accepts returns whether events of the type should be sent to the sink.
+func (sink NotificationSink) Accepts(eventType EventType) bool {
+	if len(sink.Events) == 0 {
+		return true
+	}
+	for _, accepted := range sink.Events {
+		if accepted == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// Verify verifies the notification sink.
+func (sink NotificationSink) Verify() error {
+	for _, eventType := range sink.Events {
+		if !eventType.Valid() {
+			return errs.New("unknown event type %q", eventType)
+		}
+	}
+
+	switch sink.Type {
+	case WebhookSink:
+		u, err := url.Parse(sink.URL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return errs.New("webhook URL %q is not valid", sink.URL)
+		}
+		if sink.Secret == "" {
+			return errs.New("webhook secret is missing")
+		}
+	case FileSink:
+		if !fileSinkName.MatchString(sink.Name) {
+			return errs.New("file name %q is not valid", sink.Name)
+		}
+	default:
+		return errs.New("unknown sink type %q", sink.Type)
+	}
+	return nil
+}
+
+// Notifications is the event notification configuration of a bucket.
+type Notifications struct {
+	Sinks []NotificationSink `json:"sinks"`
+}
+
+// Verify verifies the notification configuration.
+func (notifications Notifications) Verify() error {
+	if len(notifications.Sinks) > MaxNotificationSinks {
+		return ErrInvalidNotifications.New("too many sinks: %d, maximum allowed is %d", len(notifications.Sinks), MaxNotificationSinks)
+	}
+	for i, sink := range notifications.Sinks {
+		if err := sink.Verify(); err != nil {
+			return ErrInvalidNotifications.New("sink %d: %v", i, err)
+		}
+	}
+	return nil
+}
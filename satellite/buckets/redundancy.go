@@ -0,0 +1,152 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package buckets
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/memory"
+	"storj.io/common/storj"
+)
+
+// ErrInvalidRedundancy is returned when a bucket is created with a redundancy scheme
+// which isn't one of the allowed profiles.
+var ErrInvalidRedundancy = errs.Class("invalid bucket redundancy")
+
+// RedundancyProfile is a named redundancy scheme buckets can be created with.
+type RedundancyProfile struct {
+	Name   string
+	Scheme storj.RedundancyScheme
+}
+
+// String returns the profile in the format name:k/m/o/n-sharesize.
+func (profile RedundancyProfile) String() string {
+	rs := profile.Scheme
+	return fmt.Sprintf("%s:%d/%d/%d/%d-%s",
+		profile.Name,
+		rs.RequiredShares,
+		rs.RepairShares,
+		rs.OptimalShares,
+		rs.TotalShares,
+		memory.Size(rs.ShareSize).String())
+}
+
+// RedundancyProfiles is a configuration struct that contains the redundancy
+// profiles buckets can be created with.
+//
+// Can be used as a flag.
+type RedundancyProfiles struct {
+	List []RedundancyProfile
+}
+
+// Type implements pflag.Value.
+func (RedundancyProfiles) Type() string { return "buckets.RedundancyProfiles" }
+
+// String is required for pflag.Value. It is a comma separated list of profiles.
+func (profiles *RedundancyProfiles) String() string {
+	var s strings.Builder
+	for i, profile := range profiles.List {
+		if i > 0 {
+			s.WriteString(",")
+		}
+		s.WriteString(profile.String())
+	}
+	return s.String()
+}
+
+// Set sets the value from a string in the format "name:k/m/o/n-sharesize,name:k/m/o/n-sharesize,...".
+func (profiles *RedundancyProfiles) Set(s string) error {
+	profiles.List = nil
+	for _, value := range strings.Split(s, ",") {
+		value = strings.TrimSpace(value)
+		if value == "" {
+			continue
+		}
+		info := strings.Split(value, ":")
+		if len(info) != 2 {
+			return ErrInvalidRedundancy.New("invalid redundancy profile (expect format name:k/m/o/n-sharesize, got %s)", value)
+		}
+		name := strings.TrimSpace(info[0])
+		if name == "" {
+			return ErrInvalidRedundancy.New("redundancy profile name is empty: %s", value)
+		}
+		if _, ok := profiles.Lookup(name); ok {
+			return ErrInvalidRedundancy.New("redundancy profile %q is defined multiple times", name)
+		}
+		scheme, err := parseRedundancyScheme(strings.TrimSpace(info[1]))
+		if err != nil {
+			return err
+		}
+		profiles.List = append(profiles.List, RedundancyProfile{Name: name, Scheme: scheme})
+	}
+	return nil
+}
+
+// Lookup returns the redundancy scheme of the named profile.
+func (profiles *RedundancyProfiles) Lookup(name string) (storj.RedundancyScheme, bool) {
+	for _, profile := range profiles.List {
+		if profile.Name == name {
+			return profile.Scheme, true
+		}
+	}
+	return storj.RedundancyScheme{}, false
+}
+
+// Find returns the profile with the redundancy scheme.
+func (profiles *RedundancyProfiles) Find(scheme storj.RedundancyScheme) (RedundancyProfile, bool) {
+	for _, profile := range profiles.List {
+		if profile.Scheme == scheme {
+			return profile, true
+		}
+	}
+	return RedundancyProfile{}, false
+}
+
+// parseRedundancyScheme parses a scheme in the format k/m/o/n-sharesize.
+func parseRedundancyScheme(s string) (storj.RedundancyScheme, error) {
+	info := strings.Split(s, "-")
+	if len(info) != 2 {
+		return storj.RedundancyScheme{}, ErrInvalidRedundancy.New("invalid redundancy scheme (expect format k/m/o/n-sharesize, got %s)", s)
+	}
+
+	shareSize, err := memory.ParseString(info[1])
+	if err != nil {
+		return storj.RedundancyScheme{}, ErrInvalidRedundancy.New("invalid share size in redundancy scheme: '%s', %w", info[1], err)
+	}
+	if shareSize <= 0 {
+		return storj.RedundancyScheme{}, ErrInvalidRedundancy.New("share size must be positive: %s", s)
+	}
+
+	numbers := strings.Split(info[0], "/")
+	if len(numbers) != 4 {
+		return storj.RedundancyScheme{}, ErrInvalidRedundancy.New("invalid redundancy numbers (wrong size, expect 4): %s", info[0])
+	}
+
+	minValue := 1
+	values := make([]int16, 0, len(numbers))
+	for _, number := range numbers {
+		value, err := strconv.ParseInt(number, 10, 16)
+		if err != nil {
+			return storj.RedundancyScheme{}, ErrInvalidRedundancy.New("invalid redundancy numbers (should all be valid integers): %s, %w", info[0], err)
+		}
+		if int(value) < minValue {
+			return storj.RedundancyScheme{}, ErrInvalidRedundancy.New("invalid redundancy numbers (should be non-decreasing): %s", info[0])
+		}
+		values = append(values, int16(value))
+		minValue = int(value)
+	}
+
+	return storj.RedundancyScheme{
+		Algorithm:      storj.ReedSolomon,
+		ShareSize:      int32(shareSize),
+		RequiredShares: values[0],
+		RepairShares:   values[1],
+		OptimalShares:  values[2],
+		TotalShares:    values[3],
+	}, nil
+}
@@ -0,0 +1,81 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package buckets_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/storj"
+	"storj.io/storj/satellite/buckets"
+)
+
+func TestRedundancyProfilesConfig(t *testing.T) {
+	var profiles buckets.RedundancyProfiles
+	require.NoError(t, profiles.Set("archive:16/20/30/40-256B, durable:29/35/80/130-1KiB"))
+
+	archive := storj.RedundancyScheme{
+		Algorithm:      storj.ReedSolomon,
+		ShareSize:      256,
+		RequiredShares: 16,
+		RepairShares:   20,
+		OptimalShares:  30,
+		TotalShares:    40,
+	}
+	durable := storj.RedundancyScheme{
+		Algorithm:      storj.ReedSolomon,
+		ShareSize:      1024,
+		RequiredShares: 29,
+		RepairShares:   35,
+		OptimalShares:  80,
+		TotalShares:    130,
+	}
+	require.Equal(t, []buckets.RedundancyProfile{
+		{Name: "archive", Scheme: archive},
+		{Name: "durable", Scheme: durable},
+	}, profiles.List)
+	require.Equal(t, "archive:16/20/30/40-256 B,durable:29/35/80/130-1.0 KiB", profiles.String())
+
+	scheme, ok := profiles.Lookup("durable")
+	require.True(t, ok)
+	require.Equal(t, durable, scheme)
+	_, ok = profiles.Lookup("unknown")
+	require.False(t, ok)
+
+	profile, ok := profiles.Find(archive)
+	require.True(t, ok)
+	require.Equal(t, "archive", profile.Name)
+	_, ok = profiles.Find(storj.RedundancyScheme{})
+	require.False(t, ok)
+
+	var reparsed buckets.RedundancyProfiles
+	require.NoError(t, reparsed.Set(profiles.String()))
+	require.Equal(t, profiles.List, reparsed.List)
+
+	require.NoError(t, profiles.Set(""))
+	require.Empty(t, profiles.List)
+
+	for _, invalid := range []string{
+		"archive",
+		"archive:16/20/30/40",
+		"archive:16/20/30-256B",
+		"archive:16/20/10/40-256B",
+		"archive:0/20/30/40-256B",
+		"archive:16/20/30/40-0B",
+		":16/20/30/40-256B",
+		"archive:16/20/30/40-256B,archive:1/2/3/4-256B",
+	} {
+		require.Error(t, profiles.Set(invalid), invalid)
+	}
+}
+
+func TestValidateName(t *testing.T) {
+	for _, valid := range []string{"abc", "a.b.c", "bucket-1", "1bucket"} {
+		require.NoError(t, buckets.ValidateName([]byte(valid)), valid)
+	}
+	for _, invalid := range []string{"ab", "-bucket", "bucket-", "Bucket", "a..b", "192.168.1.234", "bucket_1"} {
+		require.True(t, buckets.ErrInvalidName.Has(buckets.ValidateName([]byte(invalid))), invalid)
+	}
+}
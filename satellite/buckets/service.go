@@ -9,12 +9,17 @@ import (
 	"github.com/zeebo/errs"
 
 	"storj.io/common/storj"
+	"storj.io/common/uuid"
 	"storj.io/storj/satellite/metabase"
 )
 
 var (
 	// ErrBucketNotEmpty is returned when a caller attempts to change placement constraints.
 	ErrBucketNotEmpty = errs.Class("bucket must be empty")
+	// ErrInvalidVersioning is returned when a caller attempts an unsupported change of the versioning state.
+	ErrInvalidVersioning = errs.Class("invalid bucket versioning")
+	// ErrInvalidObjectLock is returned when a caller attempts an unsupported change of the object lock state.
+	ErrInvalidObjectLock = errs.Class("invalid bucket object lock")
 )
 
 // NewService converts the provided db and metabase calls into a single DB interface.
@@ -56,3 +61,109 @@ func (buckets *Service) UpdateBucket(ctx context.Context, bucket storj.Bucket) (
 
 	return buckets.DB.UpdateBucket(ctx, bucket)
 }
+
+// UpdateBucketVersioning overrides the default UpdateBucketVersioning behaviour by refusing to return
+// a bucket to the unversioned state once versioning has been enabled. Uploads to unversioned buckets
+// remove all existing versions of an object, which would silently drop versions the user asked to keep.
+func (buckets *Service) UpdateBucketVersioning(ctx context.Context, bucketName []byte, projectID uuid.UUID, versioning Versioning) error {
+	switch versioning {
+	case Unversioned, VersioningEnabled, VersioningSuspended:
+	default:
+		return ErrInvalidVersioning.New("unknown versioning state %d", versioning)
+	}
+
+	current, err := buckets.GetBucketVersioning(ctx, bucketName, projectID)
+	if err != nil {
+		return err
+	}
+
+	if current.IsVersioned() && versioning == Unversioned {
+		return ErrInvalidVersioning.New("versioning can only be suspended once it was enabled")
+	}
+
+	if versioning != VersioningEnabled {
+		objectLock, err := buckets.GetBucketObjectLockEnabled(ctx, bucketName, projectID)
+		if err != nil {
+			return err
+		}
+		if objectLock {
+			return ErrInvalidVersioning.New("versioning can not be suspended while object lock is enabled")
+		}
+	}
+
+	return buckets.DB.UpdateBucketVersioning(ctx, bucketName, projectID, versioning)
+}
+
+// UpdateBucketObjectLockEnabled overrides the default UpdateBucketObjectLockEnabled behaviour by requiring
+// versioning to be enabled before object lock can be enabled. Once enabled, object lock can not be disabled,
+// otherwise retention periods of locked objects could be circumvented by overwriting them.
+func (buckets *Service) UpdateBucketObjectLockEnabled(ctx context.Context, bucketName []byte, projectID uuid.UUID, enabled bool) error {
+	current, err := buckets.GetBucketObjectLockEnabled(ctx, bucketName, projectID)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case current == enabled:
+		return nil
+	case current && !enabled:
+		return ErrInvalidObjectLock.New("object lock can not be disabled once it was enabled")
+	}
+
+	versioning, err := buckets.GetBucketVersioning(ctx, bucketName, projectID)
+	if err != nil {
+		return err
+	}
+	if versioning != VersioningEnabled {
+		return ErrInvalidObjectLock.New("object lock requires versioning to be enabled")
+	}
+
+	return buckets.DB.UpdateBucketObjectLockEnabled(ctx, bucketName, projectID, enabled)
+}
+
+// UpdateBucketLifecycle overrides the default UpdateBucketLifecycle behaviour by verifying the rules.
+func (buckets *Service) UpdateBucketLifecycle(ctx context.Context, bucketName []byte, projectID uuid.UUID, lifecycle Lifecycle) error {
+	if err := lifecycle.Verify(); err != nil {
+		return err
+	}
+
+	return buckets.DB.UpdateBucketLifecycle(ctx, bucketName, projectID, lifecycle)
+}
+
+// UpdateBucketNotifications overrides the default UpdateBucketNotifications behaviour by verifying the sinks.
+// The metabase records the events of the bucket objects only while the bucket has sinks.
+func (buckets *Service) UpdateBucketNotifications(ctx context.Context, bucketName []byte, projectID uuid.UUID, notifications Notifications) error {
+	if err := notifications.Verify(); err != nil {
+		return err
+	}
+
+	err := buckets.DB.UpdateBucketNotifications(ctx, bucketName, projectID, notifications)
+	if err != nil {
+		return err
+	}
+
+	return buckets.metabase.SetBucketEventsEnabled(ctx, metabase.SetBucketEventsEnabled{
+		BucketLocation: metabase.BucketLocation{
+			ProjectID:  projectID,
+			BucketName: string(bucketName),
+		},
+		Enabled: len(notifications.Sinks) > 0,
+	})
+}
+
+// DeleteBucket overrides the default DeleteBucket behaviour by also stopping the
+// metabase from recording events of the bucket objects.
+func (buckets *Service) DeleteBucket(ctx context.Context, bucketName []byte, projectID uuid.UUID) error {
+	err := buckets.DB.DeleteBucket(ctx, bucketName, projectID)
+	if err != nil {
+		return err
+	}
+
+	return buckets.metabase.SetBucketEventsEnabled(ctx, metabase.SetBucketEventsEnabled{
+		BucketLocation: metabase.BucketLocation{
+			ProjectID:  projectID,
+			BucketName: string(bucketName),
+		},
+		Enabled: false,
+	})
+}
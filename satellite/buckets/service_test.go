@@ -12,6 +12,8 @@ import (
 	"storj.io/common/storj"
 	"storj.io/common/testcontext"
 	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite/buckets"
+	"storj.io/storj/satellite/metabase"
 )
 
 const TestBucket = "testbucket"
@@ -247,3 +249,164 @@ func TestBucketPlacement_PendingObject(t *testing.T) {
 		},
 	)
 }
+
+func TestBucketVersioning(t *testing.T) {
+	testplanet.Run(t,
+		testplanet.Config{
+			SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+		},
+		func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+			satellite := planet.Satellites[0]
+			service := satellite.API.Buckets.Service
+			uplink := planet.Uplinks[0]
+			projectID := uplink.Projects[0].ID
+
+			err := uplink.CreateBucket(ctx, satellite, TestBucket)
+			require.NoError(t, err)
+
+			// new buckets are unversioned
+			versioning, err := service.GetBucketVersioning(ctx, []byte(TestBucket), projectID)
+			require.NoError(t, err)
+			assert.Equal(t, buckets.Unversioned, versioning)
+
+			_, err = service.GetBucketVersioning(ctx, []byte("not-existing-bucket"), projectID)
+			require.True(t, storj.ErrBucketNotFound.Has(err), err)
+
+			err = service.UpdateBucketVersioning(ctx, []byte("not-existing-bucket"), projectID, buckets.VersioningEnabled)
+			require.True(t, storj.ErrBucketNotFound.Has(err), err)
+
+			err = service.UpdateBucketVersioning(ctx, []byte(TestBucket), projectID, buckets.Versioning(10))
+			require.True(t, buckets.ErrInvalidVersioning.Has(err), err)
+
+			err = service.UpdateBucketVersioning(ctx, []byte(TestBucket), projectID, buckets.VersioningEnabled)
+			require.NoError(t, err)
+
+			versioning, err = service.GetBucketVersioning(ctx, []byte(TestBucket), projectID)
+			require.NoError(t, err)
+			assert.Equal(t, buckets.VersioningEnabled, versioning)
+
+			// every upload creates a new version
+			for _, data := range []string{"first", "second"} {
+				err = uplink.Upload(ctx, satellite, TestBucket, TestObject, []byte(data))
+				require.NoError(t, err)
+			}
+
+			objects, err := satellite.Metabase.DB.TestingAllCommittedObjects(ctx, projectID, TestBucket)
+			require.NoError(t, err)
+			require.Len(t, objects, 2)
+
+			// only the latest version is listed and downloaded
+			listed, err := uplink.ListObjects(ctx, satellite, TestBucket)
+			require.NoError(t, err)
+			require.Len(t, listed, 1)
+
+			data, err := uplink.Download(ctx, satellite, TestBucket, TestObject)
+			require.NoError(t, err)
+			require.Equal(t, "second", string(data))
+
+			// deleting creates a delete marker and keeps the versions
+			err = uplink.DeleteObject(ctx, satellite, TestBucket, TestObject)
+			require.NoError(t, err)
+
+			_, err = uplink.Download(ctx, satellite, TestBucket, TestObject)
+			require.Error(t, err)
+
+			listed, err = uplink.ListObjects(ctx, satellite, TestBucket)
+			require.NoError(t, err)
+			require.Empty(t, listed)
+
+			objects, err = satellite.Metabase.DB.TestingAllCommittedObjects(ctx, projectID, TestBucket)
+			require.NoError(t, err)
+			require.Len(t, objects, 2)
+
+			// versioning cannot be disabled anymore, only suspended
+			err = service.UpdateBucketVersioning(ctx, []byte(TestBucket), projectID, buckets.Unversioned)
+			require.True(t, buckets.ErrInvalidVersioning.Has(err), err)
+
+			err = service.UpdateBucketVersioning(ctx, []byte(TestBucket), projectID, buckets.VersioningSuspended)
+			require.NoError(t, err)
+
+			// with suspended versioning an upload replaces only the latest version
+			err = uplink.Upload(ctx, satellite, TestBucket, TestObject, []byte("third"))
+			require.NoError(t, err)
+
+			data, err = uplink.Download(ctx, satellite, TestBucket, TestObject)
+			require.NoError(t, err)
+			require.Equal(t, "third", string(data))
+
+			objects, err = satellite.Metabase.DB.TestingAllCommittedObjects(ctx, projectID, TestBucket)
+			require.NoError(t, err)
+			require.Len(t, objects, 3)
+		},
+	)
+}
+
+func TestBucketObjectLock(t *testing.T) {
+	testplanet.Run(t,
+		testplanet.Config{
+			SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+		},
+		func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+			satellite := planet.Satellites[0]
+			service := satellite.API.Buckets.Service
+			uplink := planet.Uplinks[0]
+			projectID := uplink.Projects[0].ID
+
+			err := uplink.CreateBucket(ctx, satellite, TestBucket)
+			require.NoError(t, err)
+
+			enabled, err := service.GetBucketObjectLockEnabled(ctx, []byte(TestBucket), projectID)
+			require.NoError(t, err)
+			assert.False(t, enabled)
+
+			// object lock requires versioning
+			err = service.UpdateBucketObjectLockEnabled(ctx, []byte(TestBucket), projectID, true)
+			require.True(t, buckets.ErrInvalidObjectLock.Has(err), err)
+
+			err = service.UpdateBucketVersioning(ctx, []byte(TestBucket), projectID, buckets.VersioningEnabled)
+			require.NoError(t, err)
+
+			err = service.UpdateBucketObjectLockEnabled(ctx, []byte(TestBucket), projectID, true)
+			require.NoError(t, err)
+
+			enabled, err = service.GetBucketObjectLockEnabled(ctx, []byte(TestBucket), projectID)
+			require.NoError(t, err)
+			assert.True(t, enabled)
+
+			// neither object lock nor versioning can be turned off anymore
+			err = service.UpdateBucketObjectLockEnabled(ctx, []byte(TestBucket), projectID, false)
+			require.True(t, buckets.ErrInvalidObjectLock.Has(err), err)
+
+			err = service.UpdateBucketVersioning(ctx, []byte(TestBucket), projectID, buckets.VersioningSuspended)
+			require.True(t, buckets.ErrInvalidVersioning.Has(err), err)
+
+			err = uplink.Upload(ctx, satellite, TestBucket, TestObject, []byte("locked"))
+			require.NoError(t, err)
+
+			objects, err := satellite.Metabase.DB.TestingAllCommittedObjects(ctx, projectID, TestBucket)
+			require.NoError(t, err)
+			require.Len(t, objects, 1)
+
+			err = satellite.Metabase.DB.SetObjectLegalHold(ctx, metabase.SetObjectLegalHold{
+				ObjectLocation: metabase.ObjectLocation{
+					ProjectID:  projectID,
+					BucketName: TestBucket,
+					ObjectKey:  objects[0].ObjectKey,
+				},
+				Version:   objects[0].Version,
+				LegalHold: true,
+			})
+			require.NoError(t, err)
+
+			// the locked object survives deleting the whole bucket
+			_, err = satellite.Metabase.DB.DeleteBucketObjects(ctx, metabase.DeleteBucketObjects{
+				Bucket: metabase.BucketLocation{ProjectID: projectID, BucketName: TestBucket},
+			})
+			require.True(t, metabase.ErrObjectLocked.Has(err), err)
+
+			data, err := uplink.Download(ctx, satellite, TestBucket, TestObject)
+			require.NoError(t, err)
+			require.Equal(t, "locked", string(data))
+		},
+	)
+}
@@ -0,0 +1,69 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package buckets
+
+import (
+	"bytes"
+	"regexp"
+
+	"github.com/zeebo/errs"
+)
+
+// ErrInvalidName is returned when a bucket name is not valid.
+var ErrInvalidName = errs.Class("invalid bucket name")
+
+var ipRegexp = regexp.MustCompile(`^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$`)
+
+// ValidateName verifies that the bucket name follows the DNS compatible naming rules.
+func ValidateName(name []byte) error {
+	if len(name) < 3 || len(name) > 63 {
+		return ErrInvalidName.New("bucket name must be at least 3 and no more than 63 characters long")
+	}
+
+	// Regexp not used because benchmark shows it will be slower for valid bucket names
+	// https://gist.github.com/mniewrzal/49de3af95f36e63e88fac24f565e444c
+	labels := bytes.Split(name, []byte("."))
+	for _, label := range labels {
+		err := validateLabel(label)
+		if err != nil {
+			return err
+		}
+	}
+
+	if ipRegexp.MatchString(string(name)) {
+		return ErrInvalidName.New("bucket name cannot be formatted as an IP address")
+	}
+
+	return nil
+}
+
+func validateLabel(label []byte) error {
+	if len(label) == 0 {
+		return ErrInvalidName.New("bucket label cannot be empty")
+	}
+
+	if !isLowerLetter(label[0]) && !isDigit(label[0]) {
+		return ErrInvalidName.New("bucket label must start with a lowercase letter or number")
+	}
+
+	if label[0] == '-' || label[len(label)-1] == '-' {
+		return ErrInvalidName.New("bucket label cannot start or end with a hyphen")
+	}
+
+	for i := 1; i < len(label)-1; i++ {
+		if !isLowerLetter(label[i]) && !isDigit(label[i]) && (label[i] != '-') && (label[i] != '.') {
+			return ErrInvalidName.New("bucket name must contain only lowercase letters, numbers or hyphens")
+		}
+	}
+
+	return nil
+}
+
+func isLowerLetter(r byte) bool {
+	return r >= 'a' && r <= 'z'
+}
+
+func isDigit(r byte) bool {
+	return r >= '0' && r <= '9'
+}
@@ -0,0 +1,42 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package buckets
+
+// Versioning represents the versioning state of a bucket.
+type Versioning int
+
+const (
+	// Unversioned is the default state of a bucket. Uploading an object
+	// replaces all existing versions of it and deleting an object removes
+	// it permanently.
+	Unversioned Versioning = 0
+	// VersioningEnabled means that every upload creates a new version of
+	// the object and deleting an object without specifying a version
+	// creates a delete marker instead of removing any data.
+	VersioningEnabled Versioning = 1
+	// VersioningSuspended means that uploads and deletes replace the null
+	// version of the object, which is the version not created while versioning
+	// was enabled. Deletes leave a delete marker as the null version. Versions
+	// created while versioning was enabled are kept.
+	VersioningSuspended Versioning = 2
+)
+
+// IsVersioned returns whether versions created in the past may exist in the bucket.
+func (v Versioning) IsVersioned() bool {
+	return v == VersioningEnabled || v == VersioningSuspended
+}
+
+// String returns the name of the versioning state.
+func (v Versioning) String() string {
+	switch v {
+	case Unversioned:
+		return "unversioned"
+	case VersioningEnabled:
+		return "enabled"
+	case VersioningSuspended:
+		return "suspended"
+	default:
+		return "unknown"
+	}
+}
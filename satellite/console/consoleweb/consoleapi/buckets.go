@@ -6,6 +6,7 @@ package consoleapi
 import (
 	"encoding/json"
 	"net/http"
+	"time"
 
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
@@ -66,6 +67,106 @@ func (b *Buckets) AllBucketNames(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// CreateBucket creates a new bucket with an optional redundancy profile.
+func (b *Buckets) CreateBucket(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	var request struct {
+		ProjectID         string `json:"projectID"`
+		Name              string `json:"name"`
+		RedundancyProfile string `json:"redundancyProfile"`
+	}
+	err = json.NewDecoder(r.Body).Decode(&request)
+	if err != nil {
+		b.serveJSONError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	projectID, err := uuid.FromString(request.ProjectID)
+	if err != nil {
+		b.serveJSONError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	bucket, err := b.service.CreateBucket(ctx, projectID, request.Name, request.RedundancyProfile)
+	if err != nil {
+		switch {
+		case console.ErrUnauthorized.Has(err):
+			b.serveJSONError(w, http.StatusUnauthorized, err)
+		case console.ErrValidation.Has(err):
+			b.serveJSONError(w, http.StatusBadRequest, err)
+		case console.ErrBucketLimit.Has(err):
+			b.serveJSONError(w, http.StatusForbidden, err)
+		default:
+			b.serveJSONError(w, http.StatusInternalServerError, err)
+		}
+		return
+	}
+
+	err = json.NewEncoder(w).Encode(struct {
+		Name              string    `json:"name"`
+		CreatedAt         time.Time `json:"createdAt"`
+		RedundancyProfile string    `json:"redundancyProfile"`
+	}{
+		Name:              bucket.Name,
+		CreatedAt:         bucket.Created,
+		RedundancyProfile: request.RedundancyProfile,
+	})
+	if err != nil {
+		b.log.Error("failed to write json create bucket response", zap.Error(ErrBucketsAPI.Wrap(err)))
+	}
+}
+
+// RedundancyProfiles returns the redundancy profiles buckets can be created with.
+func (b *Buckets) RedundancyProfiles(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	profiles, err := b.service.GetRedundancyProfiles(ctx)
+	if err != nil {
+		if console.ErrUnauthorized.Has(err) {
+			b.serveJSONError(w, http.StatusUnauthorized, err)
+			return
+		}
+
+		b.serveJSONError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	type redundancyProfile struct {
+		Name           string `json:"name"`
+		RequiredShares int16  `json:"requiredShares"`
+		RepairShares   int16  `json:"repairShares"`
+		OptimalShares  int16  `json:"optimalShares"`
+		TotalShares    int16  `json:"totalShares"`
+		ShareSize      int32  `json:"shareSize"`
+	}
+
+	response := make([]redundancyProfile, 0, len(profiles))
+	for _, profile := range profiles {
+		response = append(response, redundancyProfile{
+			Name:           profile.Name,
+			RequiredShares: profile.Scheme.RequiredShares,
+			RepairShares:   profile.Scheme.RepairShares,
+			OptimalShares:  profile.Scheme.OptimalShares,
+			TotalShares:    profile.Scheme.TotalShares,
+			ShareSize:      profile.Scheme.ShareSize,
+		})
+	}
+
+	err = json.NewEncoder(w).Encode(response)
+	if err != nil {
+		b.log.Error("failed to write json redundancy profiles response", zap.Error(ErrBucketsAPI.Wrap(err)))
+	}
+}
+
 // serveJSONError writes JSON error to response output stream.
 func (b *Buckets) serveJSONError(w http.ResponseWriter, status int, err error) {
 	serveJSONError(b.log, w, status, err)
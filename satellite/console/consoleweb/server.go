@@ -261,7 +261,9 @@ func NewServer(logger *zap.Logger, config Config, service *console.Service, mail
 	bucketsController := consoleapi.NewBuckets(logger, service)
 	bucketsRouter := router.PathPrefix("/api/v0/buckets").Subrouter()
 	bucketsRouter.Use(server.withAuth)
+	bucketsRouter.HandleFunc("", bucketsController.CreateBucket).Methods(http.MethodPost)
 	bucketsRouter.HandleFunc("/bucket-names", bucketsController.AllBucketNames).Methods(http.MethodGet)
+	bucketsRouter.HandleFunc("/redundancy-profiles", bucketsController.RedundancyProfiles).Methods(http.MethodGet)
 
 	apiKeysController := consoleapi.NewAPIKeys(logger, service)
 	apiKeysRouter := router.PathPrefix("/api/v0/api-keys").Subrouter()
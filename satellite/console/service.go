@@ -27,6 +27,7 @@ import (
 	"storj.io/private/cfgstruct"
 	"storj.io/storj/satellite/accounting"
 	"storj.io/storj/satellite/analytics"
+	"storj.io/storj/satellite/buckets"
 	"storj.io/storj/satellite/console/consoleauth"
 	"storj.io/storj/satellite/payments"
 	"storj.io/storj/satellite/rewards"
@@ -93,6 +94,9 @@ var (
 
 	// ErrRecoveryToken describes account recovery token errors.
 	ErrRecoveryToken = errs.Class("recovery token")
+
+	// ErrBucketLimit is error type of bucket limit.
+	ErrBucketLimit = errs.Class("bucket limit")
 )
 
 // Service is handling accounts related logic.
@@ -133,6 +137,10 @@ type Config struct {
 	DefaultProjectLimit     int  `help:"default project limits for users" default:"1" testDefault:"5"`
 	UsageLimits             UsageLimitsConfig
 	Recaptcha               RecaptchaConfig
+
+	// RedundancyProfiles and DefaultMaxBuckets are set from the metainfo configuration.
+	RedundancyProfiles buckets.RedundancyProfiles `internal:"true"`
+	DefaultMaxBuckets  int                        `internal:"true"`
 }
 
 // RecaptchaConfig contains configurations for the reCAPTCHA system.
@@ -1609,6 +1617,91 @@ func (s *Service) GetAllBucketNames(ctx context.Context, projectID uuid.UUID) (_
 	return list, nil
 }
 
+// CreateBucket creates a new bucket in the project. The bucket stores new
+// objects with the named redundancy profile, or with the satellite default
+// when the profile is empty.
+func (s *Service) CreateBucket(ctx context.Context, projectID uuid.UUID, name, redundancyProfile string) (_ storj.Bucket, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	auth, err := s.getAuthAndAuditLog(ctx, "create bucket",
+		zap.String("projectID", projectID.String()),
+		zap.String("bucket", name),
+		zap.String("redundancyProfile", redundancyProfile))
+	if err != nil {
+		return storj.Bucket{}, Error.Wrap(err)
+	}
+
+	_, err = s.isProjectMember(ctx, auth.User.ID, projectID)
+	if err != nil {
+		return storj.Bucket{}, Error.Wrap(err)
+	}
+
+	if err := buckets.ValidateName([]byte(name)); err != nil {
+		return storj.Bucket{}, ErrValidation.Wrap(err)
+	}
+
+	var scheme storj.RedundancyScheme
+	if redundancyProfile != "" {
+		var ok bool
+		scheme, ok = s.config.RedundancyProfiles.Lookup(redundancyProfile)
+		if !ok {
+			return storj.Bucket{}, ErrValidation.New("unknown redundancy profile %q", redundancyProfile)
+		}
+	}
+
+	_, err = s.buckets.GetBucket(ctx, []byte(name), projectID)
+	if err == nil {
+		return storj.Bucket{}, ErrValidation.New("bucket %q already exists", name)
+	}
+	if !storj.ErrBucketNotFound.Has(err) {
+		return storj.Bucket{}, Error.Wrap(err)
+	}
+
+	maxBuckets, err := s.store.Projects().GetMaxBuckets(ctx, projectID)
+	if err != nil {
+		return storj.Bucket{}, Error.Wrap(err)
+	}
+	if maxBuckets == nil {
+		maxBuckets = &s.config.DefaultMaxBuckets
+	}
+	bucketCount, err := s.buckets.CountBuckets(ctx, projectID)
+	if err != nil {
+		return storj.Bucket{}, Error.Wrap(err)
+	}
+	if bucketCount >= *maxBuckets {
+		return storj.Bucket{}, ErrBucketLimit.New("number of allocated buckets (%d) exceeded", *maxBuckets)
+	}
+
+	bucketID, err := uuid.New()
+	if err != nil {
+		return storj.Bucket{}, Error.Wrap(err)
+	}
+
+	bucket, err := s.buckets.CreateBucket(ctx, storj.Bucket{
+		ID:                      bucketID,
+		Name:                    name,
+		ProjectID:               projectID,
+		DefaultRedundancyScheme: scheme,
+	})
+	if err != nil {
+		return storj.Bucket{}, Error.Wrap(err)
+	}
+
+	return bucket, nil
+}
+
+// GetRedundancyProfiles returns the redundancy profiles buckets can be created with.
+func (s *Service) GetRedundancyProfiles(ctx context.Context) (_ []buckets.RedundancyProfile, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = s.getAuthAndAuditLog(ctx, "get redundancy profiles")
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return s.config.RedundancyProfiles.List, nil
+}
+
 // GetBucketUsageRollups retrieves summed usage rollups for every bucket of particular project for a given period.
 func (s *Service) GetBucketUsageRollups(ctx context.Context, projectID uuid.UUID, since, before time.Time) (_ []accounting.BucketUsageRollup, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -14,6 +14,7 @@ import (
 	"storj.io/common/pb"
 	"storj.io/common/rpc/rpcstatus"
 	"storj.io/common/storj"
+	"storj.io/storj/private/nodeload"
 	"storj.io/storj/private/nodeoperator"
 	"storj.io/storj/satellite/overlay"
 )
@@ -101,6 +102,13 @@ func (endpoint *Endpoint) CheckIn(ctx context.Context, req *pb.CheckInRequest) (
 		}
 	}
 
+	load, err := nodeload.Decode(req)
+	if err != nil {
+		endpoint.log.Debug("ignoring invalid load",
+			zap.Stringer("Node ID", nodeID),
+			zap.Error(err))
+	}
+
 	nodeInfo := overlay.NodeCheckInInfo{
 		NodeID: peerID.ID,
 		Address: &pb.NodeAddress{
@@ -113,6 +121,7 @@ func (endpoint *Endpoint) CheckIn(ctx context.Context, req *pb.CheckInRequest) (
 		Capacity:   req.Capacity,
 		Operator:   req.Operator,
 		Version:    req.Version,
+		Load:       load,
 	}
 
 	err = endpoint.service.overlay.UpdateCheckIn(ctx, nodeInfo, time.Now().UTC())
@@ -35,6 +35,8 @@ import (
 	"storj.io/storj/satellite/metabase"
 	"storj.io/storj/satellite/metabase/segmentloop"
 	"storj.io/storj/satellite/metabase/zombiedeletion"
+	"storj.io/storj/satellite/metainfo/bucketevents"
+	"storj.io/storj/satellite/metainfo/bucketlifecycle"
 	"storj.io/storj/satellite/metainfo/expireddeletion"
 	"storj.io/storj/satellite/metrics"
 	"storj.io/storj/satellite/orders"
@@ -89,7 +91,8 @@ type Core struct {
 	}
 
 	Reputation struct {
-		Service *reputation.Service
+		Service       *reputation.Service
+		OutcomesChore *reputation.OutcomesChore
 	}
 
 	Repair struct {
@@ -97,17 +100,28 @@ type Core struct {
 	}
 
 	Audit struct {
-		Queues   *audit.Queues
-		Worker   *audit.Worker
-		Chore    *audit.Chore
-		Verifier *audit.Verifier
-		Reporter *audit.Reporter
+		Queues        *audit.Queues
+		Worker        *audit.Worker
+		Chore         *audit.Chore
+		Verifier      *audit.Verifier
+		Reporter      *audit.Reporter
+		PieceQueue    *audit.PieceQueue
+		PieceWorker   *audit.PieceWorker
+		RequestWorker *audit.RequestWorker
 	}
 
 	ExpiredDeletion struct {
 		Chore *expireddeletion.Chore
 	}
 
+	BucketLifecycle struct {
+		Chore *bucketlifecycle.Chore
+	}
+
+	BucketEvents struct {
+		Chore *bucketevents.Chore
+	}
+
 	ZombieDeletion struct {
 		Chore *zombiedeletion.Chore
 	}
@@ -300,12 +314,25 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB,
 			Name:  "reputation",
 			Close: peer.Reputation.Service.Close,
 		})
+
+		peer.Reputation.OutcomesChore = reputation.NewOutcomesChore(log.Named("reputation:outcomes"),
+			peer.DB.Reputation(),
+			config.Reputation,
+		)
+		peer.Services.Add(lifecycle.Item{
+			Name:  "reputation:outcomes",
+			Run:   peer.Reputation.OutcomesChore.Run,
+			Close: peer.Reputation.OutcomesChore.Close,
+		})
+		peer.Debug.Server.Panel.Add(
+			debug.Cycle("Reputation Audit Outcomes", peer.Reputation.OutcomesChore.Loop))
 	}
 
 	{ // setup audit
 		config := config.Audit
 
 		peer.Audit.Queues = audit.NewQueues()
+		peer.Audit.PieceQueue = audit.NewPieceQueue()
 
 		peer.Audit.Verifier = audit.NewVerifier(log.Named("audit:verifier"),
 			peer.Metainfo.Metabase,
@@ -343,8 +370,40 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB,
 			return nil, errs.Combine(err, peer.Close())
 		}
 
+		peer.Audit.PieceWorker = audit.NewPieceWorker(peer.Log.Named("audit:piece-worker"),
+			peer.Audit.PieceQueue,
+			peer.Audit.Verifier,
+			peer.Audit.Reporter,
+			config,
+		)
+		peer.Services.Add(lifecycle.Item{
+			Name:  "audit:piece-worker",
+			Run:   peer.Audit.PieceWorker.Run,
+			Close: peer.Audit.PieceWorker.Close,
+		})
+		peer.Debug.Server.Panel.Add(
+			debug.Cycle("Audit Piece Worker", peer.Audit.PieceWorker.Loop))
+
+		peer.Audit.RequestWorker = audit.NewRequestWorker(peer.Log.Named("audit:request-worker"),
+			peer.DB.AuditRequests(),
+			peer.Metainfo.Metabase,
+			peer.Metainfo.SegmentLoop,
+			peer.Audit.Verifier,
+			peer.Audit.Reporter,
+			config,
+		)
+		peer.Services.Add(lifecycle.Item{
+			Name:  "audit:request-worker",
+			Run:   peer.Audit.RequestWorker.Run,
+			Close: peer.Audit.RequestWorker.Close,
+		})
+		peer.Debug.Server.Panel.Add(
+			debug.Cycle("Audit Request Worker", peer.Audit.RequestWorker.Loop))
+
 		peer.Audit.Chore = audit.NewChore(peer.Log.Named("audit:chore"),
 			peer.Audit.Queues,
+			peer.Audit.PieceQueue,
+			peer.DB.PieceAuditRequests(),
 			peer.Metainfo.SegmentLoop,
 			config,
 		)
@@ -372,6 +431,42 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB,
 			debug.Cycle("Expired Segments Chore", peer.ExpiredDeletion.Chore.Loop))
 	}
 
+	{ // setup bucket lifecycle rules
+		peer.BucketLifecycle.Chore = bucketlifecycle.NewChore(
+			peer.Log.Named("core-bucket-lifecycle"),
+			config.BucketLifecycle,
+			peer.DB.Buckets(),
+			peer.Metainfo.Metabase,
+		)
+		peer.Services.Add(lifecycle.Item{
+			Name:  "bucketlifecycle:chore",
+			Run:   peer.BucketLifecycle.Chore.Run,
+			Close: peer.BucketLifecycle.Chore.Close,
+		})
+		peer.Debug.Server.Panel.Add(
+			debug.Cycle("Bucket Lifecycle Chore", peer.BucketLifecycle.Chore.Loop))
+	}
+
+	{ // setup bucket events delivery
+		peer.BucketEvents.Chore, err = bucketevents.NewChore(
+			peer.Log.Named("core-bucket-events"),
+			config.BucketEvents,
+			peer.DB.BucketEvents(),
+			peer.Metainfo.Metabase,
+			peer.DB.Buckets(),
+		)
+		if err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
+		peer.Services.Add(lifecycle.Item{
+			Name:  "bucketevents:chore",
+			Run:   peer.BucketEvents.Chore.Run,
+			Close: peer.BucketEvents.Chore.Close,
+		})
+		peer.Debug.Server.Panel.Add(
+			debug.Cycle("Bucket Events Chore", peer.BucketEvents.Chore.Loop))
+	}
+
 	{ // setup zombie objects cleanup
 		peer.ZombieDeletion.Chore = zombiedeletion.NewChore(
 			peer.Log.Named("core-zombie-deletion"),
@@ -145,12 +145,22 @@ func NewGarbageCollection(log *zap.Logger, full *identity.FullIdentity, db DB,
 	}
 
 	{ // setup garbage collection
+		var filters gc.FilterStore
+		if config.GarbageCollection.FilterStore != "" {
+			var err error
+			filters, err = gc.NewDirFilterStore(config.GarbageCollection.FilterStore)
+			if err != nil {
+				return nil, errs.Combine(err, peer.Close())
+			}
+		}
+
 		peer.GarbageCollection.Service = gc.NewService(
 			peer.Log.Named("garbage-collection"),
 			config.GarbageCollection,
 			peer.Dialer,
 			peer.Overlay.DB,
 			peer.Metainfo.SegmentLoop,
+			filters,
 		)
 		peer.Services.Add(lifecycle.Item{
 			Name: "garbage-collection",
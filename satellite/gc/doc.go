@@ -16,6 +16,12 @@ The gc.Service will send that request to the storagenode after a full segments l
 iteration, and the storage node will use that request to delete the "garbage" pieces
 that are not in the bloom filter.
 
+When a filter store is configured, the gc.Service stores the bloom filters and a
+manifest of the run in it instead of sending them. The gc.Sender, which runs as
+a separate process, sends the filters of the latest complete run, retries every
+node with a backoff and records the delivery status of every node next to the
+filters. This also allows inspecting the filter of a node before it's sent.
+
 See storj/docs/design/garbage-collection.md for more info.
 */
 package gc
@@ -0,0 +1,215 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package gc
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"time"
+
+	"storj.io/common/pb"
+	"storj.io/common/storj"
+)
+
+// runIDFormat is the time format of the ids of the persisted runs, which
+// sort in the order of their creation dates.
+const runIDFormat = "20060102T150405Z"
+
+// Manifest describes a garbage collection run persisted in a filter store. It's
+// written after all the filters of the run, so a run without a manifest is incomplete.
+type Manifest struct {
+	RunID        string         `json:"run_id"`
+	CreationDate time.Time      `json:"creation_date"`
+	Nodes        []ManifestNode `json:"nodes"`
+}
+
+// ManifestNode describes the retain filter of a node.
+type ManifestNode struct {
+	NodeID     storj.NodeID `json:"node_id"`
+	PieceCount int          `json:"piece_count"`
+	FilterSize int64        `json:"filter_size"`
+}
+
+// DeliveryStatus is the status of sending the retain filter of a run to a node.
+type DeliveryStatus struct {
+	NodeID      storj.NodeID `json:"node_id"`
+	Attempts    int          `json:"attempts"`
+	LastAttempt time.Time    `json:"last_attempt"`
+	LastError   string       `json:"last_error,omitempty"`
+	DeliveredAt time.Time    `json:"delivered_at"`
+}
+
+// Delivered returns whether the node has accepted the retain filter.
+func (status *DeliveryStatus) Delivered() bool { return !status.DeliveredAt.IsZero() }
+
+func manifestKey(runID string) string { return runID + "/manifest.json" }
+
+func filterKey(runID string, nodeID storj.NodeID) string {
+	return runID + "/filters/" + nodeID.String()
+}
+
+func statusKey(runID string, nodeID storj.NodeID) string {
+	return runID + "/status/" + nodeID.String() + ".json"
+}
+
+// SaveFilters stores the retain filters of a run and its manifest.
+func SaveFilters(ctx context.Context, store FilterStore, creationDate time.Time, retainInfos map[storj.NodeID]*RetainInfo) (_ Manifest, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	manifest := Manifest{
+		RunID:        creationDate.UTC().Format(runIDFormat),
+		CreationDate: creationDate,
+	}
+
+	for id, info := range retainInfos {
+		data, err := pb.Marshal(&pb.RetainRequest{
+			CreationDate: info.CreationDate,
+			Filter:       info.Filter.Bytes(),
+		})
+		if err != nil {
+			return Manifest{}, Error.Wrap(err)
+		}
+		if err := store.Put(ctx, filterKey(manifest.RunID, id), data); err != nil {
+			return Manifest{}, Error.Wrap(err)
+		}
+
+		manifest.Nodes = append(manifest.Nodes, ManifestNode{
+			NodeID:     id,
+			PieceCount: info.Count,
+			FilterSize: info.Filter.Size(),
+		})
+	}
+	sort.Slice(manifest.Nodes, func(i, k int) bool {
+		return manifest.Nodes[i].NodeID.Less(manifest.Nodes[k].NodeID)
+	})
+
+	data, err := json.Marshal(manifest)
+	if err != nil {
+		return Manifest{}, Error.Wrap(err)
+	}
+	if err := store.Put(ctx, manifestKey(manifest.RunID), data); err != nil {
+		return Manifest{}, Error.Wrap(err)
+	}
+	return manifest, nil
+}
+
+// LoadManifest loads the manifest of the run.
+func LoadManifest(ctx context.Context, store FilterStore, runID string) (_ Manifest, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	data, err := store.Get(ctx, manifestKey(runID))
+	if err != nil {
+		return Manifest{}, err
+	}
+	var manifest Manifest
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		return Manifest{}, Error.Wrap(err)
+	}
+	return manifest, nil
+}
+
+// LatestManifest loads the manifest of the latest complete run. It returns
+// ErrObjectNotFound when there are no complete runs.
+func LatestManifest(ctx context.Context, store FilterStore) (_ Manifest, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	runIDs, err := store.List(ctx, "")
+	if err != nil {
+		return Manifest{}, Error.Wrap(err)
+	}
+	for i := len(runIDs) - 1; i >= 0; i-- {
+		manifest, err := LoadManifest(ctx, store, runIDs[i])
+		if ErrObjectNotFound.Has(err) {
+			continue
+		}
+		return manifest, err
+	}
+	return Manifest{}, ErrObjectNotFound.New("no complete garbage collection runs")
+}
+
+// PruneRuns deletes all runs older than the latest keep complete runs. Incomplete
+// runs newer than those are kept, since they may still be written. It returns the
+// ids of the deleted runs.
+func PruneRuns(ctx context.Context, store FilterStore, keep int) (deleted []string, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if keep <= 0 {
+		return nil, nil
+	}
+
+	runIDs, err := store.List(ctx, "")
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	complete := 0
+	for i := len(runIDs) - 1; i >= 0; i-- {
+		_, err := store.Get(ctx, manifestKey(runIDs[i]))
+		if ErrObjectNotFound.Has(err) {
+			continue
+		} else if err != nil {
+			return deleted, Error.Wrap(err)
+		}
+
+		complete++
+		if complete < keep {
+			continue
+		}
+
+		for _, runID := range runIDs[:i] {
+			if err := store.Delete(ctx, runID+"/"); err != nil {
+				return deleted, Error.Wrap(err)
+			}
+			deleted = append(deleted, runID)
+		}
+		break
+	}
+	return deleted, nil
+}
+
+// LoadFilter loads the retain request of the node in the run.
+func LoadFilter(ctx context.Context, store FilterStore, runID string, nodeID storj.NodeID) (_ *pb.RetainRequest, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	data, err := store.Get(ctx, filterKey(runID, nodeID))
+	if err != nil {
+		return nil, err
+	}
+	var request pb.RetainRequest
+	if err := pb.Unmarshal(data, &request); err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return &request, nil
+}
+
+// LoadStatus loads the delivery status of the retain filter of the node in the run.
+// The status is empty when no delivery has been attempted yet.
+func LoadStatus(ctx context.Context, store FilterStore, runID string, nodeID storj.NodeID) (_ DeliveryStatus, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	data, err := store.Get(ctx, statusKey(runID, nodeID))
+	if err != nil {
+		if ErrObjectNotFound.Has(err) {
+			return DeliveryStatus{NodeID: nodeID}, nil
+		}
+		return DeliveryStatus{}, err
+	}
+	var status DeliveryStatus
+	if err := json.Unmarshal(data, &status); err != nil {
+		return DeliveryStatus{}, Error.Wrap(err)
+	}
+	return status, nil
+}
+
+// SaveStatus stores the delivery status of the retain filter of the node in the run.
+func SaveStatus(ctx context.Context, store FilterStore, runID string, status DeliveryStatus) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	data, err := json.Marshal(status)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	return store.Put(ctx, statusKey(runID, status.NodeID), data)
+}
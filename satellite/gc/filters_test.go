@@ -0,0 +1,117 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package gc_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/bloomfilter"
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite/gc"
+)
+
+func TestSaveFilters(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	store, err := gc.NewDirFilterStore(ctx.Dir("filters"))
+	require.NoError(t, err)
+
+	_, err = gc.LatestManifest(ctx, store)
+	require.True(t, gc.ErrObjectNotFound.Has(err))
+
+	creationDate := time.Now().UTC().Truncate(time.Second)
+	nodeID, pieceID := testrand.NodeID(), testrand.PieceID()
+
+	filter := bloomfilter.NewOptimal(10, 0.1)
+	filter.Add(pieceID)
+	retainInfos := map[storj.NodeID]*gc.RetainInfo{
+		nodeID: {Filter: filter, CreationDate: creationDate, Count: 1},
+	}
+
+	older, err := gc.SaveFilters(ctx, store, creationDate.Add(-time.Hour), retainInfos)
+	require.NoError(t, err)
+	saved, err := gc.SaveFilters(ctx, store, creationDate, retainInfos)
+	require.NoError(t, err)
+	require.NotEqual(t, older.RunID, saved.RunID)
+
+	manifest, err := gc.LatestManifest(ctx, store)
+	require.NoError(t, err)
+	require.Equal(t, saved.RunID, manifest.RunID)
+	require.Equal(t, []gc.ManifestNode{{NodeID: nodeID, PieceCount: 1, FilterSize: filter.Size()}}, manifest.Nodes)
+
+	request, err := gc.LoadFilter(ctx, store, manifest.RunID, nodeID)
+	require.NoError(t, err)
+	require.True(t, request.CreationDate.Equal(creationDate))
+	loaded, err := bloomfilter.NewFromBytes(request.Filter)
+	require.NoError(t, err)
+	require.True(t, loaded.Contains(pieceID))
+
+	_, err = gc.LoadFilter(ctx, store, manifest.RunID, testrand.NodeID())
+	require.True(t, gc.ErrObjectNotFound.Has(err))
+
+	status, err := gc.LoadStatus(ctx, store, manifest.RunID, nodeID)
+	require.NoError(t, err)
+	require.Equal(t, gc.DeliveryStatus{NodeID: nodeID}, status)
+
+	status.Attempts = 1
+	status.LastAttempt = creationDate
+	status.DeliveredAt = creationDate
+	require.NoError(t, gc.SaveStatus(ctx, store, manifest.RunID, status))
+
+	loadedStatus, err := gc.LoadStatus(ctx, store, manifest.RunID, nodeID)
+	require.NoError(t, err)
+	require.True(t, loadedStatus.Delivered())
+	require.Equal(t, 1, loadedStatus.Attempts)
+
+	// the status is kept per run
+	status, err = gc.LoadStatus(ctx, store, older.RunID, nodeID)
+	require.NoError(t, err)
+	require.False(t, status.Delivered())
+}
+
+func TestPruneRuns(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	store, err := gc.NewDirFilterStore(ctx.Dir("filters"))
+	require.NoError(t, err)
+
+	now := time.Now().UTC().Truncate(time.Second)
+	retainInfos := map[storj.NodeID]*gc.RetainInfo{
+		testrand.NodeID(): {Filter: bloomfilter.NewOptimal(10, 0.1), CreationDate: now, Count: 1},
+	}
+
+	var runIDs []string
+	for i := 4; i > 0; i-- {
+		manifest, err := gc.SaveFilters(ctx, store, now.Add(-time.Duration(i)*time.Hour), retainInfos)
+		require.NoError(t, err)
+		runIDs = append(runIDs, manifest.RunID)
+	}
+
+	// an incomplete run that is still being written.
+	require.NoError(t, store.Put(ctx, "99991231T000000Z/filters/node", []byte("filter")))
+
+	deleted, err := gc.PruneRuns(ctx, store, 2)
+	require.NoError(t, err)
+	require.Equal(t, runIDs[:2], deleted)
+
+	remaining, err := store.List(ctx, "")
+	require.NoError(t, err)
+	require.Equal(t, append(runIDs[2:], "99991231T000000Z"), remaining)
+
+	manifest, err := gc.LatestManifest(ctx, store)
+	require.NoError(t, err)
+	require.Equal(t, runIDs[3], manifest.RunID)
+
+	// nothing is deleted when there are not enough complete runs.
+	deleted, err = gc.PruneRuns(ctx, store, 5)
+	require.NoError(t, err)
+	require.Empty(t, deleted)
+}
@@ -0,0 +1,123 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package gc
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/zeebo/errs"
+)
+
+// ErrObjectNotFound is returned when an object doesn't exist in the filter store.
+var ErrObjectNotFound = errs.Class("object not found")
+
+// FilterStore is a durable storage for the retain bloom filters and their
+// delivery status. It can be backed by a local directory or an object store.
+//
+// Keys are slash separated paths.
+type FilterStore interface {
+	// Put stores the data under the key, replacing any existing data.
+	Put(ctx context.Context, key string, data []byte) error
+	// Get returns the data stored under the key. It returns ErrObjectNotFound
+	// when the key doesn't exist.
+	Get(ctx context.Context, key string) ([]byte, error)
+	// List returns the names of the entries directly under the prefix in ascending
+	// order, like an object store listing with a "/" delimiter. The prefix is either
+	// empty or ends with a slash.
+	List(ctx context.Context, prefix string) ([]string, error)
+	// Delete removes the key and every key under it when it ends with a slash.
+	// Deleting a key that doesn't exist is not an error.
+	Delete(ctx context.Context, key string) error
+}
+
+// DirFilterStore implements FilterStore in a local directory.
+type DirFilterStore struct {
+	path string
+}
+
+// NewDirFilterStore creates a filter store in the local directory.
+func NewDirFilterStore(path string) (*DirFilterStore, error) {
+	if err := os.MkdirAll(path, 0700); err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return &DirFilterStore{path: path}, nil
+}
+
+// Put atomically stores the data under the key.
+func (store *DirFilterStore) Put(ctx context.Context, key string, data []byte) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	path := store.keyPath(key)
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return Error.Wrap(err)
+	}
+
+	tmp, err := ioutil.TempFile(filepath.Dir(path), ".tmp-")
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	_, err = tmp.Write(data)
+	err = errs.Combine(err, tmp.Sync(), tmp.Close())
+	if err == nil {
+		err = os.Rename(tmp.Name(), path)
+	}
+	if err != nil {
+		return Error.Wrap(errs.Combine(err, os.Remove(tmp.Name())))
+	}
+	return nil
+}
+
+// Get returns the data stored under the key.
+func (store *DirFilterStore) Get(ctx context.Context, key string) (_ []byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	data, err := ioutil.ReadFile(store.keyPath(key))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, ErrObjectNotFound.New("%s", key)
+		}
+		return nil, Error.Wrap(err)
+	}
+	return data, nil
+}
+
+// List returns the names of the entries directly under the prefix.
+func (store *DirFilterStore) List(ctx context.Context, prefix string) (_ []string, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	entries, err := ioutil.ReadDir(store.keyPath(prefix))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, Error.Wrap(err)
+	}
+
+	var names []string
+	for _, entry := range entries {
+		if strings.HasPrefix(entry.Name(), ".tmp-") {
+			continue
+		}
+		names = append(names, entry.Name())
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// Delete removes the key and everything under it.
+func (store *DirFilterStore) Delete(ctx context.Context, key string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return Error.Wrap(os.RemoveAll(store.keyPath(key)))
+}
+
+// keyPath returns the local path of the key.
+func (store *DirFilterStore) keyPath(key string) string {
+	return filepath.Join(store.path, filepath.FromSlash(key))
+}
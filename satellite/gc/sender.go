@@ -0,0 +1,158 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package gc
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storj.io/common/rpc"
+	"storj.io/common/storj"
+	"storj.io/common/sync2"
+	"storj.io/storj/satellite/overlay"
+)
+
+// SenderConfig contains configurable values for the garbage collection sender.
+type SenderConfig struct {
+	Interval        time.Duration `help:"how frequently the sender checks for retain filters to send" releaseDefault:"5m" devDefault:"1m" testDefault:"$TESTINTERVAL"`
+	MaxAttempts     int           `help:"the maximum number of attempts to send a retain filter to a node" default:"10"`
+	RetryBackoff    time.Duration `help:"the time to wait before resending a retain filter after a failed attempt, doubled after every attempt" default:"10m"`
+	MaxRetryBackoff time.Duration `help:"the maximum time to wait before resending a retain filter" default:"6h"`
+}
+
+// Sender sends the retain filters of the latest complete run in the filter
+// store to the storage nodes and records the delivery status of every node.
+//
+// architecture: Chore
+type Sender struct {
+	log    *zap.Logger
+	config Config
+	Loop   *sync2.Cycle
+
+	dialer  rpc.Dialer
+	overlay overlay.DB
+	filters FilterStore
+
+	nowFn func() time.Time
+}
+
+// NewSender creates a new garbage collection sender.
+func NewSender(log *zap.Logger, config Config, dialer rpc.Dialer, overlay overlay.DB, filters FilterStore) *Sender {
+	return &Sender{
+		log:    log,
+		config: config,
+		Loop:   sync2.NewCycle(config.Sender.Interval),
+
+		dialer:  dialer,
+		overlay: overlay,
+		filters: filters,
+
+		nowFn: time.Now,
+	}
+}
+
+// Run starts the sender loop.
+func (sender *Sender) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return sender.Loop.Run(ctx, func(ctx context.Context) error {
+		err := sender.SendLatest(ctx)
+		if err != nil {
+			sender.log.Error("error sending retain filters", zap.Error(err))
+		}
+		return nil
+	})
+}
+
+// Close stops the sender loop.
+func (sender *Sender) Close() error {
+	sender.Loop.Close()
+	return nil
+}
+
+// SendLatest sends the retain filters of the latest complete run to the nodes, which
+// haven't received them yet and whose retry backoff has passed.
+func (sender *Sender) SendLatest(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	manifest, err := LatestManifest(ctx, sender.filters)
+	if err != nil {
+		if ErrObjectNotFound.Has(err) {
+			return nil
+		}
+		return err
+	}
+
+	limiter := sync2.NewLimiter(sender.config.ConcurrentSends)
+	for _, node := range manifest.Nodes {
+		id := node.NodeID
+		limiter.Go(ctx, func() {
+			err := sender.sendToNode(ctx, manifest.RunID, id)
+			if err != nil {
+				sender.log.Warn("error sending retain filter to node", zap.String("Run ID", manifest.RunID), zap.Stringer("Node ID", id), zap.Error(err))
+			}
+		})
+	}
+	limiter.Wait()
+
+	return nil
+}
+
+// sendToNode sends the retain filter of the run to the node when it's due and
+// records the outcome.
+func (sender *Sender) sendToNode(ctx context.Context, runID string, id storj.NodeID) (err error) {
+	defer mon.Task()(&ctx, id.String())(&err)
+
+	status, err := LoadStatus(ctx, sender.filters, runID, id)
+	if err != nil {
+		return err
+	}
+
+	now := sender.nowFn()
+	if status.Delivered() || status.Attempts >= sender.config.Sender.MaxAttempts || now.Before(sender.nextAttempt(status)) {
+		return nil
+	}
+
+	request, err := LoadFilter(ctx, sender.filters, runID, id)
+	if err != nil {
+		return err
+	}
+
+	sendErr := sendRetainRequest(ctx, sender.dialer, sender.overlay, sender.config.RetainSendTimeout, id, request)
+
+	status.Attempts++
+	status.LastAttempt = now
+	if sendErr != nil {
+		status.LastError = sendErr.Error()
+		mon.Counter("retain_filter_send_failure").Inc(1)
+		if status.Attempts >= sender.config.Sender.MaxAttempts {
+			sender.log.Warn("giving up sending retain filter to node", zap.String("Run ID", runID), zap.Stringer("Node ID", id), zap.Error(sendErr))
+		}
+	} else {
+		status.LastError = ""
+		status.DeliveredAt = now
+		mon.Counter("retain_filter_send_success").Inc(1)
+	}
+
+	return SaveStatus(ctx, sender.filters, runID, status)
+}
+
+// nextAttempt returns when the retain filter can be sent next, the backoff
+// doubles after every failed attempt.
+func (sender *Sender) nextAttempt(status DeliveryStatus) time.Time {
+	if status.Attempts == 0 {
+		return time.Time{}
+	}
+
+	backoff := sender.config.Sender.RetryBackoff
+	for i := 1; i < status.Attempts && backoff < sender.config.Sender.MaxRetryBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > sender.config.Sender.MaxRetryBackoff {
+		backoff = sender.config.Sender.MaxRetryBackoff
+	}
+	return status.LastAttempt.Add(backoff)
+}
@@ -37,6 +37,10 @@ type Config struct {
 	FalsePositiveRate float64       `help:"the false positive rate used for creating a garbage collection bloom filter" releaseDefault:"0.1" devDefault:"0.1"`
 	ConcurrentSends   int           `help:"the number of nodes to concurrently send garbage collection bloom filters to" releaseDefault:"1" devDefault:"1"`
 	RetainSendTimeout time.Duration `help:"the amount of time to allow a node to handle a retain request" default:"1m"`
+
+	FilterStore     string `help:"local directory to store the retain filters in instead of sending them, they are sent by the garbage collection sender" default:""`
+	FilterStoreRuns int    `help:"number of complete runs to keep in the filter store, older runs are deleted. 0 keeps all runs" default:"3"`
+	Sender          SenderConfig
 }
 
 // Service implements the garbage collection service.
@@ -50,6 +54,7 @@ type Service struct {
 	dialer      rpc.Dialer
 	overlay     overlay.DB
 	segmentLoop *segmentloop.Service
+	filters     FilterStore
 }
 
 // RetainInfo contains info needed for a storage node to retain important data and delete garbage data.
@@ -60,7 +65,10 @@ type RetainInfo struct {
 }
 
 // NewService creates a new instance of the gc service.
-func NewService(log *zap.Logger, config Config, dialer rpc.Dialer, overlay overlay.DB, loop *segmentloop.Service) *Service {
+//
+// When filters is not nil, the retain filters are stored in it instead of
+// being sent to the storage nodes.
+func NewService(log *zap.Logger, config Config, dialer rpc.Dialer, overlay overlay.DB, loop *segmentloop.Service, filters FilterStore) *Service {
 	return &Service{
 		log:         log,
 		config:      config,
@@ -68,6 +76,7 @@ func NewService(log *zap.Logger, config Config, dialer rpc.Dialer, overlay overl
 		dialer:      dialer,
 		overlay:     overlay,
 		segmentLoop: loop,
+		filters:     filters,
 	}
 }
 
@@ -120,6 +129,23 @@ func (service *Service) Run(ctx context.Context) (err error) {
 			mon.IntVal("retain_filter_size_bytes").Observe(info.Filter.Size())
 		}
 
+		if service.filters != nil {
+			manifest, err := SaveFilters(ctx, service.filters, pieceTracker.creationDate, pieceTracker.RetainInfos)
+			if err != nil {
+				service.log.Error("error storing retain filters", zap.Error(err))
+				return nil
+			}
+			service.log.Info("stored retain filters", zap.String("Run ID", manifest.RunID), zap.Int("Nodes", len(manifest.Nodes)))
+
+			deleted, err := PruneRuns(ctx, service.filters, service.config.FilterStoreRuns)
+			if err != nil {
+				service.log.Error("error deleting old retain filters", zap.Error(err))
+			} else if len(deleted) > 0 {
+				service.log.Info("deleted old retain filters", zap.Strings("Run IDs", deleted))
+			}
+			return nil
+		}
+
 		// send retain requests
 		limiter := sync2.NewLimiter(service.config.ConcurrentSends)
 		for id, info := range pieceTracker.RetainInfos {
@@ -140,14 +166,22 @@ func (service *Service) Run(ctx context.Context) (err error) {
 func (service *Service) sendRetainRequest(ctx context.Context, id storj.NodeID, info *RetainInfo) (err error) {
 	defer mon.Task()(&ctx, id.String())(&err)
 
-	dossier, err := service.overlay.Get(ctx, id)
+	return sendRetainRequest(ctx, service.dialer, service.overlay, service.config.RetainSendTimeout, id, &pb.RetainRequest{
+		CreationDate: info.CreationDate,
+		Filter:       info.Filter.Bytes(),
+	})
+}
+
+// sendRetainRequest sends the retain request to the node.
+func sendRetainRequest(ctx context.Context, dialer rpc.Dialer, overlay overlay.DB, timeout time.Duration, id storj.NodeID, request *pb.RetainRequest) (err error) {
+	dossier, err := overlay.Get(ctx, id)
 	if err != nil {
 		return Error.Wrap(err)
 	}
 
-	if service.config.RetainSendTimeout > 0 {
+	if timeout > 0 {
 		var cancel func()
-		ctx, cancel = context.WithTimeout(ctx, service.config.RetainSendTimeout)
+		ctx, cancel = context.WithTimeout(ctx, timeout)
 		defer cancel()
 	}
 
@@ -156,7 +190,7 @@ func (service *Service) sendRetainRequest(ctx context.Context, id storj.NodeID,
 		Address: dossier.Address.Address,
 	}
 
-	client, err := piecestore.Dial(ctx, service.dialer, nodeurl, piecestore.DefaultConfig)
+	client, err := piecestore.Dial(ctx, dialer, nodeurl, piecestore.DefaultConfig)
 	if err != nil {
 		return Error.Wrap(err)
 	}
@@ -164,9 +198,6 @@ func (service *Service) sendRetainRequest(ctx context.Context, id storj.NodeID,
 		err = errs.Combine(err, Error.Wrap(client.Close()))
 	}()
 
-	err = client.Retain(ctx, &pb.RetainRequest{
-		CreationDate: info.CreationDate,
-		Filter:       info.Filter.Bytes(),
-	})
+	err = client.Retain(ctx, request)
 	return Error.Wrap(err)
 }
@@ -0,0 +1,176 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellite
+
+import (
+	"context"
+	"errors"
+	"net"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+
+	"storj.io/common/identity"
+	"storj.io/common/peertls/extensions"
+	"storj.io/common/peertls/tlsopts"
+	"storj.io/common/rpc"
+	"storj.io/common/storj"
+	"storj.io/private/debug"
+	"storj.io/private/version"
+	"storj.io/storj/private/lifecycle"
+	version_checker "storj.io/storj/private/version/checker"
+	"storj.io/storj/satellite/gc"
+	"storj.io/storj/satellite/overlay"
+)
+
+// GarbageCollectionSender is the satellite process, which sends the retain
+// filters stored by the garbage collection process to the storage nodes.
+//
+// architecture: Peer
+type GarbageCollectionSender struct {
+	Log      *zap.Logger
+	Identity *identity.FullIdentity
+	DB       DB
+
+	Servers  *lifecycle.Group
+	Services *lifecycle.Group
+
+	Dialer rpc.Dialer
+
+	Version struct {
+		Chore   *version_checker.Chore
+		Service *version_checker.Service
+	}
+
+	Debug struct {
+		Listener net.Listener
+		Server   *debug.Server
+	}
+
+	Overlay struct {
+		DB overlay.DB
+	}
+
+	GarbageCollection struct {
+		Filters gc.FilterStore
+		Sender  *gc.Sender
+	}
+}
+
+// NewGarbageCollectionSender creates a new satellite garbage collection sender process.
+func NewGarbageCollectionSender(log *zap.Logger, full *identity.FullIdentity, db DB,
+	revocationDB extensions.RevocationDB,
+	versionInfo version.Info, config *Config, atomicLogLevel *zap.AtomicLevel) (*GarbageCollectionSender, error) {
+	peer := &GarbageCollectionSender{
+		Log:      log,
+		Identity: full,
+		DB:       db,
+
+		Servers:  lifecycle.NewGroup(log.Named("servers")),
+		Services: lifecycle.NewGroup(log.Named("services")),
+	}
+
+	{ // setup debug
+		var err error
+		if config.Debug.Address != "" {
+			peer.Debug.Listener, err = net.Listen("tcp", config.Debug.Address)
+			if err != nil {
+				withoutStack := errors.New(err.Error())
+				peer.Log.Debug("failed to start debug endpoints", zap.Error(withoutStack))
+			}
+		}
+		debugConfig := config.Debug
+		debugConfig.ControlTitle = "GC Sender"
+		peer.Debug.Server = debug.NewServerWithAtomicLevel(log.Named("debug"), peer.Debug.Listener, monkit.Default, debugConfig, atomicLogLevel)
+		peer.Servers.Add(lifecycle.Item{
+			Name:  "debug",
+			Run:   peer.Debug.Server.Run,
+			Close: peer.Debug.Server.Close,
+		})
+	}
+
+	{ // setup version control
+		peer.Log.Info("Version info",
+			zap.Stringer("Version", versionInfo.Version.Version),
+			zap.String("Commit Hash", versionInfo.CommitHash),
+			zap.Stringer("Build Timestamp", versionInfo.Timestamp),
+			zap.Bool("Release Build", versionInfo.Release),
+		)
+		peer.Version.Service = version_checker.NewService(log.Named("version"), config.Version, versionInfo, "Satellite")
+		peer.Version.Chore = version_checker.NewChore(peer.Version.Service, config.Version.CheckInterval)
+
+		peer.Services.Add(lifecycle.Item{
+			Name: "version",
+			Run:  peer.Version.Chore.Run,
+		})
+	}
+
+	{ // setup listener and server
+		sc := config.Server
+
+		tlsOptions, err := tlsopts.NewOptions(peer.Identity, sc.Config, revocationDB)
+		if err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
+
+		peer.Dialer = rpc.NewDefaultDialer(tlsOptions)
+	}
+
+	{ // setup overlay
+		peer.Overlay.DB = peer.DB.OverlayCache()
+	}
+
+	{ // setup garbage collection sender
+		if config.GarbageCollection.FilterStore == "" {
+			return nil, errs.Combine(errs.New("garbage-collection.filter-store is required"), peer.Close())
+		}
+		filters, err := gc.NewDirFilterStore(config.GarbageCollection.FilterStore)
+		if err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
+		peer.GarbageCollection.Filters = filters
+
+		peer.GarbageCollection.Sender = gc.NewSender(
+			peer.Log.Named("garbage-collection:sender"),
+			config.GarbageCollection,
+			peer.Dialer,
+			peer.Overlay.DB,
+			peer.GarbageCollection.Filters,
+		)
+		peer.Services.Add(lifecycle.Item{
+			Name:  "garbage-collection:sender",
+			Run:   peer.GarbageCollection.Sender.Run,
+			Close: peer.GarbageCollection.Sender.Close,
+		})
+		peer.Debug.Server.Panel.Add(
+			debug.Cycle("Garbage Collection Sender", peer.GarbageCollection.Sender.Loop))
+	}
+
+	return peer, nil
+}
+
+// Run runs satellite garbage collection sender until it's either closed or it errors.
+func (peer *GarbageCollectionSender) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	group, ctx := errgroup.WithContext(ctx)
+
+	peer.Servers.Run(ctx, group)
+	peer.Services.Run(ctx, group)
+
+	return group.Wait()
+}
+
+// Close closes all the resources.
+func (peer *GarbageCollectionSender) Close() error {
+	return errs.Combine(
+		peer.Servers.Close(),
+		peer.Services.Close(),
+	)
+}
+
+// ID returns the peer ID.
+func (peer *GarbageCollectionSender) ID() storj.NodeID { return peer.Identity.ID }
@@ -0,0 +1,36 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information
+
+package geoip_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/satellite/geoip"
+)
+
+func TestIP2ASNMock(t *testing.T) {
+	{
+		asn, err := geoip.MockIPToASN{}.LookupASN("127.0.0.1:1234")
+		require.NoError(t, err)
+		require.Zero(t, asn)
+	}
+
+	ipLookup := geoip.MockIPToASN{13335, 15169}
+	{
+		asn, err := ipLookup.LookupASN("127.0.0.1:1234")
+		require.NoError(t, err)
+		require.EqualValues(t, 15169, asn)
+	}
+	{
+		asn, err := ipLookup.LookupASN("127.0.0.2:1234")
+		require.NoError(t, err)
+		require.EqualValues(t, 13335, asn)
+	}
+	{
+		_, err := ipLookup.LookupASN("127.0.0.1")
+		require.Error(t, err)
+	}
+}
@@ -27,12 +27,17 @@ type ipInfo struct {
 	} `maxminddb:"country"`
 }
 
+type asnInfo struct {
+	AutonomousSystemNumber uint32 `maxminddb:"autonomous_system_number"`
+}
+
 // MaxmindDB provides access to GeoIP data via the maxmind geoip databases.
 type MaxmindDB struct {
 	db *maxminddb.Reader
 }
 
 var _ IPToCountry = &MaxmindDB{}
+var _ IPToASN = &MaxmindDB{}
 
 // Close will disconnect the underlying connection to the database.
 func (m *MaxmindDB) Close() error {
@@ -54,3 +59,20 @@ func (m *MaxmindDB) LookupISOCountryCode(address string) (location.CountryCode,
 
 	return location.ToCountryCode(info.Country.IsoCode), nil
 }
+
+// LookupASN accepts an IP address. It requires a maxmind database containing
+// autonomous system information.
+func (m *MaxmindDB) LookupASN(address string) (uint32, error) {
+	ip, err := addressToIP(address)
+	if err != nil || ip == nil {
+		return 0, err
+	}
+
+	info := &asnInfo{}
+	err = m.db.Lookup(ip, info)
+	if err != nil {
+		return 0, err
+	}
+
+	return info.AutonomousSystemNumber, nil
+}
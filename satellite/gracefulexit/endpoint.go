@@ -25,6 +25,7 @@ import (
 	"storj.io/storj/satellite/metainfo"
 	"storj.io/storj/satellite/orders"
 	"storj.io/storj/satellite/overlay"
+	"storj.io/storj/satellite/repair/queue"
 	"storj.io/storj/satellite/reputation"
 	"storj.io/uplink/private/eestream"
 )
@@ -52,6 +53,7 @@ type Endpoint struct {
 	reputation     *reputation.Service
 	metabase       *metabase.DB
 	orders         *orders.Service
+	repairQueue    queue.RepairQueue
 	connections    *connectionsTracker
 	peerIdentities overlay.PeerIdentities
 	config         Config
@@ -94,7 +96,7 @@ func (pm *connectionsTracker) delete(nodeID storj.NodeID) {
 
 // NewEndpoint creates a new graceful exit endpoint.
 func NewEndpoint(log *zap.Logger, signer signing.Signer, db DB, overlaydb overlay.DB, overlay *overlay.Service, reputation *reputation.Service, metabase *metabase.DB, orders *orders.Service,
-	peerIdentities overlay.PeerIdentities, config Config) *Endpoint {
+	repairQueue queue.RepairQueue, peerIdentities overlay.PeerIdentities, config Config) *Endpoint {
 	return &Endpoint{
 		log:            log,
 		interval:       time.Millisecond * buildQueueMillis,
@@ -105,6 +107,7 @@ func NewEndpoint(log *zap.Logger, signer signing.Signer, db DB, overlaydb overla
 		reputation:     reputation,
 		metabase:       metabase,
 		orders:         orders,
+		repairQueue:    repairQueue,
 		connections:    newConnectionsTracker(),
 		peerIdentities: peerIdentities,
 		config:         config,
@@ -328,6 +331,10 @@ func (endpoint *Endpoint) processIncomplete(ctx context.Context, stream pb.DRPCS
 		if err != nil {
 			return Error.Wrap(err)
 		}
+		err = endpoint.queueForRepair(ctx, incomplete.StreamID, incomplete.Position)
+		if err != nil {
+			return Error.Wrap(err)
+		}
 		err = endpoint.db.DeleteTransferQueueItem(ctx, nodeID, incomplete.StreamID, incomplete.Position, incomplete.PieceNum)
 		if err != nil {
 			return Error.Wrap(err)
@@ -384,6 +391,7 @@ func (endpoint *Endpoint) processIncomplete(ctx context.Context, stream pb.DRPCS
 	request := &overlay.FindStorageNodesRequest{
 		RequestedCount: 1,
 		ExcludedIDs:    excludedIDs,
+		Placement:      segment.Placement,
 	}
 
 	newNodes, err := endpoint.overlay.FindStorageNodesForGracefulExit(ctx, *request)
@@ -579,6 +587,10 @@ func (endpoint *Endpoint) handleFailed(ctx context.Context, pending *PendingMap,
 		if err != nil {
 			return Error.Wrap(err)
 		}
+		err = endpoint.queueForRepair(ctx, transfer.StreamID, transfer.Position)
+		if err != nil {
+			return Error.Wrap(err)
+		}
 
 		err = endpoint.db.IncrementProgress(ctx, nodeID, 0, 0, 1)
 		if err != nil {
@@ -606,11 +618,31 @@ func (endpoint *Endpoint) handleFailed(ctx context.Context, pending *PendingMap,
 		if err != nil {
 			return Error.Wrap(err)
 		}
+		err = endpoint.queueForRepair(ctx, transfer.StreamID, transfer.Position)
+		if err != nil {
+			return Error.Wrap(err)
+		}
 	}
 
 	return pending.Delete(pieceID)
 }
 
+// queueForRepair adds the segment to the repair queue, because the piece of the
+// exiting node won't be transferred. The segment is repaired regardless of its
+// health, since the exiting node is going to leave the network.
+func (endpoint *Endpoint) queueForRepair(ctx context.Context, streamID uuid.UUID, position metabase.SegmentPosition) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = endpoint.repairQueue.Insert(ctx, &queue.InjuredSegment{
+		StreamID:      streamID,
+		Position:      position,
+		SegmentHealth: queue.UnknownHealth,
+		UpdatedAt:     time.Now().UTC(),
+		Reason:        queue.ReasonGracefulExit,
+	})
+	return err
+}
+
 func (endpoint *Endpoint) handleDisqualifiedNode(ctx context.Context, nodeID storj.NodeID) (isDisqualified bool, err error) {
 	// check if node is disqualified
 	nodeInfo, err := endpoint.overlay.Get(ctx, nodeID)
@@ -32,6 +32,7 @@ import (
 	"storj.io/storj/satellite/metabase"
 	"storj.io/storj/satellite/metainfo"
 	"storj.io/storj/satellite/overlay"
+	"storj.io/storj/satellite/repair/queue"
 	"storj.io/storj/storagenode"
 	"storj.io/storj/storagenode/gracefulexit"
 )
@@ -1240,6 +1241,13 @@ func TestFailureNotFound(t *testing.T) {
 			require.NotEqual(t, piece.StorageNode, exitingNode.ID())
 		}
 
+		// check that the segment is queued for repair
+		injured, err := satellite.DB.RepairQueue().SelectN(ctx, 10, queue.ReasonGracefulExit)
+		require.NoError(t, err)
+		require.Len(t, injured, 1)
+		require.Equal(t, segments[0].StreamID, injured[0].StreamID)
+		require.Equal(t, segments[0].Position, injured[0].Position)
+
 		// check that the exit has completed and we have the correct transferred/failed values
 		progress, err := satellite.DB.GracefulExit().GetProgress(ctx, exitingNode.ID())
 		require.NoError(t, err)
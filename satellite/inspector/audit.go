@@ -0,0 +1,180 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package inspector
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/audit"
+	"storj.io/storj/satellite/internalpb"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/overlay"
+)
+
+// AuditEndpoint for requesting audits of nodes and objects.
+//
+// architecture: Endpoint
+type AuditEndpoint struct {
+	internalpb.DRPCAuditInspectorUnimplementedServer
+	log           *zap.Logger
+	overlay       *overlay.Service
+	metabase      *metabase.DB
+	pieceRequests audit.PieceAuditRequests
+	requests      audit.Requests
+}
+
+// NewAuditEndpoint will initialize an AuditEndpoint struct.
+func NewAuditEndpoint(log *zap.Logger, cache *overlay.Service, metabase *metabase.DB, pieceRequests audit.PieceAuditRequests, requests audit.Requests) *AuditEndpoint {
+	return &AuditEndpoint{
+		log:           log,
+		overlay:       cache,
+		metabase:      metabase,
+		pieceRequests: pieceRequests,
+		requests:      requests,
+	}
+}
+
+// PieceAudit requests full piece audits of the pieces stored on a node. The
+// pieces are selected from the node's reservoir on the next run of the audit
+// chore.
+func (endpoint *AuditEndpoint) PieceAudit(ctx context.Context, in *internalpb.PieceAuditRequest) (_ *internalpb.PieceAuditResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	// ensure the node is known.
+	if _, err := endpoint.overlay.Get(ctx, in.NodeId); err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	if err := endpoint.pieceRequests.Request(ctx, in.NodeId); err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	endpoint.log.Info("full piece audits requested", zap.Stringer("Node ID", in.NodeId))
+
+	return &internalpb.PieceAuditResponse{}, nil
+}
+
+// NodeAudit requests audits of a random sample of the segments held by a node.
+func (endpoint *AuditEndpoint) NodeAudit(ctx context.Context, in *internalpb.NodeAuditRequest) (_ *internalpb.AuditRequestResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	limit := int(in.Limit)
+	if limit == 0 {
+		limit = audit.DefaultRequestLimit
+	}
+	if limit < 0 || limit > audit.MaxRequestLimit {
+		return nil, rpcstatus.Errorf(rpcstatus.InvalidArgument, "limit must be between 1 and %d", audit.MaxRequestLimit)
+	}
+
+	// ensure the node is known.
+	if _, err := endpoint.overlay.Get(ctx, in.NodeId); err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	request := audit.Request{
+		NodeID: in.NodeId,
+		Limit:  limit,
+	}
+	return endpoint.createRequest(ctx, request)
+}
+
+// ObjectAudit requests audits of all segments of an object.
+func (endpoint *AuditEndpoint) ObjectAudit(ctx context.Context, in *internalpb.ObjectAuditRequest) (_ *internalpb.AuditRequestResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	projectID, err := uuid.FromBytes(in.ProjectId)
+	if err != nil {
+		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, "invalid project id")
+	}
+	if in.Version < 0 {
+		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, "version must not be negative")
+	}
+
+	location := metabase.ObjectLocation{
+		ProjectID:  projectID,
+		BucketName: string(in.Bucket),
+		ObjectKey:  metabase.ObjectKey(in.EncryptedPath),
+	}
+
+	// ensure the object exists.
+	if in.Version == 0 {
+		_, err = endpoint.metabase.GetObjectLatestVersion(ctx, metabase.GetObjectLatestVersion{
+			ObjectLocation: location,
+		})
+	} else {
+		_, err = endpoint.metabase.GetObjectExactVersion(ctx, metabase.GetObjectExactVersion{
+			ObjectLocation: location,
+			Version:        metabase.Version(in.Version),
+		})
+	}
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	request := audit.Request{
+		Object:  location,
+		Version: metabase.Version(in.Version),
+	}
+	return endpoint.createRequest(ctx, request)
+}
+
+// createRequest stores the request, which is processed by the audit request
+// worker of the core.
+func (endpoint *AuditEndpoint) createRequest(ctx context.Context, request audit.Request) (_ *internalpb.AuditRequestResponse, err error) {
+	request.ID, err = uuid.New()
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	if err := endpoint.requests.Create(ctx, request); err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	endpoint.log.Info("on-demand audit requested", zap.Stringer("Request ID", request.ID))
+
+	return &internalpb.AuditRequestResponse{
+		RequestId: request.ID.Bytes(),
+	}, nil
+}
+
+// AuditReport returns the status and the results of an audit request.
+func (endpoint *AuditEndpoint) AuditReport(ctx context.Context, in *internalpb.AuditReportRequest) (_ *internalpb.AuditReportResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	id, err := uuid.FromBytes(in.RequestId)
+	if err != nil {
+		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, "invalid request id")
+	}
+
+	request, err := endpoint.requests.Get(ctx, id)
+	if err != nil {
+		if audit.ErrRequestNotFound.Has(err) {
+			return nil, rpcstatus.Error(rpcstatus.NotFound, err.Error())
+		}
+		return nil, Error.Wrap(err)
+	}
+
+	results, err := endpoint.requests.Results(ctx, id)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	response := &internalpb.AuditReportResponse{
+		Status: request.Status.String(),
+		Error:  request.Error,
+	}
+	for _, result := range results {
+		response.Results = append(response.Results, &internalpb.AuditResult{
+			StreamId: result.StreamID.Bytes(),
+			Position: result.Position.Encode(),
+			NodeId:   result.NodeID,
+			Outcome:  result.Outcome.String(),
+		})
+	}
+	return response, nil
+}
@@ -296,49 +296,423 @@ func (m *SegmentHealth) GetSegment() []byte {
 	return nil
 }
 
+type PieceAuditRequest struct {
+	NodeId               NodeID   `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3,customtype=NodeID" json:"node_id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PieceAuditRequest) Reset()         { *m = PieceAuditRequest{} }
+func (m *PieceAuditRequest) String() string { return proto.CompactTextString(m) }
+func (*PieceAuditRequest) ProtoMessage()    {}
+func (*PieceAuditRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{5}
+}
+func (m *PieceAuditRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PieceAuditRequest.Unmarshal(m, b)
+}
+func (m *PieceAuditRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PieceAuditRequest.Marshal(b, m, deterministic)
+}
+func (m *PieceAuditRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PieceAuditRequest.Merge(m, src)
+}
+func (m *PieceAuditRequest) XXX_Size() int {
+	return xxx_messageInfo_PieceAuditRequest.Size(m)
+}
+func (m *PieceAuditRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PieceAuditRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PieceAuditRequest proto.InternalMessageInfo
+
+type PieceAuditResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PieceAuditResponse) Reset()         { *m = PieceAuditResponse{} }
+func (m *PieceAuditResponse) String() string { return proto.CompactTextString(m) }
+func (*PieceAuditResponse) ProtoMessage()    {}
+func (*PieceAuditResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{6}
+}
+func (m *PieceAuditResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PieceAuditResponse.Unmarshal(m, b)
+}
+func (m *PieceAuditResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PieceAuditResponse.Marshal(b, m, deterministic)
+}
+func (m *PieceAuditResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PieceAuditResponse.Merge(m, src)
+}
+func (m *PieceAuditResponse) XXX_Size() int {
+	return xxx_messageInfo_PieceAuditResponse.Size(m)
+}
+func (m *PieceAuditResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PieceAuditResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PieceAuditResponse proto.InternalMessageInfo
+
+type NodeAuditRequest struct {
+	NodeId               NodeID   `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3,customtype=NodeID" json:"node_id"`
+	Limit                int32    `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NodeAuditRequest) Reset()         { *m = NodeAuditRequest{} }
+func (m *NodeAuditRequest) String() string { return proto.CompactTextString(m) }
+func (*NodeAuditRequest) ProtoMessage()    {}
+func (*NodeAuditRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{7}
+}
+func (m *NodeAuditRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NodeAuditRequest.Unmarshal(m, b)
+}
+func (m *NodeAuditRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NodeAuditRequest.Marshal(b, m, deterministic)
+}
+func (m *NodeAuditRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeAuditRequest.Merge(m, src)
+}
+func (m *NodeAuditRequest) XXX_Size() int {
+	return xxx_messageInfo_NodeAuditRequest.Size(m)
+}
+func (m *NodeAuditRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeAuditRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeAuditRequest proto.InternalMessageInfo
+
+func (m *NodeAuditRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type ObjectAuditRequest struct {
+	ProjectId            []byte   `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Bucket               []byte   `protobuf:"bytes,2,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	EncryptedPath        []byte   `protobuf:"bytes,3,opt,name=encrypted_path,json=encryptedPath,proto3" json:"encrypted_path,omitempty"`
+	Version              int64    `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ObjectAuditRequest) Reset()         { *m = ObjectAuditRequest{} }
+func (m *ObjectAuditRequest) String() string { return proto.CompactTextString(m) }
+func (*ObjectAuditRequest) ProtoMessage()    {}
+func (*ObjectAuditRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{8}
+}
+func (m *ObjectAuditRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ObjectAuditRequest.Unmarshal(m, b)
+}
+func (m *ObjectAuditRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ObjectAuditRequest.Marshal(b, m, deterministic)
+}
+func (m *ObjectAuditRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectAuditRequest.Merge(m, src)
+}
+func (m *ObjectAuditRequest) XXX_Size() int {
+	return xxx_messageInfo_ObjectAuditRequest.Size(m)
+}
+func (m *ObjectAuditRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectAuditRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectAuditRequest proto.InternalMessageInfo
+
+func (m *ObjectAuditRequest) GetProjectId() []byte {
+	if m != nil {
+		return m.ProjectId
+	}
+	return nil
+}
+
+func (m *ObjectAuditRequest) GetBucket() []byte {
+	if m != nil {
+		return m.Bucket
+	}
+	return nil
+}
+
+func (m *ObjectAuditRequest) GetEncryptedPath() []byte {
+	if m != nil {
+		return m.EncryptedPath
+	}
+	return nil
+}
+
+func (m *ObjectAuditRequest) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+type AuditRequestResponse struct {
+	RequestId            []byte   `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AuditRequestResponse) Reset()         { *m = AuditRequestResponse{} }
+func (m *AuditRequestResponse) String() string { return proto.CompactTextString(m) }
+func (*AuditRequestResponse) ProtoMessage()    {}
+func (*AuditRequestResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{9}
+}
+func (m *AuditRequestResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AuditRequestResponse.Unmarshal(m, b)
+}
+func (m *AuditRequestResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AuditRequestResponse.Marshal(b, m, deterministic)
+}
+func (m *AuditRequestResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuditRequestResponse.Merge(m, src)
+}
+func (m *AuditRequestResponse) XXX_Size() int {
+	return xxx_messageInfo_AuditRequestResponse.Size(m)
+}
+func (m *AuditRequestResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuditRequestResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuditRequestResponse proto.InternalMessageInfo
+
+func (m *AuditRequestResponse) GetRequestId() []byte {
+	if m != nil {
+		return m.RequestId
+	}
+	return nil
+}
+
+type AuditReportRequest struct {
+	RequestId            []byte   `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AuditReportRequest) Reset()         { *m = AuditReportRequest{} }
+func (m *AuditReportRequest) String() string { return proto.CompactTextString(m) }
+func (*AuditReportRequest) ProtoMessage()    {}
+func (*AuditReportRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{10}
+}
+func (m *AuditReportRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AuditReportRequest.Unmarshal(m, b)
+}
+func (m *AuditReportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AuditReportRequest.Marshal(b, m, deterministic)
+}
+func (m *AuditReportRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuditReportRequest.Merge(m, src)
+}
+func (m *AuditReportRequest) XXX_Size() int {
+	return xxx_messageInfo_AuditReportRequest.Size(m)
+}
+func (m *AuditReportRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuditReportRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuditReportRequest proto.InternalMessageInfo
+
+func (m *AuditReportRequest) GetRequestId() []byte {
+	if m != nil {
+		return m.RequestId
+	}
+	return nil
+}
+
+type AuditReportResponse struct {
+	Status               string         `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Error                string         `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	Results              []*AuditResult `protobuf:"bytes,3,rep,name=results,proto3" json:"results,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *AuditReportResponse) Reset()         { *m = AuditReportResponse{} }
+func (m *AuditReportResponse) String() string { return proto.CompactTextString(m) }
+func (*AuditReportResponse) ProtoMessage()    {}
+func (*AuditReportResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{11}
+}
+func (m *AuditReportResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AuditReportResponse.Unmarshal(m, b)
+}
+func (m *AuditReportResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AuditReportResponse.Marshal(b, m, deterministic)
+}
+func (m *AuditReportResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuditReportResponse.Merge(m, src)
+}
+func (m *AuditReportResponse) XXX_Size() int {
+	return xxx_messageInfo_AuditReportResponse.Size(m)
+}
+func (m *AuditReportResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuditReportResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuditReportResponse proto.InternalMessageInfo
+
+func (m *AuditReportResponse) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *AuditReportResponse) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *AuditReportResponse) GetResults() []*AuditResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+type AuditResult struct {
+	StreamId             []byte   `protobuf:"bytes,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	Position             uint64   `protobuf:"varint,2,opt,name=position,proto3" json:"position,omitempty"`
+	NodeId               NodeID   `protobuf:"bytes,3,opt,name=node_id,json=nodeId,proto3,customtype=NodeID" json:"node_id"`
+	Outcome              string   `protobuf:"bytes,4,opt,name=outcome,proto3" json:"outcome,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AuditResult) Reset()         { *m = AuditResult{} }
+func (m *AuditResult) String() string { return proto.CompactTextString(m) }
+func (*AuditResult) ProtoMessage()    {}
+func (*AuditResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{12}
+}
+func (m *AuditResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AuditResult.Unmarshal(m, b)
+}
+func (m *AuditResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AuditResult.Marshal(b, m, deterministic)
+}
+func (m *AuditResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuditResult.Merge(m, src)
+}
+func (m *AuditResult) XXX_Size() int {
+	return xxx_messageInfo_AuditResult.Size(m)
+}
+func (m *AuditResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuditResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuditResult proto.InternalMessageInfo
+
+func (m *AuditResult) GetStreamId() []byte {
+	if m != nil {
+		return m.StreamId
+	}
+	return nil
+}
+
+func (m *AuditResult) GetPosition() uint64 {
+	if m != nil {
+		return m.Position
+	}
+	return 0
+}
+
+func (m *AuditResult) GetOutcome() string {
+	if m != nil {
+		return m.Outcome
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*ObjectHealthRequest)(nil), "satellite.inspector.ObjectHealthRequest")
 	proto.RegisterType((*ObjectHealthResponse)(nil), "satellite.inspector.ObjectHealthResponse")
 	proto.RegisterType((*SegmentHealthRequest)(nil), "satellite.inspector.SegmentHealthRequest")
 	proto.RegisterType((*SegmentHealthResponse)(nil), "satellite.inspector.SegmentHealthResponse")
 	proto.RegisterType((*SegmentHealth)(nil), "satellite.inspector.SegmentHealth")
+	proto.RegisterType((*PieceAuditRequest)(nil), "satellite.inspector.PieceAuditRequest")
+	proto.RegisterType((*PieceAuditResponse)(nil), "satellite.inspector.PieceAuditResponse")
+	proto.RegisterType((*NodeAuditRequest)(nil), "satellite.inspector.NodeAuditRequest")
+	proto.RegisterType((*ObjectAuditRequest)(nil), "satellite.inspector.ObjectAuditRequest")
+	proto.RegisterType((*AuditRequestResponse)(nil), "satellite.inspector.AuditRequestResponse")
+	proto.RegisterType((*AuditReportRequest)(nil), "satellite.inspector.AuditReportRequest")
+	proto.RegisterType((*AuditReportResponse)(nil), "satellite.inspector.AuditReportResponse")
+	proto.RegisterType((*AuditResult)(nil), "satellite.inspector.AuditResult")
 }
 
 func init() { proto.RegisterFile("inspector.proto", fileDescriptor_a07d9034b2dd9d26) }
 
 var fileDescriptor_a07d9034b2dd9d26 = []byte{
-	// 524 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0x4d, 0x8f, 0xd3, 0x30,
-	0x10, 0xc5, 0xdb, 0x6d, 0x81, 0x69, 0x4a, 0xc1, 0x2d, 0x28, 0x2a, 0x42, 0x54, 0x59, 0xad, 0xd4,
-	0x65, 0x51, 0x2a, 0x95, 0x1b, 0x48, 0x48, 0x54, 0x1c, 0xe8, 0x05, 0x50, 0xf6, 0xc6, 0x25, 0x4a,
-	0xe2, 0x69, 0x93, 0x25, 0xb5, 0x83, 0xed, 0x4a, 0xf4, 0x5f, 0x20, 0x71, 0xe2, 0x7f, 0xf0, 0x6b,
-	0x38, 0x70, 0xe0, 0xc2, 0xdf, 0x40, 0x75, 0xdc, 0x6c, 0xbf, 0x0e, 0x95, 0xb8, 0x65, 0xe6, 0xbd,
-	0x79, 0x9e, 0xbc, 0xe7, 0x04, 0xda, 0x19, 0x57, 0x05, 0x26, 0x5a, 0x48, 0xbf, 0x90, 0x42, 0x0b,
-	0xda, 0x51, 0x91, 0xc6, 0x3c, 0xcf, 0x34, 0xfa, 0x15, 0xd4, 0x83, 0x99, 0x98, 0x89, 0x92, 0xd0,
-	0x6b, 0x17, 0x22, 0xe3, 0x1a, 0x25, 0x8b, 0xcb, 0x86, 0xf7, 0x97, 0x40, 0xe7, 0x43, 0x7c, 0x8d,
-	0x89, 0x7e, 0x87, 0x51, 0xae, 0xd3, 0x00, 0xbf, 0x2c, 0x50, 0x69, 0x7a, 0x0e, 0xf7, 0x90, 0x27,
-	0x72, 0x59, 0x68, 0x64, 0x61, 0x11, 0xe9, 0xd4, 0x25, 0x7d, 0x32, 0x70, 0x82, 0x56, 0xd5, 0xfd,
-	0x18, 0xe9, 0x94, 0x3e, 0x82, 0x46, 0xbc, 0x48, 0x3e, 0xa3, 0x76, 0x4f, 0x0c, 0x6c, 0x2b, 0xfa,
-	0x04, 0xa0, 0x90, 0x62, 0x25, 0x1b, 0x66, 0xcc, 0xad, 0x19, 0xec, 0xae, 0xed, 0x4c, 0x18, 0xf5,
-	0xa1, 0xa3, 0x74, 0x24, 0x75, 0x18, 0x4d, 0x35, 0xca, 0x50, 0xe1, 0x6c, 0x8e, 0x5c, 0xbb, 0xa7,
-	0x7d, 0x32, 0xa8, 0x05, 0x0f, 0x0c, 0xf4, 0x66, 0x85, 0x5c, 0x95, 0x00, 0x7d, 0x0e, 0x14, 0x39,
-	0x0b, 0x63, 0x9c, 0x0a, 0x89, 0x15, 0xbd, 0x6e, 0xe8, 0xf7, 0x91, 0xb3, 0xb1, 0x01, 0xd6, 0xec,
-	0x2e, 0xd4, 0xf3, 0x6c, 0x9e, 0x69, 0xb7, 0xd1, 0x27, 0x83, 0x7a, 0x50, 0x16, 0xde, 0x77, 0x02,
-	0xdd, 0xed, 0x37, 0x55, 0x85, 0xe0, 0x0a, 0xe9, 0x6b, 0xb8, 0x63, 0x15, 0x95, 0x4b, 0xfa, 0xb5,
-	0x41, 0x73, 0xe4, 0xf9, 0x07, 0x7c, 0xf4, 0xad, 0xbc, 0x9d, 0xae, 0x66, 0xe8, 0x2b, 0x00, 0x89,
-	0x6c, 0xc1, 0x59, 0xc4, 0x93, 0xa5, 0xf1, 0xa1, 0x39, 0x7a, 0xec, 0xdf, 0x18, 0x1d, 0x54, 0xe0,
-	0x55, 0x92, 0xe2, 0x1c, 0x83, 0x0d, 0xba, 0xf7, 0x83, 0x40, 0x77, 0x5b, 0xd8, 0x06, 0x70, 0xe3,
-	0x2c, 0xd9, 0x72, 0x76, 0x3f, 0x98, 0x93, 0x43, 0xc1, 0x9c, 0x41, 0xcb, 0x2e, 0x18, 0x66, 0x9c,
-	0xe1, 0x57, 0x93, 0x41, 0x2d, 0x70, 0x6c, 0x73, 0xb2, 0xea, 0xed, 0xa4, 0x74, 0xba, 0x93, 0x92,
-	0xf7, 0x8d, 0xc0, 0xc3, 0x9d, 0xdd, 0xac, 0x65, 0x2f, 0xa1, 0x91, 0x9a, 0x8e, 0x59, 0xee, 0x38,
-	0xc3, 0xec, 0xc4, 0xff, 0xd9, 0xf5, 0x93, 0x40, 0x6b, 0x4b, 0x96, 0x5e, 0x42, 0xb3, 0x14, 0x5e,
-	0x86, 0x19, 0x2b, 0x03, 0x74, 0xc6, 0xf0, 0xeb, 0xf7, 0xd3, 0xc6, 0x7b, 0xc1, 0x70, 0xf2, 0x36,
-	0x00, 0x0b, 0x4f, 0x98, 0xa2, 0x43, 0x68, 0x2d, 0xf8, 0x26, 0xfd, 0x64, 0x8f, 0xee, 0x54, 0x84,
-	0xd5, 0xc0, 0x25, 0x34, 0xc5, 0x74, 0x9a, 0x67, 0x1c, 0x0d, 0xbd, 0xb6, 0xaf, 0x6e, 0xe1, 0x15,
-	0xd9, 0x85, 0xdb, 0x9b, 0x37, 0xd9, 0x09, 0xd6, 0xe5, 0xe8, 0x0f, 0x81, 0x76, 0xb9, 0xef, 0x64,
-	0xed, 0x0e, 0x45, 0x70, 0x36, 0xaf, 0x23, 0x1d, 0x1c, 0xf4, 0xf0, 0xc0, 0xb7, 0xd9, 0xbb, 0x38,
-	0x82, 0x59, 0x06, 0xe5, 0xdd, 0xa2, 0xe9, 0xae, 0x61, 0x17, 0x47, 0x64, 0x65, 0x0f, 0x7a, 0x76,
-	0x0c, 0x75, 0x7d, 0xd2, 0xf8, 0xfc, 0xd3, 0x99, 0xd2, 0x42, 0x5e, 0xfb, 0x99, 0x18, 0x9a, 0x87,
-	0x61, 0x35, 0x3d, 0x34, 0xe1, 0xf2, 0x28, 0x2f, 0xe2, 0xb8, 0x61, 0x7e, 0x3c, 0x2f, 0xfe, 0x05,
-	0x00, 0x00, 0xff, 0xff, 0x03, 0xb4, 0x8a, 0x34, 0xbd, 0x04, 0x00, 0x00,
+	// 827 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xc1, 0x6e, 0xdb, 0x46,
+	0x10, 0x35, 0x4d, 0x5b, 0xb2, 0x86, 0xb2, 0x9d, 0xac, 0xd5, 0x80, 0x50, 0x10, 0x44, 0x60, 0xe0,
+	0x5a, 0x69, 0x0a, 0x19, 0x50, 0xd0, 0x4b, 0x5a, 0x14, 0x88, 0xd1, 0x43, 0x79, 0x69, 0x53, 0xe6,
+	0xd6, 0x0b, 0x41, 0x71, 0x47, 0x16, 0x53, 0x6a, 0x97, 0xdd, 0x5d, 0x16, 0xf5, 0xa9, 0xe7, 0x02,
+	0x3d, 0x14, 0xe8, 0xa9, 0xe8, 0x6f, 0xf4, 0x23, 0xfa, 0x0d, 0x3d, 0xe4, 0xd0, 0x4b, 0x7f, 0xa3,
+	0xe0, 0x72, 0x49, 0x51, 0x12, 0xed, 0x08, 0xe9, 0x4d, 0x33, 0xf3, 0x66, 0x76, 0xf6, 0xcd, 0xbc,
+	0xa5, 0xe0, 0x34, 0x61, 0x32, 0xc3, 0x58, 0x71, 0x31, 0xc9, 0x04, 0x57, 0x9c, 0x9c, 0xc9, 0x48,
+	0x61, 0x9a, 0x26, 0x0a, 0x27, 0x75, 0x68, 0x08, 0xd7, 0xfc, 0x9a, 0x97, 0x80, 0xe1, 0x69, 0xc6,
+	0x13, 0xa6, 0x50, 0xd0, 0x59, 0xe9, 0xf0, 0xfe, 0xb5, 0xe0, 0xec, 0xeb, 0xd9, 0x1b, 0x8c, 0xd5,
+	0x97, 0x18, 0xa5, 0x6a, 0x11, 0xe0, 0xf7, 0x39, 0x4a, 0x45, 0xce, 0xe1, 0x04, 0x59, 0x2c, 0x6e,
+	0x32, 0x85, 0x34, 0xcc, 0x22, 0xb5, 0x70, 0xad, 0x91, 0x35, 0xee, 0x07, 0xc7, 0xb5, 0xf7, 0x55,
+	0xa4, 0x16, 0xe4, 0x01, 0x74, 0x66, 0x79, 0xfc, 0x1d, 0x2a, 0x77, 0x5f, 0x87, 0x8d, 0x45, 0x1e,
+	0x01, 0x64, 0x82, 0x17, 0x65, 0xc3, 0x84, 0xba, 0xb6, 0x8e, 0xf5, 0x8c, 0xc7, 0xa7, 0x64, 0x02,
+	0x67, 0x52, 0x45, 0x42, 0x85, 0xd1, 0x5c, 0xa1, 0x08, 0x25, 0x5e, 0x2f, 0x91, 0x29, 0xf7, 0x60,
+	0x64, 0x8d, 0xed, 0xe0, 0xbe, 0x0e, 0xbd, 0x2c, 0x22, 0xaf, 0xcb, 0x00, 0xf9, 0x18, 0x08, 0x32,
+	0x1a, 0xce, 0x70, 0xce, 0x05, 0xd6, 0xf0, 0x43, 0x0d, 0xbf, 0x87, 0x8c, 0x5e, 0xe9, 0x40, 0x85,
+	0x1e, 0xc0, 0x61, 0x9a, 0x2c, 0x13, 0xe5, 0x76, 0x46, 0xd6, 0xf8, 0x30, 0x28, 0x0d, 0xef, 0x37,
+	0x0b, 0x06, 0xeb, 0x37, 0x95, 0x19, 0x67, 0x12, 0xc9, 0xe7, 0x70, 0x64, 0x2a, 0x4a, 0xd7, 0x1a,
+	0xd9, 0x63, 0x67, 0xea, 0x4d, 0x5a, 0x78, 0x9c, 0x98, 0xf2, 0x26, 0xbb, 0xce, 0x21, 0x9f, 0x02,
+	0x08, 0xa4, 0x39, 0xa3, 0x11, 0x8b, 0x6f, 0x34, 0x0f, 0xce, 0xf4, 0xe1, 0x64, 0x45, 0x74, 0x50,
+	0x07, 0x5f, 0xc7, 0x0b, 0x5c, 0x62, 0xd0, 0x80, 0x7b, 0xbf, 0x5b, 0x30, 0x58, 0x2f, 0x6c, 0x06,
+	0xb0, 0x62, 0xd6, 0x5a, 0x63, 0x76, 0x7b, 0x30, 0xfb, 0x6d, 0x83, 0x79, 0x02, 0xc7, 0xa6, 0xc1,
+	0x30, 0x61, 0x14, 0x7f, 0xd4, 0x33, 0xb0, 0x83, 0xbe, 0x71, 0xfa, 0x85, 0x6f, 0x63, 0x4a, 0x07,
+	0x1b, 0x53, 0xf2, 0x7e, 0xb5, 0xe0, 0x83, 0x8d, 0xde, 0x0c, 0x65, 0x2f, 0xa0, 0xb3, 0xd0, 0x1e,
+	0xdd, 0xdc, 0x6e, 0x84, 0x99, 0x8c, 0xff, 0x47, 0xd7, 0x9f, 0x16, 0x1c, 0xaf, 0x95, 0x25, 0xcf,
+	0xc0, 0x29, 0x0b, 0xdf, 0x84, 0x09, 0x2d, 0x07, 0xd8, 0xbf, 0x82, 0xbf, 0xdf, 0x3e, 0xee, 0x7c,
+	0xc5, 0x29, 0xfa, 0x5f, 0x04, 0x60, 0xc2, 0x3e, 0x95, 0xe4, 0x12, 0x8e, 0x73, 0xd6, 0x84, 0xef,
+	0x6f, 0xc1, 0xfb, 0x39, 0x6b, 0x24, 0x3c, 0x03, 0x87, 0xcf, 0xe7, 0x69, 0xc2, 0x50, 0xc3, 0xed,
+	0xed, 0xea, 0x26, 0x5c, 0x80, 0x5d, 0xe8, 0x36, 0x37, 0xb9, 0x1f, 0x54, 0xa6, 0xf7, 0x19, 0xdc,
+	0x7f, 0x95, 0x60, 0x8c, 0x2f, 0x73, 0x9a, 0xa8, 0x6a, 0xc2, 0x17, 0xd0, 0x65, 0x9c, 0x16, 0x85,
+	0xcb, 0x11, 0x5f, 0x9d, 0xfc, 0xf5, 0xf6, 0xf1, 0x5e, 0xa3, 0x76, 0xa7, 0x08, 0xfb, 0xd4, 0x1b,
+	0x00, 0x69, 0x66, 0x97, 0x33, 0xf0, 0xbe, 0x81, 0x7b, 0x05, 0xee, 0xbd, 0x4a, 0xae, 0x24, 0xb2,
+	0xdf, 0x94, 0xc8, 0x2f, 0x16, 0x90, 0x52, 0x22, 0x6b, 0x55, 0xd7, 0xd7, 0xc4, 0xda, 0x14, 0xf3,
+	0x6d, 0x6f, 0xc0, 0xf6, 0xa6, 0xda, 0x6d, 0x9b, 0xea, 0x42, 0xf7, 0x07, 0x14, 0x32, 0xe1, 0xcc,
+	0xe8, 0xbf, 0x32, 0xbd, 0x4f, 0x60, 0xd0, 0xec, 0xa3, 0xde, 0xbe, 0x47, 0xc5, 0x06, 0x69, 0x57,
+	0xa3, 0x1f, 0xe3, 0xf1, 0xa9, 0xf7, 0x1c, 0x88, 0x49, 0xcb, 0xb8, 0x68, 0x5e, 0xe2, 0xae, 0xa4,
+	0x9f, 0xe0, 0x6c, 0x2d, 0xc9, 0x1c, 0xf5, 0x00, 0x3a, 0x52, 0x45, 0x2a, 0x97, 0x3a, 0xa3, 0x17,
+	0x18, 0xab, 0xe0, 0x0f, 0x85, 0xe0, 0x42, 0x5f, 0xb9, 0x17, 0x94, 0x06, 0x79, 0x01, 0x5d, 0x81,
+	0x32, 0x4f, 0x55, 0xb9, 0x29, 0xce, 0x74, 0xd4, 0xaa, 0x8b, 0x6a, 0x8e, 0x79, 0xaa, 0x82, 0x2a,
+	0xc1, 0xfb, 0xd9, 0x02, 0xa7, 0x11, 0x20, 0x0f, 0xa1, 0x27, 0x95, 0xc0, 0x68, 0xb9, 0x6a, 0xf7,
+	0xa8, 0x74, 0xf8, 0x94, 0x0c, 0xe1, 0x28, 0xe3, 0x32, 0x51, 0x05, 0x69, 0x45, 0x07, 0x07, 0x41,
+	0x6d, 0x37, 0x77, 0xc0, 0xbe, 0x73, 0x07, 0x5c, 0xe8, 0xf2, 0x5c, 0xc5, 0x7c, 0x89, 0x9a, 0xf8,
+	0x5e, 0x50, 0x99, 0xd3, 0x7f, 0x2c, 0x38, 0x2d, 0xe5, 0xe5, 0x57, 0x4d, 0x13, 0x84, 0x7e, 0xf3,
+	0xf5, 0x24, 0xe3, 0xd6, 0xab, 0xb5, 0x7c, 0x4a, 0x86, 0x4f, 0x77, 0x40, 0x9a, 0x9d, 0xde, 0x23,
+	0x8b, 0x4d, 0x7d, 0x3f, 0xdd, 0xe1, 0x69, 0x31, 0x07, 0x7d, 0xb4, 0x0b, 0xb4, 0x3a, 0x69, 0xfa,
+	0x87, 0x0d, 0x27, 0x9a, 0xf0, 0xd5, 0x1d, 0x43, 0x80, 0x95, 0xd0, 0xc8, 0x87, 0xad, 0xe5, 0xb6,
+	0x74, 0x3c, 0xbc, 0x78, 0x27, 0xae, 0xbe, 0x5d, 0x08, 0xbd, 0x5a, 0xb3, 0xe4, 0xbc, 0x35, 0x6f,
+	0x53, 0xd3, 0xb7, 0xd0, 0xd7, 0x26, 0x0c, 0x6f, 0x8f, 0xc4, 0xe0, 0x34, 0x04, 0x4c, 0x2e, 0xee,
+	0xa0, 0xfe, 0xfd, 0x0f, 0x99, 0x81, 0xd3, 0xd0, 0xca, 0x2d, 0x87, 0x6c, 0x4b, 0x70, 0x38, 0x7e,
+	0x37, 0xb0, 0x3a, 0xe3, 0xea, 0xfc, 0xdb, 0x27, 0x52, 0x71, 0xf1, 0x66, 0x92, 0xf0, 0x4b, 0xfd,
+	0xe3, 0xb2, 0xce, 0xbd, 0xd4, 0x5f, 0x0a, 0x16, 0xa5, 0xd9, 0x6c, 0xd6, 0xd1, 0xff, 0x62, 0x9e,
+	0xff, 0x37, 0x00, 0x15, 0x69, 0xa6, 0x5e, 0x0a, 0x09, 0x00, 0x00,
 }
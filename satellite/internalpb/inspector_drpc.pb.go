@@ -153,3 +153,198 @@ func (x *drpcHealthInspector_SegmentHealthStream) SendAndClose(m *SegmentHealthR
 	}
 	return x.CloseSend()
 }
+
+type DRPCAuditInspectorClient interface {
+	DRPCConn() drpc.Conn
+
+	PieceAudit(ctx context.Context, in *PieceAuditRequest) (*PieceAuditResponse, error)
+	NodeAudit(ctx context.Context, in *NodeAuditRequest) (*AuditRequestResponse, error)
+	ObjectAudit(ctx context.Context, in *ObjectAuditRequest) (*AuditRequestResponse, error)
+	AuditReport(ctx context.Context, in *AuditReportRequest) (*AuditReportResponse, error)
+}
+
+type drpcAuditInspectorClient struct {
+	cc drpc.Conn
+}
+
+func NewDRPCAuditInspectorClient(cc drpc.Conn) DRPCAuditInspectorClient {
+	return &drpcAuditInspectorClient{cc}
+}
+
+func (c *drpcAuditInspectorClient) DRPCConn() drpc.Conn { return c.cc }
+
+func (c *drpcAuditInspectorClient) PieceAudit(ctx context.Context, in *PieceAuditRequest) (*PieceAuditResponse, error) {
+	out := new(PieceAuditResponse)
+	err := c.cc.Invoke(ctx, "/satellite.inspector.AuditInspector/PieceAudit", drpcEncoding_File_inspector_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcAuditInspectorClient) NodeAudit(ctx context.Context, in *NodeAuditRequest) (*AuditRequestResponse, error) {
+	out := new(AuditRequestResponse)
+	err := c.cc.Invoke(ctx, "/satellite.inspector.AuditInspector/NodeAudit", drpcEncoding_File_inspector_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcAuditInspectorClient) ObjectAudit(ctx context.Context, in *ObjectAuditRequest) (*AuditRequestResponse, error) {
+	out := new(AuditRequestResponse)
+	err := c.cc.Invoke(ctx, "/satellite.inspector.AuditInspector/ObjectAudit", drpcEncoding_File_inspector_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcAuditInspectorClient) AuditReport(ctx context.Context, in *AuditReportRequest) (*AuditReportResponse, error) {
+	out := new(AuditReportResponse)
+	err := c.cc.Invoke(ctx, "/satellite.inspector.AuditInspector/AuditReport", drpcEncoding_File_inspector_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+type DRPCAuditInspectorServer interface {
+	PieceAudit(context.Context, *PieceAuditRequest) (*PieceAuditResponse, error)
+	NodeAudit(context.Context, *NodeAuditRequest) (*AuditRequestResponse, error)
+	ObjectAudit(context.Context, *ObjectAuditRequest) (*AuditRequestResponse, error)
+	AuditReport(context.Context, *AuditReportRequest) (*AuditReportResponse, error)
+}
+
+type DRPCAuditInspectorUnimplementedServer struct{}
+
+func (s *DRPCAuditInspectorUnimplementedServer) PieceAudit(context.Context, *PieceAuditRequest) (*PieceAuditResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+func (s *DRPCAuditInspectorUnimplementedServer) NodeAudit(context.Context, *NodeAuditRequest) (*AuditRequestResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+func (s *DRPCAuditInspectorUnimplementedServer) ObjectAudit(context.Context, *ObjectAuditRequest) (*AuditRequestResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+func (s *DRPCAuditInspectorUnimplementedServer) AuditReport(context.Context, *AuditReportRequest) (*AuditReportResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+type DRPCAuditInspectorDescription struct{}
+
+func (DRPCAuditInspectorDescription) NumMethods() int { return 4 }
+
+func (DRPCAuditInspectorDescription) Method(n int) (string, drpc.Encoding, drpc.Receiver, interface{}, bool) {
+	switch n {
+	case 0:
+		return "/satellite.inspector.AuditInspector/PieceAudit", drpcEncoding_File_inspector_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCAuditInspectorServer).
+					PieceAudit(
+						ctx,
+						in1.(*PieceAuditRequest),
+					)
+			}, DRPCAuditInspectorServer.PieceAudit, true
+	case 1:
+		return "/satellite.inspector.AuditInspector/NodeAudit", drpcEncoding_File_inspector_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCAuditInspectorServer).
+					NodeAudit(
+						ctx,
+						in1.(*NodeAuditRequest),
+					)
+			}, DRPCAuditInspectorServer.NodeAudit, true
+	case 2:
+		return "/satellite.inspector.AuditInspector/ObjectAudit", drpcEncoding_File_inspector_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCAuditInspectorServer).
+					ObjectAudit(
+						ctx,
+						in1.(*ObjectAuditRequest),
+					)
+			}, DRPCAuditInspectorServer.ObjectAudit, true
+	case 3:
+		return "/satellite.inspector.AuditInspector/AuditReport", drpcEncoding_File_inspector_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCAuditInspectorServer).
+					AuditReport(
+						ctx,
+						in1.(*AuditReportRequest),
+					)
+			}, DRPCAuditInspectorServer.AuditReport, true
+	default:
+		return "", nil, nil, nil, false
+	}
+}
+
+func DRPCRegisterAuditInspector(mux drpc.Mux, impl DRPCAuditInspectorServer) error {
+	return mux.Register(impl, DRPCAuditInspectorDescription{})
+}
+
+type DRPCAuditInspector_PieceAuditStream interface {
+	drpc.Stream
+	SendAndClose(*PieceAuditResponse) error
+}
+
+type drpcAuditInspector_PieceAuditStream struct {
+	drpc.Stream
+}
+
+func (x *drpcAuditInspector_PieceAuditStream) SendAndClose(m *PieceAuditResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_inspector_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCAuditInspector_NodeAuditStream interface {
+	drpc.Stream
+	SendAndClose(*AuditRequestResponse) error
+}
+
+type drpcAuditInspector_NodeAuditStream struct {
+	drpc.Stream
+}
+
+func (x *drpcAuditInspector_NodeAuditStream) SendAndClose(m *AuditRequestResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_inspector_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCAuditInspector_ObjectAuditStream interface {
+	drpc.Stream
+	SendAndClose(*AuditRequestResponse) error
+}
+
+type drpcAuditInspector_ObjectAuditStream struct {
+	drpc.Stream
+}
+
+func (x *drpcAuditInspector_ObjectAuditStream) SendAndClose(m *AuditRequestResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_inspector_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCAuditInspector_AuditReportStream interface {
+	drpc.Stream
+	SendAndClose(*AuditReportResponse) error
+}
+
+type drpcAuditInspector_AuditReportStream struct {
+	drpc.Stream
+}
+
+func (x *drpcAuditInspector_AuditReportStream) SendAndClose(m *AuditReportResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_inspector_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
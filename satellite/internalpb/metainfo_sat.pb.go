@@ -36,9 +36,12 @@ type StreamID struct {
 	SatelliteSignature   []byte                   `protobuf:"bytes,9,opt,name=satellite_signature,json=satelliteSignature,proto3" json:"satellite_signature,omitempty"`
 	StreamId             []byte                   `protobuf:"bytes,10,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
 	Placement            int32                    `protobuf:"varint,13,opt,name=placement,proto3" json:"placement,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
-	XXX_unrecognized     []byte                   `json:"-"`
-	XXX_sizecache        int32                    `json:"-"`
+	// redundancy is the redundancy scheme of the segments, which is resolved
+	// once when the upload begins.
+	Redundancy           *pb.RedundancyScheme `protobuf:"bytes,14,opt,name=redundancy,proto3" json:"redundancy,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
 func (m *StreamID) Reset()         { *m = StreamID{} }
@@ -135,6 +138,13 @@ func (m *StreamID) GetPlacement() int32 {
 	return 0
 }
 
+func (m *StreamID) GetRedundancy() *pb.RedundancyScheme {
+	if m != nil {
+		return m.Redundancy
+	}
+	return nil
+}
+
 type SegmentID struct {
 	StreamId             *StreamID                 `protobuf:"bytes,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
 	PartNumber           int32                     `protobuf:"varint,2,opt,name=part_number,json=partNumber,proto3" json:"part_number,omitempty"`
@@ -222,39 +232,42 @@ func init() {
 func init() { proto.RegisterFile("metainfo_sat.proto", fileDescriptor_47c60bd892d94aaf) }
 
 var fileDescriptor_47c60bd892d94aaf = []byte{
-	// 544 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x52, 0x4d, 0x6f, 0xd3, 0x4c,
-	0x10, 0x7e, 0xfd, 0x46, 0xf9, 0xda, 0x24, 0x4d, 0xb5, 0x4d, 0x91, 0x15, 0x8a, 0x62, 0x15, 0x21,
-	0x85, 0x8b, 0x8d, 0xda, 0x13, 0x47, 0xa2, 0x70, 0x88, 0xf8, 0x68, 0x71, 0xe0, 0xc2, 0xc5, 0x5a,
-	0xdb, 0x53, 0x6b, 0x5b, 0x7b, 0xd7, 0xda, 0x9d, 0xa0, 0xe6, 0xc8, 0x3f, 0xe0, 0x67, 0xf1, 0x1b,
-	0x38, 0x94, 0x7f, 0xc1, 0x19, 0x79, 0xfd, 0x91, 0x48, 0xb4, 0x07, 0xb8, 0xcd, 0x3c, 0xf3, 0xec,
-	0x33, 0x3b, 0xcf, 0x0c, 0xa1, 0x19, 0x20, 0xe3, 0xe2, 0x4a, 0x06, 0x9a, 0xa1, 0x9b, 0x2b, 0x89,
-	0x92, 0x52, 0xcd, 0x10, 0xd2, 0x94, 0x23, 0xb8, 0x75, 0x75, 0x7a, 0x08, 0x22, 0x52, 0xdb, 0x1c,
-	0xb9, 0x14, 0x25, 0x6b, 0x4a, 0x12, 0x99, 0xc8, 0x2a, 0x9e, 0x25, 0x52, 0x26, 0x29, 0x78, 0x26,
-	0x0b, 0x37, 0x57, 0x1e, 0xf2, 0x0c, 0x34, 0xb2, 0x2c, 0xaf, 0x08, 0x07, 0xb5, 0x50, 0x99, 0x9f,
-	0xfe, 0x6a, 0x91, 0xde, 0x1a, 0x15, 0xb0, 0x6c, 0xb5, 0xa4, 0x8f, 0x48, 0x27, 0xdc, 0x44, 0x37,
-	0x80, 0xb6, 0xe5, 0x58, 0xf3, 0xa1, 0x5f, 0x65, 0xf4, 0x05, 0x99, 0x54, 0x5d, 0x21, 0x0e, 0x64,
-	0x78, 0x0d, 0x11, 0x06, 0x37, 0xb0, 0xb5, 0xff, 0x37, 0x2c, 0xda, 0xd4, 0x2e, 0x4c, 0xe9, 0x0d,
-	0x6c, 0xa9, 0x4d, 0xba, 0x5f, 0x40, 0x69, 0x2e, 0x85, 0xdd, 0x72, 0xac, 0x79, 0xdb, 0xaf, 0x53,
-	0xfa, 0x89, 0x1c, 0xef, 0x26, 0x08, 0x72, 0xa6, 0x58, 0x06, 0x08, 0x4a, 0xdb, 0x43, 0xc7, 0x9a,
-	0x0f, 0xce, 0x1c, 0x77, 0x6f, 0xbe, 0xd7, 0x4d, 0x78, 0xd9, 0xf0, 0xfc, 0x09, 0xdc, 0x83, 0xd2,
-	0x15, 0x19, 0x45, 0x0a, 0x98, 0x11, 0x8d, 0x19, 0x82, 0xdd, 0x36, 0x72, 0x53, 0xb7, 0x34, 0xc4,
-	0xad, 0x0d, 0x71, 0x3f, 0xd6, 0x86, 0x2c, 0x7a, 0xdf, 0xef, 0x66, 0xff, 0x7d, 0xfb, 0x39, 0xb3,
-	0xfc, 0x61, 0xfd, 0x74, 0xc9, 0x10, 0xe8, 0x3b, 0x32, 0x86, 0xdb, 0x9c, 0xab, 0x3d, 0xb1, 0xce,
-	0x5f, 0x88, 0x1d, 0xec, 0x1e, 0x1b, 0xb9, 0xe7, 0xe4, 0x30, 0xdb, 0xa4, 0xc8, 0x73, 0xa6, 0xb0,
-	0x32, 0xcf, 0x1e, 0x38, 0xd6, 0xbc, 0xe7, 0x8f, 0x1b, 0xbc, 0x34, 0x8e, 0x7a, 0xe4, 0xa8, 0xd9,
-	0x78, 0xa0, 0x79, 0x22, 0x18, 0x6e, 0x14, 0xd8, 0xfd, 0xd2, 0xe6, 0xa6, 0xb4, 0xae, 0x2b, 0xf4,
-	0x31, 0xe9, 0x6b, 0xb3, 0xbc, 0x80, 0xc7, 0x36, 0x31, 0xb4, 0x5e, 0x09, 0xac, 0x62, 0x7a, 0x42,
-	0xfa, 0x79, 0xca, 0x22, 0xc8, 0x40, 0xa0, 0x3d, 0x32, 0x5b, 0xd8, 0x01, 0xa7, 0x5f, 0x5b, 0xa4,
-	0xbf, 0x86, 0xa4, 0x88, 0x57, 0x4b, 0xfa, 0x72, 0x5f, 0xc8, 0x32, 0xd3, 0x9e, 0xb8, 0x7f, 0x5e,
-	0x9f, 0x5b, 0x9f, 0xca, 0x5e, 0x9b, 0x19, 0x19, 0x98, 0xd1, 0xc4, 0x26, 0x0b, 0x41, 0x99, 0x9b,
-	0x68, 0xfb, 0xa4, 0x80, 0xde, 0x1b, 0x84, 0x4e, 0x48, 0x9b, 0x8b, 0x18, 0x6e, 0xab, 0x4b, 0x28,
-	0x13, 0x7a, 0x4e, 0x46, 0x4a, 0x4a, 0x0c, 0x72, 0x0e, 0x11, 0x14, 0x5d, 0x8b, 0x85, 0x0d, 0x17,
-	0xe3, 0xc2, 0xc7, 0x1f, 0x77, 0xb3, 0xee, 0x65, 0x81, 0xaf, 0x96, 0xfe, 0xa0, 0x60, 0x95, 0x49,
-	0x4c, 0x3f, 0x90, 0x63, 0xa9, 0x78, 0xc2, 0x05, 0x4b, 0x03, 0xa9, 0x62, 0x50, 0x41, 0xca, 0x33,
-	0x8e, 0xda, 0xee, 0x38, 0xad, 0xf9, 0xe0, 0xec, 0xc9, 0xee, 0xa3, 0xaf, 0xe2, 0x58, 0x81, 0xd6,
-	0x10, 0x5f, 0x14, 0xb4, 0xb7, 0x05, 0xcb, 0x3f, 0xaa, 0xdf, 0xee, 0xb0, 0x7b, 0x0e, 0xa7, 0xfb,
-	0xcf, 0x87, 0xf3, 0xc0, 0xfa, 0x7a, 0x0f, 0xad, 0x6f, 0xf1, 0xec, 0xf3, 0x53, 0x8d, 0x52, 0x5d,
-	0xbb, 0x5c, 0x7a, 0x26, 0xf0, 0x1a, 0x92, 0xc7, 0x05, 0x82, 0x12, 0x2c, 0xcd, 0xc3, 0xb0, 0x63,
-	0xfe, 0x70, 0xfe, 0x3b, 0x00, 0x00, 0xff, 0xff, 0xbf, 0x1c, 0x56, 0xf6, 0x23, 0x04, 0x00, 0x00,
+	// 578 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x52, 0xcd, 0x6e, 0xd3, 0x4c,
+	0x14, 0xfd, 0xfc, 0x95, 0xa4, 0xc9, 0x24, 0x4d, 0xaa, 0x69, 0x8a, 0x46, 0x69, 0x51, 0xac, 0x22,
+	0xa4, 0xb0, 0xb1, 0x51, 0xbb, 0x42, 0xac, 0x88, 0xc2, 0x22, 0xe2, 0xa7, 0xc5, 0x81, 0x0d, 0x1b,
+	0x6b, 0xec, 0xb9, 0x35, 0xd3, 0xda, 0x33, 0xd6, 0x78, 0x8c, 0x9a, 0x25, 0x6f, 0xc0, 0xbb, 0xf0,
+	0x12, 0x3c, 0x03, 0x8b, 0xf2, 0x2a, 0xc8, 0xe3, 0xbf, 0x48, 0xb4, 0x0b, 0xd8, 0xcd, 0x3d, 0xf7,
+	0xcc, 0x99, 0x3b, 0xf7, 0x1c, 0x84, 0x13, 0xd0, 0x94, 0x8b, 0x4b, 0xe9, 0x67, 0x54, 0x3b, 0xa9,
+	0x92, 0x5a, 0x62, 0x9c, 0x51, 0x0d, 0x71, 0xcc, 0x35, 0x38, 0x75, 0x77, 0xba, 0x0f, 0x22, 0x54,
+	0x9b, 0x54, 0x73, 0x29, 0x4a, 0xd6, 0x14, 0x45, 0x32, 0x92, 0xd5, 0x79, 0x16, 0x49, 0x19, 0xc5,
+	0xe0, 0x9a, 0x2a, 0xc8, 0x2f, 0x5d, 0xcd, 0x13, 0xc8, 0x34, 0x4d, 0xd2, 0x8a, 0x30, 0xaa, 0x85,
+	0xaa, 0x7a, 0x9c, 0x4a, 0x2e, 0x34, 0x28, 0x16, 0x94, 0xc0, 0xc9, 0xf7, 0x07, 0xa8, 0xb7, 0xd6,
+	0x0a, 0x68, 0xb2, 0x5a, 0xe2, 0x87, 0xa8, 0x1b, 0xe4, 0xe1, 0x35, 0x68, 0x62, 0xd9, 0xd6, 0x7c,
+	0xe8, 0x55, 0x15, 0x7e, 0x86, 0x26, 0xd5, 0x18, 0xc0, 0x7c, 0x19, 0x5c, 0x41, 0xa8, 0xfd, 0x6b,
+	0xd8, 0x90, 0xff, 0x0d, 0x0b, 0x37, 0xbd, 0x73, 0xd3, 0x7a, 0x0d, 0x1b, 0x4c, 0xd0, 0xee, 0x17,
+	0x50, 0x19, 0x97, 0x82, 0xec, 0xd8, 0xd6, 0xbc, 0xe3, 0xd5, 0x25, 0xfe, 0x88, 0x0e, 0xdb, 0x2f,
+	0xf9, 0x29, 0x55, 0x34, 0x01, 0x0d, 0x2a, 0x23, 0x43, 0xdb, 0x9a, 0x0f, 0x4e, 0x6d, 0x67, 0xeb,
+	0xc3, 0xaf, 0x9a, 0xe3, 0x45, 0xc3, 0xf3, 0x26, 0x70, 0x07, 0x8a, 0x57, 0x68, 0x2f, 0x54, 0x40,
+	0x8d, 0x28, 0xa3, 0x1a, 0x48, 0xc7, 0xc8, 0x4d, 0x9d, 0x72, 0x43, 0x4e, 0xbd, 0x21, 0xe7, 0x43,
+	0xbd, 0xa1, 0x45, 0xef, 0xc7, 0xed, 0xec, 0xbf, 0x6f, 0xbf, 0x66, 0x96, 0x37, 0xac, 0xaf, 0x2e,
+	0xa9, 0x06, 0xfc, 0x16, 0x8d, 0xe1, 0x26, 0xe5, 0x6a, 0x4b, 0xac, 0xfb, 0x17, 0x62, 0xa3, 0xf6,
+	0xb2, 0x91, 0x7b, 0x8a, 0xf6, 0x93, 0x3c, 0xd6, 0x3c, 0xa5, 0x4a, 0x57, 0xcb, 0x23, 0x03, 0xdb,
+	0x9a, 0xf7, 0xbc, 0x71, 0x83, 0x97, 0x8b, 0xc3, 0x2e, 0x3a, 0x68, 0x22, 0xe0, 0x67, 0x3c, 0x12,
+	0x54, 0xe7, 0x0a, 0x48, 0xbf, 0x5c, 0x73, 0xd3, 0x5a, 0xd7, 0x1d, 0x7c, 0x84, 0xfa, 0x99, 0x31,
+	0xcf, 0xe7, 0x8c, 0x20, 0x43, 0xeb, 0x95, 0xc0, 0x8a, 0xe1, 0x63, 0xd4, 0x4f, 0x63, 0x1a, 0x42,
+	0x02, 0x42, 0x93, 0x3d, 0xe3, 0x42, 0x0b, 0xe0, 0x17, 0x08, 0x29, 0x60, 0xb9, 0x60, 0x54, 0x84,
+	0x1b, 0x32, 0x32, 0x1f, 0x3c, 0x72, 0xda, 0x78, 0x78, 0x4d, 0x73, 0x1d, 0x7e, 0x86, 0x04, 0xbc,
+	0x2d, 0xfa, 0xc9, 0xd7, 0x1d, 0xd4, 0x5f, 0x43, 0x54, 0x08, 0xad, 0x96, 0xf8, 0xf9, 0xf6, 0x14,
+	0x96, 0x51, 0x3a, 0x76, 0xfe, 0xcc, 0xb2, 0x53, 0xe7, 0x6c, 0x6b, 0xc6, 0x19, 0x1a, 0x98, 0xbd,
+	0x88, 0x3c, 0x09, 0x40, 0x99, 0x40, 0x75, 0x3c, 0x54, 0x40, 0xef, 0x0c, 0x82, 0x27, 0xa8, 0xc3,
+	0x05, 0x83, 0x9b, 0x2a, 0x46, 0x65, 0x81, 0xcf, 0xd0, 0x9e, 0x92, 0x52, 0xfb, 0x29, 0x87, 0x10,
+	0x8a, 0x57, 0x0b, 0xb7, 0x87, 0x8b, 0x71, 0x61, 0xc2, 0xcf, 0xdb, 0xd9, 0xee, 0x45, 0x81, 0xaf,
+	0x96, 0xde, 0xa0, 0x60, 0x95, 0x05, 0xc3, 0xef, 0xd1, 0xa1, 0x54, 0x3c, 0xe2, 0x82, 0xc6, 0xbe,
+	0x54, 0x0c, 0x94, 0x1f, 0xf3, 0x84, 0xeb, 0x8c, 0x74, 0xed, 0x9d, 0xf9, 0xe0, 0xf4, 0x51, 0x3b,
+	0xe8, 0x4b, 0xc6, 0x14, 0x64, 0x19, 0xb0, 0xf3, 0x82, 0xf6, 0xa6, 0x60, 0x79, 0x07, 0xf5, 0xdd,
+	0x16, 0xbb, 0x23, 0x75, 0xbb, 0xff, 0x9c, 0xba, 0x7b, 0xbc, 0xef, 0xdd, 0xe7, 0xfd, 0xe2, 0xc9,
+	0xa7, 0xc7, 0x99, 0x96, 0xea, 0xca, 0xe1, 0xd2, 0x35, 0x07, 0xb7, 0x21, 0xb9, 0xc6, 0x44, 0x41,
+	0xe3, 0x34, 0x08, 0xba, 0x66, 0x86, 0xb3, 0xdf, 0x03, 0x00, 0xa7, 0x42, 0x8c, 0x5d, 0x71, 0x04,
+	0x00, 0x00,
 }
@@ -0,0 +1,204 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase
+
+import (
+	"context"
+	"time"
+
+	"storj.io/common/uuid"
+	"storj.io/private/dbutil/pgutil"
+	"storj.io/private/tagsql"
+)
+
+// BucketEventType is the type of an event recorded for an object of a bucket.
+type BucketEventType string
+
+const (
+	// BucketEventObjectCommitted is recorded when an object is committed.
+	BucketEventObjectCommitted = BucketEventType("object:committed")
+	// BucketEventObjectDeleted is recorded when an object is deleted or a delete marker is created.
+	BucketEventObjectDeleted = BucketEventType("object:deleted")
+	// BucketEventObjectMoved is recorded when an object is moved to a new key or bucket.
+	BucketEventObjectMoved = BucketEventType("object:moved")
+)
+
+// BucketEvent is an event of an object, which is recorded in the bucket event outbox
+// in the same transaction as the change of the object.
+type BucketEvent struct {
+	ID   uuid.UUID
+	Type BucketEventType
+
+	ObjectLocation
+	Version      Version
+	DeleteMarker bool
+
+	// NewBucketName and NewObjectKey are the destination of a moved object.
+	NewBucketName string
+	NewObjectKey  ObjectKey
+
+	CreatedAt time.Time
+}
+
+// SetBucketEventsEnabled contains arguments necessary for enabling or disabling
+// recording the events of a bucket.
+type SetBucketEventsEnabled struct {
+	BucketLocation
+	Enabled bool
+}
+
+// SetBucketEventsEnabled enables or disables recording the events of the objects of a bucket.
+// Events are recorded only for buckets with notifications, so that other buckets don't fill
+// the outbox.
+func (db *DB) SetBucketEventsEnabled(ctx context.Context, opts SetBucketEventsEnabled) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := opts.Verify(); err != nil {
+		return err
+	}
+
+	if opts.Enabled {
+		_, err = db.db.ExecContext(ctx, `
+			INSERT INTO notified_buckets (project_id, bucket_name) VALUES ($1, $2)
+			ON CONFLICT (project_id, bucket_name) DO NOTHING
+		`, opts.ProjectID, []byte(opts.BucketName))
+	} else {
+		_, err = db.db.ExecContext(ctx, `
+			DELETE FROM notified_buckets WHERE project_id = $1 AND bucket_name = $2
+		`, opts.ProjectID, []byte(opts.BucketName))
+	}
+	if err != nil {
+		return Error.New("unable to update notified bucket: %w", err)
+	}
+	return nil
+}
+
+// recordBucketEvents adds the events to the outbox in the transaction, unless
+// recording the events of their bucket isn't enabled.
+func (db *DB) recordBucketEvents(ctx context.Context, tx tagsql.Tx, events ...BucketEvent) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	for _, event := range events {
+		id, err := uuid.New()
+		if err != nil {
+			return Error.New("unable to create event id: %w", err)
+		}
+
+		var newBucketName, newObjectKey []byte
+		if event.Type == BucketEventObjectMoved {
+			newBucketName, newObjectKey = []byte(event.NewBucketName), []byte(event.NewObjectKey)
+		}
+
+		_, err = tx.ExecContext(ctx, `
+			INSERT INTO bucket_events (
+				id, project_id, bucket_name, event_type,
+				object_key, version, delete_marker,
+				new_bucket_name, new_object_key
+			)
+			SELECT
+				$1::BYTEA, $2::BYTEA, $3::BYTEA, $4::TEXT,
+				$5::BYTEA, $6::INT8, $7::BOOLEAN,
+				$8::BYTEA, $9::BYTEA
+			WHERE EXISTS (
+				SELECT 1 FROM notified_buckets
+				WHERE project_id = $2 AND bucket_name = $3
+			)
+		`, id, event.ProjectID, []byte(event.BucketName), string(event.Type),
+			[]byte(event.ObjectKey), event.Version, event.DeleteMarker,
+			newBucketName, newObjectKey)
+		if err != nil {
+			return Error.New("unable to record bucket event: %w", err)
+		}
+	}
+	return nil
+}
+
+// deletedObjectEvents returns the events of the deleted objects. Pending objects
+// were never visible, so deleting them has no event.
+func deletedObjectEvents(objects []Object) []BucketEvent {
+	events := make([]BucketEvent, 0, len(objects))
+	for _, object := range objects {
+		if object.Status == Pending {
+			continue
+		}
+		events = append(events, BucketEvent{
+			Type:           BucketEventObjectDeleted,
+			ObjectLocation: object.Location(),
+			Version:        object.Version,
+			DeleteMarker:   object.Status == DeleteMarker,
+		})
+	}
+	return events
+}
+
+// ListBucketEvents contains arguments necessary for listing the bucket event outbox.
+type ListBucketEvents struct {
+	Limit int
+}
+
+// ListBucketEvents returns the oldest events of the outbox.
+func (db *DB) ListBucketEvents(ctx context.Context, opts ListBucketEvents) (events []BucketEvent, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if opts.Limit <= 0 {
+		return nil, ErrInvalidRequest.New("Invalid limit: %d", opts.Limit)
+	}
+
+	err = withRows(db.db.QueryContext(ctx, `
+		SELECT
+			id, event_type,
+			project_id, bucket_name, object_key, version, delete_marker,
+			new_bucket_name, new_object_key,
+			created_at
+		FROM bucket_events
+		ORDER BY created_at ASC
+		LIMIT $1
+	`, opts.Limit))(func(rows tagsql.Rows) error {
+		for rows.Next() {
+			var event BucketEvent
+			var eventType string
+			var newBucketName, newObjectKey []byte
+			err := rows.Scan(
+				&event.ID, &eventType,
+				&event.ProjectID, &event.BucketName, &event.ObjectKey, &event.Version, &event.DeleteMarker,
+				&newBucketName, &newObjectKey,
+				&event.CreatedAt,
+			)
+			if err != nil {
+				return err
+			}
+			event.Type = BucketEventType(eventType)
+			event.NewBucketName = string(newBucketName)
+			event.NewObjectKey = ObjectKey(newObjectKey)
+			events = append(events, event)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, Error.New("unable to list bucket events: %w", err)
+	}
+	return events, nil
+}
+
+// DeleteBucketEvents contains arguments necessary for removing events from the outbox.
+type DeleteBucketEvents struct {
+	IDs []uuid.UUID
+}
+
+// DeleteBucketEvents removes the events from the outbox.
+func (db *DB) DeleteBucketEvents(ctx context.Context, opts DeleteBucketEvents) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(opts.IDs) == 0 {
+		return nil
+	}
+
+	_, err = db.db.ExecContext(ctx, `
+		DELETE FROM bucket_events WHERE id = ANY($1)
+	`, pgutil.UUIDArray(opts.IDs))
+	if err != nil {
+		return Error.New("unable to delete bucket events: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,198 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/metabasetest"
+)
+
+func TestBucketEvents(t *testing.T) {
+	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		listEvents := func(t *testing.T) []metabase.BucketEvent {
+			events, err := db.ListBucketEvents(ctx, metabase.ListBucketEvents{Limit: 100})
+			require.NoError(t, err)
+			return events
+		}
+
+		t.Run("Invalid limit", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			_, err := db.ListBucketEvents(ctx, metabase.ListBucketEvents{})
+			require.True(t, metabase.ErrInvalidRequest.Has(err))
+		})
+
+		t.Run("Not recorded without notifications", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			obj := metabasetest.RandObjectStream()
+			metabasetest.CreateObject(ctx, t, db, obj, 1)
+
+			_, err := db.DeleteObjectExactVersion(ctx, metabase.DeleteObjectExactVersion{
+				ObjectLocation: obj.Location(),
+				Version:        obj.Version,
+			})
+			require.NoError(t, err)
+
+			require.Empty(t, listEvents(t))
+		})
+
+		t.Run("Commit, delete marker and delete", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			obj := metabasetest.RandObjectStream()
+			require.NoError(t, db.SetBucketEventsEnabled(ctx, metabase.SetBucketEventsEnabled{
+				BucketLocation: obj.Location().Bucket(),
+				Enabled:        true,
+			}))
+
+			metabasetest.CreateObject(ctx, t, db, obj, 1)
+
+			marker, err := db.CreateDeleteMarker(ctx, metabase.CreateDeleteMarker{
+				ObjectLocation: obj.Location(),
+				Versioned:      true,
+			})
+			require.NoError(t, err)
+
+			_, err = db.DeleteObjectExactVersion(ctx, metabase.DeleteObjectExactVersion{
+				ObjectLocation: obj.Location(),
+				Version:        obj.Version,
+			})
+			require.NoError(t, err)
+
+			events := listEvents(t)
+			require.Len(t, events, 3)
+			for _, event := range events {
+				require.False(t, event.ID.IsZero())
+				require.Equal(t, obj.Location(), event.ObjectLocation)
+				require.False(t, event.CreatedAt.IsZero())
+			}
+			require.Equal(t, metabase.BucketEventObjectCommitted, events[0].Type)
+			require.Equal(t, obj.Version, events[0].Version)
+			require.Equal(t, metabase.BucketEventObjectDeleted, events[1].Type)
+			require.Equal(t, marker.Version, events[1].Version)
+			require.True(t, events[1].DeleteMarker)
+			require.Equal(t, metabase.BucketEventObjectDeleted, events[2].Type)
+			require.Equal(t, obj.Version, events[2].Version)
+			require.False(t, events[2].DeleteMarker)
+
+			require.NoError(t, db.DeleteBucketEvents(ctx, metabase.DeleteBucketEvents{
+				IDs: []uuid.UUID{events[0].ID, events[1].ID},
+			}))
+			require.Equal(t, events[2:], listEvents(t))
+		})
+
+		t.Run("Delete paths", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			obj := metabasetest.RandObjectStream()
+			require.NoError(t, db.SetBucketEventsEnabled(ctx, metabase.SetBucketEventsEnabled{
+				BucketLocation: obj.Location().Bucket(),
+				Enabled:        true,
+			}))
+
+			event := func(eventType metabase.BucketEventType, object metabase.Object) metabase.BucketEvent {
+				return metabase.BucketEvent{
+					Type:           eventType,
+					ObjectLocation: object.Location(),
+					Version:        object.Version,
+				}
+			}
+			checkEvents := func(t *testing.T, object metabase.Object) {
+				metabasetest.ListBucketEvents{
+					Opts: metabase.ListBucketEvents{Limit: 100},
+					Result: []metabase.BucketEvent{
+						event(metabase.BucketEventObjectCommitted, object),
+						event(metabase.BucketEventObjectDeleted, object),
+					},
+				}.Check(ctx, t, db)
+				require.NoError(t, db.TestingDeleteAll(ctx))
+				require.NoError(t, db.SetBucketEventsEnabled(ctx, metabase.SetBucketEventsEnabled{
+					BucketLocation: obj.Location().Bucket(),
+					Enabled:        true,
+				}))
+			}
+
+			t.Run("latest version", func(t *testing.T) {
+				object := metabasetest.CreateObject(ctx, t, db, obj, 1)
+				_, err := db.DeleteObjectLatestVersion(ctx, metabase.DeleteObjectLatestVersion{
+					ObjectLocation: obj.Location(),
+				})
+				require.NoError(t, err)
+				checkEvents(t, object)
+			})
+
+			t.Run("any status all versions", func(t *testing.T) {
+				object := metabasetest.CreateObject(ctx, t, db, obj, 1)
+				_, err := db.DeleteObjectAnyStatusAllVersions(ctx, metabase.DeleteObjectAnyStatusAllVersions{
+					ObjectLocation: obj.Location(),
+				})
+				require.NoError(t, err)
+				checkEvents(t, object)
+			})
+
+			t.Run("bucket objects", func(t *testing.T) {
+				object := metabasetest.CreateObject(ctx, t, db, obj, 1)
+				metabasetest.DeleteBucketObjects{
+					Opts: metabase.DeleteBucketObjects{
+						Bucket: obj.Location().Bucket(),
+					},
+					Deleted: 1,
+				}.Check(ctx, t, db)
+				checkEvents(t, object)
+			})
+
+			t.Run("expired objects", func(t *testing.T) {
+				object := metabasetest.CreateExpiredObject(ctx, t, db, obj, 1, time.Now().Add(-time.Hour))
+				metabasetest.DeleteExpiredObjects{
+					Opts: metabase.DeleteExpiredObjects{
+						ExpiredBefore: time.Now(),
+					},
+				}.Check(ctx, t, db)
+				checkEvents(t, object)
+			})
+		})
+
+		t.Run("Not recorded after disabling", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			obj := metabasetest.RandObjectStream()
+			for _, enabled := range []bool{true, false} {
+				require.NoError(t, db.SetBucketEventsEnabled(ctx, metabase.SetBucketEventsEnabled{
+					BucketLocation: obj.Location().Bucket(),
+					Enabled:        enabled,
+				}))
+			}
+
+			metabasetest.CreateObject(ctx, t, db, obj, 1)
+
+			require.Empty(t, listEvents(t))
+		})
+
+		t.Run("Not recorded for failed changes", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			obj := metabasetest.RandObjectStream()
+			require.NoError(t, db.SetBucketEventsEnabled(ctx, metabase.SetBucketEventsEnabled{
+				BucketLocation: obj.Location().Bucket(),
+				Enabled:        true,
+			}))
+
+			_, err := db.DeleteObjectExactVersion(ctx, metabase.DeleteObjectExactVersion{
+				ObjectLocation: obj.Location(),
+				Version:        obj.Version,
+			})
+			require.Error(t, err)
+
+			require.Empty(t, listEvents(t))
+		})
+	})
+}
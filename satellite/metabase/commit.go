@@ -43,6 +43,10 @@ type BeginObjectNextVersion struct {
 	EncryptedMetadataEncryptedKey []byte // optional
 
 	Encryption storj.EncryptionParameters
+
+	// Versioned marks the version as created while versioning was enabled for the bucket.
+	// Other versions are null versions, which are replaced while versioning is suspended.
+	Versioned bool
 }
 
 // Verify verifies get object request fields.
@@ -81,7 +85,8 @@ func (db *DB) BeginObjectNextVersion(ctx context.Context, opts BeginObjectNextVe
 			project_id, bucket_name, object_key, version, stream_id,
 			expires_at, encryption,
 			zombie_deletion_deadline,
-			encrypted_metadata, encrypted_metadata_nonce, encrypted_metadata_encrypted_key
+			encrypted_metadata, encrypted_metadata_nonce, encrypted_metadata_encrypted_key,
+			versioned
 		) VALUES (
 			$1, $2, $3,
 				coalesce((
@@ -93,12 +98,14 @@ func (db *DB) BeginObjectNextVersion(ctx context.Context, opts BeginObjectNextVe
 				), 1),
 			$4, $5, $6,
 			$7,
-			$8, $9, $10)
+			$8, $9, $10,
+			$11)
 		RETURNING version
 	`, opts.ProjectID, []byte(opts.BucketName), opts.ObjectKey, opts.StreamID,
 		opts.ExpiresAt, encryptionParameters{&opts.Encryption},
 		opts.ZombieDeletionDeadline,
 		opts.EncryptedMetadata, opts.EncryptedMetadataNonce, opts.EncryptedMetadataEncryptedKey,
+		opts.Versioned,
 	)
 
 	var v int64
@@ -607,7 +614,12 @@ func (db *DB) CommitObject(ctx context.Context, opts CommitObject) (object Objec
 		object.TotalPlainSize = totalPlainSize
 		object.TotalEncryptedSize = totalEncryptedSize
 		object.FixedSegmentSize = fixedSegmentSize
-		return nil
+
+		return db.recordBucketEvents(ctx, tx, BucketEvent{
+			Type:           BucketEventObjectCommitted,
+			ObjectLocation: object.Location(),
+			Version:        object.Version,
+		})
 	})
 	if err != nil {
 		return Object{}, err
@@ -141,7 +141,12 @@ func (db *DB) CommitObjectWithSegments(ctx context.Context, opts CommitObjectWit
 		object.TotalPlainSize = totalPlainSize
 		object.TotalEncryptedSize = totalEncryptedSize
 		object.FixedSegmentSize = fixedSegmentSize
-		return nil
+
+		return db.recordBucketEvents(ctx, tx, BucketEvent{
+			Type:           BucketEventObjectCommitted,
+			ObjectLocation: object.Location(),
+			Version:        object.Version,
+		})
 	})
 	if err != nil {
 		return Object{}, nil, err
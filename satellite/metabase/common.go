@@ -32,6 +32,9 @@ const ListLimit = intLimitRange(1000)
 // MoveLimit is the maximum number of segments that can be moved.
 const MoveLimit = int64(10000)
 
+// CopySegmentLimit is the maximum number of segments that can be copied.
+const CopySegmentLimit = int64(10000)
+
 // batchsizeLimit specifies up to how many items fetch from the storage layer at
 // a time.
 //
@@ -308,6 +311,9 @@ const NextVersion = Version(0)
 // DefaultVersion represents default version 1.
 const DefaultVersion = Version(1)
 
+// maxVersion is the largest version which can be stored in the database.
+const maxVersion = Version(math.MaxInt32)
+
 // ObjectStatus defines the statuses that the object might be in.
 type ObjectStatus byte
 
@@ -317,9 +323,13 @@ const (
 	Pending = ObjectStatus(1)
 	// Committed means that the object is finished and should be visible for general listing.
 	Committed = ObjectStatus(3)
+	// DeleteMarker means that the object has been deleted in a versioned bucket.
+	// It hides the older versions of the object, which can still be accessed by version.
+	DeleteMarker = ObjectStatus(4)
 
-	pendingStatus   = "1"
-	committedStatus = "3"
+	pendingStatus      = "1"
+	committedStatus    = "3"
+	deleteMarkerStatus = "4"
 )
 
 // Pieces defines information for pieces.
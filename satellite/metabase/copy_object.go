@@ -0,0 +1,354 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	pgxerrcode "github.com/jackc/pgerrcode"
+
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+	"storj.io/private/dbutil/pgutil"
+	"storj.io/private/dbutil/pgutil/pgerrcode"
+	"storj.io/private/dbutil/txutil"
+	"storj.io/private/tagsql"
+)
+
+// BeginCopyObjectResult holds data needed to finish copy object.
+type BeginCopyObjectResult BeginMoveCopyResults
+
+// BeginCopyObject holds all data needed begin copy object method.
+type BeginCopyObject struct {
+	Version Version
+	ObjectLocation
+}
+
+// BeginCopyObject collects all data needed to begin object copy procedure.
+func (db *DB) BeginCopyObject(ctx context.Context, opts BeginCopyObject) (result BeginCopyObjectResult, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	results, err := db.beginMoveCopyObject(ctx, opts.ObjectLocation, opts.Version, CopySegmentLimit)
+	if err != nil {
+		return BeginCopyObjectResult{}, err
+	}
+
+	return BeginCopyObjectResult(results), nil
+}
+
+// FinishCopyObject holds all data needed to finish object copy.
+type FinishCopyObject struct {
+	ObjectStream
+	NewBucket                    string
+	NewStreamID                  uuid.UUID
+	NewSegmentKeys               []EncryptedKeyAndNonce
+	NewEncryptedObjectKey        []byte
+	NewEncryptedMetadataKeyNonce []byte
+	NewEncryptedMetadataKey      []byte
+}
+
+// Verify verifies metabase.FinishCopyObject data.
+func (finishCopy FinishCopyObject) Verify() error {
+	if err := finishCopy.ObjectStream.Verify(); err != nil {
+		return err
+	}
+
+	switch {
+	case len(finishCopy.NewBucket) == 0:
+		return ErrInvalidRequest.New("NewBucket is missing")
+	case finishCopy.NewStreamID.IsZero():
+		return ErrInvalidRequest.New("NewStreamID is missing")
+	case finishCopy.ObjectStream.StreamID == finishCopy.NewStreamID:
+		return ErrInvalidRequest.New("StreamIDs are identical")
+	case len(finishCopy.NewEncryptedObjectKey) == 0:
+		return ErrInvalidRequest.New("NewEncryptedObjectKey is missing")
+	case len(finishCopy.NewEncryptedMetadataKeyNonce) == 0:
+		return ErrInvalidRequest.New("EncryptedMetadataKeyNonce is missing")
+	case len(finishCopy.NewEncryptedMetadataKey) == 0:
+		return ErrInvalidRequest.New("EncryptedMetadataKey is missing")
+	}
+
+	return nil
+}
+
+// FinishCopyObject accepts new encryption keys for copied object and inserts the corresponding new object ObjectKey and segments EncryptedKey.
+//
+// The new segments reference the same pieces as the source segments. The relation
+// between the copy and the original stream is tracked in segment_copies, so that
+// pieces are not deleted from storage nodes while any copy still uses them.
+func (db *DB) FinishCopyObject(ctx context.Context, opts FinishCopyObject) (object Object, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := opts.Verify(); err != nil {
+		return Object{}, err
+	}
+
+	var newSegmentKeys struct {
+		Positions          []int64
+		EncryptedKeys      [][]byte
+		EncryptedKeyNonces [][]byte
+	}
+
+	for _, u := range opts.NewSegmentKeys {
+		newSegmentKeys.EncryptedKeys = append(newSegmentKeys.EncryptedKeys, u.EncryptedKey)
+		newSegmentKeys.EncryptedKeyNonces = append(newSegmentKeys.EncryptedKeyNonces, u.EncryptedKeyNonce)
+		newSegmentKeys.Positions = append(newSegmentKeys.Positions, int64(u.Position.Encode()))
+	}
+
+	err = txutil.WithTx(ctx, db.db, nil, func(ctx context.Context, tx tagsql.Tx) (err error) {
+		object = Object{}
+
+		// Lock the source object for the rest of the transaction. A concurrent
+		// delete of the source waits until the copy is recorded in segment_copies,
+		// so that it keeps the pieces used by the copy.
+		var locked int
+		err = tx.QueryRowContext(ctx, `
+			SELECT 1 FROM objects
+			WHERE
+				project_id   = $1 AND
+				bucket_name  = $2 AND
+				object_key   = $3 AND
+				version      = $4 AND
+				stream_id    = $5 AND
+				status       = `+committedStatus+`
+			FOR UPDATE
+		`, opts.ProjectID, []byte(opts.BucketName), opts.ObjectKey, opts.Version, opts.StreamID).Scan(&locked)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return storj.ErrObjectNotFound.New("object not found")
+			}
+			return Error.New("unable to lock source object: %w", err)
+		}
+
+		err = tx.QueryRowContext(ctx, `
+			INSERT INTO objects (
+				project_id, bucket_name, object_key, version, stream_id,
+				expires_at, status, segment_count,
+				encrypted_metadata, encrypted_metadata_nonce, encrypted_metadata_encrypted_key,
+				total_plain_size, total_encrypted_size, fixed_segment_size,
+				encryption,
+				zombie_deletion_deadline
+			)
+			SELECT
+				$1, $6, $7, $8, $9,
+				expires_at, status, segment_count,
+				encrypted_metadata, $10, $11,
+				total_plain_size, total_encrypted_size, fixed_segment_size,
+				encryption,
+				NULL
+			FROM objects
+			WHERE
+				project_id   = $1 AND
+				bucket_name  = $2 AND
+				object_key   = $3 AND
+				version      = $4 AND
+				stream_id    = $5 AND
+				status       = `+committedStatus+`
+			RETURNING
+				created_at, expires_at,
+				segment_count,
+				encrypted_metadata,
+				total_plain_size, total_encrypted_size, fixed_segment_size,
+				encryption
+		`, opts.ProjectID, []byte(opts.BucketName), opts.ObjectKey, opts.Version, opts.StreamID,
+			[]byte(opts.NewBucket), opts.NewEncryptedObjectKey, DefaultVersion, opts.NewStreamID,
+			opts.NewEncryptedMetadataKeyNonce, opts.NewEncryptedMetadataKey,
+		).Scan(
+			&object.CreatedAt, &object.ExpiresAt,
+			&object.SegmentCount,
+			&object.EncryptedMetadata,
+			&object.TotalPlainSize, &object.TotalEncryptedSize, &object.FixedSegmentSize,
+			encryptionParameters{&object.Encryption},
+		)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return storj.ErrObjectNotFound.New("object not found")
+			}
+			if code := pgerrcode.FromError(err); code == pgxerrcode.UniqueViolation {
+				return ErrConflict.New("object already exists")
+			}
+			return Error.New("unable to copy object: %w", err)
+		}
+
+		if int64(object.SegmentCount) > CopySegmentLimit {
+			return ErrInvalidRequest.New("segment count of chosen object is beyond limit")
+		}
+		if int(object.SegmentCount) != len(opts.NewSegmentKeys) {
+			return ErrInvalidRequest.New("wrong amount of segments keys received (received %d, need %d)", len(opts.NewSegmentKeys), object.SegmentCount)
+		}
+
+		result, err := tx.ExecContext(ctx, `
+			INSERT INTO segments (
+				stream_id, position, expires_at,
+				root_piece_id, encrypted_key_nonce, encrypted_key,
+				encrypted_size, plain_offset, plain_size, encrypted_etag,
+				redundancy,
+				inline_data, remote_alias_pieces,
+				placement
+			)
+			SELECT
+				$1, segments.position, segments.expires_at,
+				segments.root_piece_id, P.encrypted_key_nonce, P.encrypted_key,
+				segments.encrypted_size, segments.plain_offset, segments.plain_size, segments.encrypted_etag,
+				segments.redundancy,
+				segments.inline_data, segments.remote_alias_pieces,
+				segments.placement
+			FROM segments
+			JOIN (SELECT unnest($3::INT8[]), unnest($4::BYTEA[]), unnest($5::BYTEA[])) AS P(position, encrypted_key_nonce, encrypted_key)
+				ON segments.position = P.position
+			WHERE segments.stream_id = $2
+		`, opts.NewStreamID, opts.StreamID,
+			pgutil.Int8Array(newSegmentKeys.Positions), pgutil.ByteaArray(newSegmentKeys.EncryptedKeyNonces), pgutil.ByteaArray(newSegmentKeys.EncryptedKeys))
+		if err != nil {
+			return Error.New("unable to copy segments: %w", err)
+		}
+
+		affected, err := result.RowsAffected()
+		if err != nil {
+			return Error.New("failed to get rows affected: %w", err)
+		}
+		if affected != int64(object.SegmentCount) {
+			return ErrInvalidRequest.New("segment keys don't match object segments")
+		}
+
+		// All copies reference the stream which originally uploaded the pieces,
+		// which keeps the relation flat when copying a copy. Objects with only
+		// inline segments don't share any pieces, so there is nothing to track.
+		_, err = tx.ExecContext(ctx, `
+			INSERT INTO segment_copies (
+				stream_id, ancestor_stream_id
+			)
+			SELECT
+				$1,
+				COALESCE((SELECT ancestor_stream_id FROM segment_copies WHERE stream_id = $2), $2)
+			WHERE EXISTS (
+				SELECT 1 FROM segments
+				WHERE stream_id = $1 AND remote_alias_pieces IS NOT NULL
+			)
+		`, opts.NewStreamID, opts.StreamID)
+		if err != nil {
+			return Error.New("unable to insert segment copy: %w", err)
+		}
+
+		return db.recordBucketEvents(ctx, tx, BucketEvent{
+			Type: BucketEventObjectCommitted,
+			ObjectLocation: ObjectLocation{
+				ProjectID:  opts.ProjectID,
+				BucketName: opts.NewBucket,
+				ObjectKey:  ObjectKey(opts.NewEncryptedObjectKey),
+			},
+			Version: DefaultVersion,
+		})
+	})
+	if err != nil {
+		return Object{}, err
+	}
+
+	object.ProjectID = opts.ProjectID
+	object.BucketName = opts.NewBucket
+	object.ObjectKey = ObjectKey(opts.NewEncryptedObjectKey)
+	object.Version = DefaultVersion
+	object.StreamID = opts.NewStreamID
+	object.Status = Committed
+	object.EncryptedMetadataNonce = opts.NewEncryptedMetadataKeyNonce
+	object.EncryptedMetadataEncryptedKey = opts.NewEncryptedMetadataKey
+
+	mon.Meter("finish_copy_object").Mark(1)
+
+	return object, nil
+}
+
+// sharedStreams returns which of the deleted streams still share their pieces
+// with a stream that has not been deleted, either because the deleted stream was
+// copied or because it is a copy itself.
+//
+// It also removes the deleted streams from segment_copies. It must run in the
+// transaction which deleted the streams.
+func (db *DB) sharedStreams(ctx context.Context, tx tagsql.Tx, deletedStreams []uuid.UUID) (shared map[uuid.UUID]struct{}, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(deletedStreams) == 0 {
+		return nil, nil
+	}
+
+	shared = map[uuid.UUID]struct{}{}
+	err = withRows(tx.QueryContext(ctx, `
+		SELECT deleted.stream_id
+		FROM unnest($1::BYTEA[]) AS deleted(stream_id)
+		LEFT JOIN segment_copies AS own ON own.stream_id = deleted.stream_id
+		WHERE
+			EXISTS (
+				SELECT 1 FROM segment_copies AS copies
+				WHERE
+					copies.ancestor_stream_id = COALESCE(own.ancestor_stream_id, deleted.stream_id) AND
+					EXISTS (SELECT 1 FROM segments WHERE segments.stream_id = copies.stream_id)
+			) OR (
+				own.ancestor_stream_id IS NOT NULL AND
+				EXISTS (SELECT 1 FROM segments WHERE segments.stream_id = own.ancestor_stream_id)
+			)
+	`, pgutil.UUIDArray(deletedStreams)))(func(rows tagsql.Rows) error {
+		for rows.Next() {
+			var streamID uuid.UUID
+			if err := rows.Scan(&streamID); err != nil {
+				return err
+			}
+			shared[streamID] = struct{}{}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, Error.New("unable to query segment copies: %w", err)
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		DELETE FROM segment_copies WHERE stream_id = ANY($1::BYTEA[])
+	`, pgutil.UUIDArray(deletedStreams))
+	if err != nil {
+		return nil, Error.New("unable to delete segment copies: %w", err)
+	}
+
+	return shared, nil
+}
+
+// excludeSharedSegments removes segments which pieces are still used by
+// other streams from the list of deleted segments.
+//
+// streamIDs must contain the stream id of every segment in segments.
+func (db *DB) excludeSharedSegments(ctx context.Context, tx tagsql.Tx, segments []DeletedSegmentInfo, streamIDs []uuid.UUID) (_ []DeletedSegmentInfo, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	unique := make([]uuid.UUID, 0, len(streamIDs))
+	seen := map[uuid.UUID]struct{}{}
+	for _, streamID := range streamIDs {
+		if _, ok := seen[streamID]; !ok {
+			seen[streamID] = struct{}{}
+			unique = append(unique, streamID)
+		}
+	}
+
+	shared, err := db.sharedStreams(ctx, tx, unique)
+	if err != nil {
+		return nil, err
+	}
+	if len(shared) == 0 {
+		return segments, nil
+	}
+
+	filtered := segments[:0]
+	for i, segment := range segments {
+		if _, ok := shared[streamIDs[i]]; ok {
+			continue
+		}
+		filtered = append(filtered, segment)
+	}
+
+	mon.Meter("shared_segment_delete_skipped").Mark(len(segments) - len(filtered))
+
+	if len(filtered) == 0 {
+		return nil, nil
+	}
+	return filtered, nil
+}
@@ -0,0 +1,602 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sync/errgroup"
+
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/metabasetest"
+)
+
+func TestBeginCopyObject(t *testing.T) {
+	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		obj := metabasetest.RandObjectStream()
+
+		for _, test := range metabasetest.InvalidObjectLocations(obj.Location()) {
+			test := test
+			t.Run(test.Name, func(t *testing.T) {
+				defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+				metabasetest.BeginCopyObject{
+					Opts: metabase.BeginCopyObject{
+						ObjectLocation: test.ObjectLocation,
+					},
+					ErrClass: test.ErrClass,
+					ErrText:  test.ErrText,
+				}.Check(ctx, t, db)
+
+				metabasetest.Verify{}.Check(ctx, t, db)
+			})
+		}
+
+		t.Run("invalid version", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			metabasetest.BeginCopyObject{
+				Opts: metabase.BeginCopyObject{
+					ObjectLocation: obj.Location(),
+					Version:        0,
+				},
+				ErrClass: &metabase.ErrInvalidRequest,
+				ErrText:  "Version invalid: 0",
+			}.Check(ctx, t, db)
+
+			metabasetest.Verify{}.Check(ctx, t, db)
+		})
+
+		t.Run("begin copy object", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			expectedMetadataNonce := testrand.Nonce()
+			expectedMetadataKey := testrand.Bytes(265)
+			expectedObject := metabasetest.CreateTestObject{
+				CommitObject: &metabase.CommitObject{
+					ObjectStream:                  obj,
+					EncryptedMetadata:             testrand.Bytes(64),
+					EncryptedMetadataNonce:        expectedMetadataNonce[:],
+					EncryptedMetadataEncryptedKey: expectedMetadataKey,
+				},
+			}.Run(ctx, t, db, obj, 10)
+
+			var encKeyAndNonces []metabase.EncryptedKeyAndNonce
+			expectedRawSegments := make([]metabase.RawSegment, 10)
+			for i := range expectedRawSegments {
+				expectedRawSegments[i] = metabasetest.DefaultRawSegment(expectedObject.ObjectStream, metabase.SegmentPosition{
+					Index: uint32(i),
+				})
+				expectedRawSegments[i].PlainOffset = int64(i) * int64(expectedRawSegments[i].PlainSize)
+				expectedRawSegments[i].EncryptedSize = 1060
+
+				encKeyAndNonces = append(encKeyAndNonces, metabase.EncryptedKeyAndNonce{
+					EncryptedKeyNonce: expectedRawSegments[i].EncryptedKeyNonce,
+					EncryptedKey:      expectedRawSegments[i].EncryptedKey,
+					Position:          expectedRawSegments[i].Position,
+				})
+			}
+
+			metabasetest.BeginCopyObject{
+				Opts: metabase.BeginCopyObject{
+					Version:        expectedObject.Version,
+					ObjectLocation: obj.Location(),
+				},
+				Result: metabase.BeginCopyObjectResult{
+					StreamID:                  expectedObject.StreamID,
+					EncryptedMetadata:         expectedObject.EncryptedMetadata,
+					EncryptedMetadataKey:      expectedMetadataKey,
+					EncryptedMetadataKeyNonce: expectedMetadataNonce[:],
+					EncryptedKeysNonces:       encKeyAndNonces,
+					EncryptionParameters:      expectedObject.Encryption,
+				},
+			}.Check(ctx, t, db)
+
+			metabasetest.Verify{
+				Objects: []metabase.RawObject{
+					metabase.RawObject(expectedObject),
+				},
+				Segments: expectedRawSegments,
+			}.Check(ctx, t, db)
+		})
+	})
+}
+
+func TestFinishCopyObject(t *testing.T) {
+	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		obj := metabasetest.RandObjectStream()
+		newBucketName := "New bucket name"
+
+		for _, test := range metabasetest.InvalidObjectStreams(obj) {
+			test := test
+			t.Run(test.Name, func(t *testing.T) {
+				defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+				metabasetest.FinishCopyObject{
+					Opts: metabase.FinishCopyObject{
+						NewBucket:    newBucketName,
+						ObjectStream: test.ObjectStream,
+					},
+					ErrClass: test.ErrClass,
+					ErrText:  test.ErrText,
+				}.Check(ctx, t, db)
+
+				metabasetest.Verify{}.Check(ctx, t, db)
+			})
+		}
+
+		t.Run("invalid NewBucket", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			metabasetest.FinishCopyObject{
+				Opts: metabase.FinishCopyObject{
+					ObjectStream:                 obj,
+					NewStreamID:                  testrand.UUID(),
+					NewEncryptedObjectKey:        []byte{1, 2, 3},
+					NewEncryptedMetadataKey:      []byte{1, 2, 3},
+					NewEncryptedMetadataKeyNonce: []byte{1, 2, 3},
+				},
+				ErrClass: &metabase.ErrInvalidRequest,
+				ErrText:  "NewBucket is missing",
+			}.Check(ctx, t, db)
+
+			metabasetest.Verify{}.Check(ctx, t, db)
+		})
+
+		t.Run("invalid NewStreamID", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			metabasetest.FinishCopyObject{
+				Opts: metabase.FinishCopyObject{
+					ObjectStream:                 obj,
+					NewBucket:                    newBucketName,
+					NewEncryptedObjectKey:        []byte{1, 2, 3},
+					NewEncryptedMetadataKey:      []byte{1, 2, 3},
+					NewEncryptedMetadataKeyNonce: []byte{1, 2, 3},
+				},
+				ErrClass: &metabase.ErrInvalidRequest,
+				ErrText:  "NewStreamID is missing",
+			}.Check(ctx, t, db)
+
+			metabasetest.FinishCopyObject{
+				Opts: metabase.FinishCopyObject{
+					ObjectStream:                 obj,
+					NewBucket:                    newBucketName,
+					NewStreamID:                  obj.StreamID,
+					NewEncryptedObjectKey:        []byte{1, 2, 3},
+					NewEncryptedMetadataKey:      []byte{1, 2, 3},
+					NewEncryptedMetadataKeyNonce: []byte{1, 2, 3},
+				},
+				ErrClass: &metabase.ErrInvalidRequest,
+				ErrText:  "StreamIDs are identical",
+			}.Check(ctx, t, db)
+
+			metabasetest.Verify{}.Check(ctx, t, db)
+		})
+
+		t.Run("invalid NewEncryptedObjectKey", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			metabasetest.FinishCopyObject{
+				Opts: metabase.FinishCopyObject{
+					NewBucket:    newBucketName,
+					NewStreamID:  testrand.UUID(),
+					ObjectStream: obj,
+				},
+				ErrClass: &metabase.ErrInvalidRequest,
+				ErrText:  "NewEncryptedObjectKey is missing",
+			}.Check(ctx, t, db)
+
+			metabasetest.Verify{}.Check(ctx, t, db)
+		})
+
+		t.Run("object does not exist", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			newObj := metabasetest.RandObjectStream()
+
+			metabasetest.FinishCopyObject{
+				Opts: metabase.FinishCopyObject{
+					NewBucket:                    newBucketName,
+					NewStreamID:                  testrand.UUID(),
+					ObjectStream:                 newObj,
+					NewSegmentKeys:               make([]metabase.EncryptedKeyAndNonce, 10),
+					NewEncryptedObjectKey:        testrand.Bytes(32),
+					NewEncryptedMetadataKeyNonce: testrand.Nonce().Bytes(),
+					NewEncryptedMetadataKey:      testrand.Bytes(32),
+				},
+				ErrClass: &storj.ErrObjectNotFound,
+				ErrText:  "object not found",
+			}.Check(ctx, t, db)
+
+			metabasetest.Verify{}.Check(ctx, t, db)
+		})
+
+		t.Run("less amount of segments", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			originalObj := metabasetest.CreateTestObject{}.Run(ctx, t, db, obj, 10)
+
+			newEncryptedKeysNonces := make([]metabase.EncryptedKeyAndNonce, originalObj.SegmentCount-1)
+			for i := range newEncryptedKeysNonces {
+				newEncryptedKeysNonces[i] = metabase.EncryptedKeyAndNonce{
+					Position:          metabase.SegmentPosition{Index: uint32(i)},
+					EncryptedKeyNonce: testrand.Nonce().Bytes(),
+					EncryptedKey:      testrand.Bytes(32),
+				}
+			}
+
+			metabasetest.FinishCopyObject{
+				Opts: metabase.FinishCopyObject{
+					NewBucket:                    newBucketName,
+					NewStreamID:                  testrand.UUID(),
+					ObjectStream:                 obj,
+					NewSegmentKeys:               newEncryptedKeysNonces,
+					NewEncryptedObjectKey:        testrand.Bytes(32),
+					NewEncryptedMetadataKeyNonce: testrand.Nonce().Bytes(),
+					NewEncryptedMetadataKey:      testrand.Bytes(32),
+				},
+				ErrClass: &metabase.ErrInvalidRequest,
+				ErrText:  "wrong amount of segments keys received (received 9, need 10)",
+			}.Check(ctx, t, db)
+		})
+
+		t.Run("wrong segment indexes", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			originalObj := metabasetest.CreateTestObject{}.Run(ctx, t, db, obj, 10)
+
+			newEncryptedKeysNonces := make([]metabase.EncryptedKeyAndNonce, originalObj.SegmentCount)
+			for i := range newEncryptedKeysNonces {
+				newEncryptedKeysNonces[i] = metabase.EncryptedKeyAndNonce{
+					Position:          metabase.SegmentPosition{Index: uint32(i + 5)},
+					EncryptedKeyNonce: testrand.Nonce().Bytes(),
+					EncryptedKey:      testrand.Bytes(32),
+				}
+			}
+
+			metabasetest.FinishCopyObject{
+				Opts: metabase.FinishCopyObject{
+					NewBucket:                    newBucketName,
+					NewStreamID:                  testrand.UUID(),
+					ObjectStream:                 obj,
+					NewSegmentKeys:               newEncryptedKeysNonces,
+					NewEncryptedObjectKey:        testrand.Bytes(32),
+					NewEncryptedMetadataKeyNonce: testrand.Nonce().Bytes(),
+					NewEncryptedMetadataKey:      testrand.Bytes(32),
+				},
+				ErrClass: &metabase.ErrInvalidRequest,
+				ErrText:  "segment keys don't match object segments",
+			}.Check(ctx, t, db)
+		})
+
+		t.Run("finish copy object", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			originalObj, expectedOriginalSegments := createTestObjectWithRawSegments(ctx, t, db, obj, 10)
+
+			copyObj, expectedCopySegments := finishCopyTestObject(ctx, t, db, originalObj, newBucketName)
+
+			metabasetest.Verify{
+				Objects: []metabase.RawObject{
+					metabase.RawObject(originalObj),
+					metabase.RawObject(copyObj),
+				},
+				Segments: append(expectedOriginalSegments, expectedCopySegments...),
+				SegmentCopies: []metabase.RawSegmentCopy{{
+					StreamID:         copyObj.StreamID,
+					AncestorStreamID: originalObj.StreamID,
+				}},
+			}.Check(ctx, t, db)
+		})
+
+		t.Run("copy of copy references original stream", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			originalObj, expectedOriginalSegments := createTestObjectWithRawSegments(ctx, t, db, obj, 2)
+			copyObj, expectedCopySegments := finishCopyTestObject(ctx, t, db, originalObj, newBucketName)
+			copyOfCopyObj, expectedCopyOfCopySegments := finishCopyTestObject(ctx, t, db, copyObj, newBucketName)
+
+			expectedSegments := append(expectedOriginalSegments, expectedCopySegments...)
+			expectedSegments = append(expectedSegments, expectedCopyOfCopySegments...)
+
+			metabasetest.Verify{
+				Objects: []metabase.RawObject{
+					metabase.RawObject(originalObj),
+					metabase.RawObject(copyObj),
+					metabase.RawObject(copyOfCopyObj),
+				},
+				Segments: expectedSegments,
+				SegmentCopies: []metabase.RawSegmentCopy{
+					{StreamID: copyObj.StreamID, AncestorStreamID: originalObj.StreamID},
+					{StreamID: copyOfCopyObj.StreamID, AncestorStreamID: originalObj.StreamID},
+				},
+			}.Check(ctx, t, db)
+		})
+
+		t.Run("target object already exists", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			originalObj, expectedOriginalSegments := createTestObjectWithRawSegments(ctx, t, db, obj, 2)
+
+			metabasetest.FinishCopyObject{
+				Opts: metabase.FinishCopyObject{
+					NewBucket:                    originalObj.BucketName,
+					NewStreamID:                  testrand.UUID(),
+					ObjectStream:                 originalObj.ObjectStream,
+					NewSegmentKeys:               segmentKeys(expectedOriginalSegments),
+					NewEncryptedObjectKey:        []byte(originalObj.ObjectKey),
+					NewEncryptedMetadataKeyNonce: testrand.Nonce().Bytes(),
+					NewEncryptedMetadataKey:      testrand.Bytes(32),
+				},
+				ErrClass: &metabase.ErrConflict,
+				ErrText:  "object already exists",
+			}.Check(ctx, t, db)
+
+			metabasetest.Verify{
+				Objects: []metabase.RawObject{
+					metabase.RawObject(originalObj),
+				},
+				Segments: expectedOriginalSegments,
+			}.Check(ctx, t, db)
+		})
+	})
+}
+
+func TestDeleteCopiedObject(t *testing.T) {
+	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		obj := metabasetest.RandObjectStream()
+		newBucketName := "New bucket name"
+
+		deletedSegments := func(count int) []metabase.DeletedSegmentInfo {
+			segments := make([]metabase.DeletedSegmentInfo, count)
+			for i := range segments {
+				segments[i] = metabase.DeletedSegmentInfo{
+					RootPieceID: storj.PieceID{1},
+					Pieces:      metabase.Pieces{{Number: 0, StorageNode: storj.NodeID{2}}},
+				}
+			}
+			return segments
+		}
+
+		t.Run("delete original then copy", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			originalObj, _ := createTestObjectWithRawSegments(ctx, t, db, obj, 2)
+			copyObj, expectedCopySegments := finishCopyTestObject(ctx, t, db, originalObj, newBucketName)
+
+			// pieces are still used by the copy
+			metabasetest.DeleteObjectExactVersion{
+				Opts: metabase.DeleteObjectExactVersion{
+					ObjectLocation: originalObj.Location(),
+					Version:        originalObj.Version,
+				},
+				Result: metabase.DeleteObjectResult{
+					Objects: []metabase.Object{originalObj},
+				},
+			}.Check(ctx, t, db)
+
+			metabasetest.Verify{
+				Objects: []metabase.RawObject{
+					metabase.RawObject(copyObj),
+				},
+				Segments: expectedCopySegments,
+				SegmentCopies: []metabase.RawSegmentCopy{{
+					StreamID:         copyObj.StreamID,
+					AncestorStreamID: originalObj.StreamID,
+				}},
+			}.Check(ctx, t, db)
+
+			// last reference to the pieces is deleted
+			metabasetest.DeleteObjectExactVersion{
+				Opts: metabase.DeleteObjectExactVersion{
+					ObjectLocation: copyObj.Location(),
+					Version:        copyObj.Version,
+				},
+				Result: metabase.DeleteObjectResult{
+					Objects:  []metabase.Object{copyObj},
+					Segments: deletedSegments(2),
+				},
+			}.Check(ctx, t, db)
+
+			metabasetest.Verify{}.Check(ctx, t, db)
+		})
+
+		t.Run("delete copy then original", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			originalObj, expectedOriginalSegments := createTestObjectWithRawSegments(ctx, t, db, obj, 2)
+			copyObj, _ := finishCopyTestObject(ctx, t, db, originalObj, newBucketName)
+
+			metabasetest.DeleteObjectExactVersion{
+				Opts: metabase.DeleteObjectExactVersion{
+					ObjectLocation: copyObj.Location(),
+					Version:        copyObj.Version,
+				},
+				Result: metabase.DeleteObjectResult{
+					Objects: []metabase.Object{copyObj},
+				},
+			}.Check(ctx, t, db)
+
+			metabasetest.Verify{
+				Objects: []metabase.RawObject{
+					metabase.RawObject(originalObj),
+				},
+				Segments: expectedOriginalSegments,
+			}.Check(ctx, t, db)
+
+			metabasetest.DeleteObjectExactVersion{
+				Opts: metabase.DeleteObjectExactVersion{
+					ObjectLocation: originalObj.Location(),
+					Version:        originalObj.Version,
+				},
+				Result: metabase.DeleteObjectResult{
+					Objects:  []metabase.Object{originalObj},
+					Segments: deletedSegments(2),
+				},
+			}.Check(ctx, t, db)
+
+			metabasetest.Verify{}.Check(ctx, t, db)
+		})
+
+		t.Run("delete bucket with original and copy", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			originalObj, _ := createTestObjectWithRawSegments(ctx, t, db, obj, 2)
+			_, _ = finishCopyTestObject(ctx, t, db, originalObj, originalObj.BucketName)
+
+			var piecesDeleted int
+			metabasetest.DeleteBucketObjects{
+				Opts: metabase.DeleteBucketObjects{
+					Bucket: originalObj.Location().Bucket(),
+					DeletePieces: func(ctx context.Context, segments []metabase.DeletedSegmentInfo) error {
+						piecesDeleted += len(segments)
+						return nil
+					},
+				},
+				Deleted: 2,
+			}.Check(ctx, t, db)
+
+			require.Equal(t, 4, piecesDeleted)
+
+			metabasetest.Verify{}.Check(ctx, t, db)
+		})
+
+		t.Run("delete racing copy", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			for i := 0; i < 10; i++ {
+				originalObj, _ := createTestObjectWithRawSegments(ctx, t, db, metabasetest.RandObjectStream(), 2)
+
+				newSegmentKeys := make([]metabase.EncryptedKeyAndNonce, originalObj.SegmentCount)
+				for j := range newSegmentKeys {
+					newSegmentKeys[j] = metabase.EncryptedKeyAndNonce{
+						Position:          metabase.SegmentPosition{Index: uint32(j)},
+						EncryptedKeyNonce: testrand.Nonce().Bytes(),
+						EncryptedKey:      testrand.Bytes(32),
+					}
+				}
+
+				var copyErr error
+				var deleted metabase.DeleteObjectResult
+				var group errgroup.Group
+				group.Go(func() error {
+					_, copyErr = db.FinishCopyObject(ctx, metabase.FinishCopyObject{
+						ObjectStream:                 originalObj.ObjectStream,
+						NewBucket:                    newBucketName,
+						NewStreamID:                  testrand.UUID(),
+						NewSegmentKeys:               newSegmentKeys,
+						NewEncryptedObjectKey:        testrand.Bytes(32),
+						NewEncryptedMetadataKeyNonce: testrand.Nonce().Bytes(),
+						NewEncryptedMetadataKey:      testrand.Bytes(32),
+					})
+					return nil
+				})
+				group.Go(func() (err error) {
+					deleted, err = db.DeleteObjectExactVersion(ctx, metabase.DeleteObjectExactVersion{
+						ObjectLocation: originalObj.Location(),
+						Version:        originalObj.Version,
+					})
+					return err
+				})
+				require.NoError(t, group.Wait())
+
+				require.Len(t, deleted.Objects, 1)
+				require.Equal(t, originalObj.StreamID, deleted.Objects[0].StreamID)
+				if copyErr == nil {
+					// the copy still uses the pieces
+					require.Empty(t, deleted.Segments)
+					segments, err := db.TestingAllSegments(ctx)
+					require.NoError(t, err)
+					require.Len(t, segments, 2)
+				} else {
+					require.True(t, storj.ErrObjectNotFound.Has(copyErr), copyErr)
+					require.Equal(t, deletedSegments(2), deleted.Segments)
+				}
+
+				require.NoError(t, db.TestingDeleteAll(ctx))
+			}
+		})
+	})
+}
+
+func createTestObjectWithRawSegments(ctx *testcontext.Context, t *testing.T, db *metabase.DB, obj metabase.ObjectStream, numberOfSegments int) (metabase.Object, []metabase.RawSegment) {
+	object := metabasetest.CreateTestObject{
+		CommitObject: &metabase.CommitObject{
+			ObjectStream:                  obj,
+			EncryptedMetadata:             testrand.Bytes(64),
+			EncryptedMetadataNonce:        testrand.Nonce().Bytes(),
+			EncryptedMetadataEncryptedKey: testrand.Bytes(265),
+		},
+	}.Run(ctx, t, db, obj, byte(numberOfSegments))
+
+	segments := make([]metabase.RawSegment, numberOfSegments)
+	for i := range segments {
+		segments[i] = metabasetest.DefaultRawSegment(object.ObjectStream, metabase.SegmentPosition{Index: uint32(i)})
+		segments[i].PlainOffset = int64(int32(i) * segments[i].PlainSize)
+		segments[i].EncryptedSize = 1060
+	}
+
+	return object, segments
+}
+
+func finishCopyTestObject(ctx *testcontext.Context, t *testing.T, db *metabase.DB, source metabase.Object, newBucketName string) (metabase.Object, []metabase.RawSegment) {
+	newStreamID := testrand.UUID()
+	newObjectKey := testrand.Bytes(32)
+	newEncryptedMetadataKeyNonce := testrand.Nonce()
+	newEncryptedMetadataKey := testrand.Bytes(32)
+
+	newEncryptedKeysNonces := make([]metabase.EncryptedKeyAndNonce, source.SegmentCount)
+	expectedSegments := make([]metabase.RawSegment, source.SegmentCount)
+	for i := range newEncryptedKeysNonces {
+		newEncryptedKeysNonces[i] = metabase.EncryptedKeyAndNonce{
+			Position:          metabase.SegmentPosition{Index: uint32(i)},
+			EncryptedKeyNonce: testrand.Nonce().Bytes(),
+			EncryptedKey:      testrand.Bytes(32),
+		}
+
+		expectedSegments[i] = metabasetest.DefaultRawSegment(metabase.ObjectStream{StreamID: newStreamID}, metabase.SegmentPosition{Index: uint32(i)})
+		expectedSegments[i].EncryptedKeyNonce = newEncryptedKeysNonces[i].EncryptedKeyNonce
+		expectedSegments[i].EncryptedKey = newEncryptedKeysNonces[i].EncryptedKey
+		expectedSegments[i].PlainOffset = int64(int32(i) * expectedSegments[i].PlainSize)
+		expectedSegments[i].EncryptedSize = 1060
+	}
+
+	expectedObject := source
+	expectedObject.BucketName = newBucketName
+	expectedObject.ObjectKey = metabase.ObjectKey(newObjectKey)
+	expectedObject.StreamID = newStreamID
+	expectedObject.EncryptedMetadataNonce = newEncryptedMetadataKeyNonce[:]
+	expectedObject.EncryptedMetadataEncryptedKey = newEncryptedMetadataKey
+
+	copyObject := metabasetest.FinishCopyObject{
+		Opts: metabase.FinishCopyObject{
+			ObjectStream:                 source.ObjectStream,
+			NewBucket:                    newBucketName,
+			NewStreamID:                  newStreamID,
+			NewSegmentKeys:               newEncryptedKeysNonces,
+			NewEncryptedObjectKey:        newObjectKey,
+			NewEncryptedMetadataKeyNonce: newEncryptedMetadataKeyNonce[:],
+			NewEncryptedMetadataKey:      newEncryptedMetadataKey,
+		},
+		Result: expectedObject,
+	}.Check(ctx, t, db)
+
+	return copyObject, expectedSegments
+}
+
+func segmentKeys(segments []metabase.RawSegment) []metabase.EncryptedKeyAndNonce {
+	keys := make([]metabase.EncryptedKeyAndNonce, len(segments))
+	for i, segment := range segments {
+		keys[i] = metabase.EncryptedKeyAndNonce{
+			Position:          segment.Position,
+			EncryptedKeyNonce: segment.EncryptedKeyNonce,
+			EncryptedKey:      segment.EncryptedKey,
+		}
+	}
+	return keys
+}
@@ -114,6 +114,9 @@ func (db *DB) DestroyTables(ctx context.Context) error {
 		DROP TABLE IF EXISTS objects;
 		DROP TABLE IF EXISTS segments;
 		DROP TABLE IF EXISTS node_aliases;
+		DROP TABLE IF EXISTS segment_copies;
+		DROP TABLE IF EXISTS notified_buckets;
+		DROP TABLE IF EXISTS bucket_events;
 		DROP SEQUENCE IF EXISTS node_alias_seq;
 	`)
 	db.aliasCache = NewNodeAliasCache(db)
@@ -328,6 +331,60 @@ func (db *DB) PostgresMigration() *migrate.Migration {
 					`ALTER TABLE segments ADD COLUMN placement integer`,
 				},
 			},
+			{
+				DB:          &db.db,
+				Description: "add segment_copies table",
+				Version:     15,
+				Action: migrate.SQL{
+					`CREATE TABLE segment_copies (
+						stream_id          BYTEA NOT NULL PRIMARY KEY,
+						ancestor_stream_id BYTEA NOT NULL
+					)`,
+					`CREATE INDEX ON segment_copies (ancestor_stream_id)`,
+				},
+			},
+			{
+				DB:          &db.db,
+				Description: "add object lock columns to the objects table",
+				Version:     16,
+				Action: migrate.SQL{
+					`ALTER TABLE objects ADD COLUMN retain_until TIMESTAMPTZ`,
+					`ALTER TABLE objects ADD COLUMN legal_hold BOOLEAN NOT NULL DEFAULT false`,
+				},
+			},
+			{
+				DB:          &db.db,
+				Description: "add versioned column to the objects table",
+				Version:     17,
+				Action: migrate.SQL{
+					`ALTER TABLE objects ADD COLUMN versioned BOOLEAN NOT NULL DEFAULT false`,
+				},
+			},
+			{
+				DB:          &db.db,
+				Description: "add bucket event outbox tables",
+				Version:     18,
+				Action: migrate.SQL{
+					`CREATE TABLE notified_buckets (
+						project_id  BYTEA NOT NULL,
+						bucket_name BYTEA NOT NULL,
+						PRIMARY KEY (project_id, bucket_name)
+					)`,
+					`CREATE TABLE bucket_events (
+						id              BYTEA NOT NULL PRIMARY KEY,
+						project_id      BYTEA NOT NULL,
+						bucket_name     BYTEA NOT NULL,
+						event_type      TEXT NOT NULL,
+						object_key      BYTEA NOT NULL,
+						version         INT8 NOT NULL,
+						delete_marker   BOOLEAN NOT NULL DEFAULT false,
+						new_bucket_name BYTEA,
+						new_object_key  BYTEA,
+						created_at      TIMESTAMPTZ NOT NULL DEFAULT now()
+					)`,
+					`CREATE INDEX bucket_events_created_at_index ON bucket_events (created_at)`,
+				},
+			},
 		},
 	}
 }
@@ -11,8 +11,10 @@ import (
 	"github.com/zeebo/errs"
 
 	"storj.io/common/storj"
+	"storj.io/common/uuid"
 	"storj.io/private/dbutil"
 	"storj.io/private/dbutil/pgutil"
+	"storj.io/private/dbutil/txutil"
 	"storj.io/private/tagsql"
 )
 
@@ -92,13 +94,16 @@ type DeleteObjectLatestVersion struct {
 }
 
 // DeleteObjectExactVersion deletes an exact object version.
+// The version may be a delete marker, removing it makes the previous version visible again.
 func (db *DB) DeleteObjectExactVersion(ctx context.Context, opts DeleteObjectExactVersion) (result DeleteObjectResult, err error) {
 	defer mon.Task()(&ctx)(&err)
 
 	if err := opts.Verify(); err != nil {
 		return DeleteObjectResult{}, err
 	}
-	err = withRows(db.db.QueryContext(ctx, `
+
+	err = txutil.WithTx(ctx, db.db, nil, func(ctx context.Context, tx tagsql.Tx) (err error) {
+		result, err = db.queryObjectDeletion(ctx, tx, opts.ObjectLocation, `
 			WITH deleted_objects AS (
 				DELETE FROM objects
 				WHERE
@@ -106,7 +111,8 @@ func (db *DB) DeleteObjectExactVersion(ctx context.Context, opts DeleteObjectExa
 					bucket_name  = $2 AND
 					object_key   = $3 AND
 					version      = $4 AND
-					status       = `+committedStatus+`
+					status       IN (`+committedStatus+`, `+deleteMarkerStatus+`) AND
+					`+objectNotLocked+`
 				RETURNING
 					version, stream_id,
 					created_at, expires_at,
@@ -129,9 +135,15 @@ func (db *DB) DeleteObjectExactVersion(ctx context.Context, opts DeleteObjectExa
 				deleted_segments.root_piece_id, deleted_segments.remote_alias_pieces
 			FROM deleted_objects
 			LEFT JOIN deleted_segments ON deleted_objects.stream_id = deleted_segments.stream_id
-		`, opts.ProjectID, []byte(opts.BucketName), opts.ObjectKey, opts.Version))(func(rows tagsql.Rows) error {
-		result.Objects, result.Segments, err = db.scanObjectDeletion(ctx, opts.ObjectLocation, rows)
-		return err
+			`, opts.ProjectID, []byte(opts.BucketName), opts.ObjectKey, opts.Version)
+		if err != nil {
+			return err
+		}
+		if len(result.Objects) == 0 {
+			return db.ensureObjectsNotLocked(ctx, tx, opts.ProjectID, opts.BucketName, [][]byte{[]byte(opts.ObjectKey)}, opts.Version)
+		}
+
+		return db.recordBucketEvents(ctx, tx, deletedObjectEvents(result.Objects)...)
 	})
 	if err != nil {
 		return DeleteObjectResult{}, err
@@ -168,7 +180,8 @@ func (db *DB) DeletePendingObject(ctx context.Context, opts DeletePendingObject)
 		return DeleteObjectResult{}, err
 	}
 
-	err = withRows(db.db.QueryContext(ctx, `
+	err = txutil.WithTx(ctx, db.db, nil, func(ctx context.Context, tx tagsql.Tx) (err error) {
+		result, err = db.queryObjectDeletion(ctx, tx, opts.Location(), `
 			WITH deleted_objects AS (
 				DELETE FROM objects
 				WHERE
@@ -200,8 +213,7 @@ func (db *DB) DeletePendingObject(ctx context.Context, opts DeletePendingObject)
 				deleted_segments.root_piece_id, deleted_segments.remote_alias_pieces
 			FROM deleted_objects
 			LEFT JOIN deleted_segments ON deleted_objects.stream_id = deleted_segments.stream_id
-		`, opts.ProjectID, []byte(opts.BucketName), opts.ObjectKey, opts.Version, opts.StreamID))(func(rows tagsql.Rows) error {
-		result.Objects, result.Segments, err = db.scanObjectDeletion(ctx, opts.Location(), rows)
+		`, opts.ProjectID, []byte(opts.BucketName), opts.ObjectKey, opts.Version, opts.StreamID)
 		return err
 	})
 
@@ -237,9 +249,17 @@ func (db *DB) DeleteObjectLatestVersion(ctx context.Context, opts DeleteObjectLa
 					project_id   = $1 AND
 					bucket_name  = $2 AND
 					object_key   = $3 AND
-					status       = ` + committedStatus + `
-				ORDER BY version DESC
-				LIMIT 1
+					version      = (
+						SELECT version FROM objects
+						WHERE
+							project_id   = $1 AND
+							bucket_name  = $2 AND
+							object_key   = $3 AND
+							status       = ` + committedStatus + `
+						ORDER BY version DESC LIMIT 1
+					) AND
+					status       = ` + committedStatus + ` AND
+					` + objectNotLocked + `
 				RETURNING
 					version, stream_id,
 					created_at, expires_at,
@@ -280,7 +300,8 @@ func (db *DB) DeleteObjectLatestVersion(ctx context.Context, opts DeleteObjectLa
 							status       = ` + committedStatus + `
 						ORDER BY version DESC LIMIT 1
 					) AND
-					status       = ` + committedStatus + `
+					status       = ` + committedStatus + ` AND
+					` + objectNotLocked + `
 				RETURNING
 					version, stream_id,
 					created_at, expires_at,
@@ -307,9 +328,16 @@ func (db *DB) DeleteObjectLatestVersion(ctx context.Context, opts DeleteObjectLa
 	default:
 		return DeleteObjectResult{}, Error.New("unhandled database: %v", db.impl)
 	}
-	err = withRows(db.db.QueryContext(ctx, query, opts.ProjectID, []byte(opts.BucketName), opts.ObjectKey))(func(rows tagsql.Rows) error {
-		result.Objects, result.Segments, err = db.scanObjectDeletion(ctx, opts.ObjectLocation, rows)
-		return err
+	err = txutil.WithTx(ctx, db.db, nil, func(ctx context.Context, tx tagsql.Tx) (err error) {
+		result, err = db.queryObjectDeletion(ctx, tx, opts.ObjectLocation, query, opts.ProjectID, []byte(opts.BucketName), opts.ObjectKey)
+		if err != nil {
+			return err
+		}
+		if len(result.Objects) == 0 {
+			return db.ensureLatestVersionNotLocked(ctx, tx, opts.ObjectLocation)
+		}
+
+		return db.recordBucketEvents(ctx, tx, deletedObjectEvents(result.Objects)...)
 	})
 
 	if err != nil {
@@ -326,6 +354,150 @@ func (db *DB) DeleteObjectLatestVersion(ctx context.Context, opts DeleteObjectLa
 	return result, nil
 }
 
+// DeleteObjectNullVersion contains arguments necessary for deleting the null version of an object.
+type DeleteObjectNullVersion struct {
+	ObjectLocation
+}
+
+// DeleteObjectNullVersion deletes the committed versions and delete markers of the object,
+// which weren't created while versioning was enabled for the bucket. Normally there is at
+// most one such null version. The versions created while versioning was enabled are kept.
+func (db *DB) DeleteObjectNullVersion(ctx context.Context, opts DeleteObjectNullVersion) (result DeleteObjectResult, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := opts.Verify(); err != nil {
+		return DeleteObjectResult{}, err
+	}
+
+	err = txutil.WithTx(ctx, db.db, nil, func(ctx context.Context, tx tagsql.Tx) (err error) {
+		result, err = db.queryObjectDeletion(ctx, tx, opts.ObjectLocation, `
+			WITH deleted_objects AS (
+				DELETE FROM objects
+				WHERE
+					project_id   = $1 AND
+					bucket_name  = $2 AND
+					object_key   = $3 AND
+					versioned    = false AND
+					status       IN (`+committedStatus+`, `+deleteMarkerStatus+`) AND
+					`+objectNotLocked+`
+				RETURNING
+					version, stream_id,
+					created_at, expires_at,
+					status, segment_count,
+					encrypted_metadata_nonce, encrypted_metadata, encrypted_metadata_encrypted_key,
+					total_plain_size, total_encrypted_size, fixed_segment_size,
+					encryption
+			), deleted_segments AS (
+				DELETE FROM segments
+				WHERE segments.stream_id in (SELECT deleted_objects.stream_id FROM deleted_objects)
+				RETURNING segments.stream_id,segments.root_piece_id, segments.remote_alias_pieces
+			)
+			SELECT
+				deleted_objects.version, deleted_objects.stream_id,
+				deleted_objects.created_at, deleted_objects.expires_at,
+				deleted_objects.status, deleted_objects.segment_count,
+				deleted_objects.encrypted_metadata_nonce, deleted_objects.encrypted_metadata, deleted_objects.encrypted_metadata_encrypted_key,
+				deleted_objects.total_plain_size, deleted_objects.total_encrypted_size, deleted_objects.fixed_segment_size,
+				deleted_objects.encryption,
+				deleted_segments.root_piece_id, deleted_segments.remote_alias_pieces
+			FROM deleted_objects
+			LEFT JOIN deleted_segments ON deleted_objects.stream_id = deleted_segments.stream_id
+			`, opts.ProjectID, []byte(opts.BucketName), opts.ObjectKey)
+		if err != nil {
+			return err
+		}
+		if err := db.ensureNullVersionNotLocked(ctx, tx, opts.ObjectLocation); err != nil {
+			return err
+		}
+
+		return db.recordBucketEvents(ctx, tx, deletedObjectEvents(result.Objects)...)
+	})
+	if err != nil {
+		return DeleteObjectResult{}, err
+	}
+
+	if len(result.Objects) == 0 {
+		return DeleteObjectResult{}, storj.ErrObjectNotFound.Wrap(Error.New("no rows deleted"))
+	}
+
+	mon.Meter("object_delete").Mark(len(result.Objects))
+	mon.Meter("segment_delete").Mark(len(result.Segments))
+
+	return result, nil
+}
+
+// CreateDeleteMarker contains arguments necessary for creating a delete marker.
+type CreateDeleteMarker struct {
+	ObjectLocation
+
+	// Versioned marks the delete marker as created while versioning was enabled for the bucket.
+	Versioned bool
+}
+
+// CreateDeleteMarker inserts a delete marker as the latest version of the object.
+// Existing versions of the object are kept, but the object is not visible anymore
+// when asking for the latest version.
+func (db *DB) CreateDeleteMarker(ctx context.Context, opts CreateDeleteMarker) (marker Object, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := opts.Verify(); err != nil {
+		return Object{}, err
+	}
+
+	streamID, err := uuid.New()
+	if err != nil {
+		return Object{}, Error.New("unable to create stream id: %w", err)
+	}
+
+	err = txutil.WithTx(ctx, db.db, nil, func(ctx context.Context, tx tagsql.Tx) (err error) {
+		err = tx.QueryRowContext(ctx, `
+			INSERT INTO objects (
+				project_id, bucket_name, object_key, version, stream_id,
+				status, zombie_deletion_deadline, versioned
+			) VALUES (
+				$1, $2, $3,
+					coalesce((
+						SELECT version + 1
+						FROM objects
+						WHERE project_id = $1 AND bucket_name = $2 AND object_key = $3
+						ORDER BY version DESC
+						LIMIT 1
+					), 1),
+				$4,
+				`+deleteMarkerStatus+`, NULL, $5)
+			RETURNING version, created_at
+		`, opts.ProjectID, []byte(opts.BucketName), opts.ObjectKey, streamID, opts.Versioned,
+		).Scan(&marker.Version, &marker.CreatedAt)
+		if err != nil {
+			return Error.New("unable to insert delete marker: %w", err)
+		}
+
+		return db.recordBucketEvents(ctx, tx, BucketEvent{
+			Type:           BucketEventObjectDeleted,
+			ObjectLocation: opts.ObjectLocation,
+			Version:        marker.Version,
+			DeleteMarker:   true,
+		})
+	})
+	if err != nil {
+		return Object{}, err
+	}
+
+	marker.ObjectStream = ObjectStream{
+		ProjectID:  opts.ProjectID,
+		BucketName: opts.BucketName,
+		ObjectKey:  opts.ObjectKey,
+		Version:    marker.Version,
+		StreamID:   streamID,
+	}
+	marker.Status = DeleteMarker
+	marker.Versioned = opts.Versioned
+
+	mon.Meter("object_delete_marker").Mark(1)
+
+	return marker, nil
+}
+
 // DeleteObjectAnyStatusAllVersions deletes all object versions.
 func (db *DB) DeleteObjectAnyStatusAllVersions(ctx context.Context, opts DeleteObjectAnyStatusAllVersions) (result DeleteObjectResult, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -334,13 +506,15 @@ func (db *DB) DeleteObjectAnyStatusAllVersions(ctx context.Context, opts DeleteO
 		return DeleteObjectResult{}, err
 	}
 
-	err = withRows(db.db.QueryContext(ctx, `
+	err = txutil.WithTx(ctx, db.db, nil, func(ctx context.Context, tx tagsql.Tx) (err error) {
+		result, err = db.queryObjectDeletion(ctx, tx, opts.ObjectLocation, `
 			WITH deleted_objects AS (
 				DELETE FROM objects
 				WHERE
 				project_id   = $1 AND
 				bucket_name  = $2 AND
-				object_key   = $3
+				object_key   = $3 AND
+				`+objectNotLocked+`
 				RETURNING
 					version, stream_id,
 					created_at, expires_at,
@@ -363,9 +537,16 @@ func (db *DB) DeleteObjectAnyStatusAllVersions(ctx context.Context, opts DeleteO
 				deleted_segments.root_piece_id, deleted_segments.remote_alias_pieces
 			FROM deleted_objects
 			LEFT JOIN deleted_segments ON deleted_objects.stream_id = deleted_segments.stream_id
-		`, opts.ProjectID, []byte(opts.BucketName), opts.ObjectKey))(func(rows tagsql.Rows) error {
-		result.Objects, result.Segments, err = db.scanObjectDeletion(ctx, opts.ObjectLocation, rows)
-		return err
+		`, opts.ProjectID, []byte(opts.BucketName), opts.ObjectKey)
+		if err != nil {
+			return err
+		}
+		err = db.ensureObjectsNotLocked(ctx, tx, opts.ProjectID, opts.BucketName, [][]byte{[]byte(opts.ObjectKey)}, NextVersion)
+		if err != nil {
+			return err
+		}
+
+		return db.recordBucketEvents(ctx, tx, deletedObjectEvents(result.Objects)...)
 	})
 
 	if err != nil {
@@ -409,14 +590,17 @@ func (db *DB) DeleteObjectsAllVersions(ctx context.Context, opts DeleteObjectsAl
 	sort.Slice(objectKeys, func(i, j int) bool {
 		return bytes.Compare(objectKeys[i], objectKeys[j]) < 0
 	})
-	err = withRows(db.db.QueryContext(ctx, `
+
+	err = txutil.WithTx(ctx, db.db, nil, func(ctx context.Context, tx tagsql.Tx) (err error) {
+		result, err = db.queryMultipleObjectsDeletion(ctx, tx, `
 				WITH deleted_objects AS (
 					DELETE FROM objects
 					WHERE
 					project_id   = $1 AND
 					bucket_name  = $2 AND
 					object_key   = ANY ($3) AND
-					status       = `+committedStatus+`
+					status       = `+committedStatus+` AND
+					`+objectNotLocked+`
 					RETURNING
 						project_id, bucket_name,
 						object_key, version, stream_id,
@@ -441,9 +625,16 @@ func (db *DB) DeleteObjectsAllVersions(ctx context.Context, opts DeleteObjectsAl
 					deleted_segments.root_piece_id, deleted_segments.remote_alias_pieces
 				FROM deleted_objects
 				LEFT JOIN deleted_segments ON deleted_objects.stream_id = deleted_segments.stream_id
-			`, projectID, []byte(bucketName), pgutil.ByteaArray(objectKeys)))(func(rows tagsql.Rows) error {
-		result.Objects, result.Segments, err = db.scanMultipleObjectsDeletion(ctx, rows)
-		return err
+				`, projectID, []byte(bucketName), pgutil.ByteaArray(objectKeys))
+		if err != nil {
+			return err
+		}
+		err = db.ensureObjectsNotLocked(ctx, tx, projectID, bucketName, objectKeys, NextVersion)
+		if err != nil {
+			return err
+		}
+
+		return db.recordBucketEvents(ctx, tx, deletedObjectEvents(result.Objects)...)
 	})
 
 	if err != nil {
@@ -456,7 +647,51 @@ func (db *DB) DeleteObjectsAllVersions(ctx context.Context, opts DeleteObjectsAl
 	return result, nil
 }
 
-func (db *DB) scanObjectDeletion(ctx context.Context, location ObjectLocation, rows tagsql.Rows) (objects []Object, segments []DeletedSegmentInfo, err error) {
+// queryObjectDeletion runs the deletion query of a single object in the transaction. It
+// expects the rows of scanObjectDeletion and leaves out segments which pieces are still
+// used by other streams.
+func (db *DB) queryObjectDeletion(ctx context.Context, tx tagsql.Tx, location ObjectLocation, query string, args ...interface{}) (result DeleteObjectResult, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var segmentStreamIDs []uuid.UUID
+	err = withRows(tx.QueryContext(ctx, query, args...))(func(rows tagsql.Rows) error {
+		result.Objects, result.Segments, segmentStreamIDs, err = db.scanObjectDeletion(ctx, location, rows)
+		return err
+	})
+	if err != nil {
+		return DeleteObjectResult{}, err
+	}
+
+	result.Segments, err = db.excludeSharedSegments(ctx, tx, result.Segments, segmentStreamIDs)
+	if err != nil {
+		return DeleteObjectResult{}, err
+	}
+	return result, nil
+}
+
+// queryMultipleObjectsDeletion is like queryObjectDeletion for the rows of
+// scanMultipleObjectsDeletion.
+func (db *DB) queryMultipleObjectsDeletion(ctx context.Context, tx tagsql.Tx, query string, args ...interface{}) (result DeleteObjectResult, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var segmentStreamIDs []uuid.UUID
+	err = withRows(tx.QueryContext(ctx, query, args...))(func(rows tagsql.Rows) error {
+		result.Objects, result.Segments, segmentStreamIDs, err = db.scanMultipleObjectsDeletion(ctx, rows)
+		return err
+	})
+	if err != nil {
+		return DeleteObjectResult{}, err
+	}
+
+	result.Segments, err = db.excludeSharedSegments(ctx, tx, result.Segments, segmentStreamIDs)
+	if err != nil {
+		return DeleteObjectResult{}, err
+	}
+	return result, nil
+}
+
+// scanObjectDeletion returns the deleted objects and segments together with the stream id of every segment.
+func (db *DB) scanObjectDeletion(ctx context.Context, location ObjectLocation, rows tagsql.Rows) (objects []Object, segments []DeletedSegmentInfo, segmentStreamIDs []uuid.UUID, err error) {
 	defer mon.Task()(&ctx)(&err)
 	defer func() { err = errs.Combine(err, rows.Close()) }()
 
@@ -481,7 +716,7 @@ func (db *DB) scanObjectDeletion(ctx context.Context, location ObjectLocation, r
 			&object.TotalPlainSize, &object.TotalEncryptedSize, &object.FixedSegmentSize,
 			encryptionParameters{&object.Encryption}, &rootPieceID, &aliasPieces)
 		if err != nil {
-			return nil, nil, Error.New("unable to delete object: %w", err)
+			return nil, nil, nil, Error.New("unable to delete object: %w", err)
 		}
 		if len(objects) == 0 || objects[len(objects)-1].StreamID != object.StreamID {
 			objects = append(objects, object)
@@ -490,25 +725,27 @@ func (db *DB) scanObjectDeletion(ctx context.Context, location ObjectLocation, r
 			segment.RootPieceID = *rootPieceID
 			segment.Pieces, err = db.aliasCache.ConvertAliasesToPieces(ctx, aliasPieces)
 			if err != nil {
-				return nil, nil, Error.Wrap(err)
+				return nil, nil, nil, Error.Wrap(err)
 			}
 			if len(segment.Pieces) > 0 {
 				segments = append(segments, segment)
+				segmentStreamIDs = append(segmentStreamIDs, object.StreamID)
 			}
 		}
 	}
 
 	if err := rows.Err(); err != nil {
-		return nil, nil, Error.New("unable to delete object: %w", err)
+		return nil, nil, nil, Error.New("unable to delete object: %w", err)
 	}
 
 	if len(segments) == 0 {
-		return objects, nil, nil
+		return objects, nil, nil, nil
 	}
-	return objects, segments, nil
+	return objects, segments, segmentStreamIDs, nil
 }
 
-func (db *DB) scanMultipleObjectsDeletion(ctx context.Context, rows tagsql.Rows) (objects []Object, segments []DeletedSegmentInfo, err error) {
+// scanMultipleObjectsDeletion returns the deleted objects and segments together with the stream id of every segment.
+func (db *DB) scanMultipleObjectsDeletion(ctx context.Context, rows tagsql.Rows) (objects []Object, segments []DeletedSegmentInfo, segmentStreamIDs []uuid.UUID, err error) {
 	defer mon.Task()(&ctx)(&err)
 	defer func() { err = errs.Combine(err, rows.Close()) }()
 
@@ -529,7 +766,7 @@ func (db *DB) scanMultipleObjectsDeletion(ctx context.Context, rows tagsql.Rows)
 			&object.TotalPlainSize, &object.TotalEncryptedSize, &object.FixedSegmentSize,
 			encryptionParameters{&object.Encryption}, &rootPieceID, &aliasPieces)
 		if err != nil {
-			return nil, nil, Error.New("unable to delete object: %w", err)
+			return nil, nil, nil, Error.New("unable to delete object: %w", err)
 		}
 
 		if len(objects) == 0 || objects[len(objects)-1].StreamID != object.StreamID {
@@ -539,24 +776,25 @@ func (db *DB) scanMultipleObjectsDeletion(ctx context.Context, rows tagsql.Rows)
 			segment.RootPieceID = *rootPieceID
 			segment.Pieces, err = db.aliasCache.ConvertAliasesToPieces(ctx, aliasPieces)
 			if err != nil {
-				return nil, nil, Error.Wrap(err)
+				return nil, nil, nil, Error.Wrap(err)
 			}
 			if len(segment.Pieces) > 0 {
 				segments = append(segments, segment)
+				segmentStreamIDs = append(segmentStreamIDs, object.StreamID)
 			}
 		}
 	}
 
 	if err := rows.Err(); err != nil {
-		return nil, nil, Error.New("unable to delete object: %w", err)
+		return nil, nil, nil, Error.New("unable to delete object: %w", err)
 	}
 
 	if len(objects) == 0 {
 		objects = nil
 	}
 	if len(segments) == 0 {
-		return objects, nil, nil
+		return objects, nil, nil, nil
 	}
 
-	return objects, segments, nil
+	return objects, segments, segmentStreamIDs, nil
 }
@@ -8,8 +8,10 @@ import (
 	"database/sql"
 	"errors"
 
+	"storj.io/common/storj"
 	"storj.io/common/uuid"
 	"storj.io/private/dbutil"
+	"storj.io/private/dbutil/txutil"
 	"storj.io/private/tagsql"
 )
 
@@ -28,6 +30,7 @@ type DeleteBucketObjects struct {
 }
 
 // DeleteBucketObjects deletes all objects in the specified bucket.
+// Nothing is deleted when the bucket contains objects protected by object lock.
 // Deletion performs in batches, so in case of error while processing,
 // this method will return the number of objects deleted to the moment
 // when an error occurs.
@@ -40,18 +43,35 @@ func (db *DB) DeleteBucketObjects(ctx context.Context, opts DeleteBucketObjects)
 
 	deleteBatchSizeLimit.Ensure(&opts.BatchSize)
 
+	locked, err := db.HasLockedObjects(ctx, HasLockedObjects{
+		ProjectID:  opts.Bucket.ProjectID,
+		BucketName: opts.Bucket.BucketName,
+	})
+	if err != nil {
+		return 0, err
+	}
+	if locked {
+		return 0, ErrObjectLocked.New("bucket contains objects protected by object lock")
+	}
+
 	var query string
 	switch db.impl {
 	case dbutil.Cockroach:
 		query = `
 		WITH deleted_objects AS (
 			DELETE FROM objects
-			WHERE project_id = $1 AND bucket_name = $2 LIMIT $3
-			RETURNING objects.stream_id
+			WHERE project_id = $1 AND bucket_name = $2 AND ` + objectNotLocked + ` LIMIT $3
+			RETURNING objects.object_key, objects.version, objects.stream_id, objects.status
+		), deleted_segments AS (
+			DELETE FROM segments
+			WHERE segments.stream_id in (SELECT deleted_objects.stream_id FROM deleted_objects)
+			RETURNING segments.stream_id, segments.root_piece_id, segments.remote_alias_pieces
 		)
-		DELETE FROM segments
-		WHERE segments.stream_id in (SELECT deleted_objects.stream_id FROM deleted_objects)
-		RETURNING segments.stream_id, segments.root_piece_id, segments.remote_alias_pieces
+		SELECT
+			deleted_objects.object_key, deleted_objects.version, deleted_objects.stream_id, deleted_objects.status,
+			deleted_segments.root_piece_id, deleted_segments.remote_alias_pieces
+		FROM deleted_objects
+		LEFT JOIN deleted_segments ON deleted_objects.stream_id = deleted_segments.stream_id
 	`
 	case dbutil.Postgres:
 		query = `
@@ -59,53 +79,83 @@ func (db *DB) DeleteBucketObjects(ctx context.Context, opts DeleteBucketObjects)
 			DELETE FROM objects
 			WHERE stream_id IN (
 				SELECT stream_id FROM objects
-				WHERE project_id = $1 AND bucket_name = $2
+				WHERE project_id = $1 AND bucket_name = $2 AND ` + objectNotLocked + `
 				LIMIT $3
 			)
-			RETURNING objects.stream_id
+			RETURNING objects.object_key, objects.version, objects.stream_id, objects.status
+		), deleted_segments AS (
+			DELETE FROM segments
+			WHERE segments.stream_id in (SELECT deleted_objects.stream_id FROM deleted_objects)
+			RETURNING segments.stream_id, segments.root_piece_id, segments.remote_alias_pieces
 		)
-		DELETE FROM segments
-		WHERE segments.stream_id in (SELECT deleted_objects.stream_id FROM deleted_objects)
-		RETURNING segments.stream_id, segments.root_piece_id, segments.remote_alias_pieces
+		SELECT
+			deleted_objects.object_key, deleted_objects.version, deleted_objects.stream_id, deleted_objects.status,
+			deleted_segments.root_piece_id, deleted_segments.remote_alias_pieces
+		FROM deleted_objects
+		LEFT JOIN deleted_segments ON deleted_objects.stream_id = deleted_segments.stream_id
 	`
 	default:
 		return 0, Error.New("unhandled database: %v", db.impl)
 	}
 
-	// TODO: fix the count for objects without segments
+	deletedObjects := make([]Object, 0, 100)
 	deletedSegments := make([]DeletedSegmentInfo, 0, 100)
+	deletedSegmentStreamIDs := make([]uuid.UUID, 0, 100)
 	for {
 		if err := ctx.Err(); err != nil {
 			return 0, err
 		}
 
-		deletedSegments = deletedSegments[:0]
-		deletedObjects := 0
-		err = withRows(db.db.QueryContext(ctx, query,
-			opts.Bucket.ProjectID, []byte(opts.Bucket.BucketName), opts.BatchSize))(func(rows tagsql.Rows) error {
-			ids := map[uuid.UUID]struct{}{} // TODO: avoid map here
-			for rows.Next() {
-				var streamID uuid.UUID
-				var segment DeletedSegmentInfo
-				var aliasPieces AliasPieces
-				err := rows.Scan(&streamID, &segment.RootPieceID, &aliasPieces)
-				if err != nil {
-					return Error.Wrap(err)
-				}
-				segment.Pieces, err = db.aliasCache.ConvertAliasesToPieces(ctx, aliasPieces)
-				if err != nil {
-					return Error.Wrap(err)
+		var segmentsToDelete []DeletedSegmentInfo
+		err = txutil.WithTx(ctx, db.db, nil, func(ctx context.Context, tx tagsql.Tx) (err error) {
+			deletedObjects = deletedObjects[:0]
+			deletedSegments = deletedSegments[:0]
+			deletedSegmentStreamIDs = deletedSegmentStreamIDs[:0]
+			err = withRows(tx.QueryContext(ctx, query,
+				opts.Bucket.ProjectID, []byte(opts.Bucket.BucketName), opts.BatchSize))(func(rows tagsql.Rows) error {
+				seen := map[uuid.UUID]struct{}{} // TODO: avoid map here
+				for rows.Next() {
+					object := Object{ObjectStream: ObjectStream{
+						ProjectID:  opts.Bucket.ProjectID,
+						BucketName: opts.Bucket.BucketName,
+					}}
+					var rootPieceID *storj.PieceID
+					var aliasPieces AliasPieces
+					err := rows.Scan(&object.ObjectKey, &object.Version, &object.StreamID, &object.Status, &rootPieceID, &aliasPieces)
+					if err != nil {
+						return Error.Wrap(err)
+					}
+					if _, ok := seen[object.StreamID]; !ok {
+						seen[object.StreamID] = struct{}{}
+						deletedObjects = append(deletedObjects, object)
+					}
+					if rootPieceID == nil {
+						continue
+					}
+
+					segment := DeletedSegmentInfo{RootPieceID: *rootPieceID}
+					segment.Pieces, err = db.aliasCache.ConvertAliasesToPieces(ctx, aliasPieces)
+					if err != nil {
+						return Error.Wrap(err)
+					}
+					deletedSegments = append(deletedSegments, segment)
+					deletedSegmentStreamIDs = append(deletedSegmentStreamIDs, object.StreamID)
 				}
+				return nil
+			})
+			if err != nil {
+				return err
+			}
 
-				ids[streamID] = struct{}{}
-				deletedSegments = append(deletedSegments, segment)
+			segmentsToDelete, err = db.excludeSharedSegments(ctx, tx, deletedSegments, deletedSegmentStreamIDs)
+			if err != nil {
+				return err
 			}
-			deletedObjects = len(ids)
-			deletedObjectCount += int64(deletedObjects)
-			return nil
+
+			return db.recordBucketEvents(ctx, tx, deletedObjectEvents(deletedObjects)...)
 		})
 
-		mon.Meter("object_delete").Mark(deletedObjects)
+		mon.Meter("object_delete").Mark(len(deletedObjects))
 		mon.Meter("segment_delete").Mark(len(deletedSegments))
 
 		if err != nil {
@@ -114,13 +164,14 @@ func (db *DB) DeleteBucketObjects(ctx context.Context, opts DeleteBucketObjects)
 			}
 			return deletedObjectCount, Error.Wrap(err)
 		}
+		deletedObjectCount += int64(len(deletedObjects))
 
-		if len(deletedSegments) == 0 {
+		if len(deletedObjects) == 0 {
 			return deletedObjectCount, nil
 		}
 
-		if opts.DeletePieces != nil {
-			err = opts.DeletePieces(ctx, deletedSegments)
+		if opts.DeletePieces != nil && len(segmentsToDelete) > 0 {
+			err = opts.DeletePieces(ctx, segmentsToDelete)
 			if err != nil {
 				return deletedObjectCount, Error.Wrap(err)
 			}
@@ -12,7 +12,10 @@ import (
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
 
+	"storj.io/common/uuid"
+	"storj.io/private/dbutil/pgutil"
 	"storj.io/private/dbutil/pgxutil"
+	"storj.io/private/dbutil/txutil"
 	"storj.io/private/tagsql"
 )
 
@@ -28,6 +31,7 @@ type DeleteExpiredObjects struct {
 }
 
 // DeleteExpiredObjects deletes all objects that expired before expiredBefore.
+// Objects protected by object lock are kept until the lock is lifted.
 func (db *DB) DeleteExpiredObjects(ctx context.Context, opts DeleteExpiredObjects) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
@@ -41,6 +45,7 @@ func (db *DB) DeleteExpiredObjects(ctx context.Context, opts DeleteExpiredObject
 			WHERE
 				(project_id, bucket_name, object_key, version) > ($1, $2, $3, $4)
 				AND expires_at < $5
+				AND ` + objectNotLocked + `
 				ORDER BY project_id, bucket_name, object_key, version
 			LIMIT $6;`
 
@@ -149,6 +154,114 @@ func (db *DB) DeleteZombieObjects(ctx context.Context, opts DeleteZombieObjects)
 	})
 }
 
+// DeleteObjectsCreatedBefore contains all the information necessary to delete objects
+// of a bucket, which were created before a deadline.
+type DeleteObjectsCreatedBefore struct {
+	Bucket BucketLocation
+	// Prefix limits the deletion to objects whose key starts with it.
+	Prefix ObjectKey
+	// Status selects whether committed or pending objects are deleted.
+	// Deleting committed objects also deletes delete markers.
+	Status        ObjectStatus
+	CreatedBefore time.Time
+
+	AsOfSystemTime time.Time
+	BatchSize      int
+}
+
+// Verify verifies delete objects created before request fields.
+func (opts *DeleteObjectsCreatedBefore) Verify() error {
+	if err := opts.Bucket.Verify(); err != nil {
+		return err
+	}
+	switch {
+	case opts.Status != Committed && opts.Status != Pending:
+		return ErrInvalidRequest.New("Status invalid: %v", opts.Status)
+	case opts.CreatedBefore.IsZero():
+		return ErrInvalidRequest.New("CreatedBefore missing")
+	}
+	return nil
+}
+
+// DeleteObjectsCreatedBefore deletes all objects of a bucket with the specified status,
+// which were created before CreatedBefore. Objects protected by object lock are kept.
+func (db *DB) DeleteObjectsCreatedBefore(ctx context.Context, opts DeleteObjectsCreatedBefore) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := opts.Verify(); err != nil {
+		return err
+	}
+
+	statusFilter := `status = ` + pendingStatus
+	if opts.Status == Committed {
+		statusFilter = `status IN (` + committedStatus + `, ` + deleteMarkerStatus + `)`
+	}
+
+	prefixFilter := ""
+	if opts.Prefix != "" {
+		prefixFilter = `AND object_key >= $7 AND object_key < $8`
+	}
+
+	return db.deleteObjectsAndSegmentsBatch(ctx, opts.BatchSize, func(startAfter ObjectStream, batchsize int) (last ObjectStream, err error) {
+		query := `
+			SELECT
+				project_id, bucket_name, object_key, version, stream_id
+			FROM objects
+			` + db.impl.AsOfSystemTime(opts.AsOfSystemTime) + `
+			WHERE
+				project_id = $1 AND bucket_name = $2
+				AND (object_key, version) > ($3, $4)
+				AND ` + statusFilter + `
+				AND created_at < $5
+				AND ` + objectNotLocked + `
+				` + prefixFilter + `
+				ORDER BY project_id, bucket_name, object_key, version
+			LIMIT $6;`
+
+		args := []interface{}{
+			opts.Bucket.ProjectID, []byte(opts.Bucket.BucketName),
+			[]byte(startAfter.ObjectKey), startAfter.Version,
+			opts.CreatedBefore,
+			batchsize,
+		}
+		if opts.Prefix != "" {
+			args = append(args, []byte(opts.Prefix), []byte(prefixLimit(opts.Prefix)))
+		}
+
+		objects := make([]ObjectStream, 0, batchsize)
+
+		err = withRows(db.db.QueryContext(ctx, query, args...))(func(rows tagsql.Rows) error {
+			for rows.Next() {
+				err = rows.Scan(&last.ProjectID, &last.BucketName, &last.ObjectKey, &last.Version, &last.StreamID)
+				if err != nil {
+					return Error.New("unable to delete objects: %w", err)
+				}
+
+				db.log.Debug("Deleting object by lifecycle rule",
+					zap.Stringer("Project", last.ProjectID),
+					zap.String("Bucket", last.BucketName),
+					zap.String("Object Key", string(last.ObjectKey)),
+					zap.Int64("Version", int64(last.Version)),
+					zap.String("StreamID", hex.EncodeToString(last.StreamID[:])),
+				)
+				objects = append(objects, last)
+			}
+
+			return nil
+		})
+		if err != nil {
+			return ObjectStream{}, Error.New("unable to delete objects: %w", err)
+		}
+
+		err = db.deleteObjectsAndSegments(ctx, objects)
+		if err != nil {
+			return ObjectStream{}, err
+		}
+
+		return last, nil
+	})
+}
+
 func (db *DB) deleteObjectsAndSegmentsBatch(ctx context.Context, batchsize int, deleteBatch func(startAfter ObjectStream, batchsize int) (last ObjectStream, err error)) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
@@ -167,6 +280,8 @@ func (db *DB) deleteObjectsAndSegmentsBatch(ctx context.Context, batchsize int,
 	}
 }
 
+// deleteObjectsAndSegments deletes the objects, which aren't protected by object lock,
+// together with their segments and records the events of the deleted objects.
 func (db *DB) deleteObjectsAndSegments(ctx context.Context, objects []ObjectStream) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
@@ -174,50 +289,70 @@ func (db *DB) deleteObjectsAndSegments(ctx context.Context, objects []ObjectStre
 		return nil
 	}
 
-	err = pgxutil.Conn(ctx, db.db, func(conn *pgx.Conn) error {
-		var batch pgx.Batch
-		for _, obj := range objects {
-			obj := obj
+	var projectIDs []uuid.UUID
+	var bucketNames, objectKeys [][]byte
+	var versions []int64
+	var streamIDs []uuid.UUID
+	for _, obj := range objects {
+		projectIDs = append(projectIDs, obj.ProjectID)
+		bucketNames = append(bucketNames, []byte(obj.BucketName))
+		objectKeys = append(objectKeys, []byte(obj.ObjectKey))
+		versions = append(versions, int64(obj.Version))
+		streamIDs = append(streamIDs, obj.StreamID)
+	}
 
-			batch.Queue(`
-				WITH deleted_objects AS (
-					DELETE FROM objects
-					WHERE (project_id, bucket_name, object_key, version, stream_id) = ($1::BYTEA, $2, $3, $4, $5::BYTEA)
-					RETURNING stream_id
-				)
+	var deleted []Object
+	var segmentsDeleted int64
+	err = txutil.WithTx(ctx, db.db, nil, func(ctx context.Context, tx tagsql.Tx) (err error) {
+		deleted, segmentsDeleted = nil, 0
+		err = withRows(tx.QueryContext(ctx, `
+			WITH deleted_objects AS (
+				DELETE FROM objects
+				WHERE
+					(project_id, bucket_name, object_key, version, stream_id) IN (
+						SELECT unnest($1::BYTEA[]), unnest($2::BYTEA[]), unnest($3::BYTEA[]), unnest($4::INT8[]), unnest($5::BYTEA[])
+					) AND
+					`+objectNotLocked+`
+				RETURNING project_id, bucket_name, object_key, version, stream_id, status
+			), deleted_copies AS (
+				DELETE FROM segment_copies
+				WHERE segment_copies.stream_id IN (SELECT deleted_objects.stream_id FROM deleted_objects)
+			), deleted_segments AS (
 				DELETE FROM segments
-				WHERE segments.stream_id = $5::BYTEA
-			`, obj.ProjectID, []byte(obj.BucketName), []byte(obj.ObjectKey), obj.Version, obj.StreamID)
-		}
-
-		results := conn.SendBatch(ctx, &batch)
-		defer func() { err = errs.Combine(err, results.Close()) }()
-
-		var objectsDeletedGuess, segmentsDeleted int64
-
-		var errlist errs.Group
-		for i := 0; i < batch.Len(); i++ {
-			result, err := results.Exec()
-			errlist.Add(err)
-
-			if affectedSegmentCount := result.RowsAffected(); affectedSegmentCount > 0 {
-				// Note, this slightly miscounts objects without any segments
-				// there doesn't seem to be a simple work around for this.
-				// Luckily, this is used only for metrics, where it's not a
-				// significant problem to slightly miscount.
-				objectsDeletedGuess++
-				segmentsDeleted += affectedSegmentCount
+				WHERE segments.stream_id IN (SELECT deleted_objects.stream_id FROM deleted_objects)
+				RETURNING 1
+			)
+			SELECT
+				project_id, bucket_name, object_key, version, stream_id, status,
+				(SELECT count(*) FROM deleted_segments)
+			FROM deleted_objects
+		`, pgutil.UUIDArray(projectIDs), pgutil.ByteaArray(bucketNames), pgutil.ByteaArray(objectKeys),
+			pgutil.Int8Array(versions), pgutil.UUIDArray(streamIDs)))(func(rows tagsql.Rows) error {
+			for rows.Next() {
+				var object Object
+				err := rows.Scan(
+					&object.ProjectID, &object.BucketName, &object.ObjectKey, &object.Version, &object.StreamID, &object.Status,
+					&segmentsDeleted)
+				if err != nil {
+					return err
+				}
+				deleted = append(deleted, object)
 			}
+			return nil
+		})
+		if err != nil {
+			return err
 		}
 
-		mon.Meter("object_delete").Mark64(objectsDeletedGuess)
-		mon.Meter("segment_delete").Mark64(segmentsDeleted)
-
-		return errlist.Err()
+		return db.recordBucketEvents(ctx, tx, deletedObjectEvents(deleted)...)
 	})
 	if err != nil {
 		return Error.New("unable to delete expired objects: %w", err)
 	}
+
+	mon.Meter("object_delete").Mark(len(deleted))
+	mon.Meter("segment_delete").Mark64(segmentsDeleted)
+
 	return nil
 }
 
@@ -458,3 +458,103 @@ func TestDeleteZombieObjects(t *testing.T) {
 		})
 	})
 }
+
+func TestDeleteObjectsCreatedBefore(t *testing.T) {
+	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		obj := metabasetest.RandObjectStream()
+		bucket := obj.Location().Bucket()
+
+		now := time.Now()
+
+		t.Run("invalid status", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			metabasetest.DeleteObjectsCreatedBefore{
+				Opts: metabase.DeleteObjectsCreatedBefore{
+					Bucket:        bucket,
+					Status:        metabase.DeleteMarker,
+					CreatedBefore: now,
+				},
+				ErrClass: &metabase.ErrInvalidRequest,
+				ErrText:  "Status invalid: 4",
+			}.Check(ctx, t, db)
+		})
+
+		t.Run("nothing old enough", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			object := metabasetest.CreateObject(ctx, t, db, obj, 0)
+
+			metabasetest.DeleteObjectsCreatedBefore{
+				Opts: metabase.DeleteObjectsCreatedBefore{
+					Bucket:        bucket,
+					Status:        metabase.Committed,
+					CreatedBefore: now.Add(-time.Hour),
+				},
+			}.Check(ctx, t, db)
+
+			metabasetest.Verify{
+				Objects: []metabase.RawObject{
+					metabase.RawObject(object),
+				},
+			}.Check(ctx, t, db)
+		})
+
+		t.Run("prefix and status", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			expired := obj
+			expired.ObjectKey = "a/expired"
+			metabasetest.CreateObject(ctx, t, db, expired, 0)
+
+			other := obj
+			other.ObjectKey = "b/kept"
+			other.StreamID = testrand.UUID()
+			otherObject := metabasetest.CreateObject(ctx, t, db, other, 0)
+
+			pending := obj
+			pending.ObjectKey = "a/pending"
+			pending.StreamID = testrand.UUID()
+			metabasetest.CreatePendingObject(ctx, t, db, pending, 0)
+
+			metabasetest.DeleteObjectsCreatedBefore{
+				Opts: metabase.DeleteObjectsCreatedBefore{
+					Bucket:        bucket,
+					Prefix:        "a/",
+					Status:        metabase.Committed,
+					CreatedBefore: now.Add(time.Hour),
+				},
+			}.Check(ctx, t, db)
+
+			zombieDeadline := now.Add(24 * time.Hour)
+			metabasetest.Verify{
+				Objects: []metabase.RawObject{
+					metabase.RawObject(otherObject),
+					{
+						ObjectStream: pending,
+						CreatedAt:    now,
+						Status:       metabase.Pending,
+
+						Encryption:             metabasetest.DefaultEncryption,
+						ZombieDeletionDeadline: &zombieDeadline,
+					},
+				},
+			}.Check(ctx, t, db)
+
+			metabasetest.DeleteObjectsCreatedBefore{
+				Opts: metabase.DeleteObjectsCreatedBefore{
+					Bucket:        bucket,
+					Prefix:        "a/",
+					Status:        metabase.Pending,
+					CreatedBefore: now.Add(time.Hour),
+				},
+			}.Check(ctx, t, db)
+
+			metabasetest.Verify{
+				Objects: []metabase.RawObject{
+					metabase.RawObject(otherObject),
+				},
+			}.Check(ctx, t, db)
+		})
+	})
+}
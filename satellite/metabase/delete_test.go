@@ -705,6 +705,226 @@ func TestDeleteObjectLatestVersion(t *testing.T) {
 	})
 }
 
+func TestCreateDeleteMarker(t *testing.T) {
+	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		obj := metabasetest.RandObjectStream()
+
+		location := obj.Location()
+
+		now := time.Now()
+
+		for _, test := range metabasetest.InvalidObjectLocations(location) {
+			test := test
+			t.Run(test.Name, func(t *testing.T) {
+				defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+				metabasetest.CreateDeleteMarker{
+					Opts: metabase.CreateDeleteMarker{
+						ObjectLocation: test.ObjectLocation,
+					},
+					ErrClass: test.ErrClass,
+					ErrText:  test.ErrText,
+				}.Check(ctx, t, db)
+				metabasetest.Verify{}.Check(ctx, t, db)
+			})
+		}
+
+		t.Run("Object missing", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			marker := metabasetest.CreateDeleteMarker{
+				Opts:    metabase.CreateDeleteMarker{ObjectLocation: location},
+				Version: 1,
+			}.Check(ctx, t, db)
+
+			metabasetest.Verify{
+				Objects: []metabase.RawObject{
+					{
+						ObjectStream: marker.ObjectStream,
+						CreatedAt:    now,
+						Status:       metabase.DeleteMarker,
+					},
+				},
+			}.Check(ctx, t, db)
+		})
+
+		t.Run("Hide committed object", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			object := metabasetest.CreateObject(ctx, t, db, obj, 0)
+
+			marker := metabasetest.CreateDeleteMarker{
+				Opts:    metabase.CreateDeleteMarker{ObjectLocation: location},
+				Version: obj.Version + 1,
+			}.Check(ctx, t, db)
+
+			metabasetest.GetObjectLatestVersion{
+				Opts:     metabase.GetObjectLatestVersion{ObjectLocation: location},
+				ErrClass: &storj.ErrObjectNotFound,
+				ErrText:  "metabase: latest version is a delete marker",
+			}.Check(ctx, t, db)
+
+			metabasetest.GetObjectExactVersion{
+				Opts: metabase.GetObjectExactVersion{
+					ObjectLocation: location,
+					Version:        obj.Version,
+				},
+				Result: object,
+			}.Check(ctx, t, db)
+
+			metabasetest.Verify{
+				Objects: []metabase.RawObject{
+					metabase.RawObject(object),
+					{
+						ObjectStream: marker.ObjectStream,
+						CreatedAt:    now,
+						Status:       metabase.DeleteMarker,
+					},
+				},
+			}.Check(ctx, t, db)
+		})
+
+		t.Run("Delete delete marker", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			object := metabasetest.CreateObject(ctx, t, db, obj, 0)
+
+			marker := metabasetest.CreateDeleteMarker{
+				Opts:    metabase.CreateDeleteMarker{ObjectLocation: location},
+				Version: obj.Version + 1,
+			}.Check(ctx, t, db)
+
+			metabasetest.DeleteObjectExactVersion{
+				Opts: metabase.DeleteObjectExactVersion{
+					ObjectLocation: location,
+					Version:        marker.Version,
+				},
+				Result: metabase.DeleteObjectResult{
+					Objects: []metabase.Object{marker},
+				},
+			}.Check(ctx, t, db)
+
+			metabasetest.GetObjectLatestVersion{
+				Opts:   metabase.GetObjectLatestVersion{ObjectLocation: location},
+				Result: object,
+			}.Check(ctx, t, db)
+
+			metabasetest.Verify{
+				Objects: []metabase.RawObject{
+					metabase.RawObject(object),
+				},
+			}.Check(ctx, t, db)
+		})
+
+		t.Run("Upload after delete marker", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			object := metabasetest.CreateObject(ctx, t, db, obj, 0)
+
+			marker := metabasetest.CreateDeleteMarker{
+				Opts:    metabase.CreateDeleteMarker{ObjectLocation: location},
+				Version: obj.Version + 1,
+			}.Check(ctx, t, db)
+
+			newObj := obj
+			newObj.Version = marker.Version + 1
+			newObj.StreamID = testrand.UUID()
+			newObject := metabasetest.CreateObject(ctx, t, db, newObj, 0)
+
+			metabasetest.GetObjectLatestVersion{
+				Opts:   metabase.GetObjectLatestVersion{ObjectLocation: location},
+				Result: newObject,
+			}.Check(ctx, t, db)
+
+			metabasetest.Verify{
+				Objects: []metabase.RawObject{
+					metabase.RawObject(object),
+					{
+						ObjectStream: marker.ObjectStream,
+						CreatedAt:    now,
+						Status:       metabase.DeleteMarker,
+					},
+					metabase.RawObject(newObject),
+				},
+			}.Check(ctx, t, db)
+		})
+	})
+}
+
+func TestDeleteObjectNullVersion(t *testing.T) {
+	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		obj := metabasetest.RandObjectStream()
+
+		location := obj.Location()
+
+		now := time.Now()
+
+		for _, test := range metabasetest.InvalidObjectLocations(location) {
+			test := test
+			t.Run(test.Name, func(t *testing.T) {
+				defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+				metabasetest.DeleteObjectNullVersion{
+					Opts: metabase.DeleteObjectNullVersion{
+						ObjectLocation: test.ObjectLocation,
+					},
+					ErrClass: test.ErrClass,
+					ErrText:  test.ErrText,
+				}.Check(ctx, t, db)
+				metabasetest.Verify{}.Check(ctx, t, db)
+			})
+		}
+
+		t.Run("Object missing", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			metabasetest.DeleteObjectNullVersion{
+				Opts:     metabase.DeleteObjectNullVersion{ObjectLocation: location},
+				ErrClass: &storj.ErrObjectNotFound,
+				ErrText:  "metabase: no rows deleted",
+			}.Check(ctx, t, db)
+			metabasetest.Verify{}.Check(ctx, t, db)
+		})
+
+		t.Run("Keep versioned versions", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			object := metabasetest.CreateObject(ctx, t, db, obj, 0)
+
+			marker := metabasetest.CreateDeleteMarker{
+				Opts: metabase.CreateDeleteMarker{
+					ObjectLocation: location,
+					Versioned:      true,
+				},
+				Version: obj.Version + 1,
+			}.Check(ctx, t, db)
+
+			metabasetest.DeleteObjectNullVersion{
+				Opts: metabase.DeleteObjectNullVersion{ObjectLocation: location},
+				Result: metabase.DeleteObjectResult{
+					Objects: []metabase.Object{object},
+				},
+			}.Check(ctx, t, db)
+
+			metabasetest.Verify{
+				Objects: []metabase.RawObject{
+					{
+						ObjectStream: marker.ObjectStream,
+						CreatedAt:    now,
+						Status:       metabase.DeleteMarker,
+						Versioned:    true,
+					},
+				},
+			}.Check(ctx, t, db)
+
+			// only the versioned delete marker is left
+			metabasetest.DeleteObjectNullVersion{
+				Opts:     metabase.DeleteObjectNullVersion{ObjectLocation: location},
+				ErrClass: &storj.ErrObjectNotFound,
+				ErrText:  "metabase: no rows deleted",
+			}.Check(ctx, t, db)
+		})
+	})
+}
+
 func TestDeleteObjectAnyStatusAllVersions(t *testing.T) {
 	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
 		obj := metabasetest.RandObjectStream()
@@ -79,7 +79,9 @@ func (db *DB) GetObjectExactVersion(ctx context.Context, opts GetObjectExactVers
 			segment_count,
 			encrypted_metadata_nonce, encrypted_metadata, encrypted_metadata_encrypted_key,
 			total_plain_size, total_encrypted_size, fixed_segment_size,
-			encryption
+			encryption,
+			retain_until, legal_hold,
+			versioned
 		FROM objects
 		WHERE
 			project_id   = $1 AND
@@ -95,6 +97,8 @@ func (db *DB) GetObjectExactVersion(ctx context.Context, opts GetObjectExactVers
 			&object.EncryptedMetadataNonce, &object.EncryptedMetadata, &object.EncryptedMetadataEncryptedKey,
 			&object.TotalPlainSize, &object.TotalEncryptedSize, &object.FixedSegmentSize,
 			encryptionParameters{&object.Encryption},
+			&object.RetainUntil, &object.LegalHold,
+			&object.Versioned,
 		)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -120,6 +124,8 @@ type GetObjectLatestVersion struct {
 }
 
 // GetObjectLatestVersion returns object information for latest version.
+//
+// When the latest version is a delete marker the object is reported as not found.
 func (db *DB) GetObjectLatestVersion(ctx context.Context, opts GetObjectLatestVersion) (_ Object, err error) {
 	defer mon.Task()(&ctx)(&err)
 
@@ -130,28 +136,32 @@ func (db *DB) GetObjectLatestVersion(ctx context.Context, opts GetObjectLatestVe
 	object := Object{}
 	err = db.db.QueryRowContext(ctx, `
 		SELECT
-			stream_id, version,
+			stream_id, version, status,
 			created_at, expires_at,
 			segment_count,
 			encrypted_metadata_nonce, encrypted_metadata, encrypted_metadata_encrypted_key,
 			total_plain_size, total_encrypted_size, fixed_segment_size,
-			encryption
+			encryption,
+			retain_until, legal_hold,
+			versioned
 		FROM objects
 		WHERE
 			project_id   = $1 AND
 			bucket_name  = $2 AND
 			object_key   = $3 AND
-			status       = `+committedStatus+`
+			status       IN (`+committedStatus+`, `+deleteMarkerStatus+`)
 		ORDER BY version desc
 		LIMIT 1
 	`, opts.ProjectID, []byte(opts.BucketName), opts.ObjectKey).
 		Scan(
-			&object.StreamID, &object.Version,
+			&object.StreamID, &object.Version, &object.Status,
 			&object.CreatedAt, &object.ExpiresAt,
 			&object.SegmentCount,
 			&object.EncryptedMetadataNonce, &object.EncryptedMetadata, &object.EncryptedMetadataEncryptedKey,
 			&object.TotalPlainSize, &object.TotalEncryptedSize, &object.FixedSegmentSize,
 			encryptionParameters{&object.Encryption},
+			&object.RetainUntil, &object.LegalHold,
+			&object.Versioned,
 		)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -160,12 +170,14 @@ func (db *DB) GetObjectLatestVersion(ctx context.Context, opts GetObjectLatestVe
 		return Object{}, Error.New("unable to query object status: %w", err)
 	}
 
+	if object.Status == DeleteMarker {
+		return Object{}, storj.ErrObjectNotFound.Wrap(Error.New("latest version is a delete marker"))
+	}
+
 	object.ProjectID = opts.ProjectID
 	object.BucketName = opts.BucketName
 	object.ObjectKey = opts.ObjectKey
 
-	object.Status = Committed
-
 	return object, nil
 }
 
@@ -324,7 +336,7 @@ func (db *DB) GetLatestObjectLastSegment(ctx context.Context, opts GetLatestObje
 				project_id   = $1 AND
 				bucket_name  = $2 AND
 				object_key   = $3 AND
-				status       = `+committedStatus+`
+				status       IN (`+committedStatus+`, `+deleteMarkerStatus+`)
 				ORDER BY version DESC
 				LIMIT 1
 			)
@@ -27,6 +27,9 @@ type objectsIterator struct {
 	recursive             bool
 	includeCustomMetadata bool
 	includeSystemMetadata bool
+	// latestOnly skips versions which are not the latest committed version
+	// of the object or which are hidden by a delete marker.
+	latestOnly bool
 
 	curIndex int
 	curRows  tagsql.Rows
@@ -108,6 +111,45 @@ func iterateAllVersionsWithStatus(ctx context.Context, db *DB, opts IterateObjec
 	return iterate(ctx, it, fn)
 }
 
+func iterateLatestVersions(ctx context.Context, db *DB, opts IterateObjectsWithStatus, fn func(context.Context, ObjectsIterator) error) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	it := &objectsIterator{
+		db: db,
+
+		projectID:             opts.ProjectID,
+		bucketName:            []byte(opts.BucketName),
+		status:                opts.Status,
+		prefix:                opts.Prefix,
+		prefixLimit:           prefixLimit(opts.Prefix),
+		batchSize:             opts.BatchSize,
+		recursive:             opts.Recursive,
+		includeCustomMetadata: opts.IncludeCustomMetadata,
+		includeSystemMetadata: opts.IncludeSystemMetadata,
+		latestOnly:            true,
+
+		curIndex: 0,
+		cursor:   firstIterateCursor(opts.Recursive, opts.Cursor, opts.Prefix),
+
+		doNextQuery: doNextQueryAllVersionsWithStatus,
+	}
+
+	// only a single version is listed per object key, so the cursor
+	// has to skip all versions of the object it points to.
+	if !it.cursor.Inclusive {
+		it.cursor.Version = maxVersion
+	}
+
+	// start from either the cursor or prefix, depending on which is larger
+	if lessKey(it.cursor.Key, opts.Prefix) {
+		it.cursor.Key = opts.Prefix
+		it.cursor.Version = -1
+		it.cursor.Inclusive = true
+	}
+
+	return iterate(ctx, it, fn)
+}
+
 func iteratePendingObjectsByKey(ctx context.Context, db *DB, opts IteratePendingObjectsByKey, fn func(context.Context, ObjectsIterator) error) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
@@ -337,6 +379,20 @@ func doNextQueryAllVersionsWithStatus(ctx context.Context, it *objectsIterator)
 		cursorCompare = ">="
 	}
 
+	latestFilter := ""
+	if it.latestOnly {
+		latestFilter = `
+			AND NOT EXISTS (
+				SELECT 1 FROM objects AS newer
+				WHERE
+					newer.project_id  = objects.project_id AND
+					newer.bucket_name = objects.bucket_name AND
+					newer.object_key  = objects.object_key AND
+					newer.version     > objects.version AND
+					newer.status      IN (` + committedStatus + `, ` + deleteMarkerStatus + `)
+			)`
+	}
+
 	if it.prefixLimit == "" {
 		return it.db.db.QueryContext(ctx, `
 			SELECT
@@ -346,6 +402,7 @@ func doNextQueryAllVersionsWithStatus(ctx context.Context, it *objectsIterator)
 				(project_id, bucket_name, object_key, version) `+cursorCompare+` ($1, $2, $4, $5)
 				AND (project_id, bucket_name) < ($1, $7)
 				AND status = $3
+				`+latestFilter+`
 				ORDER BY (project_id, bucket_name, object_key, version) ASC
 			LIMIT $6
 			`, it.projectID, it.bucketName,
@@ -366,6 +423,7 @@ func doNextQueryAllVersionsWithStatus(ctx context.Context, it *objectsIterator)
 			(project_id, bucket_name, object_key, version) `+cursorCompare+` ($1, $2, $4, $5)
 			AND (project_id, bucket_name, object_key) < ($1, $2, $6)
 			AND status = $3
+			`+latestFilter+`
 			ORDER BY (project_id, bucket_name, object_key, version) ASC
 		LIMIT $7
 	`, it.projectID, it.bucketName,
@@ -1260,6 +1260,145 @@ func TestIterateObjectsWithStatus(t *testing.T) {
 	})
 }
 
+func TestIterateObjectsLatestVersion(t *testing.T) {
+	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		t.Run("Status is invalid", func(t *testing.T) {
+			metabasetest.IterateObjectsLatestVersion{
+				Opts: metabase.IterateObjectsWithStatus{
+					ProjectID:  uuid.UUID{1},
+					BucketName: "test",
+					Recursive:  true,
+					Status:     metabase.Pending,
+				},
+				ErrClass: &metabase.ErrInvalidRequest,
+				ErrText:  "Status 1 is not supported",
+			}.Check(ctx, t, db)
+		})
+
+		t.Run("versions and delete markers", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			now := time.Now()
+
+			projectID, bucketName := uuid.UUID{1}, "bucky"
+
+			// "a" has two versions, only the second one should be listed.
+			a1 := metabasetest.RandObjectStream()
+			a1.ProjectID, a1.BucketName, a1.ObjectKey = projectID, bucketName, "a"
+			metabasetest.CreateObject(ctx, t, db, a1, 0)
+			a2 := a1
+			a2.Version, a2.StreamID = 2, testrand.UUID()
+			metabasetest.CreateObject(ctx, t, db, a2, 0)
+
+			// "b" is hidden by a delete marker.
+			b := metabasetest.RandObjectStream()
+			b.ProjectID, b.BucketName, b.ObjectKey = projectID, bucketName, "b"
+			metabasetest.CreateObject(ctx, t, db, b, 0)
+			bMarker := metabasetest.CreateDeleteMarker{
+				Opts:    metabase.CreateDeleteMarker{ObjectLocation: b.Location()},
+				Version: b.Version + 1,
+			}.Check(ctx, t, db)
+
+			// "c" was uploaded again after a delete marker.
+			c1 := metabasetest.RandObjectStream()
+			c1.ProjectID, c1.BucketName, c1.ObjectKey = projectID, bucketName, "c"
+			metabasetest.CreateObject(ctx, t, db, c1, 0)
+			cMarker := metabasetest.CreateDeleteMarker{
+				Opts:    metabase.CreateDeleteMarker{ObjectLocation: c1.Location()},
+				Version: c1.Version + 1,
+			}.Check(ctx, t, db)
+			c3 := c1
+			c3.Version, c3.StreamID = cMarker.Version+1, testrand.UUID()
+			metabasetest.CreateObject(ctx, t, db, c3, 0)
+
+			entry := func(obj metabase.ObjectStream) metabase.ObjectEntry {
+				return metabase.ObjectEntry{
+					ObjectKey:  obj.ObjectKey,
+					Version:    obj.Version,
+					StreamID:   obj.StreamID,
+					CreatedAt:  now,
+					Status:     metabase.Committed,
+					Encryption: metabasetest.DefaultEncryption,
+				}
+			}
+
+			metabasetest.IterateObjectsLatestVersion{
+				Opts: metabase.IterateObjectsWithStatus{
+					ProjectID:             projectID,
+					BucketName:            bucketName,
+					Recursive:             true,
+					Status:                metabase.Committed,
+					IncludeSystemMetadata: true,
+				},
+				Result: []metabase.ObjectEntry{entry(a2), entry(c3)},
+			}.Check(ctx, t, db)
+
+			metabasetest.IterateObjectsLatestVersion{
+				Opts: metabase.IterateObjectsWithStatus{
+					ProjectID:             projectID,
+					BucketName:            bucketName,
+					BatchSize:             1,
+					Recursive:             true,
+					Status:                metabase.Committed,
+					IncludeSystemMetadata: true,
+				},
+				Result: []metabase.ObjectEntry{entry(a2), entry(c3)},
+			}.Check(ctx, t, db)
+
+			metabasetest.IterateObjectsLatestVersion{
+				Opts: metabase.IterateObjectsWithStatus{
+					ProjectID:             projectID,
+					BucketName:            bucketName,
+					Cursor:                metabase.IterateCursor{Key: "a", Version: 1},
+					Recursive:             true,
+					Status:                metabase.Committed,
+					IncludeSystemMetadata: true,
+				},
+				Result: []metabase.ObjectEntry{entry(c3)},
+			}.Check(ctx, t, db)
+
+			// all versions are still listed when iterating without the latest filter.
+			metabasetest.IterateObjectsWithStatus{
+				Opts: metabase.IterateObjectsWithStatus{
+					ProjectID:             projectID,
+					BucketName:            bucketName,
+					Recursive:             true,
+					Status:                metabase.Committed,
+					IncludeSystemMetadata: true,
+				},
+				Result: []metabase.ObjectEntry{entry(a1), entry(a2), entry(b), entry(c1), entry(c3)},
+			}.Check(ctx, t, db)
+
+			metabasetest.IterateObjects{
+				Opts: metabase.IterateObjects{
+					ProjectID:  projectID,
+					BucketName: bucketName,
+				},
+				Result: []metabase.ObjectEntry{
+					entry(a1), entry(a2),
+					entry(b),
+					{
+						ObjectKey: "b",
+						Version:   bMarker.Version,
+						StreamID:  bMarker.StreamID,
+						CreatedAt: now,
+						Status:    metabase.DeleteMarker,
+					},
+					entry(c1),
+					{
+						ObjectKey: "c",
+						Version:   cMarker.Version,
+						StreamID:  cMarker.StreamID,
+						CreatedAt: now,
+						Status:    metabase.DeleteMarker,
+					},
+					entry(c3),
+				},
+			}.Check(ctx, t, db)
+		})
+	})
+}
+
 func TestIterateObjectsSkipCursor(t *testing.T) {
 	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
 		projectID, bucketName := uuid.UUID{1}, "bucky"
@@ -114,6 +114,19 @@ func (db *DB) IterateObjectsAllVersionsWithStatus(ctx context.Context, opts Iter
 	return iterateAllVersionsWithStatus(ctx, db, opts, fn)
 }
 
+// IterateObjectsLatestVersion iterates through the latest committed version of all objects.
+// Objects which latest version is a delete marker are skipped.
+func (db *DB) IterateObjectsLatestVersion(ctx context.Context, opts IterateObjectsWithStatus, fn func(context.Context, ObjectsIterator) error) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	if err = opts.Verify(); err != nil {
+		return err
+	}
+	if opts.Status != Committed {
+		return ErrInvalidRequest.New("Status %v is not supported", opts.Status)
+	}
+	return iterateLatestVersions(ctx, db, opts, fn)
+}
+
 // Verify verifies get object request fields.
 func (opts *IterateObjectsWithStatus) Verify() error {
 	switch {
@@ -39,6 +39,8 @@ func (step Verify) Check(ctx *testcontext.Context, t testing.TB, db *metabase.DB
 	sortRawObjects(step.Objects)
 	sortRawSegments(state.Segments)
 	sortRawSegments(step.Segments)
+	sortRawSegmentCopies(state.SegmentCopies)
+	sortRawSegmentCopies(step.SegmentCopies)
 
 	diff := cmp.Diff(metabase.RawState(step), *state,
 		cmpopts.EquateApproxTime(5*time.Second))
@@ -63,12 +65,30 @@ func sortRawSegments(segments []metabase.RawSegment) {
 	})
 }
 
+func sortRawSegmentCopies(copies []metabase.RawSegmentCopy) {
+	sort.Slice(copies, func(i, j int) bool {
+		return bytes.Compare(copies[i].StreamID[:], copies[j].StreamID[:]) < 0
+	})
+}
+
 func sortDeletedSegments(segments []metabase.DeletedSegmentInfo) {
 	sort.Slice(segments, func(i, j int) bool {
 		return bytes.Compare(segments[i].RootPieceID[:], segments[j].RootPieceID[:]) < 0
 	})
 }
 
+func sortBucketEvents(events []metabase.BucketEvent) {
+	sort.Slice(events, func(i, j int) bool {
+		if events[i].ObjectKey != events[j].ObjectKey {
+			return events[i].ObjectKey < events[j].ObjectKey
+		}
+		if events[i].Version != events[j].Version {
+			return events[i].Version < events[j].Version
+		}
+		return events[i].Type < events[j].Type
+	})
+}
+
 func checkError(t testing.TB, err error, errClass *errs.Class, errText string) {
 	if errClass != nil {
 		require.True(t, errClass.Has(err), "expected an error %v got %v", *errClass, err)
@@ -404,6 +404,69 @@ func (step DeleteObjectExactVersion) Check(ctx *testcontext.Context, t testing.T
 	require.Zero(t, diff)
 }
 
+// CreateDeleteMarker is for testing metabase.CreateDeleteMarker.
+type CreateDeleteMarker struct {
+	Opts     metabase.CreateDeleteMarker
+	Version  metabase.Version
+	ErrClass *errs.Class
+	ErrText  string
+}
+
+// Check runs the test.
+func (step CreateDeleteMarker) Check(ctx *testcontext.Context, t testing.TB, db *metabase.DB) metabase.Object {
+	marker, err := db.CreateDeleteMarker(ctx, step.Opts)
+	checkError(t, err, step.ErrClass, step.ErrText)
+	if step.ErrClass == nil {
+		require.Equal(t, step.Opts.ObjectLocation, marker.Location())
+		require.Equal(t, step.Version, marker.Version)
+		require.Equal(t, metabase.DeleteMarker, marker.Status)
+		require.False(t, marker.StreamID.IsZero())
+		require.WithinDuration(t, time.Now(), marker.CreatedAt, 5*time.Second)
+	}
+	return marker
+}
+
+// SetObjectRetention is for testing metabase.SetObjectRetention.
+type SetObjectRetention struct {
+	Opts     metabase.SetObjectRetention
+	ErrClass *errs.Class
+	ErrText  string
+}
+
+// Check runs the test.
+func (step SetObjectRetention) Check(ctx *testcontext.Context, t testing.TB, db *metabase.DB) {
+	err := db.SetObjectRetention(ctx, step.Opts)
+	checkError(t, err, step.ErrClass, step.ErrText)
+}
+
+// SetObjectLegalHold is for testing metabase.SetObjectLegalHold.
+type SetObjectLegalHold struct {
+	Opts     metabase.SetObjectLegalHold
+	ErrClass *errs.Class
+	ErrText  string
+}
+
+// Check runs the test.
+func (step SetObjectLegalHold) Check(ctx *testcontext.Context, t testing.TB, db *metabase.DB) {
+	err := db.SetObjectLegalHold(ctx, step.Opts)
+	checkError(t, err, step.ErrClass, step.ErrText)
+}
+
+// HasLockedObjects is for testing metabase.HasLockedObjects.
+type HasLockedObjects struct {
+	Opts     metabase.HasLockedObjects
+	Result   bool
+	ErrClass *errs.Class
+	ErrText  string
+}
+
+// Check runs the test.
+func (step HasLockedObjects) Check(ctx *testcontext.Context, t testing.TB, db *metabase.DB) {
+	result, err := db.HasLockedObjects(ctx, step.Opts)
+	checkError(t, err, step.ErrClass, step.ErrText)
+	require.Equal(t, step.Result, result)
+}
+
 // DeletePendingObject is for testing metabase.DeletePendingObject.
 type DeletePendingObject struct {
 	Opts     metabase.DeletePendingObject
@@ -450,6 +513,29 @@ func (step DeleteObjectLatestVersion) Check(ctx *testcontext.Context, t testing.
 	require.Zero(t, diff)
 }
 
+// DeleteObjectNullVersion is for testing metabase.DeleteObjectNullVersion.
+type DeleteObjectNullVersion struct {
+	Opts     metabase.DeleteObjectNullVersion
+	Result   metabase.DeleteObjectResult
+	ErrClass *errs.Class
+	ErrText  string
+}
+
+// Check runs the test.
+func (step DeleteObjectNullVersion) Check(ctx *testcontext.Context, t testing.TB, db *metabase.DB) {
+	result, err := db.DeleteObjectNullVersion(ctx, step.Opts)
+	checkError(t, err, step.ErrClass, step.ErrText)
+
+	sortObjects(result.Objects)
+	sortObjects(step.Result.Objects)
+
+	sortDeletedSegments(result.Segments)
+	sortDeletedSegments(step.Result.Segments)
+
+	diff := cmp.Diff(step.Result, result, cmpopts.EquateApproxTime(5*time.Second))
+	require.Zero(t, diff)
+}
+
 // DeleteObjectAnyStatusAllVersions is for testing metabase.DeleteObjectAnyStatusAllVersions.
 type DeleteObjectAnyStatusAllVersions struct {
 	Opts     metabase.DeleteObjectAnyStatusAllVersions
@@ -510,6 +596,20 @@ func (step DeleteExpiredObjects) Check(ctx *testcontext.Context, t testing.TB, d
 	checkError(t, err, step.ErrClass, step.ErrText)
 }
 
+// DeleteObjectsCreatedBefore is for testing metabase.DeleteObjectsCreatedBefore.
+type DeleteObjectsCreatedBefore struct {
+	Opts metabase.DeleteObjectsCreatedBefore
+
+	ErrClass *errs.Class
+	ErrText  string
+}
+
+// Check runs the test.
+func (step DeleteObjectsCreatedBefore) Check(ctx *testcontext.Context, t testing.TB, db *metabase.DB) {
+	err := db.DeleteObjectsCreatedBefore(ctx, step.Opts)
+	checkError(t, err, step.ErrClass, step.ErrText)
+}
+
 // DeleteZombieObjects is for testing metabase.DeleteZombieObjects.
 type DeleteZombieObjects struct {
 	Opts metabase.DeleteZombieObjects
@@ -616,6 +716,26 @@ func (step IterateObjectsWithStatus) Check(ctx *testcontext.Context, t testing.T
 	require.Zero(t, diff)
 }
 
+// IterateObjectsLatestVersion is for testing metabase.IterateObjectsLatestVersion.
+type IterateObjectsLatestVersion struct {
+	Opts metabase.IterateObjectsWithStatus
+
+	Result   []metabase.ObjectEntry
+	ErrClass *errs.Class
+	ErrText  string
+}
+
+// Check runs the test.
+func (step IterateObjectsLatestVersion) Check(ctx *testcontext.Context, t testing.TB, db *metabase.DB) {
+	var result IterateCollector
+
+	err := db.IterateObjectsLatestVersion(ctx, step.Opts, result.Add)
+	checkError(t, err, step.ErrClass, step.ErrText)
+
+	diff := cmp.Diff(step.Result, []metabase.ObjectEntry(result), cmpopts.EquateApproxTime(5*time.Second))
+	require.Zero(t, diff)
+}
+
 // IterateLoopObjects is for testing metabase.IterateLoopObjects.
 type IterateLoopObjects struct {
 	Opts metabase.IterateLoopObjects
@@ -712,6 +832,41 @@ func (step FinishMoveObject) Check(ctx *testcontext.Context, t testing.TB, db *m
 	checkError(t, err, step.ErrClass, step.ErrText)
 }
 
+// BeginCopyObject is for testing metabase.BeginCopyObject.
+type BeginCopyObject struct {
+	Opts     metabase.BeginCopyObject
+	Result   metabase.BeginCopyObjectResult
+	ErrClass *errs.Class
+	ErrText  string
+}
+
+// Check runs the test.
+func (step BeginCopyObject) Check(ctx *testcontext.Context, t testing.TB, db *metabase.DB) {
+	result, err := db.BeginCopyObject(ctx, step.Opts)
+	checkError(t, err, step.ErrClass, step.ErrText)
+
+	diff := cmp.Diff(step.Result, result)
+	require.Zero(t, diff)
+}
+
+// FinishCopyObject is for testing metabase.FinishCopyObject.
+type FinishCopyObject struct {
+	Opts     metabase.FinishCopyObject
+	Result   metabase.Object
+	ErrClass *errs.Class
+	ErrText  string
+}
+
+// Check runs the test.
+func (step FinishCopyObject) Check(ctx *testcontext.Context, t testing.TB, db *metabase.DB) metabase.Object {
+	result, err := db.FinishCopyObject(ctx, step.Opts)
+	checkError(t, err, step.ErrClass, step.ErrText)
+
+	diff := cmp.Diff(step.Result, result, cmpopts.EquateApproxTime(5*time.Second))
+	require.Zero(t, diff)
+	return result
+}
+
 // GetProjectSegmentCount is for testing metabase.GetProjectSegmentCount.
 type GetProjectSegmentCount struct {
 	Opts     metabase.GetProjectSegmentCount
@@ -726,3 +881,29 @@ func (step GetProjectSegmentCount) Check(ctx *testcontext.Context, t testing.TB,
 	checkError(t, err, step.ErrClass, step.ErrText)
 	require.Equal(t, step.Result, result)
 }
+
+// ListBucketEvents is for testing metabase.ListBucketEvents.
+//
+// Events are compared regardless of their order and without the generated
+// ID and CreatedAt, because events recorded in one transaction have the same
+// creation time.
+type ListBucketEvents struct {
+	Opts     metabase.ListBucketEvents
+	Result   []metabase.BucketEvent
+	ErrClass *errs.Class
+	ErrText  string
+}
+
+// Check runs the test.
+func (step ListBucketEvents) Check(ctx *testcontext.Context, t testing.TB, db *metabase.DB) {
+	result, err := db.ListBucketEvents(ctx, step.Opts)
+	checkError(t, err, step.ErrClass, step.ErrText)
+
+	sortBucketEvents(result)
+	sortBucketEvents(step.Result)
+
+	diff := cmp.Diff(step.Result, result,
+		cmpopts.IgnoreFields(metabase.BucketEvent{}, "ID", "CreatedAt"),
+		cmpopts.EquateEmpty())
+	require.Zero(t, diff)
+}
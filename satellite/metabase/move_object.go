@@ -15,8 +15,8 @@ import (
 	"storj.io/private/tagsql"
 )
 
-// BeginMoveObjectResult holds data needed to finish move object.
-type BeginMoveObjectResult struct {
+// BeginMoveCopyResults holds all data needed to finish move or copy object.
+type BeginMoveCopyResults struct {
 	StreamID uuid.UUID
 	// TODO we need metadata because of an uplink issue with how we are storing key and nonce
 	EncryptedMetadata         []byte
@@ -26,6 +26,9 @@ type BeginMoveObjectResult struct {
 	EncryptionParameters      storj.EncryptionParameters
 }
 
+// BeginMoveObjectResult holds data needed to finish move object.
+type BeginMoveObjectResult BeginMoveCopyResults
+
 // EncryptedKeyAndNonce holds single segment position, encrypted key and nonce.
 type EncryptedKeyAndNonce struct {
 	Position          SegmentPosition
@@ -43,12 +46,24 @@ type BeginMoveObject struct {
 func (db *DB) BeginMoveObject(ctx context.Context, opts BeginMoveObject) (result BeginMoveObjectResult, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	if err := opts.ObjectLocation.Verify(); err != nil {
+	results, err := db.beginMoveCopyObject(ctx, opts.ObjectLocation, opts.Version, MoveLimit)
+	if err != nil {
 		return BeginMoveObjectResult{}, err
 	}
 
-	if opts.Version <= 0 {
-		return BeginMoveObjectResult{}, ErrInvalidRequest.New("Version invalid: %v", opts.Version)
+	return BeginMoveObjectResult(results), nil
+}
+
+// beginMoveCopyObject collects all data needed to begin object move or copy procedure.
+func (db *DB) beginMoveCopyObject(ctx context.Context, location ObjectLocation, version Version, segmentLimit int64) (result BeginMoveCopyResults, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := location.Verify(); err != nil {
+		return BeginMoveCopyResults{}, err
+	}
+
+	if version <= 0 {
+		return BeginMoveCopyResults{}, ErrInvalidRequest.New("Version invalid: %v", version)
 	}
 
 	var segmentCount int64
@@ -64,7 +79,7 @@ func (db *DB) BeginMoveObject(ctx context.Context, opts BeginMoveObject) (result
 			object_key   = $3 AND
 			version      = $4 AND
 			status       = `+committedStatus,
-		opts.ProjectID, []byte(opts.BucketName), opts.ObjectKey, opts.Version).
+		location.ProjectID, []byte(location.BucketName), location.ObjectKey, version).
 		Scan(
 			&result.StreamID,
 			encryptionParameters{&result.EncryptionParameters},
@@ -73,13 +88,13 @@ func (db *DB) BeginMoveObject(ctx context.Context, opts BeginMoveObject) (result
 		)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return BeginMoveObjectResult{}, storj.ErrObjectNotFound.Wrap(err)
+			return BeginMoveCopyResults{}, storj.ErrObjectNotFound.Wrap(err)
 		}
-		return BeginMoveObjectResult{}, Error.New("unable to query object status: %w", err)
+		return BeginMoveCopyResults{}, Error.New("unable to query object status: %w", err)
 	}
 
-	if segmentCount > MoveLimit {
-		return BeginMoveObjectResult{}, Error.New("segment count of chosen object is beyond limit")
+	if segmentCount > segmentLimit {
+		return BeginMoveCopyResults{}, Error.New("segment count of chosen object is beyond limit")
 	}
 
 	err = withRows(db.db.QueryContext(ctx, `
@@ -103,7 +118,7 @@ func (db *DB) BeginMoveObject(ctx context.Context, opts BeginMoveObject) (result
 		return nil
 	})
 	if err != nil && !errors.Is(err, sql.ErrNoRows) {
-		return BeginMoveObjectResult{}, Error.New("unable to fetch object segments: %w", err)
+		return BeginMoveCopyResults{}, Error.New("unable to fetch object segments: %w", err)
 	}
 
 	return result, nil
@@ -159,15 +174,20 @@ func (db *DB) FinishMoveObject(ctx context.Context, opts FinishMoveObject) (err
 				bucket_name = $6 AND
 				object_key = $7 AND
 				version = $8 AND
-				stream_id = $9
+				stream_id = $9 AND
+				` + objectNotLocked + `
 			RETURNING
 				segment_count;
         `
 
 		var segmentsCount int
-		row := db.db.QueryRowContext(ctx, updateObjectsQuery, []byte(opts.NewBucket), opts.NewEncryptedObjectKey, opts.NewEncryptedMetadataKey, opts.NewEncryptedMetadataKeyNonce, opts.ProjectID, []byte(opts.BucketName), opts.ObjectKey, opts.Version, opts.StreamID)
+		row := tx.QueryRowContext(ctx, updateObjectsQuery, []byte(opts.NewBucket), opts.NewEncryptedObjectKey, opts.NewEncryptedMetadataKey, opts.NewEncryptedMetadataKeyNonce, opts.ProjectID, []byte(opts.BucketName), opts.ObjectKey, opts.Version, opts.StreamID)
 		if err = row.Scan(&segmentsCount); err != nil {
 			if errors.Is(err, sql.ErrNoRows) {
+				err = db.ensureObjectsNotLocked(ctx, tx, opts.ProjectID, opts.BucketName, [][]byte{[]byte(opts.ObjectKey)}, opts.Version)
+				if err != nil {
+					return err
+				}
 				return storj.ErrObjectNotFound.New("object not found")
 			}
 			return Error.New("unable to update object: %w", err)
@@ -188,7 +208,7 @@ func (db *DB) FinishMoveObject(ctx context.Context, opts FinishMoveObject) (err
 			newSegmentKeys.Positions = append(newSegmentKeys.Positions, int64(u.Position.Encode()))
 		}
 
-		updateResult, err := db.db.ExecContext(ctx, `
+		updateResult, err := tx.ExecContext(ctx, `
 					UPDATE segments SET
 						encrypted_key_nonce = P.encrypted_key_nonce,
 						encrypted_key = P.encrypted_key
@@ -209,7 +229,14 @@ func (db *DB) FinishMoveObject(ctx context.Context, opts FinishMoveObject) (err
 		if affected != int64(len(newSegmentKeys.Positions)) {
 			return Error.New("segment is missing")
 		}
-		return nil
+
+		return db.recordBucketEvents(ctx, tx, BucketEvent{
+			Type:           BucketEventObjectMoved,
+			ObjectLocation: opts.Location(),
+			Version:        opts.Version,
+			NewBucketName:  opts.NewBucket,
+			NewObjectKey:   ObjectKey(opts.NewEncryptedObjectKey),
+		})
 	})
 	if err != nil {
 		return err
@@ -0,0 +1,288 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+	"storj.io/private/dbutil/pgutil"
+	"storj.io/private/tagsql"
+)
+
+// ErrObjectLocked is used to indicate that an object is protected by object lock
+// and can not be deleted or overwritten.
+var ErrObjectLocked = errs.Class("metabase: object is locked")
+
+// objectNotLocked matches objects which are neither under legal hold nor retained.
+const objectNotLocked = `(legal_hold = false AND (retain_until IS NULL OR retain_until <= now()))`
+
+// objectLocked matches objects which are either under legal hold or retained.
+const objectLocked = `(legal_hold = true OR COALESCE(retain_until > now(), false))`
+
+// SetObjectRetention contains arguments necessary for setting the retention of an object version.
+type SetObjectRetention struct {
+	ObjectLocation
+	Version Version
+
+	RetainUntil time.Time
+}
+
+// Verify verifies set object retention request fields.
+func (opts *SetObjectRetention) Verify() error {
+	if err := opts.ObjectLocation.Verify(); err != nil {
+		return err
+	}
+	switch {
+	case opts.Version <= 0:
+		return ErrInvalidRequest.New("Version invalid: %v", opts.Version)
+	case opts.RetainUntil.IsZero():
+		return ErrInvalidRequest.New("RetainUntil missing")
+	}
+	return nil
+}
+
+// SetObjectRetention sets the time until which a committed object version can not
+// be deleted or overwritten. The retention can only be extended, never shortened.
+func (db *DB) SetObjectRetention(ctx context.Context, opts SetObjectRetention) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := opts.Verify(); err != nil {
+		return err
+	}
+
+	result, err := db.db.ExecContext(ctx, `
+		UPDATE objects SET
+			retain_until = $5
+		WHERE
+			project_id   = $1 AND
+			bucket_name  = $2 AND
+			object_key   = $3 AND
+			version      = $4 AND
+			status       = `+committedStatus+` AND
+			(retain_until IS NULL OR retain_until <= $5)
+	`, opts.ProjectID, []byte(opts.BucketName), opts.ObjectKey, opts.Version, opts.RetainUntil)
+	if err != nil {
+		return Error.New("unable to update object retention: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return Error.New("failed to get rows affected: %w", err)
+	}
+	if affected == 0 {
+		var exists bool
+		err = db.db.QueryRowContext(ctx, `
+			SELECT EXISTS (
+				SELECT 1 FROM objects
+				WHERE
+					project_id   = $1 AND
+					bucket_name  = $2 AND
+					object_key   = $3 AND
+					version      = $4 AND
+					status       = `+committedStatus+`
+			)
+		`, opts.ProjectID, []byte(opts.BucketName), opts.ObjectKey, opts.Version).Scan(&exists)
+		if err != nil {
+			return Error.New("unable to query object: %w", err)
+		}
+		if !exists {
+			return storj.ErrObjectNotFound.New("object not found")
+		}
+		return ErrObjectLocked.New("retention can only be extended")
+	}
+
+	mon.Meter("object_set_retention").Mark(1)
+
+	return nil
+}
+
+// SetObjectLegalHold contains arguments necessary for placing or removing a legal hold on an object version.
+type SetObjectLegalHold struct {
+	ObjectLocation
+	Version Version
+
+	LegalHold bool
+}
+
+// Verify verifies set object legal hold request fields.
+func (opts *SetObjectLegalHold) Verify() error {
+	if err := opts.ObjectLocation.Verify(); err != nil {
+		return err
+	}
+	if opts.Version <= 0 {
+		return ErrInvalidRequest.New("Version invalid: %v", opts.Version)
+	}
+	return nil
+}
+
+// SetObjectLegalHold places or removes a legal hold on a committed object version.
+// An object under legal hold can not be deleted or overwritten regardless of its retention.
+func (db *DB) SetObjectLegalHold(ctx context.Context, opts SetObjectLegalHold) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := opts.Verify(); err != nil {
+		return err
+	}
+
+	result, err := db.db.ExecContext(ctx, `
+		UPDATE objects SET
+			legal_hold = $5
+		WHERE
+			project_id   = $1 AND
+			bucket_name  = $2 AND
+			object_key   = $3 AND
+			version      = $4 AND
+			status       = `+committedStatus+`
+	`, opts.ProjectID, []byte(opts.BucketName), opts.ObjectKey, opts.Version, opts.LegalHold)
+	if err != nil {
+		return Error.New("unable to update object legal hold: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return Error.New("failed to get rows affected: %w", err)
+	}
+	if affected == 0 {
+		return storj.ErrObjectNotFound.New("object not found")
+	}
+
+	mon.Meter("object_set_legal_hold").Mark(1)
+
+	return nil
+}
+
+// HasLockedObjects contains arguments necessary for checking whether locked objects exist.
+type HasLockedObjects struct {
+	ProjectID uuid.UUID
+	// BucketName is optional, when empty the whole project is checked.
+	BucketName string
+}
+
+// HasLockedObjects returns whether the project or bucket contains any object
+// which is under legal hold or retained.
+func (db *DB) HasLockedObjects(ctx context.Context, opts HasLockedObjects) (locked bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if opts.ProjectID.IsZero() {
+		return false, ErrInvalidRequest.New("ProjectID missing")
+	}
+
+	if opts.BucketName == "" {
+		err = db.db.QueryRowContext(ctx, `
+			SELECT EXISTS (
+				SELECT 1 FROM objects
+				WHERE
+					project_id = $1 AND
+					`+objectLocked+`
+			)
+		`, opts.ProjectID).Scan(&locked)
+	} else {
+		err = db.db.QueryRowContext(ctx, `
+			SELECT EXISTS (
+				SELECT 1 FROM objects
+				WHERE
+					project_id  = $1 AND
+					bucket_name = $2 AND
+					`+objectLocked+`
+			)
+		`, opts.ProjectID, []byte(opts.BucketName)).Scan(&locked)
+	}
+	if err != nil {
+		return false, Error.New("unable to query locked objects: %w", err)
+	}
+
+	return locked, nil
+}
+
+// The changes of objects skip locked objects in their WHERE clause. The checks
+// below run afterwards in the same transaction to tell whether nothing was changed
+// because of object lock and to roll back changes, which must not be partial.
+
+// ensureObjectsNotLocked returns ErrObjectLocked when any version of the objects is locked.
+// When version is not NextVersion only that version is checked.
+func (db *DB) ensureObjectsNotLocked(ctx context.Context, tx tagsql.Tx, projectID uuid.UUID, bucketName string, objectKeys [][]byte, version Version) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var locked bool
+	err = tx.QueryRowContext(ctx, `
+		SELECT EXISTS (
+			SELECT 1 FROM objects
+			WHERE
+				project_id   = $1 AND
+				bucket_name  = $2 AND
+				object_key   = ANY ($3) AND
+				($4 = 0 OR version = $4) AND
+				`+objectLocked+`
+		)
+	`, projectID, []byte(bucketName), pgutil.ByteaArray(objectKeys), version).Scan(&locked)
+	if err != nil {
+		return Error.New("unable to query object lock: %w", err)
+	}
+	if locked {
+		return ErrObjectLocked.New("object is protected by object lock")
+	}
+	return nil
+}
+
+// ensureLatestVersionNotLocked returns ErrObjectLocked when the latest committed
+// version of the object is locked.
+func (db *DB) ensureLatestVersionNotLocked(ctx context.Context, tx tagsql.Tx, location ObjectLocation) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var locked bool
+	err = tx.QueryRowContext(ctx, `
+		SELECT `+objectLocked+`
+		FROM objects
+		WHERE
+			project_id   = $1 AND
+			bucket_name  = $2 AND
+			object_key   = $3 AND
+			status       = `+committedStatus+`
+		ORDER BY version DESC
+		LIMIT 1
+	`, location.ProjectID, []byte(location.BucketName), location.ObjectKey).Scan(&locked)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+		return Error.New("unable to query object lock: %w", err)
+	}
+	if locked {
+		return ErrObjectLocked.New("object is protected by object lock")
+	}
+	return nil
+}
+
+// ensureNullVersionNotLocked returns ErrObjectLocked when any version of the object,
+// which wasn't created while versioning was enabled, is locked.
+func (db *DB) ensureNullVersionNotLocked(ctx context.Context, tx tagsql.Tx, location ObjectLocation) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var locked bool
+	err = tx.QueryRowContext(ctx, `
+		SELECT EXISTS (
+			SELECT 1 FROM objects
+			WHERE
+				project_id   = $1 AND
+				bucket_name  = $2 AND
+				object_key   = $3 AND
+				versioned    = false AND
+				`+objectLocked+`
+		)
+	`, location.ProjectID, []byte(location.BucketName), location.ObjectKey).Scan(&locked)
+	if err != nil {
+		return Error.New("unable to query object lock: %w", err)
+	}
+	if locked {
+		return ErrObjectLocked.New("object is protected by object lock")
+	}
+	return nil
+}
@@ -0,0 +1,273 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase_test
+
+import (
+	"testing"
+	"time"
+
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/metabasetest"
+)
+
+func TestSetObjectRetention(t *testing.T) {
+	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		obj := metabasetest.RandObjectStream()
+		location := obj.Location()
+
+		now := time.Now()
+		retainUntil := now.Add(time.Hour)
+
+		for _, test := range metabasetest.InvalidObjectLocations(location) {
+			test := test
+			t.Run(test.Name, func(t *testing.T) {
+				defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+				metabasetest.SetObjectRetention{
+					Opts: metabase.SetObjectRetention{
+						ObjectLocation: test.ObjectLocation,
+						Version:        1,
+						RetainUntil:    retainUntil,
+					},
+					ErrClass: test.ErrClass,
+					ErrText:  test.ErrText,
+				}.Check(ctx, t, db)
+				metabasetest.Verify{}.Check(ctx, t, db)
+			})
+		}
+
+		t.Run("RetainUntil missing", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			metabasetest.SetObjectRetention{
+				Opts: metabase.SetObjectRetention{
+					ObjectLocation: location,
+					Version:        1,
+				},
+				ErrClass: &metabase.ErrInvalidRequest,
+				ErrText:  "RetainUntil missing",
+			}.Check(ctx, t, db)
+			metabasetest.Verify{}.Check(ctx, t, db)
+		})
+
+		t.Run("Object missing", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			metabasetest.SetObjectRetention{
+				Opts: metabase.SetObjectRetention{
+					ObjectLocation: location,
+					Version:        1,
+					RetainUntil:    retainUntil,
+				},
+				ErrClass: &storj.ErrObjectNotFound,
+				ErrText:  "object not found",
+			}.Check(ctx, t, db)
+			metabasetest.Verify{}.Check(ctx, t, db)
+		})
+
+		t.Run("Extend and shorten", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			object := metabasetest.CreateObject(ctx, t, db, obj, 0)
+
+			metabasetest.SetObjectRetention{
+				Opts: metabase.SetObjectRetention{
+					ObjectLocation: location,
+					Version:        obj.Version,
+					RetainUntil:    retainUntil,
+				},
+			}.Check(ctx, t, db)
+
+			extended := retainUntil.Add(time.Hour)
+			metabasetest.SetObjectRetention{
+				Opts: metabase.SetObjectRetention{
+					ObjectLocation: location,
+					Version:        obj.Version,
+					RetainUntil:    extended,
+				},
+			}.Check(ctx, t, db)
+
+			metabasetest.SetObjectRetention{
+				Opts: metabase.SetObjectRetention{
+					ObjectLocation: location,
+					Version:        obj.Version,
+					RetainUntil:    retainUntil,
+				},
+				ErrClass: &metabase.ErrObjectLocked,
+				ErrText:  "retention can only be extended",
+			}.Check(ctx, t, db)
+
+			object.RetainUntil = &extended
+			metabasetest.Verify{
+				Objects: []metabase.RawObject{
+					metabase.RawObject(object),
+				},
+			}.Check(ctx, t, db)
+		})
+	})
+}
+
+func TestSetObjectLegalHold(t *testing.T) {
+	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		obj := metabasetest.RandObjectStream()
+		location := obj.Location()
+
+		t.Run("Object missing", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			metabasetest.SetObjectLegalHold{
+				Opts: metabase.SetObjectLegalHold{
+					ObjectLocation: location,
+					Version:        1,
+					LegalHold:      true,
+				},
+				ErrClass: &storj.ErrObjectNotFound,
+				ErrText:  "object not found",
+			}.Check(ctx, t, db)
+			metabasetest.Verify{}.Check(ctx, t, db)
+		})
+
+		t.Run("Place and remove", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			object := metabasetest.CreateObject(ctx, t, db, obj, 0)
+
+			metabasetest.SetObjectLegalHold{
+				Opts: metabase.SetObjectLegalHold{
+					ObjectLocation: location,
+					Version:        obj.Version,
+					LegalHold:      true,
+				},
+			}.Check(ctx, t, db)
+
+			metabasetest.HasLockedObjects{
+				Opts:   metabase.HasLockedObjects{ProjectID: obj.ProjectID},
+				Result: true,
+			}.Check(ctx, t, db)
+
+			metabasetest.SetObjectLegalHold{
+				Opts: metabase.SetObjectLegalHold{
+					ObjectLocation: location,
+					Version:        obj.Version,
+					LegalHold:      false,
+				},
+			}.Check(ctx, t, db)
+
+			metabasetest.HasLockedObjects{
+				Opts:   metabase.HasLockedObjects{ProjectID: obj.ProjectID},
+				Result: false,
+			}.Check(ctx, t, db)
+
+			metabasetest.Verify{
+				Objects: []metabase.RawObject{
+					metabase.RawObject(object),
+				},
+			}.Check(ctx, t, db)
+		})
+	})
+}
+
+func TestObjectLockPreventsDeletion(t *testing.T) {
+	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		obj := metabasetest.RandObjectStream()
+		location := obj.Location()
+
+		now := time.Now()
+		retainUntil := now.Add(time.Hour)
+
+		t.Run("Delete exact version", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			object := metabasetest.CreateObject(ctx, t, db, obj, 0)
+
+			metabasetest.SetObjectRetention{
+				Opts: metabase.SetObjectRetention{
+					ObjectLocation: location,
+					Version:        obj.Version,
+					RetainUntil:    retainUntil,
+				},
+			}.Check(ctx, t, db)
+
+			metabasetest.DeleteObjectExactVersion{
+				Opts: metabase.DeleteObjectExactVersion{
+					ObjectLocation: location,
+					Version:        obj.Version,
+				},
+				ErrClass: &metabase.ErrObjectLocked,
+				ErrText:  "object is protected by object lock",
+			}.Check(ctx, t, db)
+
+			metabasetest.DeleteObjectAnyStatusAllVersions{
+				Opts:     metabase.DeleteObjectAnyStatusAllVersions{ObjectLocation: location},
+				ErrClass: &metabase.ErrObjectLocked,
+				ErrText:  "object is protected by object lock",
+			}.Check(ctx, t, db)
+
+			object.RetainUntil = &retainUntil
+			metabasetest.Verify{
+				Objects: []metabase.RawObject{
+					metabase.RawObject(object),
+				},
+			}.Check(ctx, t, db)
+		})
+
+		t.Run("Delete bucket", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			object := metabasetest.CreateObject(ctx, t, db, obj, 0)
+
+			metabasetest.SetObjectLegalHold{
+				Opts: metabase.SetObjectLegalHold{
+					ObjectLocation: location,
+					Version:        obj.Version,
+					LegalHold:      true,
+				},
+			}.Check(ctx, t, db)
+
+			metabasetest.DeleteBucketObjects{
+				Opts: metabase.DeleteBucketObjects{
+					Bucket: obj.Location().Bucket(),
+				},
+				ErrClass: &metabase.ErrObjectLocked,
+				ErrText:  "bucket contains objects protected by object lock",
+			}.Check(ctx, t, db)
+
+			object.LegalHold = true
+			metabasetest.Verify{
+				Objects: []metabase.RawObject{
+					metabase.RawObject(object),
+				},
+			}.Check(ctx, t, db)
+		})
+
+		t.Run("Expired object is kept", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			expiresAt := now.Add(-time.Hour)
+			object := metabasetest.CreateExpiredObject(ctx, t, db, obj, 0, expiresAt)
+
+			metabasetest.SetObjectRetention{
+				Opts: metabase.SetObjectRetention{
+					ObjectLocation: location,
+					Version:        obj.Version,
+					RetainUntil:    retainUntil,
+				},
+			}.Check(ctx, t, db)
+
+			metabasetest.DeleteExpiredObjects{
+				Opts: metabase.DeleteExpiredObjects{
+					ExpiredBefore: now,
+				},
+			}.Check(ctx, t, db)
+
+			object.RetainUntil = &retainUntil
+			metabasetest.Verify{
+				Objects: []metabase.RawObject{
+					metabase.RawObject(object),
+				},
+			}.Check(ctx, t, db)
+		})
+	})
+}
@@ -35,6 +35,14 @@ type RawObject struct {
 
 	Encryption storj.EncryptionParameters
 
+	// RetainUntil is the time until which the object can not be deleted or overwritten.
+	RetainUntil *time.Time
+	// LegalHold prevents the object from being deleted or overwritten until it is removed.
+	LegalHold bool
+
+	// Versioned is set for versions created while versioning was enabled for the bucket.
+	Versioned bool
+
 	// ZombieDeletionDeadline defines when the pending raw object should be deleted from the database.
 	// This is as a safeguard against objects that failed to upload and the client has not indicated
 	// whether they want to continue uploading or delete the already uploaded data.
@@ -69,10 +77,17 @@ type RawSegment struct {
 	Placement storj.PlacementConstraint
 }
 
+// RawSegmentCopy defines the relation between a copied stream and the stream it was copied from.
+type RawSegmentCopy struct {
+	StreamID         uuid.UUID
+	AncestorStreamID uuid.UUID
+}
+
 // RawState contains full state of a table.
 type RawState struct {
-	Objects  []RawObject
-	Segments []RawSegment
+	Objects       []RawObject
+	Segments      []RawSegment
+	SegmentCopies []RawSegmentCopy
 }
 
 // TestingGetState returns the state of the database.
@@ -89,6 +104,11 @@ func (db *DB) TestingGetState(ctx context.Context) (_ *RawState, err error) {
 		return nil, Error.New("GetState: %w", err)
 	}
 
+	state.SegmentCopies, err = db.testingGetAllSegmentCopies(ctx)
+	if err != nil {
+		return nil, Error.New("GetState: %w", err)
+	}
+
 	return state, nil
 }
 
@@ -97,6 +117,9 @@ func (db *DB) TestingDeleteAll(ctx context.Context) (err error) {
 	_, err = db.db.ExecContext(ctx, `
 		DELETE FROM objects;
 		DELETE FROM segments;
+		DELETE FROM segment_copies;
+		DELETE FROM notified_buckets;
+		DELETE FROM bucket_events;
 		DELETE FROM node_aliases;
 		SELECT setval('node_alias_seq', 1, false);
 	`)
@@ -116,6 +139,8 @@ func (db *DB) testingGetAllObjects(ctx context.Context) (_ []RawObject, err erro
 			encrypted_metadata_nonce, encrypted_metadata, encrypted_metadata_encrypted_key,
 			total_plain_size, total_encrypted_size, fixed_segment_size,
 			encryption,
+			retain_until, legal_hold,
+			versioned,
 			zombie_deletion_deadline
 		FROM objects
 		ORDER BY project_id ASC, bucket_name ASC, object_key ASC, version ASC
@@ -148,6 +173,8 @@ func (db *DB) testingGetAllObjects(ctx context.Context) (_ []RawObject, err erro
 			&obj.FixedSegmentSize,
 
 			encryptionParameters{&obj.Encryption},
+			&obj.RetainUntil, &obj.LegalHold,
+			&obj.Versioned,
 			&obj.ZombieDeletionDeadline,
 		)
 		if err != nil {
@@ -233,3 +260,38 @@ func (db *DB) testingGetAllSegments(ctx context.Context) (_ []RawSegment, err er
 	}
 	return segs, nil
 }
+
+// testingGetAllSegmentCopies returns the state of the database.
+func (db *DB) testingGetAllSegmentCopies(ctx context.Context) (_ []RawSegmentCopy, err error) {
+	copies := []RawSegmentCopy{}
+
+	rows, err := db.db.QueryContext(ctx, `
+		SELECT
+			stream_id, ancestor_stream_id
+		FROM segment_copies
+		ORDER BY stream_id ASC, ancestor_stream_id ASC
+	`)
+	if err != nil {
+		return nil, Error.New("testingGetAllSegmentCopies query: %w", err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+	for rows.Next() {
+		var segmentCopy RawSegmentCopy
+		err := rows.Scan(
+			&segmentCopy.StreamID,
+			&segmentCopy.AncestorStreamID,
+		)
+		if err != nil {
+			return nil, Error.New("testingGetAllSegmentCopies scan failed: %w", err)
+		}
+		copies = append(copies, segmentCopy)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, Error.New("testingGetAllSegmentCopies scan failed: %w", err)
+	}
+
+	if len(copies) == 0 {
+		return nil, nil
+	}
+	return copies, nil
+}
@@ -0,0 +1,141 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package bucketevents_test
+
+import (
+	"bufio"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/memory"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite/buckets"
+	"storj.io/storj/satellite/metainfo/bucketevents"
+)
+
+func TestWebhookSink(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	secret := "secret"
+	payload := []byte(`{"type":"object:committed"}`)
+
+	var status int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := ioutil.ReadAll(r.Body)
+		require.NoError(t, err)
+		require.Equal(t, payload, body)
+		require.Equal(t, http.MethodPost, r.Method)
+		require.Equal(t, bucketevents.Sign([]byte(secret), body), r.Header.Get(bucketevents.SignatureHeader))
+		w.WriteHeader(status)
+	}))
+	defer server.Close()
+
+	sink := bucketevents.NewWebhookSink(server.Client(), server.URL, secret)
+
+	status = http.StatusNoContent
+	require.NoError(t, sink.Send(ctx, payload))
+
+	status = http.StatusInternalServerError
+	require.Error(t, sink.Send(ctx, payload))
+}
+
+func TestFileSink(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	path := ctx.File("events.jsonl")
+	sink := bucketevents.NewFileSink(path)
+
+	require.NoError(t, sink.Send(ctx, []byte(`{"a":1}`)))
+	require.NoError(t, sink.Send(ctx, []byte(`{"b":2}`)))
+
+	data, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, "{\"a\":1}\n{\"b\":2}\n", string(data))
+}
+
+func TestBucketEvents(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		upl := planet.Uplinks[0]
+		projectID := upl.Projects[0].ID
+		eventsChore := satellite.Core.BucketEvents.Chore
+
+		eventsChore.Loop.Pause()
+
+		var mu sync.Mutex
+		var webhookEvents []bucketevents.Event
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var event bucketevents.Event
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&event))
+
+			mu.Lock()
+			webhookEvents = append(webhookEvents, event)
+			mu.Unlock()
+		}))
+		defer server.Close()
+
+		require.NoError(t, upl.CreateBucket(ctx, satellite, "events"))
+		require.NoError(t, upl.CreateBucket(ctx, satellite, "quiet"))
+
+		err := satellite.API.Buckets.Service.UpdateBucketNotifications(ctx, []byte("events"), projectID, buckets.Notifications{
+			Sinks: []buckets.NotificationSink{
+				{Type: buckets.FileSink, Name: "events"},
+				{Type: buckets.WebhookSink, URL: server.URL, Secret: "secret", Events: []buckets.EventType{buckets.EventObjectDeleted}},
+			},
+		})
+		require.NoError(t, err)
+
+		for _, bucket := range []string{"events", "quiet"} {
+			require.NoError(t, upl.Upload(ctx, satellite, bucket, "object", testrand.Bytes(1*memory.KiB)))
+			require.NoError(t, upl.DeleteObject(ctx, satellite, bucket, "object"))
+		}
+
+		// events are delivered only by the chore
+		_, err = os.Stat(filepath.Join(satellite.Config.BucketEvents.FileSinkDir, "events.jsonl"))
+		require.True(t, os.IsNotExist(err))
+
+		eventsChore.Loop.TriggerWait()
+
+		file, err := os.Open(filepath.Join(satellite.Config.BucketEvents.FileSinkDir, "events.jsonl"))
+		require.NoError(t, err)
+		defer ctx.Check(file.Close)
+
+		var fileEvents []bucketevents.Event
+		scanner := bufio.NewScanner(file)
+		for scanner.Scan() {
+			var event bucketevents.Event
+			require.NoError(t, json.Unmarshal(scanner.Bytes(), &event))
+			fileEvents = append(fileEvents, event)
+		}
+		require.NoError(t, scanner.Err())
+
+		require.Len(t, fileEvents, 2)
+		require.Equal(t, buckets.EventObjectCommitted, fileEvents[0].Type)
+		require.Equal(t, buckets.EventObjectDeleted, fileEvents[1].Type)
+		for _, event := range fileEvents {
+			require.Equal(t, projectID, event.ProjectID)
+			require.Equal(t, "events", event.Bucket)
+			require.NotEmpty(t, event.ObjectKey)
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+		require.Len(t, webhookEvents, 1)
+		require.Equal(t, fileEvents[1], webhookEvents[0])
+	})
+}
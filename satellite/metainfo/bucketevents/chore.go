@@ -0,0 +1,328 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package bucketevents
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"path/filepath"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/storj"
+	"storj.io/common/sync2"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/buckets"
+	"storj.io/storj/satellite/metabase"
+)
+
+var (
+	// Error defines the bucketevents errors class.
+	Error = errs.Class("bucket events")
+	mon   = monkit.Package()
+)
+
+// Config contains configurable values for delivering bucket events.
+type Config struct {
+	Interval      time.Duration `help:"the time between each attempt to deliver pending bucket events" releaseDefault:"10s" devDefault:"1s" testDefault:"$TESTINTERVAL"`
+	Enabled       bool          `help:"set if delivering bucket events is enabled or not" releaseDefault:"true" devDefault:"true"`
+	BatchSize     int           `help:"how many recorded or pending bucket events to query in a batch" default:"100"`
+	MaxAttempts   int           `help:"how many times delivering a bucket event is attempted before it's dropped" default:"10"`
+	RetryDelay    time.Duration `help:"how long to wait before retrying a failed delivery, doubled with every attempt" default:"1m"`
+	MaxRetryDelay time.Duration `help:"the maximum time to wait before retrying a failed delivery" default:"6h"`
+	Timeout       time.Duration `help:"timeout for delivering a single bucket event" default:"10s"`
+	FileSinkDir   string        `help:"directory of file sinks, file sinks are disabled when empty" default:""`
+}
+
+// Verify verifies configuration sanity.
+func (config *Config) Verify() errs.Group {
+	var errlist errs.Group
+	if config.BatchSize <= 0 {
+		errlist.Add(Error.New("batch size %d must be greater than 0", config.BatchSize))
+	}
+	if config.MaxAttempts <= 0 {
+		errlist.Add(Error.New("max attempts %d must be greater than 0", config.MaxAttempts))
+	}
+	if config.RetryDelay <= 0 {
+		errlist.Add(Error.New("retry delay %v must be greater than 0", config.RetryDelay))
+	}
+	if config.MaxRetryDelay < config.RetryDelay {
+		errlist.Add(Error.New("max retry delay %v should be at least the retry delay %v", config.MaxRetryDelay, config.RetryDelay))
+	}
+	return errlist
+}
+
+// Chore relays the bucket events recorded by the metabase to the outbox and
+// delivers pending bucket events from the outbox.
+//
+// architecture: Chore
+type Chore struct {
+	log      *zap.Logger
+	config   Config
+	outbox   DB
+	metabase *metabase.DB
+	buckets  buckets.DB
+	client   *http.Client
+
+	nowFn func() time.Time
+	Loop  *sync2.Cycle
+}
+
+// NewChore creates a new instance of the bucketevents chore.
+func NewChore(log *zap.Logger, config Config, outbox DB, metabaseDB *metabase.DB, buckets buckets.DB) (*Chore, error) {
+	if errs := config.Verify(); len(errs) > 0 {
+		return nil, errs.Err()
+	}
+
+	return &Chore{
+		log:      log,
+		config:   config,
+		outbox:   outbox,
+		metabase: metabaseDB,
+		buckets:  buckets,
+		client:   &http.Client{Timeout: config.Timeout},
+
+		nowFn: time.Now,
+		Loop:  sync2.NewCycle(config.Interval),
+	}, nil
+}
+
+// Run starts the bucketevents loop service.
+func (chore *Chore) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if !chore.config.Enabled {
+		return nil
+	}
+
+	return chore.Loop.Run(ctx, func(ctx context.Context) error {
+		// events which are already in the outbox can be delivered even when relaying fails
+		if err := chore.relayEvents(ctx); err != nil {
+			chore.log.Error("relaying recorded bucket events failed", zap.Error(err))
+		}
+		return chore.deliverEvents(ctx)
+	})
+}
+
+// Close stops the bucketevents chore.
+func (chore *Chore) Close() error {
+	chore.Loop.Close()
+	return nil
+}
+
+// SetNow allows tests to have the server act as if the current time is whatever they want.
+func (chore *Chore) SetNow(nowFn func() time.Time) {
+	chore.nowFn = nowFn
+}
+
+// relayEvents moves the events recorded by the metabase to the outbox. An entry
+// is added for every sink of the bucket, which accepts the event. Events of
+// buckets without sinks are dropped.
+//
+// The recorded events are removed only after the entries were added, so an
+// event may be relayed twice. Receivers deduplicate events by their ID.
+func (chore *Chore) relayEvents(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	for {
+		events, err := chore.metabase.ListBucketEvents(ctx, metabase.ListBucketEvents{
+			Limit: chore.config.BatchSize,
+		})
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		if len(events) == 0 {
+			return nil
+		}
+
+		notifications := make(map[metabase.BucketLocation]buckets.Notifications)
+		ids := make([]uuid.UUID, 0, len(events))
+		var entries []Entry
+		for _, event := range events {
+			ids = append(ids, event.ID)
+
+			bucket := event.Bucket()
+			bucketNotifications, ok := notifications[bucket]
+			if !ok {
+				bucketNotifications, err = chore.buckets.GetBucketNotifications(ctx, []byte(bucket.BucketName), bucket.ProjectID)
+				if err != nil && !storj.ErrBucketNotFound.Has(err) {
+					return Error.Wrap(err)
+				}
+				notifications[bucket] = bucketNotifications
+			}
+
+			bucketEntries, err := newEntries(event, bucketNotifications.Sinks)
+			if err != nil {
+				return Error.Wrap(err)
+			}
+			entries = append(entries, bucketEntries...)
+		}
+
+		if err := chore.outbox.Insert(ctx, entries); err != nil {
+			return Error.Wrap(err)
+		}
+		if err := chore.metabase.DeleteBucketEvents(ctx, metabase.DeleteBucketEvents{IDs: ids}); err != nil {
+			return Error.Wrap(err)
+		}
+		mon.Meter("bucket_events_published").Mark(len(entries))
+
+		if len(events) < chore.config.BatchSize {
+			return nil
+		}
+	}
+}
+
+// newEntries returns the outbox entries of a recorded event for the sinks, which accept it.
+func newEntries(recorded metabase.BucketEvent, sinks []buckets.NotificationSink) (entries []Entry, err error) {
+	event := Event{
+		ID:           recorded.ID,
+		Type:         buckets.EventType(recorded.Type),
+		Time:         recorded.CreatedAt,
+		ProjectID:    recorded.ProjectID,
+		Bucket:       recorded.BucketName,
+		ObjectKey:    []byte(recorded.ObjectKey),
+		Version:      int64(recorded.Version),
+		DeleteMarker: recorded.DeleteMarker,
+		NewBucket:    recorded.NewBucketName,
+		NewObjectKey: []byte(recorded.NewObjectKey),
+	}
+
+	var payload []byte
+	for _, sink := range sinks {
+		if !sink.Accepts(event.Type) {
+			continue
+		}
+
+		if payload == nil {
+			payload, err = json.Marshal(event)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		id, err := uuid.New()
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, Entry{
+			ID:            id,
+			Bucket:        recorded.Bucket(),
+			Sink:          sink,
+			Payload:       payload,
+			NextAttemptAt: recorded.CreatedAt,
+			CreatedAt:     recorded.CreatedAt,
+		})
+	}
+	return entries, nil
+}
+
+// deliverEvents attempts to deliver the entries, which are due. Every entry is
+// attempted at most once in a pass. The pass stops when the outbox can't be
+// updated, since the same entries would be listed again.
+func (chore *Chore) deliverEvents(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	now := chore.nowFn()
+	attempted := map[uuid.UUID]struct{}{}
+
+	for {
+		entries, err := chore.outbox.ListDue(ctx, now, chore.config.BatchSize)
+		if err != nil {
+			chore.log.Error("listing pending bucket events failed", zap.Error(err))
+			return nil
+		}
+
+		delivered := 0
+		for _, entry := range entries {
+			if _, ok := attempted[entry.ID]; ok {
+				continue
+			}
+			attempted[entry.ID] = struct{}{}
+			delivered++
+
+			// failing to send is recorded in the outbox, so a single entry doesn't block the others
+			if err := chore.deliver(ctx, entry, now); err != nil {
+				chore.log.Error("updating pending bucket event failed",
+					zap.Stringer("ID", entry.ID),
+					zap.Error(err))
+				return nil
+			}
+		}
+
+		// attempted entries are deleted or rescheduled, so they aren't listed again
+		if delivered == 0 || len(entries) < chore.config.BatchSize {
+			return nil
+		}
+	}
+}
+
+// deliver sends the entry and deletes or reschedules it. It returns only the
+// errors of updating the outbox.
+func (chore *Chore) deliver(ctx context.Context, entry Entry, now time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	sendErr := chore.send(ctx, entry)
+	if sendErr == nil {
+		mon.Meter("bucket_events_delivered").Mark(1)
+		return chore.outbox.Delete(ctx, entry.ID)
+	}
+
+	attempts := entry.Attempts + 1
+	if attempts >= chore.config.MaxAttempts {
+		chore.log.Warn("dropping bucket event after too many failed attempts",
+			zap.Stringer("ID", entry.ID),
+			zap.Stringer("Project ID", entry.Bucket.ProjectID),
+			zap.String("Bucket", entry.Bucket.BucketName),
+			zap.Int("Attempts", attempts),
+			zap.Error(sendErr))
+		mon.Meter("bucket_events_dropped").Mark(1)
+		return chore.outbox.Delete(ctx, entry.ID)
+	}
+
+	mon.Meter("bucket_events_failed").Mark(1)
+	return chore.outbox.Reschedule(ctx, entry.ID, now.Add(chore.retryDelay(attempts)), sendErr.Error())
+}
+
+func (chore *Chore) send(ctx context.Context, entry Entry) error {
+	sink, err := chore.sink(entry.Sink)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, chore.config.Timeout)
+	defer cancel()
+
+	return sink.Send(ctx, entry.Payload)
+}
+
+// sink returns the sink for the notification sink configuration.
+func (chore *Chore) sink(config buckets.NotificationSink) (Sink, error) {
+	switch config.Type {
+	case buckets.WebhookSink:
+		return NewWebhookSink(chore.client, config.URL, config.Secret), nil
+	case buckets.FileSink:
+		if chore.config.FileSinkDir == "" {
+			return nil, Error.New("file sinks are disabled")
+		}
+		return NewFileSink(filepath.Join(chore.config.FileSinkDir, config.Name+".jsonl")), nil
+	default:
+		return nil, Error.New("unknown sink type %q", config.Type)
+	}
+}
+
+// retryDelay returns how long to wait after the specified number of failed attempts.
+func (chore *Chore) retryDelay(attempts int) time.Duration {
+	delay := chore.config.RetryDelay
+	for i := 1; i < attempts && delay < chore.config.MaxRetryDelay; i++ {
+		delay *= 2
+	}
+	if delay > chore.config.MaxRetryDelay {
+		delay = chore.config.MaxRetryDelay
+	}
+	return delay
+}
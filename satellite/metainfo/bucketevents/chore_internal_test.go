@@ -0,0 +1,89 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package bucketevents
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/buckets"
+)
+
+// stuckOutbox lists the same entries regardless of their next attempt.
+type stuckOutbox struct {
+	entries       []Entry
+	rescheduleErr error
+
+	listed      int
+	rescheduled []uuid.UUID
+}
+
+func (outbox *stuckOutbox) Insert(ctx context.Context, entries []Entry) error { return nil }
+
+func (outbox *stuckOutbox) ListDue(ctx context.Context, now time.Time, limit int) ([]Entry, error) {
+	outbox.listed++
+	return outbox.entries, nil
+}
+
+func (outbox *stuckOutbox) Delete(ctx context.Context, id uuid.UUID) error { return nil }
+
+func (outbox *stuckOutbox) Reschedule(ctx context.Context, id uuid.UUID, nextAttemptAt time.Time, lastError string) error {
+	outbox.rescheduled = append(outbox.rescheduled, id)
+	return outbox.rescheduleErr
+}
+
+func TestDeliverEventsAttemptsOncePerPass(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	config := Config{BatchSize: 2, MaxAttempts: 10, RetryDelay: time.Minute, MaxRetryDelay: time.Hour}
+
+	newOutbox := func() *stuckOutbox {
+		outbox := &stuckOutbox{}
+		for i := 0; i < config.BatchSize; i++ {
+			outbox.entries = append(outbox.entries, Entry{
+				ID:   testrand.UUID(),
+				Sink: buckets.NotificationSink{Type: "unknown"},
+			})
+		}
+		return outbox
+	}
+
+	t.Run("rescheduled entries are listed again", func(t *testing.T) {
+		outbox := newOutbox()
+		chore, err := NewChore(zaptest.NewLogger(t), config, outbox, nil, nil)
+		require.NoError(t, err)
+
+		require.NoError(t, chore.deliverEvents(ctx))
+		require.Equal(t, 2, outbox.listed)
+		require.Equal(t, []uuid.UUID{outbox.entries[0].ID, outbox.entries[1].ID}, outbox.rescheduled)
+	})
+
+	t.Run("outbox failure stops the pass", func(t *testing.T) {
+		outbox := newOutbox()
+		outbox.rescheduleErr = errors.New("outbox unavailable")
+		chore, err := NewChore(zaptest.NewLogger(t), config, outbox, nil, nil)
+		require.NoError(t, err)
+
+		require.NoError(t, chore.deliverEvents(ctx))
+		require.Equal(t, 1, outbox.listed)
+		require.Equal(t, []uuid.UUID{outbox.entries[0].ID}, outbox.rescheduled)
+	})
+}
+
+func TestConfigVerify(t *testing.T) {
+	config := Config{BatchSize: 100, MaxAttempts: 10, RetryDelay: time.Minute, MaxRetryDelay: time.Hour}
+	require.Empty(t, config.Verify())
+
+	config.RetryDelay = 0
+	_, err := NewChore(zaptest.NewLogger(t), config, &stuckOutbox{}, nil, nil)
+	require.Error(t, err)
+}
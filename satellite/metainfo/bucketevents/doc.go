@@ -0,0 +1,20 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+/*
+Package bucketevents contains the functions needed to notify about events of bucket objects.
+
+The metabase records an event whenever an object of a bucket with
+notification sinks is committed, deleted or moved. The event is recorded in
+the same transaction as the change of the object, so no event is lost and no
+event is recorded for a failed change.
+
+The bucketevents chore periodically relays the recorded events to a
+persistent outbox. For every sink of the bucket notification configuration,
+which accepts the event, an entry is added to the outbox. Then the chore
+delivers the entries of the outbox which are due. Entries are removed from the outbox only after a successful
+delivery, failed deliveries are retried with an exponential backoff until the
+maximum number of attempts is reached. Hence events are delivered at least
+once and receivers should deduplicate them by their ID.
+*/
+package bucketevents
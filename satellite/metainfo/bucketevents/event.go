@@ -0,0 +1,55 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package bucketevents
+
+import (
+	"context"
+	"time"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/buckets"
+	"storj.io/storj/satellite/metabase"
+)
+
+// Event is an event of a bucket object, which is sent to the sinks as JSON.
+type Event struct {
+	ID        uuid.UUID         `json:"id"`
+	Type      buckets.EventType `json:"type"`
+	Time      time.Time         `json:"time"`
+	ProjectID uuid.UUID         `json:"projectId"`
+	Bucket    string            `json:"bucket"`
+	// ObjectKey is the encrypted key of the object.
+	ObjectKey []byte `json:"objectKey"`
+	Version   int64  `json:"version,omitempty"`
+	// DeleteMarker is set when a delete marker was created instead of deleting the object.
+	DeleteMarker bool `json:"deleteMarker,omitempty"`
+	// NewBucket and NewObjectKey are the destination of a moved object.
+	NewBucket    string `json:"newBucket,omitempty"`
+	NewObjectKey []byte `json:"newObjectKey,omitempty"`
+}
+
+// Entry is an event pending delivery to a single sink.
+type Entry struct {
+	ID            uuid.UUID
+	Bucket        metabase.BucketLocation
+	Sink          buckets.NotificationSink
+	Payload       []byte
+	Attempts      int
+	NextAttemptAt time.Time
+	CreatedAt     time.Time
+}
+
+// DB is the persistent outbox of events pending delivery.
+//
+// architecture: Database
+type DB interface {
+	// Insert adds entries to the outbox.
+	Insert(ctx context.Context, entries []Entry) error
+	// ListDue returns at most limit entries, which are due for delivery at the specified time.
+	ListDue(ctx context.Context, now time.Time, limit int) ([]Entry, error)
+	// Delete removes an entry from the outbox.
+	Delete(ctx context.Context, id uuid.UUID) error
+	// Reschedule records a failed delivery attempt of an entry and when to attempt it again.
+	Reschedule(ctx context.Context, id uuid.UUID, nextAttemptAt time.Time, lastError string) error
+}
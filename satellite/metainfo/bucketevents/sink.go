@@ -0,0 +1,102 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package bucketevents
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"os"
+
+	"github.com/zeebo/errs"
+)
+
+// SignatureHeader is the header of webhook requests, which contains the signature of the body.
+const SignatureHeader = "X-Storj-Signature"
+
+// Sink delivers event payloads.
+type Sink interface {
+	Send(ctx context.Context, payload []byte) error
+}
+
+// Sign returns the signature of the payload, which is sent in the SignatureHeader.
+// It's the hex encoded HMAC-SHA256 of the payload prefixed with "sha256=".
+func Sign(secret, payload []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	_, _ = mac.Write(payload)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// WebhookSink sends events with HTTP POST requests signed with a secret.
+type WebhookSink struct {
+	client *http.Client
+	url    string
+	secret []byte
+}
+
+// NewWebhookSink creates a new webhook sink.
+func NewWebhookSink(client *http.Client, url, secret string) *WebhookSink {
+	return &WebhookSink{
+		client: client,
+		url:    url,
+		secret: []byte(secret),
+	}
+}
+
+// Send sends the payload to the webhook. Any response other than 2xx is considered a failure.
+func (sink *WebhookSink) Send(ctx context.Context, payload []byte) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, sink.url, bytes.NewReader(payload))
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(SignatureHeader, Sign(sink.secret, payload))
+
+	resp, err := sink.client.Do(req)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, resp.Body.Close()) }()
+
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return Error.New("webhook responded with %s", resp.Status)
+	}
+	return nil
+}
+
+// FileSink appends events as lines to a local file. It's meant for testing.
+type FileSink struct {
+	path string
+}
+
+// NewFileSink creates a new file sink.
+func NewFileSink(path string) *FileSink {
+	return &FileSink{path: path}
+}
+
+// Send appends the payload to the file.
+func (sink *FileSink) Send(ctx context.Context, payload []byte) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	file, err := os.OpenFile(sink.path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0644)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, Error.Wrap(file.Close())) }()
+
+	line := make([]byte, 0, len(payload)+1)
+	line = append(line, payload...)
+	line = append(line, '\n')
+
+	_, err = file.Write(line)
+	return Error.Wrap(err)
+}
@@ -0,0 +1,62 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package bucketlifecycle_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/memory"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite/buckets"
+)
+
+func TestBucketLifecycle(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		upl := planet.Uplinks[0]
+		projectID := upl.Projects[0].ID
+		lifecycleChore := satellite.Core.BucketLifecycle.Chore
+
+		lifecycleChore.Loop.Pause()
+
+		for _, bucket := range []string{"expiring", "keeping"} {
+			err := upl.Upload(ctx, satellite, bucket, "inline", testrand.Bytes(1*memory.KiB))
+			require.NoError(t, err)
+			err = upl.Upload(ctx, satellite, bucket, "remote", testrand.Bytes(8*memory.KiB))
+			require.NoError(t, err)
+		}
+
+		err := satellite.API.Buckets.Service.UpdateBucketLifecycle(ctx, []byte("expiring"), projectID, buckets.Lifecycle{
+			Rules: []buckets.LifecycleRule{{ExpireAfterDays: 1}},
+		})
+		require.NoError(t, err)
+
+		// the objects are not old enough yet
+		lifecycleChore.Loop.TriggerWait()
+
+		objects, err := satellite.Metabase.DB.TestingAllObjects(ctx)
+		require.NoError(t, err)
+		require.Len(t, objects, 4)
+
+		lifecycleChore.SetNow(func() time.Time {
+			return time.Now().Add(2 * 24 * time.Hour)
+		})
+		lifecycleChore.Loop.TriggerWait()
+
+		// only the objects of the bucket with lifecycle rules are deleted
+		objects, err = satellite.Metabase.DB.TestingAllObjects(ctx)
+		require.NoError(t, err)
+		require.Len(t, objects, 2)
+		for _, object := range objects {
+			require.Equal(t, "keeping", object.BucketName)
+		}
+	})
+}
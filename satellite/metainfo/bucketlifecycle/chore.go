@@ -0,0 +1,146 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package bucketlifecycle
+
+import (
+	"context"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/sync2"
+	"storj.io/storj/satellite/buckets"
+	"storj.io/storj/satellite/metabase"
+)
+
+var (
+	// Error defines the bucketlifecycle chore errors class.
+	Error = errs.Class("bucket lifecycle")
+	mon   = monkit.Package()
+)
+
+// Config contains configurable values for applying bucket lifecycle rules.
+type Config struct {
+	Interval        time.Duration `help:"the time between each attempt to go through the buckets and apply lifecycle rules" releaseDefault:"24h" devDefault:"10s" testDefault:"$TESTINTERVAL"`
+	Enabled         bool          `help:"set if applying bucket lifecycle rules is enabled or not" releaseDefault:"true" devDefault:"true"`
+	BucketListLimit int           `help:"how many buckets with lifecycle rules to query in a batch" default:"100"`
+	ListLimit       int           `help:"how many objects to query in a batch" default:"100"`
+}
+
+// Chore implements the bucket lifecycle rules chore.
+//
+// architecture: Chore
+type Chore struct {
+	log      *zap.Logger
+	config   Config
+	buckets  buckets.DB
+	metabase *metabase.DB
+
+	nowFn func() time.Time
+	Loop  *sync2.Cycle
+}
+
+// NewChore creates a new instance of the bucketlifecycle chore.
+func NewChore(log *zap.Logger, config Config, buckets buckets.DB, metabase *metabase.DB) *Chore {
+	return &Chore{
+		log:      log,
+		config:   config,
+		buckets:  buckets,
+		metabase: metabase,
+
+		nowFn: time.Now,
+		Loop:  sync2.NewCycle(config.Interval),
+	}
+}
+
+// Run starts the bucketlifecycle loop service.
+func (chore *Chore) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if !chore.config.Enabled {
+		return nil
+	}
+
+	return chore.Loop.Run(ctx, chore.applyLifecycleRules)
+}
+
+// Close stops the bucketlifecycle chore.
+func (chore *Chore) Close() error {
+	chore.Loop.Close()
+	return nil
+}
+
+// SetNow allows tests to have the server act as if the current time is whatever they want.
+func (chore *Chore) SetNow(nowFn func() time.Time) {
+	chore.nowFn = nowFn
+}
+
+func (chore *Chore) applyLifecycleRules(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	chore.log.Debug("applying bucket lifecycle rules")
+
+	now := chore.nowFn()
+
+	var cursor metabase.BucketLocation
+	for {
+		lifecycles, err := chore.buckets.ListBucketLifecycles(ctx, cursor, chore.config.BucketListLimit)
+		if err != nil {
+			chore.log.Error("listing bucket lifecycles failed", zap.Error(err))
+			return nil
+		}
+
+		for _, lifecycle := range lifecycles {
+			for _, rule := range lifecycle.Lifecycle.Rules {
+				// log error instead of failing, so a single bucket doesn't block the others
+				if err := chore.applyRule(ctx, lifecycle.Bucket, rule, now); err != nil {
+					chore.log.Error("applying bucket lifecycle rule failed",
+						zap.Stringer("Project ID", lifecycle.Bucket.ProjectID),
+						zap.String("Bucket", lifecycle.Bucket.BucketName),
+						zap.Error(err))
+				}
+			}
+		}
+
+		if len(lifecycles) == 0 || len(lifecycles) < chore.config.BucketListLimit {
+			return nil
+		}
+		cursor = lifecycles[len(lifecycles)-1].Bucket
+	}
+}
+
+func (chore *Chore) applyRule(ctx context.Context, bucket metabase.BucketLocation, rule buckets.LifecycleRule, now time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	const day = 24 * time.Hour
+
+	if rule.ExpireAfterDays > 0 {
+		err = chore.metabase.DeleteObjectsCreatedBefore(ctx, metabase.DeleteObjectsCreatedBefore{
+			Bucket:        bucket,
+			Prefix:        metabase.ObjectKey(rule.Prefix),
+			Status:        metabase.Committed,
+			CreatedBefore: now.Add(-time.Duration(rule.ExpireAfterDays) * day),
+			BatchSize:     chore.config.ListLimit,
+		})
+		if err != nil {
+			return Error.Wrap(err)
+		}
+	}
+
+	if rule.AbortIncompleteUploadAfterDays > 0 {
+		err = chore.metabase.DeleteObjectsCreatedBefore(ctx, metabase.DeleteObjectsCreatedBefore{
+			Bucket:        bucket,
+			Prefix:        metabase.ObjectKey(rule.Prefix),
+			Status:        metabase.Pending,
+			CreatedBefore: now.Add(-time.Duration(rule.AbortIncompleteUploadAfterDays) * day),
+			BatchSize:     chore.config.ListLimit,
+		})
+		if err != nil {
+			return Error.Wrap(err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,12 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+/*
+Package bucketlifecycle contains the functions needed to apply bucket lifecycle rules.
+
+The bucketlifecycle chore periodically goes through all buckets which have
+lifecycle rules configured and deletes, in batches, the committed objects
+older than the expiration of a rule and the pending objects older than the
+incomplete upload limit of a rule.
+*/
+package bucketlifecycle
@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"storj.io/common/memory"
+	"storj.io/storj/satellite/buckets"
 	"storj.io/storj/satellite/metabase/segmentloop"
 	"storj.io/storj/satellite/metainfo/piecedeletion"
 )
@@ -110,16 +111,17 @@ type Config struct {
 	MaxInlineSegmentSize memory.Size `default:"4KiB" help:"maximum inline segment size"`
 	// we have such default value because max value for ObjectKey is 1024(1 Kib) but EncryptedObjectKey
 	// has encryption overhead 16 bytes. So overall size is 1024 + 16 * 16.
-	MaxEncryptedObjectKeyLength int                  `default:"1280" help:"maximum encrypted object key length"`
-	MaxSegmentSize              memory.Size          `default:"64MiB" help:"maximum segment size"`
-	MaxMetadataSize             memory.Size          `default:"2KiB" help:"maximum segment metadata size"`
-	MaxCommitInterval           time.Duration        `default:"48h" testDefault:"1h" help:"maximum time allowed to pass between creating and committing a segment"`
-	MinPartSize                 memory.Size          `default:"5MiB" testDefault:"0" help:"minimum allowed part size (last part has no minimum size limit)"`
-	MaxNumberOfParts            int                  `default:"10000" help:"maximum number of parts object can contain"`
-	Overlay                     bool                 `default:"true" help:"toggle flag if overlay is enabled"`
-	RS                          RSConfig             `releaseDefault:"29/35/80/110-256B" devDefault:"4/6/8/10-256B" help:"redundancy scheme configuration in the format k/m/o/n-sharesize"`
-	SegmentLoop                 segmentloop.Config   `help:"segment loop configuration"`
-	RateLimiter                 RateLimiterConfig    `help:"rate limiter configuration"`
-	ProjectLimits               ProjectLimitConfig   `help:"project limit configuration"`
-	PieceDeletion               piecedeletion.Config `help:"piece deletion configuration"`
+	MaxEncryptedObjectKeyLength int                        `default:"1280" help:"maximum encrypted object key length"`
+	MaxSegmentSize              memory.Size                `default:"64MiB" help:"maximum segment size"`
+	MaxMetadataSize             memory.Size                `default:"2KiB" help:"maximum segment metadata size"`
+	MaxCommitInterval           time.Duration              `default:"48h" testDefault:"1h" help:"maximum time allowed to pass between creating and committing a segment"`
+	MinPartSize                 memory.Size                `default:"5MiB" testDefault:"0" help:"minimum allowed part size (last part has no minimum size limit)"`
+	MaxNumberOfParts            int                        `default:"10000" help:"maximum number of parts object can contain"`
+	Overlay                     bool                       `default:"true" help:"toggle flag if overlay is enabled"`
+	RS                          RSConfig                   `releaseDefault:"29/35/80/110-256B" devDefault:"4/6/8/10-256B" help:"redundancy scheme configuration in the format k/m/o/n-sharesize"`
+	RedundancyProfiles          buckets.RedundancyProfiles `default:"" help:"comma-separated redundancy schemes buckets can be created with, in the format name:k/m/o/n-sharesize. Their repair thresholds can be overridden with checker.repair-overrides"`
+	SegmentLoop                 segmentloop.Config         `help:"segment loop configuration"`
+	RateLimiter                 RateLimiterConfig          `help:"rate limiter configuration"`
+	ProjectLimits               ProjectLimitConfig         `help:"project limit configuration"`
+	PieceDeletion               piecedeletion.Config       `help:"piece deletion configuration"`
 }
@@ -8,6 +8,7 @@ import (
 	"context"
 	"crypto/sha256"
 	"fmt"
+	"math"
 	"time"
 
 	"github.com/spacemonkeygo/monkit/v3"
@@ -200,8 +201,16 @@ func (endpoint *Endpoint) GetBucket(ctx context.Context, req *pb.BucketGetReques
 		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
 	}
 
-	// override RS to fit satellite settings
-	convBucket, err := convertBucketToProto(bucket, endpoint.defaultRS, endpoint.config.MaxSegmentSize)
+	rs, err := endpoint.getBucketRS(ctx, keyInfo.ProjectID, req.GetName())
+	if err != nil {
+		if storj.ErrBucketNotFound.Has(err) {
+			return nil, rpcstatus.Error(rpcstatus.NotFound, err.Error())
+		}
+		endpoint.log.Error("internal", zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+
+	convBucket, err := convertBucketToProto(bucket, rs, endpoint.config.MaxSegmentSize)
 	if err != nil {
 		return resp, err
 	}
@@ -269,6 +278,11 @@ func (endpoint *Endpoint) CreateBucket(ctx context.Context, req *pb.BucketCreate
 		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
 	}
 
+	bucketReq.DefaultRedundancyScheme, err = endpoint.validateBucketRS(req.GetDefaultRedundancyScheme())
+	if err != nil {
+		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+	}
+
 	bucket, err := endpoint.buckets.CreateBucket(ctx, bucketReq)
 	if err != nil {
 		endpoint.log.Error("error while creating bucket", zap.String("bucketName", bucketReq.Name), zap.Error(err))
@@ -280,11 +294,15 @@ func (endpoint *Endpoint) CreateBucket(ctx context.Context, req *pb.BucketCreate
 		return nil, err
 	}
 
-	// override RS to fit satellite settings
+	rs := endpoint.defaultRS
+	if !bucket.DefaultRedundancyScheme.IsZero() {
+		rs = convertRedundancyToProto(bucket.DefaultRedundancyScheme)
+	}
+
 	convBucket, err := convertBucketToProto(buckets.Bucket{
 		Name:      []byte(bucket.Name),
 		CreatedAt: bucket.Created,
-	}, endpoint.defaultRS, endpoint.config.MaxSegmentSize)
+	}, rs, endpoint.config.MaxSegmentSize)
 	if err != nil {
 		endpoint.log.Error("error while converting bucket to proto", zap.String("bucketName", bucket.Name), zap.Error(err))
 		return nil, rpcstatus.Error(rpcstatus.Internal, "unable to create bucket")
@@ -422,6 +440,9 @@ func (endpoint *Endpoint) isBucketEmpty(ctx context.Context, projectID uuid.UUID
 func (endpoint *Endpoint) deleteBucketNotEmpty(ctx context.Context, projectID uuid.UUID, bucketName []byte) ([]byte, int64, error) {
 	deletedCount, err := endpoint.deleteBucketObjects(ctx, projectID, bucketName)
 	if err != nil {
+		if metabase.ErrObjectLocked.Has(err) {
+			return nil, deletedCount, rpcstatus.Error(rpcstatus.FailedPrecondition, err.Error())
+		}
 		endpoint.log.Error("internal", zap.Error(err))
 		return nil, 0, rpcstatus.Error(rpcstatus.Internal, err.Error())
 	}
@@ -572,6 +593,73 @@ func convertBucketToProto(bucket buckets.Bucket, rs *pb.RedundancyScheme, maxSeg
 	}, nil
 }
 
+// validateBucketRS validates the redundancy scheme requested for a new bucket.
+// A zero or the satellite default scheme results in a zero scheme, which means
+// the bucket follows the satellite default.
+func (endpoint *Endpoint) validateBucketRS(rs *pb.RedundancyScheme) (storj.RedundancyScheme, error) {
+	if rs == nil || rs.MinReq == 0 && rs.RepairThreshold == 0 && rs.SuccessThreshold == 0 && rs.Total == 0 {
+		return storj.RedundancyScheme{}, nil
+	}
+
+	scheme := convertRedundancyFromProto(rs)
+	if scheme == convertRedundancyFromProto(endpoint.defaultRS) {
+		return storj.RedundancyScheme{}, nil
+	}
+	if _, ok := endpoint.config.RedundancyProfiles.Find(scheme); !ok {
+		return storj.RedundancyScheme{}, buckets.ErrInvalidRedundancy.New("redundancy scheme %d/%d/%d/%d-%d is not allowed",
+			scheme.RequiredShares, scheme.RepairShares, scheme.OptimalShares, scheme.TotalShares, scheme.ShareSize)
+	}
+	return scheme, nil
+}
+
+// getBucketRS returns the redundancy scheme used for new segments of the bucket.
+func (endpoint *Endpoint) getBucketRS(ctx context.Context, projectID uuid.UUID, bucketName []byte) (_ *pb.RedundancyScheme, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	scheme, err := endpoint.buckets.GetBucketRedundancy(ctx, bucketName, projectID)
+	if err != nil {
+		return nil, err
+	}
+	if scheme.IsZero() {
+		return endpoint.defaultRS, nil
+	}
+	return convertRedundancyToProto(scheme), nil
+}
+
+// getStreamRS returns the redundancy scheme resolved when the upload of the stream began.
+// Stream ids issued before the scheme was added to them fall back to the scheme of the bucket.
+func (endpoint *Endpoint) getStreamRS(ctx context.Context, projectID uuid.UUID, streamID *internalpb.StreamID) (_ *pb.RedundancyScheme, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if streamID.Redundancy != nil {
+		return streamID.Redundancy, nil
+	}
+	return endpoint.getBucketRS(ctx, projectID, streamID.Bucket)
+}
+
+func convertRedundancyToProto(scheme storj.RedundancyScheme) *pb.RedundancyScheme {
+	return &pb.RedundancyScheme{
+		Type:             pb.RedundancyScheme_SchemeType(scheme.Algorithm),
+		ErasureShareSize: scheme.ShareSize,
+
+		MinReq:           int32(scheme.RequiredShares),
+		RepairThreshold:  int32(scheme.RepairShares),
+		SuccessThreshold: int32(scheme.OptimalShares),
+		Total:            int32(scheme.TotalShares),
+	}
+}
+
+func convertRedundancyFromProto(rs *pb.RedundancyScheme) storj.RedundancyScheme {
+	return storj.RedundancyScheme{
+		Algorithm:      storj.RedundancyAlgorithm(rs.Type),
+		ShareSize:      rs.ErasureShareSize,
+		RequiredShares: int16(rs.MinReq),
+		RepairShares:   int16(rs.RepairThreshold),
+		OptimalShares:  int16(rs.SuccessThreshold),
+		TotalShares:    int16(rs.Total),
+	}
+}
+
 // BeginObject begins object.
 func (endpoint *Endpoint) BeginObject(ctx context.Context, req *pb.ObjectBeginRequest) (resp *pb.ObjectBeginResponse, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -628,27 +716,52 @@ func (endpoint *Endpoint) BeginObject(ctx context.Context, req *pb.ObjectBeginRe
 		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
 	}
 
+	rs, err := endpoint.getBucketRS(ctx, keyInfo.ProjectID, req.Bucket)
+	if err != nil {
+		if storj.ErrBucketNotFound.Has(err) {
+			return nil, rpcstatus.Error(rpcstatus.NotFound, "bucket not found: non-existing-bucket")
+		}
+		endpoint.log.Error("unable to check bucket", zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+
 	objectKeyLength := len(req.EncryptedPath)
 	if objectKeyLength > endpoint.config.MaxEncryptedObjectKeyLength {
 		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, fmt.Sprintf("key length is too big, got %v, maximum allowed is %v", objectKeyLength, endpoint.config.MaxEncryptedObjectKeyLength))
 	}
 
-	if canDelete {
-		_, err = endpoint.DeleteObjectAnyStatus(ctx, metabase.ObjectLocation{
-			ProjectID:  keyInfo.ProjectID,
-			BucketName: string(req.Bucket),
-			ObjectKey:  metabase.ObjectKey(req.EncryptedPath),
-		})
+	versioning, err := endpoint.buckets.GetBucketVersioning(ctx, req.Bucket, keyInfo.ProjectID)
+	if err != nil {
+		if storj.ErrBucketNotFound.Has(err) {
+			return nil, rpcstatus.Error(rpcstatus.NotFound, "bucket not found: non-existing-bucket")
+		}
+		endpoint.log.Error("unable to check bucket", zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+
+	location := metabase.ObjectLocation{
+		ProjectID:  keyInfo.ProjectID,
+		BucketName: string(req.Bucket),
+		ObjectKey:  metabase.ObjectKey(req.EncryptedPath),
+	}
+
+	switch {
+	case versioning == buckets.VersioningEnabled:
+		// every upload creates a new version, nothing is overwritten
+	case canDelete && versioning == buckets.VersioningSuspended:
+		// the upload replaces the null version, versions created while versioning was enabled are kept
+		_, err = endpoint.DeleteObjectNullVersion(ctx, location)
 		if err != nil && !storj.ErrObjectNotFound.Has(err) {
-			return nil, err
+			return nil, endpoint.convertMetabaseErr(err)
 		}
-	} else {
+	case canDelete:
+		_, err = endpoint.DeleteObjectAnyStatus(ctx, location)
+		if err != nil && !storj.ErrObjectNotFound.Has(err) {
+			return nil, endpoint.convertMetabaseErr(err)
+		}
+	default:
 		_, err = endpoint.metabase.GetObjectLatestVersion(ctx, metabase.GetObjectLatestVersion{
-			ObjectLocation: metabase.ObjectLocation{
-				ProjectID:  keyInfo.ProjectID,
-				BucketName: string(req.Bucket),
-				ObjectKey:  metabase.ObjectKey(req.EncryptedPath),
-			},
+			ObjectLocation: location,
 		})
 		if err == nil {
 			return nil, rpcstatus.Error(rpcstatus.PermissionDenied, "Unauthorized API credentials")
@@ -679,17 +792,34 @@ func (endpoint *Endpoint) BeginObject(ctx context.Context, req *pb.ObjectBeginRe
 		expiresAt = &req.ExpiresAt
 	}
 
-	object, err := endpoint.metabase.BeginObjectExactVersion(ctx, metabase.BeginObjectExactVersion{
-		ObjectStream: metabase.ObjectStream{
-			ProjectID:  keyInfo.ProjectID,
-			BucketName: string(req.Bucket),
-			ObjectKey:  metabase.ObjectKey(req.EncryptedPath),
-			StreamID:   streamID,
-			Version:    metabase.Version(1),
-		},
-		ExpiresAt:  expiresAt,
-		Encryption: encryptionParameters,
-	})
+	var object metabase.Object
+	if versioning.IsVersioned() {
+		object.Version, err = endpoint.metabase.BeginObjectNextVersion(ctx, metabase.BeginObjectNextVersion{
+			ObjectStream: metabase.ObjectStream{
+				ProjectID:  keyInfo.ProjectID,
+				BucketName: string(req.Bucket),
+				ObjectKey:  metabase.ObjectKey(req.EncryptedPath),
+				StreamID:   streamID,
+				Version:    metabase.NextVersion,
+			},
+			ExpiresAt:  expiresAt,
+			Encryption: encryptionParameters,
+			Versioned:  versioning == buckets.VersioningEnabled,
+		})
+		object.CreatedAt = now
+	} else {
+		object, err = endpoint.metabase.BeginObjectExactVersion(ctx, metabase.BeginObjectExactVersion{
+			ObjectStream: metabase.ObjectStream{
+				ProjectID:  keyInfo.ProjectID,
+				BucketName: string(req.Bucket),
+				ObjectKey:  metabase.ObjectKey(req.EncryptedPath),
+				StreamID:   streamID,
+				Version:    metabase.DefaultVersion,
+			},
+			ExpiresAt:  expiresAt,
+			Encryption: encryptionParameters,
+		})
+	}
 	if err != nil {
 		return nil, endpoint.convertMetabaseErr(err)
 	}
@@ -704,6 +834,7 @@ func (endpoint *Endpoint) BeginObject(ctx context.Context, req *pb.ObjectBeginRe
 		MultipartObject:      object.FixedSegmentSize <= 0,
 		EncryptionParameters: req.EncryptionParameters,
 		Placement:            int32(placement),
+		Redundancy:           rs,
 	})
 	if err != nil {
 		endpoint.log.Error("internal", zap.Error(err))
@@ -718,7 +849,7 @@ func (endpoint *Endpoint) BeginObject(ctx context.Context, req *pb.ObjectBeginRe
 		EncryptedPath:    req.EncryptedPath,
 		Version:          req.Version,
 		StreamId:         satStreamID,
-		RedundancyScheme: endpoint.defaultRS,
+		RedundancyScheme: rs,
 	}, nil
 }
 
@@ -772,7 +903,7 @@ func (endpoint *Endpoint) CommitObject(ctx context.Context, req *pb.ObjectCommit
 			BucketName: string(streamID.Bucket),
 			ObjectKey:  metabase.ObjectKey(streamID.EncryptedObjectKey),
 			StreamID:   id,
-			Version:    metabase.Version(1),
+			Version:    metabase.Version(streamID.Version),
 		},
 		Encryption: encryption,
 	}
@@ -825,13 +956,11 @@ func (endpoint *Endpoint) GetObject(ctx context.Context, req *pb.ObjectGetReques
 		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
 	}
 
-	mbObject, err := endpoint.metabase.GetObjectLatestVersion(ctx, metabase.GetObjectLatestVersion{
-		ObjectLocation: metabase.ObjectLocation{
-			ProjectID:  keyInfo.ProjectID,
-			BucketName: string(req.Bucket),
-			ObjectKey:  metabase.ObjectKey(req.EncryptedPath),
-		},
-	})
+	mbObject, err := endpoint.getObjectVersion(ctx, metabase.ObjectLocation{
+		ProjectID:  keyInfo.ProjectID,
+		BucketName: string(req.Bucket),
+		ObjectKey:  metabase.ObjectKey(req.EncryptedPath),
+	}, metabase.Version(req.Version))
 	if err != nil {
 		return nil, endpoint.convertMetabaseErr(err)
 	}
@@ -877,6 +1006,23 @@ func (endpoint *Endpoint) GetObject(ctx context.Context, req *pb.ObjectGetReques
 	return &pb.ObjectGetResponse{Object: object}, nil
 }
 
+// getObjectVersion returns the specified version of the object or the latest
+// version when version is not set.
+func (endpoint *Endpoint) getObjectVersion(ctx context.Context, location metabase.ObjectLocation, version metabase.Version) (_ metabase.Object, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if version > 0 {
+		return endpoint.metabase.GetObjectExactVersion(ctx, metabase.GetObjectExactVersion{
+			ObjectLocation: location,
+			Version:        version,
+		})
+	}
+
+	return endpoint.metabase.GetObjectLatestVersion(ctx, metabase.GetObjectLatestVersion{
+		ObjectLocation: location,
+	})
+}
+
 // DownloadObject gets object information, creates a download for segments and lists the object segments.
 func (endpoint *Endpoint) DownloadObject(ctx context.Context, req *pb.ObjectDownloadRequest) (resp *pb.ObjectDownloadResponse, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -925,16 +1071,31 @@ func (endpoint *Endpoint) DownloadObject(ctx context.Context, req *pb.ObjectDown
 
 	// get the object information
 
-	object, err := endpoint.metabase.GetObjectLatestVersion(ctx, metabase.GetObjectLatestVersion{
-		ObjectLocation: metabase.ObjectLocation{
-			ProjectID:  keyInfo.ProjectID,
-			BucketName: string(req.Bucket),
-			ObjectKey:  metabase.ObjectKey(req.EncryptedObjectKey),
-		},
-	})
+	objectVersion, err := requestedDownloadVersion(req)
+	if err != nil {
+		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+	}
+
+	var version metabase.Version
+	var streamIDSuffix []byte
+	if objectVersion != nil {
+		version, streamIDSuffix, err = decodeObjectVersion(objectVersion)
+		if err != nil {
+			return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+		}
+	}
+
+	object, err := endpoint.getObjectVersion(ctx, metabase.ObjectLocation{
+		ProjectID:  keyInfo.ProjectID,
+		BucketName: string(req.Bucket),
+		ObjectKey:  metabase.ObjectKey(req.EncryptedObjectKey),
+	}, version)
 	if err != nil {
 		return nil, endpoint.convertMetabaseErr(err)
 	}
+	if streamIDSuffix != nil && !matchesObjectVersion(object.StreamID, streamIDSuffix) {
+		return nil, rpcstatus.Error(rpcstatus.NotFound, "object not found")
+	}
 
 	// get the range segments
 
@@ -1276,9 +1437,61 @@ func (endpoint *Endpoint) ListObjects(ctx context.Context, req *pb.ObjectListReq
 		includeSystemMetadata = !req.ObjectIncludes.ExcludeSystemMetadata
 	}
 
+	includeAllVersions, versionCursor, err := requestedListVersions(req)
+	if err != nil {
+		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+	}
+	if includeAllVersions {
+		if !req.Recursive {
+			return nil, rpcstatus.Error(rpcstatus.InvalidArgument, "listing all versions is supported only for recursive listing")
+		}
+
+		cursorVersion := metabase.Version(0)
+		if len(cursor) != 0 {
+			// without a version cursor, all versions of the cursor key were listed
+			cursorVersion = metabase.Version(math.MaxInt64)
+		}
+		if len(versionCursor) != 0 {
+			cursorVersion, _, err = decodeObjectVersion(versionCursor)
+			if err != nil {
+				return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+			}
+		}
+
+		resp, err = endpoint.listAllVersions(ctx, req.Bucket, metabase.IterateObjects{
+			ProjectID:  keyInfo.ProjectID,
+			BucketName: string(req.Bucket),
+			Prefix:     prefix,
+			Cursor: metabase.IterateCursor{
+				Key:     metabase.ObjectKey(cursor),
+				Version: cursorVersion,
+			},
+			BatchSize: limit + 1,
+		}, limit, includeCustomMetadata, placement)
+		if err != nil {
+			return nil, err
+		}
+
+		endpoint.log.Info("Object List", zap.Stringer("Project ID", keyInfo.ProjectID), zap.String("operation", "list"), zap.String("type", "object"))
+		mon.Meter("req_list_object").Mark(1)
+
+		return resp, nil
+	}
+
+	iterateObjects := endpoint.metabase.IterateObjectsAllVersionsWithStatus
+	if status == metabase.Committed {
+		versioning, err := endpoint.buckets.GetBucketVersioning(ctx, req.Bucket, keyInfo.ProjectID)
+		if err != nil {
+			endpoint.log.Error("unable to check bucket", zap.Error(err))
+			return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+		}
+		if versioning.IsVersioned() {
+			iterateObjects = endpoint.metabase.IterateObjectsLatestVersion
+		}
+	}
+
 	resp = &pb.ObjectListResponse{}
-	// TODO: Replace with IterateObjectsLatestVersion when ready
-	err = endpoint.metabase.IterateObjectsAllVersionsWithStatus(ctx,
+	err = iterateObjects(ctx,
 		metabase.IterateObjectsWithStatus{
 			ProjectID:  keyInfo.ProjectID,
 			BucketName: string(req.Bucket),
@@ -1315,6 +1528,50 @@ func (endpoint *Endpoint) ListObjects(ctx context.Context, req *pb.ObjectListReq
 	return resp, nil
 }
 
+// listAllVersions lists the committed versions and the delete markers of the objects
+// in the order of their keys and versions.
+func (endpoint *Endpoint) listAllVersions(ctx context.Context, bucket []byte, opts metabase.IterateObjects,
+	limit int, includeMetadata bool, placement storj.PlacementConstraint) (resp *pb.ObjectListResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	// one more entry is listed to know whether there are more entries and
+	// whether the last listed entry is the latest version of its object.
+	entries := make([]metabase.ObjectEntry, 0, limit+1)
+	err = endpoint.metabase.IterateObjectsAllVersions(ctx, opts, func(ctx context.Context, it metabase.ObjectsIterator) error {
+		entry := metabase.ObjectEntry{}
+		for len(entries) <= limit && it.Next(ctx, &entry) {
+			if entry.Status == metabase.Pending {
+				continue
+			}
+			entries = append(entries, entry)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, endpoint.convertMetabaseErr(err)
+	}
+
+	resp = &pb.ObjectListResponse{More: len(entries) > limit}
+	for i, entry := range entries {
+		if i == limit {
+			break
+		}
+
+		item, err := endpoint.objectEntryToProtoListItem(ctx, bucket, entry, opts.Prefix, includeMetadata, placement)
+		if err != nil {
+			return nil, endpoint.convertMetabaseErr(err)
+		}
+
+		isLatest := i+1 == len(entries) || entries[i+1].ObjectKey != entry.ObjectKey
+		if err := setListItemVersion(item, entry.Version, entry.StreamID, isLatest); err != nil {
+			return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+		}
+		resp.Items = append(resp.Items, item)
+	}
+
+	return resp, nil
+}
+
 // ListPendingObjectStreams list pending objects according to specific parameters.
 func (endpoint *Endpoint) ListPendingObjectStreams(ctx context.Context, req *pb.ObjectListPendingStreamsRequest) (resp *pb.ObjectListPendingStreamsResponse, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -1471,17 +1728,22 @@ func (endpoint *Endpoint) BeginDeleteObject(ctx context.Context, req *pb.ObjectB
 						ProjectID:  keyInfo.ProjectID,
 						BucketName: string(req.Bucket),
 						ObjectKey:  metabase.ObjectKey(req.EncryptedPath),
-						Version:    metabase.Version(req.GetVersion()),
+						Version:    metabase.Version(pbStreamID.Version),
 						StreamID:   streamID,
 					})
 			}
 		}
 	} else {
-		deletedObjects, err = endpoint.DeleteCommittedObject(ctx, keyInfo.ProjectID, string(req.Bucket), metabase.ObjectKey(req.EncryptedPath))
+		deletedObjects, err = endpoint.deleteCommittedObjectVersion(ctx, metabase.ObjectLocation{
+			ProjectID:  keyInfo.ProjectID,
+			BucketName: string(req.Bucket),
+			ObjectKey:  metabase.ObjectKey(req.EncryptedPath),
+		}, metabase.Version(req.GetVersion()))
 	}
 	if err != nil {
 		if !canRead && !canList {
-			// No error info is returned if neither Read, nor List permission is granted
+			// No error info is returned if neither Read, nor List permission is granted,
+			// this includes whether the object is protected by object lock.
 			return &pb.ObjectBeginDeleteResponse{}, nil
 		}
 		return nil, endpoint.convertMetabaseErr(err)
@@ -1682,7 +1944,16 @@ func (endpoint *Endpoint) BeginSegment(ctx context.Context, req *pb.SegmentBegin
 		return nil, err
 	}
 
-	redundancy, err := eestream.NewRedundancyStrategyFromProto(endpoint.defaultRS)
+	rs, err := endpoint.getStreamRS(ctx, keyInfo.ProjectID, streamID)
+	if err != nil {
+		if storj.ErrBucketNotFound.Has(err) {
+			return nil, rpcstatus.Error(rpcstatus.NotFound, err.Error())
+		}
+		endpoint.log.Error("internal", zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+
+	redundancy, err := eestream.NewRedundancyStrategyFromProto(rs)
 	if err != nil {
 		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
 	}
@@ -1725,7 +1996,7 @@ func (endpoint *Endpoint) BeginSegment(ctx context.Context, req *pb.SegmentBegin
 			BucketName: string(streamID.Bucket),
 			ObjectKey:  metabase.ObjectKey(streamID.EncryptedObjectKey),
 			StreamID:   id,
-			Version:    1,
+			Version:    metabase.Version(streamID.Version),
 		},
 		Position: metabase.SegmentPosition{
 			Part:  uint32(req.Position.PartNumber),
@@ -1754,7 +2025,7 @@ func (endpoint *Endpoint) BeginSegment(ctx context.Context, req *pb.SegmentBegin
 		SegmentId:        segmentID,
 		AddressedLimits:  addressedLimits,
 		PrivateKey:       piecePrivateKey,
-		RedundancyScheme: endpoint.defaultRS,
+		RedundancyScheme: rs,
 	}, nil
 }
 
@@ -1784,27 +2055,29 @@ func (endpoint *Endpoint) CommitSegment(ctx context.Context, req *pb.SegmentComm
 		return nil, err
 	}
 
+	streamRS, err := endpoint.getStreamRS(ctx, keyInfo.ProjectID, streamID)
+	if err != nil {
+		if storj.ErrBucketNotFound.Has(err) {
+			return nil, rpcstatus.Error(rpcstatus.NotFound, err.Error())
+		}
+		endpoint.log.Error("internal", zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+
 	// cheap basic verification
-	if numResults := len(req.UploadResult); numResults < int(endpoint.defaultRS.GetSuccessThreshold()) {
+	if numResults := len(req.UploadResult); numResults < int(streamRS.GetSuccessThreshold()) {
 		endpoint.log.Debug("the results of uploaded pieces for the segment is below the redundancy optimal threshold",
 			zap.Int("upload pieces results", numResults),
-			zap.Int32("redundancy optimal threshold", endpoint.defaultRS.GetSuccessThreshold()),
+			zap.Int32("redundancy optimal threshold", streamRS.GetSuccessThreshold()),
 			zap.Stringer("Segment ID", req.SegmentId),
 		)
 		return nil, rpcstatus.Errorf(rpcstatus.InvalidArgument,
 			"the number of results of uploaded pieces (%d) is below the optimal threshold (%d)",
-			numResults, endpoint.defaultRS.GetSuccessThreshold(),
+			numResults, streamRS.GetSuccessThreshold(),
 		)
 	}
 
-	rs := storj.RedundancyScheme{
-		Algorithm:      storj.RedundancyAlgorithm(endpoint.defaultRS.Type),
-		RequiredShares: int16(endpoint.defaultRS.MinReq),
-		RepairShares:   int16(endpoint.defaultRS.RepairThreshold),
-		OptimalShares:  int16(endpoint.defaultRS.SuccessThreshold),
-		TotalShares:    int16(endpoint.defaultRS.Total),
-		ShareSize:      endpoint.defaultRS.ErasureShareSize,
-	}
+	rs := convertRedundancyFromProto(streamRS)
 
 	err = endpoint.pointerVerification.VerifySizes(ctx, rs, req.SizeEncryptedData, req.UploadResult)
 	if err != nil {
@@ -1874,7 +2147,7 @@ func (endpoint *Endpoint) CommitSegment(ctx context.Context, req *pb.SegmentComm
 			BucketName: string(streamID.Bucket),
 			ObjectKey:  metabase.ObjectKey(streamID.EncryptedObjectKey),
 			StreamID:   id,
-			Version:    1,
+			Version:    metabase.Version(streamID.Version),
 		},
 		ExpiresAt:         expiresAt,
 		EncryptedKey:      req.EncryptedKey,
@@ -2005,7 +2278,7 @@ func (endpoint *Endpoint) MakeInlineSegment(ctx context.Context, req *pb.Segment
 			BucketName: string(streamID.Bucket),
 			ObjectKey:  metabase.ObjectKey(streamID.EncryptedObjectKey),
 			StreamID:   id,
-			Version:    1,
+			Version:    metabase.Version(streamID.Version),
 		},
 		ExpiresAt:         expiresAt,
 		EncryptedKey:      req.EncryptedKey,
@@ -2443,6 +2716,82 @@ func (endpoint *Endpoint) DeleteObjectAnyStatus(ctx context.Context, location me
 	return deletedObjects, nil
 }
 
+// deleteCommittedObjectVersion deletes a committed object taking the versioning
+// state of the bucket into account.
+//
+// In a versioned bucket, deleting without a version only creates a delete marker.
+// When versioning is suspended the delete marker replaces the null version of the
+// object. A specific version can be deleted only from a versioned bucket.
+func (endpoint *Endpoint) deleteCommittedObjectVersion(ctx context.Context, location metabase.ObjectLocation, version metabase.Version) (deletedObjects []*pb.Object, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	versioning, err := endpoint.buckets.GetBucketVersioning(ctx, []byte(location.BucketName), location.ProjectID)
+	if err != nil && !storj.ErrBucketNotFound.Has(err) {
+		return nil, Error.Wrap(err)
+	}
+
+	switch {
+	case versioning.IsVersioned() && version > 0:
+		return endpoint.DeleteObjectExactVersion(ctx, location, version)
+	case versioning.IsVersioned():
+		if versioning == buckets.VersioningSuspended {
+			deletedObjects, err = endpoint.DeleteObjectNullVersion(ctx, location)
+			if err != nil && !storj.ErrObjectNotFound.Has(err) {
+				return nil, err
+			}
+		}
+
+		_, err = endpoint.metabase.CreateDeleteMarker(ctx, metabase.CreateDeleteMarker{
+			ObjectLocation: location,
+			Versioned:      versioning == buckets.VersioningEnabled,
+		})
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		return deletedObjects, nil
+	default:
+		return endpoint.DeleteCommittedObject(ctx, location.ProjectID, location.BucketName, location.ObjectKey)
+	}
+}
+
+// DeleteObjectExactVersion deletes all the pieces of the storage nodes that belongs
+// to the specified object version.
+//
+// NOTE: this method is exported for being able to individually test it without
+// having import cycles.
+func (endpoint *Endpoint) DeleteObjectExactVersion(ctx context.Context, location metabase.ObjectLocation, version metabase.Version) (deletedObjects []*pb.Object, err error) {
+	defer mon.Task()(&ctx, location.ProjectID.String(), location.BucketName, location.ObjectKey)(&err)
+
+	result, err := endpoint.metabase.DeleteObjectExactVersion(ctx, metabase.DeleteObjectExactVersion{
+		ObjectLocation: location,
+		Version:        version,
+	})
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return endpoint.deleteObjectsPieces(ctx, result)
+}
+
+// DeleteObjectNullVersion deletes all the pieces of the storage nodes that belongs
+// to the null version of the specified object. Versions created while versioning
+// was enabled for the bucket are kept.
+//
+// NOTE: this method is exported for being able to individually test it without
+// having import cycles.
+func (endpoint *Endpoint) DeleteObjectNullVersion(ctx context.Context, location metabase.ObjectLocation) (deletedObjects []*pb.Object, err error) {
+	defer mon.Task()(&ctx, location.ProjectID.String(), location.BucketName, location.ObjectKey)(&err)
+
+	result, err := endpoint.metabase.DeleteObjectNullVersion(ctx, metabase.DeleteObjectNullVersion{
+		ObjectLocation: location,
+	})
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return endpoint.deleteObjectsPieces(ctx, result)
+}
+
 // DeletePendingObject deletes all the pieces of the storage nodes that belongs
 // to the specified pending object.
 //
@@ -2817,6 +3166,20 @@ func (endpoint *Endpoint) BeginMoveObject(ctx context.Context, req *pb.ObjectBeg
 		}
 	}
 
+	for _, bucket := range [][]byte{req.Bucket, req.NewBucket} {
+		versioning, err := endpoint.buckets.GetBucketVersioning(ctx, bucket, keyInfo.ProjectID)
+		if err != nil {
+			if storj.ErrBucketNotFound.Has(err) {
+				return nil, rpcstatus.Error(rpcstatus.NotFound, fmt.Sprintf("bucket not found: %s", bucket))
+			}
+			endpoint.log.Error("unable to check bucket", zap.Error(err))
+			return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+		}
+		if versioning.IsVersioned() {
+			return nil, rpcstatus.Error(rpcstatus.InvalidArgument, "moving objects in versioned buckets is not (yet) supported")
+		}
+	}
+
 	result, err := endpoint.metabase.BeginMoveObject(ctx, metabase.BeginMoveObject{
 		ObjectLocation: metabase.ObjectLocation{
 			ProjectID:  keyInfo.ProjectID,
@@ -2973,6 +3336,211 @@ func (endpoint *Endpoint) FinishMoveObject(ctx context.Context, req *pb.ObjectFi
 	return &pb.ObjectFinishMoveResponse{}, nil
 }
 
+// Server side copy.
+
+// BeginCopyObject begins copying object to different key.
+func (endpoint *Endpoint) BeginCopyObject(ctx context.Context, req *pb.ObjectBeginCopyRequest) (resp *pb.ObjectBeginCopyResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = endpoint.versionCollector.collect(req.Header.UserAgent, mon.Func().ShortName())
+	if err != nil {
+		endpoint.log.Warn("unable to collect uplink version", zap.Error(err))
+	}
+
+	now := time.Now()
+	keyInfo, err := endpoint.validateAuthN(ctx, req.Header,
+		verifyPermission{
+			action: macaroon.Action{
+				Op:            macaroon.ActionRead,
+				Bucket:        req.Bucket,
+				EncryptedPath: req.EncryptedObjectKey,
+				Time:          now,
+			},
+		},
+		verifyPermission{
+			action: macaroon.Action{
+				Op:            macaroon.ActionWrite,
+				Bucket:        req.NewBucket,
+				EncryptedPath: req.NewEncryptedObjectKey,
+				Time:          now,
+			},
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, bucket := range [][]byte{req.Bucket, req.NewBucket} {
+		err = endpoint.validateBucket(ctx, bucket)
+		if err != nil {
+			return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+		}
+	}
+
+	// the copy shares the pieces of the source object, so both buckets
+	// need to have the same placement
+	newBucketPlacement, err := endpoint.buckets.GetBucketPlacement(ctx, req.NewBucket, keyInfo.ProjectID)
+	if err != nil {
+		if storj.ErrBucketNotFound.Has(err) {
+			return nil, rpcstatus.Error(rpcstatus.NotFound, fmt.Sprintf("target bucket not found: %s", req.NewBucket))
+		}
+		endpoint.log.Error("unable to check bucket", zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+
+	if !bytes.Equal(req.Bucket, req.NewBucket) {
+		oldBucketPlacement, err := endpoint.buckets.GetBucketPlacement(ctx, req.Bucket, keyInfo.ProjectID)
+		if err != nil {
+			if storj.ErrBucketNotFound.Has(err) {
+				return nil, rpcstatus.Error(rpcstatus.NotFound, fmt.Sprintf("source bucket not found: %s", req.Bucket))
+			}
+			endpoint.log.Error("unable to check bucket", zap.Error(err))
+			return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+		}
+		if oldBucketPlacement != newBucketPlacement {
+			return nil, rpcstatus.Error(rpcstatus.InvalidArgument, "copying object to bucket with different placement policy is not (yet) supported")
+		}
+	}
+
+	for _, bucket := range [][]byte{req.Bucket, req.NewBucket} {
+		versioning, err := endpoint.buckets.GetBucketVersioning(ctx, bucket, keyInfo.ProjectID)
+		if err != nil {
+			if storj.ErrBucketNotFound.Has(err) {
+				return nil, rpcstatus.Error(rpcstatus.NotFound, fmt.Sprintf("bucket not found: %s", bucket))
+			}
+			endpoint.log.Error("unable to check bucket", zap.Error(err))
+			return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+		}
+		if versioning.IsVersioned() {
+			return nil, rpcstatus.Error(rpcstatus.InvalidArgument, "copying objects in versioned buckets is not (yet) supported")
+		}
+	}
+
+	if err := endpoint.checkExceedsStorageUsage(ctx, keyInfo.ProjectID); err != nil {
+		return nil, err
+	}
+
+	result, err := endpoint.metabase.BeginCopyObject(ctx, metabase.BeginCopyObject{
+		ObjectLocation: metabase.ObjectLocation{
+			ProjectID:  keyInfo.ProjectID,
+			BucketName: string(req.Bucket),
+			ObjectKey:  metabase.ObjectKey(req.EncryptedObjectKey),
+		},
+		Version: metabase.DefaultVersion,
+	})
+	if err != nil {
+		return nil, endpoint.convertMetabaseErr(err)
+	}
+
+	moveResponse, err := convertBeginMoveObjectResults(metabase.BeginMoveObjectResult(result))
+	if err != nil {
+		endpoint.log.Error("internal", zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+
+	satStreamID, err := endpoint.packStreamID(ctx, &internalpb.StreamID{
+		Bucket:             req.Bucket,
+		EncryptedObjectKey: req.EncryptedObjectKey,
+		Version:            int32(metabase.DefaultVersion),
+		StreamId:           result.StreamID[:],
+		EncryptionParameters: &pb.EncryptionParameters{
+			CipherSuite: pb.CipherSuite(result.EncryptionParameters.CipherSuite),
+			BlockSize:   int64(result.EncryptionParameters.BlockSize),
+		},
+		Placement: int32(newBucketPlacement),
+	})
+	if err != nil {
+		endpoint.log.Error("internal", zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+
+	return &pb.ObjectBeginCopyResponse{
+		StreamId:                  satStreamID,
+		EncryptedMetadataKeyNonce: moveResponse.EncryptedMetadataKeyNonce,
+		EncryptedMetadataKey:      moveResponse.EncryptedMetadataKey,
+		SegmentKeys:               moveResponse.SegmentKeys,
+		EncryptionParameters:      moveResponse.EncryptionParameters,
+	}, nil
+}
+
+// FinishCopyObject accepts new encryption keys for copied object and inserts the corresponding new object ObjectKey and segments EncryptedKey.
+func (endpoint *Endpoint) FinishCopyObject(ctx context.Context, req *pb.ObjectFinishCopyRequest) (resp *pb.ObjectFinishCopyResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = endpoint.versionCollector.collect(req.Header.UserAgent, mon.Func().ShortName())
+	if err != nil {
+		endpoint.log.Warn("unable to collect uplink version", zap.Error(err))
+	}
+
+	streamID, err := endpoint.unmarshalSatStreamID(ctx, req.StreamId)
+	if err != nil {
+		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+	}
+
+	keyInfo, err := endpoint.validateAuth(ctx, req.Header, macaroon.Action{
+		Op:            macaroon.ActionWrite,
+		Time:          time.Now(),
+		Bucket:        req.NewBucket,
+		EncryptedPath: req.NewEncryptedObjectKey,
+	})
+	if err != nil {
+		return nil, rpcstatus.Error(rpcstatus.Unauthenticated, err.Error())
+	}
+
+	err = endpoint.validateBucket(ctx, req.NewBucket)
+	if err != nil {
+		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+	}
+
+	exists, err := endpoint.buckets.HasBucket(ctx, req.NewBucket, keyInfo.ProjectID)
+	if err != nil {
+		endpoint.log.Error("unable to check bucket", zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	} else if !exists {
+		return nil, rpcstatus.Error(rpcstatus.NotFound, fmt.Sprintf("target bucket not found: %s", req.NewBucket))
+	}
+
+	streamUUID, err := uuid.FromBytes(streamID.StreamId)
+	if err != nil {
+		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+	}
+
+	newStreamID, err := uuid.New()
+	if err != nil {
+		endpoint.log.Error("internal", zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+
+	object, err := endpoint.metabase.FinishCopyObject(ctx, metabase.FinishCopyObject{
+		ObjectStream: metabase.ObjectStream{
+			ProjectID:  keyInfo.ProjectID,
+			BucketName: string(streamID.Bucket),
+			ObjectKey:  metabase.ObjectKey(streamID.EncryptedObjectKey),
+			Version:    metabase.DefaultVersion,
+			StreamID:   streamUUID,
+		},
+		NewStreamID:                  newStreamID,
+		NewSegmentKeys:               protobufkeysToMetabase(req.NewSegmentKeys),
+		NewBucket:                    string(req.NewBucket),
+		NewEncryptedObjectKey:        req.NewEncryptedObjectKey,
+		NewEncryptedMetadataKeyNonce: req.NewEncryptedMetadataKeyNonce[:],
+		NewEncryptedMetadataKey:      req.NewEncryptedMetadataKey,
+	})
+	if err != nil {
+		return nil, endpoint.convertMetabaseErr(err)
+	}
+
+	protoObject, err := endpoint.objectToProto(ctx, object, nil)
+	if err != nil {
+		endpoint.log.Error("internal", zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+
+	return &pb.ObjectFinishCopyResponse{
+		Object: protoObject,
+	}, nil
+}
+
 // convertMetabaseErr converts domain errors from metabase to appropriate rpc statuses errors.
 func (endpoint *Endpoint) convertMetabaseErr(err error) error {
 	switch {
@@ -2982,6 +3550,10 @@ func (endpoint *Endpoint) convertMetabaseErr(err error) error {
 		return rpcstatus.Error(rpcstatus.NotFound, err.Error())
 	case metabase.ErrInvalidRequest.Has(err):
 		return rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+	case metabase.ErrObjectLocked.Has(err):
+		return rpcstatus.Error(rpcstatus.PermissionDenied, err.Error())
+	case metabase.ErrConflict.Has(err):
+		return rpcstatus.Error(rpcstatus.AlreadyExists, err.Error())
 	default:
 		endpoint.log.Error("internal", zap.Error(err))
 		return rpcstatus.Error(rpcstatus.Internal, err.Error())
@@ -12,6 +12,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/gogo/protobuf/proto"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/zeebo/errs"
@@ -943,6 +944,111 @@ func TestUploadWithPlacement(t *testing.T) {
 		require.Equal(t, storj.EU, segments[0].Placement)
 	})
 }
+
+func TestUploadWithRedundancyProfile(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(logger *zap.Logger, index int, config *satellite.Config) {
+				err := config.Metainfo.RedundancyProfiles.Set("archive:1/2/3/4-256B")
+				require.NoError(t, err)
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		apiKey := planet.Uplinks[0].APIKey[planet.Satellites[0].ID()]
+		metainfoClient, err := planet.Uplinks[0].DialMetainfo(ctx, planet.Satellites[0], apiKey)
+		require.NoError(t, err)
+		defer ctx.Check(metainfoClient.Close)
+
+		archive := storj.RedundancyScheme{
+			Algorithm:      storj.ReedSolomon,
+			ShareSize:      256,
+			RequiredShares: 1,
+			RepairShares:   2,
+			OptimalShares:  3,
+			TotalShares:    4,
+		}
+
+		_, err = metainfoClient.CreateBucket(ctx, metaclient.CreateBucketParams{
+			Name: []byte("not-allowed"),
+			DefaultRedundancyScheme: storj.RedundancyScheme{
+				Algorithm:      storj.ReedSolomon,
+				ShareSize:      256,
+				RequiredShares: 1,
+				RepairShares:   1,
+				OptimalShares:  1,
+				TotalShares:    1,
+			},
+		})
+		require.True(t, errs2.IsRPC(err, rpcstatus.InvalidArgument))
+
+		bucket, err := metainfoClient.CreateBucket(ctx, metaclient.CreateBucketParams{
+			Name:                    []byte("archive"),
+			DefaultRedundancyScheme: archive,
+		})
+		require.NoError(t, err)
+		require.Equal(t, archive, bucket.DefaultRedundancyScheme)
+
+		// this should be bigger than the max inline segment
+		content := testrand.Bytes(5 * memory.KiB)
+		err = planet.Uplinks[0].Upload(ctx, planet.Satellites[0], "archive", "file1", content)
+		require.NoError(t, err)
+
+		err = planet.Uplinks[0].CreateBucket(ctx, planet.Satellites[0], "default")
+		require.NoError(t, err)
+		err = planet.Uplinks[0].Upload(ctx, planet.Satellites[0], "default", "file1", content)
+		require.NoError(t, err)
+
+		segments, err := planet.Satellites[0].Metabase.DB.TestingAllSegments(ctx)
+		require.NoError(t, err)
+		require.Len(t, segments, 2)
+
+		objects, err := planet.Satellites[0].Metabase.DB.TestingAllObjects(ctx)
+		require.NoError(t, err)
+		bucketNames := map[uuid.UUID]string{}
+		for _, object := range objects {
+			bucketNames[object.StreamID] = object.BucketName
+		}
+
+		rsConfig := planet.Satellites[0].Config.Metainfo.RS
+		for _, segment := range segments {
+			switch bucketNames[segment.StreamID] {
+			case "archive":
+				require.Equal(t, archive, segment.Redundancy)
+			case "default":
+				require.EqualValues(t, rsConfig.Min, segment.Redundancy.RequiredShares)
+				require.EqualValues(t, rsConfig.Total, segment.Redundancy.TotalShares)
+			default:
+				t.Fatalf("unexpected segment %s", segment.StreamID)
+			}
+		}
+
+		// the scheme is resolved when the upload begins and carried in the stream id,
+		// so the segments don't look up the bucket anymore
+		beginObjectResponse, err := metainfoClient.BeginObject(ctx, metaclient.BeginObjectParams{
+			Bucket:        []byte("archive"),
+			EncryptedPath: []byte("file2"),
+			EncryptionParameters: storj.EncryptionParameters{
+				CipherSuite: storj.EncAESGCM,
+				BlockSize:   256,
+			},
+		})
+		require.NoError(t, err)
+
+		err = planet.Satellites[0].API.Buckets.Service.DB.DeleteBucket(ctx, []byte("archive"), planet.Uplinks[0].Projects[0].ID)
+		require.NoError(t, err)
+
+		beginSegmentResponse, err := metainfoClient.BeginSegment(ctx, metaclient.BeginSegmentParams{
+			StreamID:      beginObjectResponse.StreamID,
+			Position:      metaclient.SegmentPosition{Index: 0},
+			MaxOrderLimit: memory.KiB.Int64(),
+		})
+		require.NoError(t, err)
+		require.Equal(t, archive.TotalShares, int16(beginSegmentResponse.RedundancyStrategy.TotalCount()))
+		require.Equal(t, archive.RequiredShares, int16(beginSegmentResponse.RedundancyStrategy.RequiredCount()))
+	})
+}
+
 func TestRemoteSegment(t *testing.T) {
 	testplanet.Run(t, testplanet.Config{
 		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
@@ -2018,6 +2124,201 @@ func TestObjectOverrideOnUpload(t *testing.T) {
 	})
 }
 
+func TestSuspendedVersioning(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		uplink := planet.Uplinks[0]
+		projectID := uplink.Projects[0].ID
+
+		require.NoError(t, uplink.CreateBucket(ctx, sat, "versioned"))
+		require.NoError(t, sat.API.Buckets.Service.UpdateBucketVersioning(ctx, []byte("versioned"), projectID, buckets.VersioningEnabled))
+
+		versionedData := testrand.Bytes(5 * memory.KiB)
+		require.NoError(t, uplink.Upload(ctx, sat, "versioned", "object", versionedData))
+
+		require.NoError(t, sat.API.Buckets.Service.UpdateBucketVersioning(ctx, []byte("versioned"), projectID, buckets.VersioningSuspended))
+
+		// uploads replace only the null version
+		require.NoError(t, uplink.Upload(ctx, sat, "versioned", "object", testrand.Bytes(5*memory.KiB)))
+		nullData := testrand.Bytes(5 * memory.KiB)
+		require.NoError(t, uplink.Upload(ctx, sat, "versioned", "object", nullData))
+
+		data, err := uplink.Download(ctx, sat, "versioned", "object")
+		require.NoError(t, err)
+		require.Equal(t, nullData, data)
+
+		objects, err := sat.Metabase.DB.TestingAllObjects(ctx)
+		require.NoError(t, err)
+		require.Len(t, objects, 2)
+		require.True(t, objects[0].Versioned)
+		require.False(t, objects[1].Versioned)
+
+		// deletes replace the null version with a delete marker
+		require.NoError(t, uplink.DeleteObject(ctx, sat, "versioned", "object"))
+
+		_, err = uplink.Download(ctx, sat, "versioned", "object")
+		require.Error(t, err)
+
+		objects, err = sat.Metabase.DB.TestingAllObjects(ctx)
+		require.NoError(t, err)
+		require.Len(t, objects, 2)
+		require.Equal(t, metabase.Committed, objects[0].Status)
+		require.True(t, objects[0].Versioned)
+		require.Equal(t, metabase.DeleteMarker, objects[1].Status)
+		require.False(t, objects[1].Versioned)
+
+		// the version created while versioning was enabled is still readable
+		object, err := sat.Metabase.DB.GetObjectExactVersion(ctx, metabase.GetObjectExactVersion{
+			ObjectLocation: objects[0].Location(),
+			Version:        objects[0].Version,
+		})
+		require.NoError(t, err)
+		require.Equal(t, objects[0].StreamID, object.StreamID)
+	})
+}
+
+// listVersionsRequest contains the version fields of the newer ObjectListRequest.
+type listVersionsRequest struct {
+	IncludeAllVersions bool   `protobuf:"varint,9,opt,name=include_all_versions,proto3"`
+	VersionCursor      []byte `protobuf:"bytes,10,opt,name=version_cursor,proto3"`
+}
+
+func (m *listVersionsRequest) Reset()         { *m = listVersionsRequest{} }
+func (m *listVersionsRequest) String() string { return proto.CompactTextString(m) }
+func (*listVersionsRequest) ProtoMessage()    {}
+
+// listVersionsItem contains the version fields of the newer ObjectListItem.
+type listVersionsItem struct {
+	ObjectVersion []byte `protobuf:"bytes,12,opt,name=object_version,proto3"`
+	IsLatest      bool   `protobuf:"varint,13,opt,name=is_latest,proto3"`
+}
+
+func (m *listVersionsItem) Reset()         { *m = listVersionsItem{} }
+func (m *listVersionsItem) String() string { return proto.CompactTextString(m) }
+func (*listVersionsItem) ProtoMessage()    {}
+
+// downloadVersionRequest contains the version field of the newer ObjectDownloadRequest.
+type downloadVersionRequest struct {
+	ObjectVersion []byte `protobuf:"bytes,6,opt,name=object_version,proto3"`
+}
+
+func (m *downloadVersionRequest) Reset()         { *m = downloadVersionRequest{} }
+func (m *downloadVersionRequest) String() string { return proto.CompactTextString(m) }
+func (*downloadVersionRequest) ProtoMessage()    {}
+
+func TestListAndDownloadVersions(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		uplink := planet.Uplinks[0]
+		projectID := uplink.Projects[0].ID
+		apiKey := uplink.APIKey[sat.ID()]
+		endpoint := sat.API.Metainfo.Endpoint
+
+		require.NoError(t, uplink.CreateBucket(ctx, sat, "versioned"))
+		require.NoError(t, sat.API.Buckets.Service.UpdateBucketVersioning(ctx, []byte("versioned"), projectID, buckets.VersioningEnabled))
+
+		oldData := testrand.Bytes(5 * memory.KiB)
+		require.NoError(t, uplink.Upload(ctx, sat, "versioned", "object", oldData))
+		require.NoError(t, uplink.Upload(ctx, sat, "versioned", "object", testrand.Bytes(5*memory.KiB)))
+
+		objects, err := sat.Metabase.DB.TestingAllObjects(ctx)
+		require.NoError(t, err)
+		require.Len(t, objects, 2)
+		sort.Slice(objects, func(i, j int) bool { return objects[i].Version < objects[j].Version })
+
+		listVersions := func(t *testing.T, limit int32, versionCursor []byte) (*pb.ObjectListResponse, []listVersionsItem) {
+			fields, err := proto.Marshal(&listVersionsRequest{IncludeAllVersions: true, VersionCursor: versionCursor})
+			require.NoError(t, err)
+
+			req := &pb.ObjectListRequest{
+				Header:    &pb.RequestHeader{ApiKey: apiKey.SerializeRaw()},
+				Bucket:    []byte("versioned"),
+				Recursive: true,
+				Limit:     limit,
+			}
+			if versionCursor != nil {
+				req.EncryptedCursor = []byte(objects[0].ObjectKey)
+			}
+			req.XXX_unrecognized = fields
+
+			resp, err := endpoint.ListObjects(ctx, req)
+			require.NoError(t, err)
+
+			var versions []listVersionsItem
+			for _, item := range resp.Items {
+				data, err := pb.Marshal(item)
+				require.NoError(t, err)
+				var version listVersionsItem
+				require.NoError(t, proto.Unmarshal(data, &version))
+				versions = append(versions, version)
+			}
+			return resp, versions
+		}
+
+		resp, versions := listVersions(t, 10, nil)
+		require.False(t, resp.More)
+		require.Len(t, resp.Items, 2)
+		require.Equal(t, int32(objects[0].Version), resp.Items[0].Version)
+		require.False(t, versions[0].IsLatest)
+		require.Equal(t, int32(objects[1].Version), resp.Items[1].Version)
+		require.True(t, versions[1].IsLatest)
+
+		// the latest version isn't known until the next entry is listed
+		resp, firstPage := listVersions(t, 1, nil)
+		require.True(t, resp.More)
+		require.Len(t, resp.Items, 1)
+		require.False(t, firstPage[0].IsLatest)
+
+		resp, _ = listVersions(t, 1, firstPage[0].ObjectVersion)
+		require.False(t, resp.More)
+		require.Len(t, resp.Items, 1)
+		require.Equal(t, int32(objects[1].Version), resp.Items[0].Version)
+
+		// non-recursive listing of all versions isn't supported
+		fields, err := proto.Marshal(&listVersionsRequest{IncludeAllVersions: true})
+		require.NoError(t, err)
+		_, err = endpoint.ListObjects(ctx, &pb.ObjectListRequest{
+			Header:           &pb.RequestHeader{ApiKey: apiKey.SerializeRaw()},
+			Bucket:           []byte("versioned"),
+			XXX_unrecognized: fields,
+		})
+		require.True(t, errs2.IsRPC(err, rpcstatus.InvalidArgument))
+
+		download := func(objectVersion []byte) (*pb.ObjectDownloadResponse, error) {
+			fields, err := proto.Marshal(&downloadVersionRequest{ObjectVersion: objectVersion})
+			require.NoError(t, err)
+			return endpoint.DownloadObject(ctx, &pb.ObjectDownloadRequest{
+				Header:             &pb.RequestHeader{ApiKey: apiKey.SerializeRaw()},
+				Bucket:             []byte("versioned"),
+				EncryptedObjectKey: []byte(objects[0].ObjectKey),
+				XXX_unrecognized:   fields,
+			})
+		}
+
+		// the older version is downloaded when named by the request
+		downloadResp, err := download(versions[0].ObjectVersion)
+		require.NoError(t, err)
+		require.Equal(t, int32(objects[0].Version), downloadResp.Object.Version)
+
+		downloadResp, err = download(nil)
+		require.NoError(t, err)
+		require.Equal(t, int32(objects[1].Version), downloadResp.Object.Version)
+
+		// the version must belong to the same stream
+		mismatched := append([]byte{}, versions[0].ObjectVersion[:8]...)
+		mismatched = append(mismatched, testrand.Bytes(8)...)
+		_, err = download(mismatched)
+		require.True(t, errs2.IsRPC(err, rpcstatus.NotFound))
+
+		_, err = download([]byte{1, 2, 3})
+		require.True(t, errs2.IsRPC(err, rpcstatus.InvalidArgument))
+	})
+}
+
 func TestStableUploadID(t *testing.T) {
 	testplanet.Run(t, testplanet.Config{
 		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
@@ -2217,6 +2518,80 @@ func TestMoveObject_Geofencing(t *testing.T) {
 	)
 }
 
+func TestCopyObject(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: testplanet.ReconfigureRS(2, 2, 4, 4),
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		apiKey := planet.Uplinks[0].APIKey[satellite.ID()]
+		header := &pb.RequestHeader{ApiKey: apiKey.SerializeRaw()}
+
+		err := planet.Uplinks[0].Upload(ctx, satellite, "testbucket", "source", testrand.Bytes(10*memory.KiB))
+		require.NoError(t, err)
+
+		objects, err := satellite.Metabase.DB.TestingAllObjects(ctx)
+		require.NoError(t, err)
+		require.Len(t, objects, 1)
+		source := objects[0]
+
+		conn, err := planet.Uplinks[0].Dialer.DialNodeURL(ctx, satellite.NodeURL())
+		require.NoError(t, err)
+		defer ctx.Check(conn.Close)
+		client := pb.NewDRPCMetainfoClient(conn)
+
+		beginResponse, err := client.BeginCopyObject(ctx, &pb.ObjectBeginCopyRequest{
+			Header:                header,
+			Bucket:                []byte("testbucket"),
+			EncryptedObjectKey:    []byte(source.ObjectKey),
+			NewBucket:             []byte("testbucket"),
+			NewEncryptedObjectKey: []byte("copy"),
+		})
+		require.NoError(t, err)
+		require.Len(t, beginResponse.SegmentKeys, int(source.SegmentCount))
+
+		finishRequest := &pb.ObjectFinishCopyRequest{
+			Header:                       header,
+			StreamId:                     beginResponse.StreamId,
+			NewBucket:                    []byte("testbucket"),
+			NewEncryptedObjectKey:        []byte("copy"),
+			NewEncryptedMetadataKeyNonce: testrand.Nonce(),
+			NewEncryptedMetadataKey:      testrand.Bytes(32),
+			NewSegmentKeys:               beginResponse.SegmentKeys,
+		}
+		finishResponse, err := client.FinishCopyObject(ctx, finishRequest)
+		require.NoError(t, err)
+		require.Equal(t, []byte("copy"), finishResponse.Object.EncryptedPath)
+
+		// the destination can't be overwritten by another copy
+		_, err = client.FinishCopyObject(ctx, finishRequest)
+		require.True(t, errs2.IsRPC(err, rpcstatus.AlreadyExists))
+
+		segments, err := satellite.Metabase.DB.TestingAllSegments(ctx)
+		require.NoError(t, err)
+		require.Len(t, segments, 2)
+		require.NotEqual(t, segments[0].StreamID, segments[1].StreamID)
+		require.Equal(t, segments[0].RootPieceID, segments[1].RootPieceID)
+		require.Equal(t, segments[0].Pieces, segments[1].Pieces)
+
+		// the copy is kept when the source is deleted
+		err = planet.Uplinks[0].DeleteObject(ctx, satellite, "testbucket", "source")
+		require.NoError(t, err)
+
+		segments, err = satellite.Metabase.DB.TestingAllSegments(ctx)
+		require.NoError(t, err)
+		require.Len(t, segments, 1)
+
+		objects, err = satellite.Metabase.DB.TestingAllObjects(ctx)
+		require.NoError(t, err)
+		require.Len(t, objects, 1)
+		require.Equal(t, metabase.ObjectKey("copy"), objects[0].ObjectKey)
+		require.Equal(t, objects[0].StreamID, segments[0].StreamID)
+	})
+}
+
 func createGeofencedBucket(t *testing.T, ctx *testcontext.Context, buckets *buckets.Service, projectID uuid.UUID, bucketName string, placement storj.PlacementConstraint) {
 	// generate the bucket id
 	bucketID, err := uuid.New()
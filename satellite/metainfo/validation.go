@@ -4,10 +4,8 @@
 package metainfo
 
 import (
-	"bytes"
 	"context"
 	"crypto/subtle"
-	"regexp"
 	"time"
 
 	"github.com/zeebo/errs"
@@ -20,15 +18,12 @@ import (
 	"storj.io/common/rpc/rpcstatus"
 	"storj.io/common/storj"
 	"storj.io/common/uuid"
+	"storj.io/storj/satellite/buckets"
 	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/console/consoleauth"
 	"storj.io/storj/satellite/metabase"
 )
 
-var (
-	ipRegexp = regexp.MustCompile(`^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$`)
-)
-
 func getAPIKey(ctx context.Context, header *pb.RequestHeader) (key *macaroon.APIKey, err error) {
 	defer mon.Task()(&ctx)(&err)
 	if header != nil {
@@ -205,55 +200,7 @@ func (endpoint *Endpoint) validateBucket(ctx context.Context, bucket []byte) (er
 		return Error.Wrap(storj.ErrNoBucket.New(""))
 	}
 
-	if len(bucket) < 3 || len(bucket) > 63 {
-		return Error.New("bucket name must be at least 3 and no more than 63 characters long")
-	}
-
-	// Regexp not used because benchmark shows it will be slower for valid bucket names
-	// https://gist.github.com/mniewrzal/49de3af95f36e63e88fac24f565e444c
-	labels := bytes.Split(bucket, []byte("."))
-	for _, label := range labels {
-		err = validateBucketLabel(label)
-		if err != nil {
-			return err
-		}
-	}
-
-	if ipRegexp.MatchString(string(bucket)) {
-		return Error.New("bucket name cannot be formatted as an IP address")
-	}
-
-	return nil
-}
-
-func validateBucketLabel(label []byte) error {
-	if len(label) == 0 {
-		return Error.New("bucket label cannot be empty")
-	}
-
-	if !isLowerLetter(label[0]) && !isDigit(label[0]) {
-		return Error.New("bucket label must start with a lowercase letter or number")
-	}
-
-	if label[0] == '-' || label[len(label)-1] == '-' {
-		return Error.New("bucket label cannot start or end with a hyphen")
-	}
-
-	for i := 1; i < len(label)-1; i++ {
-		if !isLowerLetter(label[i]) && !isDigit(label[i]) && (label[i] != '-') && (label[i] != '.') {
-			return Error.New("bucket name must contain only lowercase letters, numbers or hyphens")
-		}
-	}
-
-	return nil
-}
-
-func isLowerLetter(r byte) bool {
-	return r >= 'a' && r <= 'z'
-}
-
-func isDigit(r byte) bool {
-	return r >= '0' && r <= '9'
+	return Error.Wrap(buckets.ValidateName(bucket))
 }
 
 func (endpoint *Endpoint) validateRemoteSegment(ctx context.Context, commitRequest metabase.CommitSegment, originalLimits []*pb.OrderLimit) (err error) {
@@ -0,0 +1,127 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metainfo
+
+import (
+	"bytes"
+	"encoding/binary"
+
+	"github.com/gogo/protobuf/proto"
+
+	"storj.io/common/pb"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/metabase"
+)
+
+// The requests defined in storj.io/common can't name an object version yet. Newer
+// uplinks send the version fields with the field numbers of the newer protocol. The
+// generated code keeps them in XXX_unrecognized, where they are read from and written
+// to with the messages below. After bumping storj.io/common to the newer protocol,
+// the generated fields replace these messages.
+
+// downloadObjectVersion contains the fields of the newer ObjectDownloadRequest,
+// which are missing from pb.ObjectDownloadRequest.
+type downloadObjectVersion struct {
+	ObjectVersion []byte `protobuf:"bytes,6,opt,name=object_version,json=objectVersion,proto3"`
+}
+
+func (m *downloadObjectVersion) Reset()         { *m = downloadObjectVersion{} }
+func (m *downloadObjectVersion) String() string { return proto.CompactTextString(m) }
+func (*downloadObjectVersion) ProtoMessage()    {}
+
+// listObjectsVersions contains the fields of the newer ObjectListRequest, which
+// are missing from pb.ObjectListRequest.
+type listObjectsVersions struct {
+	IncludeAllVersions bool   `protobuf:"varint,9,opt,name=include_all_versions,json=includeAllVersions,proto3"`
+	VersionCursor      []byte `protobuf:"bytes,10,opt,name=version_cursor,json=versionCursor,proto3"`
+}
+
+func (m *listObjectsVersions) Reset()         { *m = listObjectsVersions{} }
+func (m *listObjectsVersions) String() string { return proto.CompactTextString(m) }
+func (*listObjectsVersions) ProtoMessage()    {}
+
+// listItemVersion contains the fields of the newer ObjectListItem, which are
+// missing from pb.ObjectListItem.
+type listItemVersion struct {
+	ObjectVersion []byte `protobuf:"bytes,12,opt,name=object_version,json=objectVersion,proto3"`
+	IsLatest      bool   `protobuf:"varint,13,opt,name=is_latest,json=isLatest,proto3"`
+}
+
+func (m *listItemVersion) Reset()         { *m = listItemVersion{} }
+func (m *listItemVersion) String() string { return proto.CompactTextString(m) }
+func (*listItemVersion) ProtoMessage()    {}
+
+// objectVersionLength is the length of an encoded object version.
+const objectVersionLength = 16
+
+// encodeObjectVersion returns the object version of the public API. It consists
+// of the version followed by the second half of the stream id, so that a version
+// of a replaced object doesn't name the object, which replaced it.
+func encodeObjectVersion(version metabase.Version, streamID uuid.UUID) []byte {
+	encoded := make([]byte, objectVersionLength)
+	binary.BigEndian.PutUint64(encoded[:8], uint64(version))
+	copy(encoded[8:], streamID[8:])
+	return encoded
+}
+
+// decodeObjectVersion returns the version and the stream id suffix of an
+// encoded object version.
+func decodeObjectVersion(encoded []byte) (version metabase.Version, streamIDSuffix []byte, err error) {
+	if len(encoded) != objectVersionLength {
+		return 0, nil, Error.New("invalid object version length: %d", len(encoded))
+	}
+	version = metabase.Version(binary.BigEndian.Uint64(encoded[:8]))
+	if version <= 0 {
+		return 0, nil, Error.New("invalid object version: %d", version)
+	}
+	return version, encoded[8:], nil
+}
+
+// matchesObjectVersion returns whether the stream id ends with the suffix of
+// a decoded object version.
+func matchesObjectVersion(streamID uuid.UUID, streamIDSuffix []byte) bool {
+	return bytes.Equal(streamID[8:], streamIDSuffix)
+}
+
+// requestedDownloadVersion returns the object version named by the download
+// request or nil, when the latest version should be downloaded.
+func requestedDownloadVersion(req *pb.ObjectDownloadRequest) ([]byte, error) {
+	if len(req.XXX_unrecognized) == 0 {
+		return nil, nil
+	}
+
+	var msg downloadObjectVersion
+	if err := proto.Unmarshal(req.XXX_unrecognized, &msg); err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return msg.ObjectVersion, nil
+}
+
+// requestedListVersions returns whether the list request asks for all versions
+// and the version cursor.
+func requestedListVersions(req *pb.ObjectListRequest) (includeAllVersions bool, versionCursor []byte, err error) {
+	if len(req.XXX_unrecognized) == 0 {
+		return false, nil, nil
+	}
+
+	var msg listObjectsVersions
+	if err := proto.Unmarshal(req.XXX_unrecognized, &msg); err != nil {
+		return false, nil, Error.Wrap(err)
+	}
+	return msg.IncludeAllVersions, msg.VersionCursor, nil
+}
+
+// setListItemVersion adds the object version and whether it's the latest
+// version to the list item.
+func setListItemVersion(item *pb.ObjectListItem, version metabase.Version, streamID uuid.UUID, isLatest bool) error {
+	data, err := proto.Marshal(&listItemVersion{
+		ObjectVersion: encodeObjectVersion(version, streamID),
+		IsLatest:      isLatest,
+	})
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	item.XXX_unrecognized = append(item.XXX_unrecognized, data...)
+	return nil
+}
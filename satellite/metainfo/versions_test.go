@@ -0,0 +1,75 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metainfo
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/pb"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite/metabase"
+)
+
+func TestObjectVersionEncoding(t *testing.T) {
+	streamID := testrand.UUID()
+
+	encoded := encodeObjectVersion(12345, streamID)
+	require.Len(t, encoded, objectVersionLength)
+
+	version, suffix, err := decodeObjectVersion(encoded)
+	require.NoError(t, err)
+	require.Equal(t, metabase.Version(12345), version)
+	require.True(t, matchesObjectVersion(streamID, suffix))
+	require.False(t, matchesObjectVersion(testrand.UUID(), suffix))
+
+	_, _, err = decodeObjectVersion(encoded[:8])
+	require.Error(t, err)
+	_, _, err = decodeObjectVersion(make([]byte, objectVersionLength))
+	require.Error(t, err)
+}
+
+func TestVersionFieldsRoundTrip(t *testing.T) {
+	objectVersion := encodeObjectVersion(3, testrand.UUID())
+
+	// requests of newer uplinks are the known fields followed by the version fields
+	unmarshalWith := func(known, versions proto.Message, into proto.Message) {
+		data, err := pb.Marshal(known)
+		require.NoError(t, err)
+		extra, err := proto.Marshal(versions)
+		require.NoError(t, err)
+		require.NoError(t, pb.Unmarshal(append(data, extra...), into))
+	}
+
+	var download pb.ObjectDownloadRequest
+	unmarshalWith(&pb.ObjectDownloadRequest{Bucket: []byte("bucket")},
+		&downloadObjectVersion{ObjectVersion: objectVersion}, &download)
+	requested, err := requestedDownloadVersion(&download)
+	require.NoError(t, err)
+	require.Equal(t, objectVersion, requested)
+
+	requested, err = requestedDownloadVersion(&pb.ObjectDownloadRequest{})
+	require.NoError(t, err)
+	require.Nil(t, requested)
+
+	var list pb.ObjectListRequest
+	unmarshalWith(&pb.ObjectListRequest{Bucket: []byte("bucket")},
+		&listObjectsVersions{IncludeAllVersions: true, VersionCursor: objectVersion}, &list)
+	includeAllVersions, versionCursor, err := requestedListVersions(&list)
+	require.NoError(t, err)
+	require.True(t, includeAllVersions)
+	require.Equal(t, objectVersion, versionCursor)
+
+	item := &pb.ObjectListItem{EncryptedPath: []byte("key")}
+	require.NoError(t, setListItemVersion(item, 3, testrand.UUID(), true))
+	data, err := pb.Marshal(item)
+	require.NoError(t, err)
+
+	var fields listItemVersion
+	require.NoError(t, proto.Unmarshal(data, &fields))
+	require.Len(t, fields.ObjectVersion, objectVersionLength)
+	require.True(t, fields.IsLatest)
+}
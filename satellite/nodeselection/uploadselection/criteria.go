@@ -12,6 +12,9 @@ type Criteria struct {
 	ExcludeNodeIDs     []storj.NodeID
 	AutoExcludeSubnets map[string]struct{} // initialize it with empty map to keep only one node per subnet.
 	Placement          storj.PlacementConstraint
+	Placements         PlacementRules
+	// LoadWeight biases the selection away from loaded nodes, 0 disables it.
+	LoadWeight float64
 }
 
 // MatchInclude returns with true if node is selected.
@@ -20,7 +23,7 @@ func (c *Criteria) MatchInclude(node *Node) bool {
 		return false
 	}
 
-	if !c.Placement.AllowedCountry(node.CountryCode) {
+	if !c.Placements.Match(c.Placement, node) {
 		return false
 	}
 
@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"storj.io/common/storj"
 	"storj.io/common/storj/location"
@@ -138,3 +139,33 @@ func TestCriteria_Geofencing(t *testing.T) {
 		})
 	}
 }
+
+func TestCriteria_PlacementRules(t *testing.T) {
+	rules, err := ParsePlacementRules(`10: country("DE") && tag("tier", "ssd")`)
+	require.NoError(t, err)
+
+	criteria := Criteria{
+		Placement:  10,
+		Placements: rules,
+	}
+
+	assert.True(t, criteria.MatchInclude(&Node{
+		CountryCode: location.Germany,
+		Tags:        map[string]string{"tier": "ssd"},
+	}))
+
+	assert.False(t, criteria.MatchInclude(&Node{
+		CountryCode: location.Germany,
+		Tags:        map[string]string{"tier": "hdd"},
+	}))
+
+	assert.False(t, criteria.MatchInclude(&Node{
+		CountryCode: location.Austria,
+		Tags:        map[string]string{"tier": "ssd"},
+	}))
+
+	criteria.Placement = storj.EU
+	assert.True(t, criteria.MatchInclude(&Node{
+		CountryCode: location.Austria,
+	}), "built-in placements are kept")
+}
@@ -0,0 +1,52 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package uploadselection
+
+import (
+	"math"
+	mathrand "math/rand" // Using mathrand here because crypto-graphic randomness is not required and simplifies code.
+	"sort"
+)
+
+// LoadWeight returns the selection weight of a node with the specified load.
+//
+// The weight is 1 for an idle node or a node which didn't report its load and it
+// decreases as the load grows, loadWeight controls how fast it decreases.
+func LoadWeight(load, loadWeight float64) float64 {
+	if load <= 0 || loadWeight <= 0 {
+		return 1
+	}
+	return 1 / (1 + loadWeight*load)
+}
+
+// WeightedPerm returns a permutation of [0, n), where items with a larger weight
+// are more likely to be at the beginning. Items with equal weights are shuffled
+// uniformly.
+func WeightedPerm(rng *mathrand.Rand, n int, weight func(i int) float64) []int {
+	type key struct {
+		index int
+		value float64
+	}
+
+	keys := make([]key, n)
+	for i := range keys {
+		// Sorting by -ln(u)/w is the same as sampling without replacement with
+		// probabilities proportional to w.
+		keys[i] = key{index: i, value: -math.Log(1-randFloat(rng)) / weight(i)}
+	}
+	sort.Slice(keys, func(i, k int) bool { return keys[i].value < keys[k].value })
+
+	perm := make([]int, n)
+	for i, key := range keys {
+		perm[i] = key.index
+	}
+	return perm
+}
+
+func randFloat(rng *mathrand.Rand) float64 {
+	if rng == nil {
+		return mathrand.Float64()
+	}
+	return rng.Float64()
+}
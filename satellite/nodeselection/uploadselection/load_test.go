@@ -0,0 +1,74 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package uploadselection_test
+
+import (
+	mathrand "math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/storj"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite/nodeselection/uploadselection"
+)
+
+func TestLoadWeight(t *testing.T) {
+	require.Equal(t, 1.0, uploadselection.LoadWeight(0, 1))
+	require.Equal(t, 1.0, uploadselection.LoadWeight(2, 0))
+	require.Equal(t, 0.5, uploadselection.LoadWeight(1, 1))
+	require.Less(t, uploadselection.LoadWeight(2, 1), uploadselection.LoadWeight(1, 1))
+}
+
+func TestWeightedPerm(t *testing.T) {
+	rng := mathrand.New(mathrand.NewSource(1))
+	weights := []float64{1, 0.1, 1}
+
+	const executionCount = 10000
+	first := make([]int, len(weights))
+	for i := 0; i < executionCount; i++ {
+		perm := uploadselection.WeightedPerm(rng, len(weights), func(i int) float64 { return weights[i] })
+		require.ElementsMatch(t, []int{0, 1, 2}, perm)
+		first[perm[0]]++
+	}
+
+	// the probability of the light item being first is 0.1/2.1.
+	require.InDelta(t, executionCount/2.1, first[0], executionCount*0.05)
+	require.InDelta(t, executionCount*0.1/2.1, first[1], executionCount*0.05)
+	require.InDelta(t, executionCount/2.1, first[2], executionCount*0.05)
+}
+
+func TestSelectByIDLoad(t *testing.T) {
+	var nodes []*uploadselection.Node
+	for i, load := range []float64{0, 4, 0} {
+		nodes = append(nodes, &uploadselection.Node{
+			NodeURL:     storj.NodeURL{ID: testrand.NodeID()},
+			LastNet:     string(rune('a' + i)),
+			IngressLoad: load,
+		})
+	}
+
+	const executionCount = 10000
+	count := func(selector uploadselection.Selector, loadWeight float64) int {
+		loaded := 0
+		for i := 0; i < executionCount; i++ {
+			selected := selector.Select(1, uploadselection.Criteria{LoadWeight: loadWeight})
+			require.Len(t, selected, 1)
+			if selected[0].ID == nodes[1].ID {
+				loaded++
+			}
+		}
+		return loaded
+	}
+
+	for _, selector := range []uploadselection.Selector{
+		uploadselection.SelectByID(nodes),
+		uploadselection.SelectBySubnetFromNodes(nodes),
+	} {
+		// without the weight every node is equally likely.
+		require.InDelta(t, executionCount/3, count(selector, 0), executionCount*0.05)
+		// the loaded node has weight 0.2 compared to 1 of the others.
+		require.InDelta(t, executionCount*0.2/2.2, count(selector, 1), executionCount*0.05)
+	}
+}
@@ -6,6 +6,7 @@ package uploadselection
 import (
 	"storj.io/common/storj"
 	"storj.io/common/storj/location"
+	"storj.io/private/version"
 )
 
 // Node defines necessary information for node-selection.
@@ -14,6 +15,14 @@ type Node struct {
 	LastNet     string
 	LastIPPort  string
 	CountryCode location.CountryCode
+	// ASN is the autonomous system number of LastIPPort, zero when unknown.
+	ASN      uint32
+	FreeDisk int64
+	Version  version.SemVer
+	// IngressLoad is the upload load reported by the node, zero when unknown.
+	IngressLoad float64
+	// Tags are declared by the satellite operator and used by placement rules.
+	Tags map[string]string
 }
 
 // Clone returns a deep clone of the selected node.
@@ -23,5 +32,22 @@ func (node *Node) Clone() *Node {
 		LastNet:     node.LastNet,
 		LastIPPort:  node.LastIPPort,
 		CountryCode: node.CountryCode,
+		ASN:         node.ASN,
+		FreeDisk:    node.FreeDisk,
+		Version:     node.Version,
+		IngressLoad: node.IngressLoad,
+		Tags:        CloneTags(node.Tags),
 	}
 }
+
+// CloneTags returns a copy of the node tags.
+func CloneTags(tags map[string]string) map[string]string {
+	if tags == nil {
+		return nil
+	}
+	clone := make(map[string]string, len(tags))
+	for key, value := range tags {
+		clone[key] = value
+	}
+	return clone
+}
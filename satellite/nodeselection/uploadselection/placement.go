@@ -0,0 +1,480 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package uploadselection
+
+import (
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/memory"
+	"storj.io/common/storj"
+	"storj.io/common/storj/location"
+	"storj.io/private/version"
+)
+
+// ErrInvalidPlacement is returned when a placement definition can not be parsed.
+var ErrInvalidPlacement = errs.Class("invalid placement")
+
+// NodeFilter decides whether a node can be used for a placement.
+type NodeFilter interface {
+	MatchInclude(node *Node) bool
+}
+
+// PlacementRules contains the operator defined node filters of placement constraints.
+//
+// Placement constraints without a rule fall back to the built-in country based rules.
+type PlacementRules map[storj.PlacementConstraint]NodeFilter
+
+// Match returns whether the node can be used for the placement.
+func (rules PlacementRules) Match(placement storj.PlacementConstraint, node *Node) bool {
+	if filter, ok := rules[placement]; ok {
+		return filter.MatchInclude(node)
+	}
+	return placement.AllowedCountry(node.CountryCode)
+}
+
+// Defined returns whether the placement is either built-in or defined by the rules.
+func (rules PlacementRules) Defined(placement storj.PlacementConstraint) bool {
+	if placement <= storj.DE {
+		return true
+	}
+	_, ok := rules[placement]
+	return ok
+}
+
+// ParsePlacementRules parses placement definitions separated by semicolons.
+// Every definition has the form `id:filter`, for example:
+//
+//	10:country("DE","AT") && tag("tier","ssd"); 11:!network("10.0.0.0/8")
+//
+// IDs of the built-in placements (0-4) can not be redefined.
+func ParsePlacementRules(definitions string) (PlacementRules, error) {
+	rules := PlacementRules{}
+	for _, definition := range strings.Split(definitions, ";") {
+		definition = strings.TrimSpace(definition)
+		if definition == "" {
+			continue
+		}
+
+		idPart, expression := definition, ""
+		if separator := strings.Index(definition, ":"); separator >= 0 {
+			idPart, expression = definition[:separator], definition[separator+1:]
+		}
+
+		id, err := strconv.ParseUint(strings.TrimSpace(idPart), 10, 16)
+		if err != nil {
+			return nil, ErrInvalidPlacement.New("invalid placement id %q", idPart)
+		}
+		placement := storj.PlacementConstraint(id)
+		if placement <= storj.DE {
+			return nil, ErrInvalidPlacement.New("placement %d is reserved", placement)
+		}
+		if _, exists := rules[placement]; exists {
+			return nil, ErrInvalidPlacement.New("placement %d is defined multiple times", placement)
+		}
+
+		filter, err := ParseNodeFilter(expression)
+		if err != nil {
+			return nil, ErrInvalidPlacement.New("placement %d: %v", placement, err)
+		}
+		rules[placement] = filter
+	}
+	return rules, nil
+}
+
+// ParseNodeFilter parses a node filter expression.
+//
+// Expressions combine the following functions with `&&`, `||`, `!` and parentheses:
+//
+//	country("DE", "EU", ...)   node is located in one of the countries, EU and EEA are accepted as aliases
+//	network("10.0.0.0/8", ...) node address is in one of the networks
+//	asn("AS13335", "15169", ...) node address belongs to one of the autonomous systems
+//	tag("key")                 node has the tag
+//	tag("key", "value")        node has the tag with the value
+//	free_disk("1TB")           node has at least the specified amount of free disk
+//	version("1.40.0")          node runs at least the specified version
+func ParseNodeFilter(expression string) (NodeFilter, error) {
+	tokens, err := tokenize(expression)
+	if err != nil {
+		return nil, err
+	}
+	p := &filterParser{tokens: tokens}
+
+	filter, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if !p.done() {
+		return nil, errs.New("unexpected %q", p.peek().text)
+	}
+	return filter, nil
+}
+
+type tokenKind int
+
+const (
+	tokenIdent tokenKind = iota
+	tokenString
+	tokenSymbol
+)
+
+type token struct {
+	kind tokenKind
+	text string
+}
+
+func tokenize(expression string) (tokens []token, err error) {
+	for i := 0; i < len(expression); {
+		c := expression[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c == '"':
+			end := strings.IndexByte(expression[i+1:], '"')
+			if end < 0 {
+				return nil, errs.New("unterminated string at %d", i)
+			}
+			tokens = append(tokens, token{tokenString, expression[i+1 : i+1+end]})
+			i += end + 2
+		case c == '&' || c == '|':
+			if i+1 >= len(expression) || expression[i+1] != c {
+				return nil, errs.New("unexpected %q at %d", c, i)
+			}
+			tokens = append(tokens, token{tokenSymbol, expression[i : i+2]})
+			i += 2
+		case c == '!' || c == '(' || c == ')' || c == ',':
+			tokens = append(tokens, token{tokenSymbol, string(c)})
+			i++
+		case c == '_' || ('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z'):
+			start := i
+			for i < len(expression) && (expression[i] == '_' || ('a' <= expression[i] && expression[i] <= 'z') || ('A' <= expression[i] && expression[i] <= 'Z')) {
+				i++
+			}
+			tokens = append(tokens, token{tokenIdent, expression[start:i]})
+		default:
+			return nil, errs.New("unexpected %q at %d", c, i)
+		}
+	}
+	return tokens, nil
+}
+
+type filterParser struct {
+	tokens []token
+	pos    int
+}
+
+func (p *filterParser) done() bool { return p.pos >= len(p.tokens) }
+
+func (p *filterParser) peek() token {
+	if p.done() {
+		return token{}
+	}
+	return p.tokens[p.pos]
+}
+
+func (p *filterParser) accept(symbol string) bool {
+	if !p.done() && p.tokens[p.pos].kind == tokenSymbol && p.tokens[p.pos].text == symbol {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *filterParser) expect(symbol string) error {
+	if !p.accept(symbol) {
+		if p.done() {
+			return errs.New("expected %q, got end of expression", symbol)
+		}
+		return errs.New("expected %q, got %q", symbol, p.peek().text)
+	}
+	return nil
+}
+
+func (p *filterParser) parseOr() (NodeFilter, error) {
+	first, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	filters := anyFilter{first}
+	for p.accept("||") {
+		next, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, next)
+	}
+	if len(filters) == 1 {
+		return first, nil
+	}
+	return filters, nil
+}
+
+func (p *filterParser) parseAnd() (NodeFilter, error) {
+	first, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	filters := allFilter{first}
+	for p.accept("&&") {
+		next, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, next)
+	}
+	if len(filters) == 1 {
+		return first, nil
+	}
+	return filters, nil
+}
+
+func (p *filterParser) parseUnary() (NodeFilter, error) {
+	switch {
+	case p.accept("!"):
+		filter, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return notFilter{filter}, nil
+	case p.accept("("):
+		filter, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect(")"); err != nil {
+			return nil, err
+		}
+		return filter, nil
+	}
+	return p.parseCall()
+}
+
+func (p *filterParser) parseCall() (NodeFilter, error) {
+	if p.done() {
+		return nil, errs.New("unexpected end of expression")
+	}
+	name := p.peek()
+	if name.kind != tokenIdent {
+		return nil, errs.New("unexpected %q", name.text)
+	}
+	p.pos++
+
+	if err := p.expect("("); err != nil {
+		return nil, err
+	}
+	var args []string
+	if !p.accept(")") {
+		for {
+			if p.done() || p.peek().kind != tokenString {
+				return nil, errs.New("%s: expected string argument", name.text)
+			}
+			args = append(args, p.peek().text)
+			p.pos++
+			if p.accept(")") {
+				break
+			}
+			if err := p.expect(","); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return newFunctionFilter(name.text, args)
+}
+
+func newFunctionFilter(name string, args []string) (NodeFilter, error) {
+	switch name {
+	case "country":
+		if len(args) == 0 {
+			return nil, errs.New("country: at least one country is required")
+		}
+		var filter countryFilter
+		for _, arg := range args {
+			switch strings.ToUpper(arg) {
+			case "EU":
+				filter = append(filter, location.EuCountries...)
+			case "EEA":
+				filter = append(filter, location.EuCountries...)
+				filter = append(filter, location.EeaNonEuCountries...)
+			default:
+				code := location.ToCountryCode(arg)
+				if code == 0 {
+					return nil, errs.New("country: invalid country code %q", arg)
+				}
+				filter = append(filter, code)
+			}
+		}
+		return filter, nil
+	case "network":
+		if len(args) == 0 {
+			return nil, errs.New("network: at least one network is required")
+		}
+		var filter networkFilter
+		for _, arg := range args {
+			_, network, err := net.ParseCIDR(arg)
+			if err != nil {
+				return nil, errs.New("network: invalid network %q", arg)
+			}
+			filter = append(filter, network)
+		}
+		return filter, nil
+	case "asn":
+		if len(args) == 0 {
+			return nil, errs.New("asn: at least one autonomous system number is required")
+		}
+		var filter asnFilter
+		for _, arg := range args {
+			number := arg
+			if len(number) > 2 && strings.EqualFold(number[:2], "AS") {
+				number = number[2:]
+			}
+			asn, err := strconv.ParseUint(number, 10, 32)
+			if err != nil || asn == 0 {
+				return nil, errs.New("asn: invalid autonomous system number %q", arg)
+			}
+			filter = append(filter, uint32(asn))
+		}
+		return filter, nil
+	case "tag":
+		switch len(args) {
+		case 1:
+			return tagFilter{key: args[0]}, nil
+		case 2:
+			return tagFilter{key: args[0], value: args[1], matchValue: true}, nil
+		default:
+			return nil, errs.New("tag: expected key and optional value")
+		}
+	case "free_disk":
+		if len(args) != 1 {
+			return nil, errs.New("free_disk: expected a single size")
+		}
+		// memory.ParseString doesn't handle sizes without any digits.
+		if strings.IndexAny(args[0], "0123456789") < 0 {
+			return nil, errs.New("free_disk: invalid size %q", args[0])
+		}
+		size, err := memory.ParseString(args[0])
+		if err != nil {
+			return nil, errs.New("free_disk: invalid size %q", args[0])
+		}
+		return freeDiskFilter(size), nil
+	case "version":
+		if len(args) != 1 {
+			return nil, errs.New("version: expected a single version")
+		}
+		minimum, err := version.NewSemVer(args[0])
+		if err != nil {
+			return nil, errs.New("version: invalid version %q", args[0])
+		}
+		return versionFilter{minimum}, nil
+	default:
+		return nil, errs.New("unknown function %q", name)
+	}
+}
+
+// anyFilter matches nodes which are matched by any of the filters.
+type anyFilter []NodeFilter
+
+func (filters anyFilter) MatchInclude(node *Node) bool {
+	for _, filter := range filters {
+		if filter.MatchInclude(node) {
+			return true
+		}
+	}
+	return false
+}
+
+// allFilter matches nodes which are matched by all of the filters.
+type allFilter []NodeFilter
+
+func (filters allFilter) MatchInclude(node *Node) bool {
+	for _, filter := range filters {
+		if !filter.MatchInclude(node) {
+			return false
+		}
+	}
+	return true
+}
+
+// notFilter matches nodes which are not matched by the filter.
+type notFilter struct{ filter NodeFilter }
+
+func (n notFilter) MatchInclude(node *Node) bool {
+	return !n.filter.MatchInclude(node)
+}
+
+// countryFilter matches nodes located in one of the countries.
+type countryFilter []location.CountryCode
+
+func (countries countryFilter) MatchInclude(node *Node) bool {
+	for _, country := range countries {
+		if country == node.CountryCode {
+			return true
+		}
+	}
+	return false
+}
+
+// networkFilter matches nodes whose last known address is in one of the networks.
+type networkFilter []*net.IPNet
+
+func (networks networkFilter) MatchInclude(node *Node) bool {
+	host, _, err := net.SplitHostPort(node.LastIPPort)
+	if err != nil {
+		return false
+	}
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return false
+	}
+	for _, network := range networks {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// asnFilter matches nodes whose last known address belongs to one of the autonomous systems.
+type asnFilter []uint32
+
+func (asns asnFilter) MatchInclude(node *Node) bool {
+	for _, asn := range asns {
+		if asn == node.ASN {
+			return true
+		}
+	}
+	return false
+}
+
+// tagFilter matches nodes with the tag declared by the satellite operator.
+type tagFilter struct {
+	key        string
+	value      string
+	matchValue bool
+}
+
+func (tag tagFilter) MatchInclude(node *Node) bool {
+	value, ok := node.Tags[tag.key]
+	if !ok {
+		return false
+	}
+	return !tag.matchValue || value == tag.value
+}
+
+// freeDiskFilter matches nodes with at least the specified amount of free disk.
+type freeDiskFilter int64
+
+func (minimum freeDiskFilter) MatchInclude(node *Node) bool {
+	return node.FreeDisk >= int64(minimum)
+}
+
+// versionFilter matches nodes running at least the specified version.
+type versionFilter struct{ minimum version.SemVer }
+
+func (filter versionFilter) MatchInclude(node *Node) bool {
+	return !node.Version.IsZero() && node.Version.Compare(filter.minimum) >= 0
+}
@@ -0,0 +1,139 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package uploadselection_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/memory"
+	"storj.io/common/storj"
+	"storj.io/common/storj/location"
+	"storj.io/private/version"
+	"storj.io/storj/satellite/nodeselection/uploadselection"
+)
+
+func TestParseNodeFilter(t *testing.T) {
+	v1_40, err := version.NewSemVer("v1.40.0")
+	require.NoError(t, err)
+	v1_39, err := version.NewSemVer("v1.39.5")
+	require.NoError(t, err)
+
+	germanSSD := &uploadselection.Node{
+		LastIPPort:  "10.1.2.3:28967",
+		CountryCode: location.Germany,
+		ASN:         3320,
+		FreeDisk:    2 * memory.TB.Int64(),
+		Version:     v1_40,
+		Tags:        map[string]string{"tier": "ssd", "datacenter": "fra1"},
+	}
+	austrianHDD := &uploadselection.Node{
+		LastIPPort:  "192.168.1.1:28967",
+		CountryCode: location.Austria,
+		ASN:         8447,
+		FreeDisk:    500 * memory.GB.Int64(),
+		Version:     v1_39,
+		Tags:        map[string]string{"tier": "hdd"},
+	}
+	american := &uploadselection.Node{
+		LastIPPort:  "[2001:db8::1]:28967",
+		CountryCode: location.UnitedStates,
+	}
+
+	for _, tt := range []struct {
+		expression string
+		matches    []bool
+	}{
+		{`country("DE")`, []bool{true, false, false}},
+		{`country("de", "AT")`, []bool{true, true, false}},
+		{`country("EU")`, []bool{true, true, false}},
+		{`!country("EEA")`, []bool{false, false, true}},
+		{`network("10.0.0.0/8")`, []bool{true, false, false}},
+		{`network("192.168.0.0/16", "2001:db8::/32")`, []bool{false, true, true}},
+		{`asn("AS3320")`, []bool{true, false, false}},
+		{`asn("3320", "as8447")`, []bool{true, true, false}},
+		{`tag("datacenter")`, []bool{true, false, false}},
+		{`tag("tier", "hdd")`, []bool{false, true, false}},
+		{`free_disk("1TB")`, []bool{true, false, false}},
+		{`version("1.40.0")`, []bool{true, false, false}},
+		{`country("EU") && tag("tier","ssd")`, []bool{true, false, false}},
+		{`tag("tier","ssd") || country("US")`, []bool{true, false, true}},
+		{`!(country("DE") || country("US")) && free_disk("100GB")`, []bool{false, true, false}},
+		{`country("US") || country("AT") && tag("tier","hdd")`, []bool{false, true, true}},
+	} {
+		filter, err := uploadselection.ParseNodeFilter(tt.expression)
+		require.NoError(t, err, tt.expression)
+
+		for i, node := range []*uploadselection.Node{germanSSD, austrianHDD, american} {
+			assert.Equal(t, tt.matches[i], filter.MatchInclude(node), "%s: node %d", tt.expression, i)
+		}
+	}
+
+	for _, invalid := range []string{
+		``,
+		`country()`,
+		`country("DEU")`,
+		`network("10.0.0.0")`,
+		`asn()`,
+		`asn("AS")`,
+		`asn("0")`,
+		`asn("AS4294967296")`,
+		`tag()`,
+		`tag("a","b","c")`,
+		`free_disk("lots")`,
+		`version("latest")`,
+		`unknown("x")`,
+		`country("DE") &&`,
+		`country("DE") & tag("x")`,
+		`(country("DE")`,
+		`country("DE"))`,
+		`country("DE)`,
+		`country(DE)`,
+	} {
+		_, err := uploadselection.ParseNodeFilter(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
+
+func TestParsePlacementRules(t *testing.T) {
+	rules, err := uploadselection.ParsePlacementRules(`
+		10: country("DE") && tag("tier", "ssd");
+		11: network("10.0.0.0/8");
+	`)
+	require.NoError(t, err)
+	require.Len(t, rules, 2)
+
+	node := &uploadselection.Node{
+		LastIPPort:  "10.1.2.3:28967",
+		CountryCode: location.Germany,
+		Tags:        map[string]string{"tier": "hdd"},
+	}
+
+	assert.False(t, rules.Match(10, node))
+	assert.True(t, rules.Match(11, node))
+	assert.True(t, rules.Match(storj.EU, node), "built-in placements are kept")
+	assert.False(t, rules.Match(storj.US, node), "built-in placements are kept")
+	assert.False(t, rules.Match(12, node), "undefined placements do not match")
+
+	assert.True(t, rules.Defined(storj.DE))
+	assert.True(t, rules.Defined(11))
+	assert.False(t, rules.Defined(12))
+
+	var empty uploadselection.PlacementRules
+	assert.True(t, empty.Match(storj.EveryCountry, node))
+	assert.True(t, empty.Match(storj.DE, node))
+
+	for _, invalid := range []string{
+		`1: country("DE")`,
+		`x: country("DE")`,
+		`10 country("DE")`,
+		`10: country("DE"); 10: country("AT")`,
+		`10: unknown()`,
+	} {
+		_, err := uploadselection.ParsePlacementRules(invalid)
+		assert.True(t, uploadselection.ErrInvalidPlacement.Has(err), invalid)
+	}
+}
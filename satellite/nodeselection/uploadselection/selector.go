@@ -22,7 +22,7 @@ func (nodes SelectByID) Select(n int, criteria Criteria) []*Node {
 	}
 
 	selected := []*Node{}
-	for _, idx := range mathrand.Perm(len(nodes)) {
+	for _, idx := range nodes.perm(criteria.LoadWeight) {
 		node := nodes[idx]
 
 		if !criteria.MatchInclude(node) {
@@ -38,6 +38,16 @@ func (nodes SelectByID) Select(n int, criteria Criteria) []*Node {
 	return selected
 }
 
+// perm returns the order in which the nodes are considered.
+func (nodes SelectByID) perm(loadWeight float64) []int {
+	if loadWeight <= 0 {
+		return mathrand.Perm(len(nodes))
+	}
+	return WeightedPerm(nil, len(nodes), func(i int) float64 {
+		return LoadWeight(nodes[i].IngressLoad, loadWeight)
+	})
+}
+
 // SelectBySubnet implements selection from nodes with every subnet having equal probability.
 type SelectBySubnet []Subnet
 
@@ -77,9 +87,9 @@ func (subnets SelectBySubnet) Select(n int, criteria Criteria) []*Node {
 	}
 
 	selected := []*Node{}
-	for _, idx := range mathrand.Perm(len(subnets)) {
+	for _, idx := range subnets.perm(criteria.LoadWeight) {
 		subnet := subnets[idx]
-		node := subnet.Nodes[mathrand.Intn(len(subnet.Nodes))]
+		node := subnet.pick(criteria.LoadWeight)
 
 		if !criteria.MatchInclude(node) {
 			continue
@@ -93,3 +103,43 @@ func (subnets SelectBySubnet) Select(n int, criteria Criteria) []*Node {
 
 	return selected
 }
+
+// perm returns the order in which the subnets are considered. With a load weight
+// a subnet is weighted by the average weight of its nodes.
+func (subnets SelectBySubnet) perm(loadWeight float64) []int {
+	if loadWeight <= 0 {
+		return mathrand.Perm(len(subnets))
+	}
+	return WeightedPerm(nil, len(subnets), func(i int) float64 {
+		return subnets[i].weight(loadWeight)
+	})
+}
+
+// weight returns the average weight of the nodes in the subnet.
+func (subnet Subnet) weight(loadWeight float64) float64 {
+	total := 0.0
+	for _, node := range subnet.Nodes {
+		total += LoadWeight(node.IngressLoad, loadWeight)
+	}
+	return total / float64(len(subnet.Nodes))
+}
+
+// pick picks a node from the subnet.
+func (subnet Subnet) pick(loadWeight float64) *Node {
+	if loadWeight <= 0 {
+		return subnet.Nodes[mathrand.Intn(len(subnet.Nodes))]
+	}
+
+	total := 0.0
+	for _, node := range subnet.Nodes {
+		total += LoadWeight(node.IngressLoad, loadWeight)
+	}
+	target := mathrand.Float64() * total
+	for _, node := range subnet.Nodes {
+		target -= LoadWeight(node.IngressLoad, loadWeight)
+		if target < 0 {
+			return node
+		}
+	}
+	return subnet.Nodes[len(subnet.Nodes)-1]
+}
@@ -88,6 +88,8 @@ type Request struct {
 	Distinct    bool
 	ExcludedIDs []storj.NodeID
 	Placement   storj.PlacementConstraint
+	Placements  PlacementRules
+	LoadWeight  float64
 }
 
 // Select selects requestedCount nodes where there will be newFraction nodes.
@@ -112,6 +114,8 @@ func (state *State) Select(ctx context.Context, request Request) (_ []*Node, err
 	}
 
 	criteria.Placement = request.Placement
+	criteria.Placements = request.Placements
+	criteria.LoadWeight = request.LoadWeight
 
 	if request.Distinct {
 		criteria.AutoExcludeSubnets = make(map[string]struct{})
@@ -19,6 +19,7 @@ import (
 	"storj.io/common/uuid"
 	"storj.io/storj/satellite/internalpb"
 	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/nodeselection/uploadselection"
 	"storj.io/storj/satellite/overlay"
 	"storj.io/uplink/private/eestream"
 )
@@ -38,6 +39,7 @@ type Config struct {
 	FlushInterval       time.Duration  `help:"how often to flush the rollups write cache to the database" devDefault:"30s" releaseDefault:"1m" testDefault:"$TESTINTERVAL"`
 	NodeStatusLogging   bool           `hidden:"true" help:"deprecated, log the offline/disqualification status of nodes" default:"false" testDefault:"true"`
 	OrdersSemaphoreSize int            `help:"how many concurrent orders to process at once. zero is unlimited" default:"2"`
+	DownloadLoadWeight  float64        `help:"how strongly downloads prefer nodes with a lower reported egress load. zero disables it" releaseDefault:"0" devDefault:"1"`
 }
 
 // BucketsDB returns information about buckets.
@@ -58,7 +60,8 @@ type Service struct {
 
 	encryptionKeys EncryptionKeys
 
-	orderExpiration time.Duration
+	orderExpiration    time.Duration
+	downloadLoadWeight float64
 
 	rngMu sync.Mutex
 	rng   *mathrand.Rand
@@ -83,7 +86,8 @@ func NewService(
 
 		encryptionKeys: config.EncryptionKeys,
 
-		orderExpiration: config.Expiration,
+		orderExpiration:    config.Expiration,
+		downloadLoadWeight: config.DownloadLoadWeight,
 
 		rng: mathrand.New(mathrand.NewSource(time.Now().UnixNano())),
 	}, nil
@@ -156,7 +160,7 @@ func (service *Service) CreateGetOrderLimits(ctx context.Context, bucket metabas
 	neededLimits := segment.Redundancy.DownloadNodes()
 
 	pieces := segment.Pieces
-	for _, pieceIndex := range service.perm(len(pieces)) {
+	for _, pieceIndex := range service.downloadPerm(pieces, nodes) {
 		piece := pieces[pieceIndex]
 		node, ok := nodes[piece.StorageNode]
 		if !ok {
@@ -209,6 +213,24 @@ func (service *Service) perm(n int) []int {
 	return service.rng.Perm(n)
 }
 
+// downloadPerm returns the order in which pieces are considered for download,
+// preferring nodes with a lower egress load when it's enabled.
+func (service *Service) downloadPerm(pieces metabase.Pieces, nodes map[storj.NodeID]*overlay.SelectedNode) []int {
+	if service.downloadLoadWeight <= 0 {
+		return service.perm(len(pieces))
+	}
+
+	service.rngMu.Lock()
+	defer service.rngMu.Unlock()
+	return uploadselection.WeightedPerm(service.rng, len(pieces), func(i int) float64 {
+		node, ok := nodes[pieces[i].StorageNode]
+		if !ok {
+			return 1
+		}
+		return uploadselection.LoadWeight(node.Load.Egress, service.downloadLoadWeight)
+	})
+}
+
 // sortLimits sorts order limits and fill missing ones with nil values.
 func sortLimits(limits []*pb.AddressedOrderLimit, segment metabase.Segment) ([]*pb.AddressedOrderLimit, error) {
 	sorted := make([]*pb.AddressedOrderLimit, segment.Redundancy.TotalShares)
@@ -41,6 +41,8 @@ type NodeSelectionConfig struct {
 	OnlineWindow     time.Duration `help:"the amount of time without seeing a node before its considered offline" default:"4h" testDefault:"1m"`
 	DistinctIP       bool          `help:"require distinct IPs when choosing nodes for upload" releaseDefault:"true" devDefault:"false"`
 	MinimumDiskSpace memory.Size   `help:"how much disk space a node at minimum must have to be selected for upload" default:"500.00MB" testDefault:"100.00MB"`
+	Placements       string        `help:"custom placement constraints as semicolon separated id:filter definitions, for example 10:country(\"DE\") && tag(\"tier\",\"ssd\")" default:""`
+	UploadLoadWeight float64       `help:"how strongly uploads prefer nodes with a lower reported ingress load. zero disables it" releaseDefault:"0" devDefault:"1"`
 
 	AsOfSystemTime AsOfSystemTimeConfig
 }
@@ -49,6 +51,7 @@ type NodeSelectionConfig struct {
 type GeoIPConfig struct {
 	DB            string   `help:"the location of the maxmind database containing geoip country information"`
 	MockCountries []string `help:"a mock list of countries the satellite will attribute to nodes (useful for testing)"`
+	ASNDatabase   string   `help:"the location of the maxmind database containing autonomous system information, used by asn placement filters"`
 }
 
 func (aost *AsOfSystemTimeConfig) isValid() error {
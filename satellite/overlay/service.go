@@ -15,8 +15,11 @@ import (
 	"storj.io/common/pb"
 	"storj.io/common/storj"
 	"storj.io/common/storj/location"
+	"storj.io/private/version"
+	"storj.io/storj/private/nodeload"
 	"storj.io/storj/satellite/geoip"
 	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/nodeselection/uploadselection"
 )
 
 // ErrEmptyNode is returned when the nodeID is empty.
@@ -60,6 +63,10 @@ type DB interface {
 	KnownReliable(ctx context.Context, onlineWindow time.Duration, nodeIDs storj.NodeIDList) ([]*pb.Node, error)
 	// Reliable returns all nodes that are reliable
 	Reliable(context.Context, *NodeCriteria) (storj.NodeIDList, error)
+	// ReliableNodes returns all nodes that are reliable together with the attributes used by placements.
+	ReliableNodes(context.Context, *NodeCriteria) ([]*SelectedNode, error)
+	// GetSelectedNodes returns the nodes with the attributes used by placements. Unknown nodes are skipped.
+	GetSelectedNodes(ctx context.Context, nodeIDs storj.NodeIDList) ([]*SelectedNode, error)
 	// UpdateReputation updates the DB columns for all reputation fields in ReputationStatus.
 	UpdateReputation(ctx context.Context, id storj.NodeID, request *ReputationStatus) error
 	// UpdateNodeInfo updates node dossier with info requested from the node itself like node type, email, wallet, capacity, and version.
@@ -88,6 +95,13 @@ type DB interface {
 
 	// DisqualifyNode disqualifies a storage node.
 	DisqualifyNode(ctx context.Context, nodeID storj.NodeID) (err error)
+	// UpdateNodeTags sets the tags declared by the satellite operator for a storage node.
+	UpdateNodeTags(ctx context.Context, nodeID storj.NodeID, tags map[string]string) (err error)
+
+	// ListPenalizedNodes returns up to limit nodes, which haven't exited, most recently penalized first.
+	// It includes disqualified nodes when disqualified is set and suspended nodes, which aren't
+	// disqualified, when suspended is set.
+	ListPenalizedNodes(ctx context.Context, disqualified, suspended bool, limit int) (_ []PenalizedNode, err error)
 
 	// DQNodesLastSeenBefore disqualifies a limited number of nodes where last_contact_success < cutoff except those already disqualified
 	// or gracefully exited or where last_contact_success = '0001-01-01 00:00:00+00'.
@@ -122,6 +136,8 @@ type NodeCheckInInfo struct {
 	Capacity    *pb.NodeCapacity
 	Version     *pb.NodeVersion
 	CountryCode location.CountryCode
+	// Load is nil when the node didn't report it.
+	Load *nodeload.Load
 }
 
 // InfoResponse contains node dossier info requested from the storage node.
@@ -152,6 +168,38 @@ type NodeCriteria struct {
 	AsOfSystemInterval time.Duration // only used for CRDB queries
 }
 
+// DisqualificationReason is the reason a node was disqualified.
+type DisqualificationReason int
+
+const (
+	// DisqualificationReasonUnknown is used when the reason of the disqualification is not known.
+	DisqualificationReasonUnknown DisqualificationReason = 0
+	// DisqualificationReasonAuditFailure is used when the audit reputation fell below the threshold.
+	DisqualificationReasonAuditFailure DisqualificationReason = 1
+	// DisqualificationReasonSuspension is used when the node was suspended for unknown audits longer than the grace period.
+	DisqualificationReasonSuspension DisqualificationReason = 2
+	// DisqualificationReasonNodeOffline is used when the online score stayed below the threshold after the review period.
+	DisqualificationReasonNodeOffline DisqualificationReason = 3
+	// DisqualificationReasonStrayNode is used when the node wasn't seen for too long.
+	DisqualificationReasonStrayNode DisqualificationReason = 4
+)
+
+// String returns a string representation of the disqualification reason.
+func (reason DisqualificationReason) String() string {
+	switch reason {
+	case DisqualificationReasonAuditFailure:
+		return "audit failure"
+	case DisqualificationReasonSuspension:
+		return "suspension grace period expired"
+	case DisqualificationReasonNodeOffline:
+		return "node offline"
+	case DisqualificationReasonStrayNode:
+		return "stray node"
+	default:
+		return "unknown"
+	}
+}
+
 // ReputationStatus indicates current reputation status for a node.
 type ReputationStatus struct {
 	Contained             bool // TODO: check to see if this column is still used.
@@ -159,6 +207,22 @@ type ReputationStatus struct {
 	UnknownAuditSuspended *time.Time
 	OfflineSuspended      *time.Time
 	VettedAt              *time.Time
+
+	// DisqualificationReason is stored together with Disqualified.
+	// It's not part of the status comparison.
+	DisqualificationReason DisqualificationReason
+}
+
+// PenalizedNode is a node which is disqualified or suspended.
+type PenalizedNode struct {
+	ID                     storj.NodeID
+	Address                string
+	Email                  string
+	Disqualified           *time.Time
+	DisqualificationReason DisqualificationReason
+	UnknownAuditSuspended  *time.Time
+	OfflineSuspended       *time.Time
+	LastContactSuccess     time.Time
 }
 
 // Equal checks if two ReputationStatus contains the same value.
@@ -239,6 +303,9 @@ type NodeDossier struct {
 	LastNet               string
 	LastIPPort            string
 	CountryCode           location.CountryCode
+	Tags                  map[string]string
+	// Load is the load reported in the last check-in, nil when the node didn't report it.
+	Load *nodeload.Load
 }
 
 // NodeStats contains statistics about a node.
@@ -268,6 +335,11 @@ type SelectedNode struct {
 	LastNet     string
 	LastIPPort  string
 	CountryCode location.CountryCode
+	FreeDisk    int64
+	Version     version.SemVer
+	Tags        map[string]string
+	// Load is zero when the node didn't report it.
+	Load nodeload.Load
 }
 
 // Clone returns a deep clone of the selected node.
@@ -278,8 +350,13 @@ func (node *SelectedNode) Clone() *SelectedNode {
 			Transport: node.Address.Transport,
 			Address:   node.Address.Address,
 		},
-		LastNet:    node.LastNet,
-		LastIPPort: node.LastIPPort,
+		LastNet:     node.LastNet,
+		LastIPPort:  node.LastIPPort,
+		CountryCode: node.CountryCode,
+		FreeDisk:    node.FreeDisk,
+		Version:     node.Version,
+		Tags:        uploadselection.CloneTags(node.Tags),
+		Load:        node.Load,
 	}
 }
 
@@ -291,7 +368,10 @@ type Service struct {
 	db     DB
 	config Config
 
+	placements uploadselection.PlacementRules
+
 	GeoIP                  geoip.IPToCountry
+	ASN                    geoip.IPToASN
 	UploadSelectionCache   *UploadSelectionCache
 	DownloadSelectionCache *DownloadSelectionCache
 }
@@ -303,6 +383,11 @@ func NewService(log *zap.Logger, db DB, config Config) (*Service, error) {
 		return nil, err
 	}
 
+	placements, err := uploadselection.ParsePlacementRules(config.Node.Placements)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
 	var geoIP geoip.IPToCountry = geoip.NewMockIPToCountry(config.GeoIP.MockCountries)
 	if config.GeoIP.DB != "" {
 		geoIP, err = geoip.OpenMaxmindDB(config.GeoIP.DB)
@@ -311,16 +396,31 @@ func NewService(log *zap.Logger, db DB, config Config) (*Service, error) {
 		}
 	}
 
+	var asn geoip.IPToASN = geoip.MockIPToASN{}
+	if config.GeoIP.ASNDatabase != "" {
+		asn, err = geoip.OpenMaxmindDB(config.GeoIP.ASNDatabase)
+		if err != nil {
+			return nil, Error.Wrap(errs.Combine(err, geoIP.Close()))
+		}
+	}
+
+	uploadSelectionCache := NewUploadSelectionCache(log, db,
+		config.NodeSelectionCache.Staleness, config.Node,
+	)
+	uploadSelectionCache.placements = placements
+	uploadSelectionCache.asn = asn
+
 	return &Service{
 		log:    log,
 		db:     db,
 		config: config,
 
+		placements: placements,
+
 		GeoIP: geoIP,
+		ASN:   asn,
 
-		UploadSelectionCache: NewUploadSelectionCache(log, db,
-			config.NodeSelectionCache.Staleness, config.Node,
-		),
+		UploadSelectionCache: uploadSelectionCache,
 
 		DownloadSelectionCache: NewDownloadSelectionCache(log, db, DownloadSelectionCacheConfig{
 			Staleness:      config.NodeSelectionCache.Staleness,
@@ -332,7 +432,7 @@ func NewService(log *zap.Logger, db DB, config Config) (*Service, error) {
 
 // Close closes resources.
 func (service *Service) Close() error {
-	return service.GeoIP.Close()
+	return errs.Combine(service.GeoIP.Close(), service.ASN.Close())
 }
 
 // Get looks up the provided nodeID from the overlay.
@@ -379,7 +479,11 @@ func (service *Service) FindStorageNodesForUpload(ctx context.Context, req FindS
 	}
 
 	if service.config.NodeSelectionCache.Disabled {
-		return service.FindStorageNodesWithPreferences(ctx, req, &service.config.Node)
+		// the database based selection doesn't know about placements.
+		if req.Placement == storj.EveryCountry {
+			return service.FindStorageNodesWithPreferences(ctx, req, &service.config.Node)
+		}
+		return service.findStorageNodesForPlacement(ctx, req)
 	}
 
 	selectedNodes, err := service.UploadSelectionCache.GetNodes(ctx, req)
@@ -404,6 +508,21 @@ func (service *Service) FindStorageNodesForUpload(ctx context.Context, req FindS
 	return selectedNodes, err
 }
 
+// findStorageNodesForPlacement selects the nodes matching the placement of the request
+// without the cache. The placement filters can't be evaluated by the database, so the
+// selection is made from all nodes, which qualify to store data.
+func (service *Service) findStorageNodesForPlacement(ctx context.Context, req FindStorageNodesRequest) (_ []*SelectedNode, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	reputableNodes, newNodes, err := service.db.SelectAllStorageNodesUpload(ctx, service.config.Node)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	state := uploadselection.NewState(convSelectedNodesToNodes(reputableNodes, service.ASN), convSelectedNodesToNodes(newNodes, service.ASN))
+	return selectNodes(ctx, state, req, service.config.Node, service.placements)
+}
+
 // FindStorageNodesWithPreferences searches the overlay network for nodes that meet the provided criteria.
 //
 // This does not use a cache.
@@ -450,6 +569,79 @@ func (service *Service) FindStorageNodesWithPreferences(ctx context.Context, req
 	return nodes, nil
 }
 
+// PlacementRules returns the operator defined placement rules.
+func (service *Service) PlacementRules() uploadselection.PlacementRules {
+	return service.placements
+}
+
+// AllowedByPlacement returns whether the node can hold data of the placement.
+func (service *Service) AllowedByPlacement(ctx context.Context, nodeID storj.NodeID, placement storj.PlacementConstraint) (_ bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if placement == storj.EveryCountry {
+		return true, nil
+	}
+
+	dossier, err := service.Get(ctx, nodeID)
+	if err != nil {
+		return false, err
+	}
+
+	// nodes with an unknown version don't match version filters.
+	ver, _ := version.NewSemVer(dossier.Version.Version)
+
+	return service.NodeAllowedByPlacement(&SelectedNode{
+		ID:          dossier.Id,
+		Address:     dossier.Address,
+		LastNet:     dossier.LastNet,
+		LastIPPort:  dossier.LastIPPort,
+		CountryCode: dossier.CountryCode,
+		FreeDisk:    dossier.Capacity.FreeDisk,
+		Version:     ver,
+		Tags:        dossier.Tags,
+	}, placement), nil
+}
+
+// NodeAllowedByPlacement returns whether the selected node can hold data of the placement.
+func (service *Service) NodeAllowedByPlacement(node *SelectedNode, placement storj.PlacementConstraint) bool {
+	if placement == storj.EveryCountry {
+		return true
+	}
+	return service.placements.Match(placement, convSelectedNodeToNode(node, service.ASN))
+}
+
+// GetOutOfPlacementPieces returns the pieces which are stored on nodes not allowed
+// by the placement. Pieces on unknown nodes are not returned.
+func (service *Service) GetOutOfPlacementPieces(ctx context.Context, pieces metabase.Pieces, placement storj.PlacementConstraint) (outOfPlacement metabase.Pieces, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if placement == storj.EveryCountry || len(pieces) == 0 {
+		return nil, nil
+	}
+
+	var nodeIDs storj.NodeIDList
+	for _, p := range pieces {
+		nodeIDs = append(nodeIDs, p.StorageNode)
+	}
+	nodes, err := service.db.GetSelectedNodes(ctx, nodeIDs)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	disallowed := make(map[storj.NodeID]bool, len(nodes))
+	for _, node := range nodes {
+		if !service.NodeAllowedByPlacement(node, placement) {
+			disallowed[node.ID] = true
+		}
+	}
+	for _, p := range pieces {
+		if disallowed[p.StorageNode] {
+			outOfPlacement = append(outOfPlacement, p)
+		}
+	}
+	return outOfPlacement, nil
+}
+
 // KnownOffline filters a set of nodes to offline nodes.
 func (service *Service) KnownOffline(ctx context.Context, nodeIds storj.NodeIDList) (offlineNodes storj.NodeIDList, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -483,6 +675,15 @@ func (service *Service) Reliable(ctx context.Context) (nodes storj.NodeIDList, e
 	return service.db.Reliable(ctx, criteria)
 }
 
+// ReliableNodes returns all reliable nodes together with the attributes used by placements.
+func (service *Service) ReliableNodes(ctx context.Context) (nodes []*SelectedNode, err error) {
+	defer mon.Task()(&ctx)(&err)
+	criteria := &NodeCriteria{
+		OnlineWindow: service.config.Node.OnlineWindow,
+	}
+	return service.db.ReliableNodes(ctx, criteria)
+}
+
 // UpdateReputation updates the DB columns for any of the reputation fields.
 func (service *Service) UpdateReputation(ctx context.Context, id storj.NodeID, request *ReputationStatus) (err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -549,6 +750,8 @@ func (service *Service) UpdateCheckIn(ctx context.Context, node NodeCheckInInfo,
 	spaceChanged := (node.Capacity == nil && oldInfo.Capacity.FreeDisk != 0) ||
 		(node.Capacity != nil && node.Capacity.FreeDisk != oldInfo.Capacity.FreeDisk)
 
+	loadChanged := nodeload.Changed(oldInfo.Load, node.Load)
+
 	if oldInfo.CountryCode == location.CountryCode(0) || oldInfo.LastIPPort != node.LastIPPort {
 		node.CountryCode, err = service.GeoIP.LookupISOCountryCode(node.LastIPPort)
 		if err != nil {
@@ -562,7 +765,7 @@ func (service *Service) UpdateCheckIn(ctx context.Context, node NodeCheckInInfo,
 		node.CountryCode = oldInfo.CountryCode
 	}
 
-	if dbStale || addrChanged || walletChanged || verChanged || spaceChanged ||
+	if dbStale || addrChanged || walletChanged || verChanged || spaceChanged || loadChanged ||
 		oldInfo.LastNet != node.LastNet || oldInfo.LastIPPort != node.LastIPPort ||
 		oldInfo.CountryCode != node.CountryCode {
 		return service.db.UpdateCheckIn(ctx, node, timestamp, service.config.Node)
@@ -604,6 +807,16 @@ func (service *Service) DisqualifyNode(ctx context.Context, nodeID storj.NodeID)
 	return service.db.DisqualifyNode(ctx, nodeID)
 }
 
+// UpdateNodeTags sets the tags declared by the satellite operator for a storage node.
+// The tags are used by placement rules.
+func (service *Service) UpdateNodeTags(ctx context.Context, nodeID storj.NodeID, tags map[string]string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	if nodeID.IsZero() {
+		return ErrEmptyNode
+	}
+	return service.db.UpdateNodeTags(ctx, nodeID, tags)
+}
+
 // ResolveIPAndNetwork resolves the target address and determines its IP and /24 subnet IPv4 or /64 subnet IPv6.
 func ResolveIPAndNetwork(ctx context.Context, target string) (ipPort, network string, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -21,6 +21,7 @@ import (
 	"storj.io/common/storj"
 	"storj.io/common/testcontext"
 	"storj.io/common/testrand"
+	"storj.io/storj/private/nodeload"
 	"storj.io/storj/private/testplanet"
 	"storj.io/storj/satellite"
 	"storj.io/storj/satellite/overlay"
@@ -595,6 +596,40 @@ func TestUpdateCheckIn(t *testing.T) {
 	})
 }
 
+func TestUpdateCheckInLoad(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		nodeID := testrand.NodeID()
+		info := overlay.NodeCheckInInfo{
+			NodeID:     nodeID,
+			Address:    &pb.NodeAddress{Address: "1.2.4.4:8080"},
+			IsUp:       true,
+			Capacity:   &pb.NodeCapacity{FreeDisk: 5678},
+			Operator:   &pb.NodeOperator{Email: "test@email.com", Wallet: "0x123"},
+			Version:    &pb.NodeVersion{Version: "v1.0.0"},
+			LastIPPort: "1.2.4.4:8080",
+			LastNet:    "1.2.4",
+		}
+
+		// nodes which don't report the load have none stored.
+		err := db.OverlayCache().UpdateCheckIn(ctx, info, time.Now(), overlay.NodeSelectionConfig{})
+		require.NoError(t, err)
+		node, err := db.OverlayCache().Get(ctx, nodeID)
+		require.NoError(t, err)
+		require.Nil(t, node.Load)
+
+		info.Load = &nodeload.Load{Ingress: 0.25, Egress: 1.5}
+		err = db.OverlayCache().UpdateCheckIn(ctx, info, time.Now(), overlay.NodeSelectionConfig{})
+		require.NoError(t, err)
+		node, err = db.OverlayCache().Get(ctx, nodeID)
+		require.NoError(t, err)
+		require.Equal(t, info.Load, node.Load)
+
+		nodes, err := db.OverlayCache().GetOnlineNodesForGetDelete(ctx, []storj.NodeID{nodeID}, time.Hour)
+		require.NoError(t, err)
+		require.Equal(t, *info.Load, nodes[nodeID].Load)
+	})
+}
+
 // TestSuspendedSelection ensures that suspended nodes are not selected by SelectStorageNodes.
 func TestSuspendedSelection(t *testing.T) {
 	totalNodes := 10
@@ -12,6 +12,8 @@ import (
 
 	"storj.io/common/pb"
 	"storj.io/common/storj"
+	"storj.io/storj/private/nodeload"
+	"storj.io/storj/satellite/geoip"
 	"storj.io/storj/satellite/nodeselection/uploadselection"
 )
 
@@ -37,6 +39,8 @@ type UploadSelectionCache struct {
 	db              UploadSelectionDB
 	selectionConfig NodeSelectionConfig
 	staleness       time.Duration
+	placements      uploadselection.PlacementRules
+	asn             geoip.IPToASN
 
 	mu          sync.RWMutex
 	lastRefresh time.Time
@@ -79,7 +83,7 @@ func (cache *UploadSelectionCache) refresh(ctx context.Context) (state *uploadse
 	}
 
 	cache.lastRefresh = time.Now().UTC()
-	cache.state = uploadselection.NewState(convSelectedNodesToNodes(reputableNodes), convSelectedNodesToNodes(newNodes))
+	cache.state = uploadselection.NewState(convSelectedNodesToNodes(reputableNodes, cache.asn), convSelectedNodesToNodes(newNodes, cache.asn))
 
 	mon.IntVal("refresh_cache_size_reputable").Observe(int64(len(reputableNodes)))
 	mon.IntVal("refresh_cache_size_new").Observe(int64(len(newNodes)))
@@ -105,12 +109,21 @@ func (cache *UploadSelectionCache) GetNodes(ctx context.Context, req FindStorage
 		}
 	}
 
+	return selectNodes(ctx, state, req, cache.selectionConfig, cache.placements)
+}
+
+// selectNodes selects the nodes for the upload request from the state.
+func selectNodes(ctx context.Context, state *uploadselection.State, req FindStorageNodesRequest, config NodeSelectionConfig, placements uploadselection.PlacementRules) (_ []*SelectedNode, err error) {
+	defer mon.Task()(&ctx)(&err)
+
 	selected, err := state.Select(ctx, uploadselection.Request{
 		Count:       req.RequestedCount,
-		NewFraction: cache.selectionConfig.NewNodeFraction,
-		Distinct:    cache.selectionConfig.DistinctIP,
+		NewFraction: config.NewNodeFraction,
+		Distinct:    config.DistinctIP,
 		ExcludedIDs: req.ExcludedIDs,
 		Placement:   req.Placement,
+		Placements:  placements,
+		LoadWeight:  config.UploadLoadWeight,
 	})
 	if uploadselection.ErrNotEnoughNodes.Has(err) {
 		err = ErrNotEnoughNodes.Wrap(err)
@@ -141,22 +154,41 @@ func convNodesToSelectedNodes(nodes []*uploadselection.Node) (xs []*SelectedNode
 			LastNet:     n.LastNet,
 			LastIPPort:  n.LastIPPort,
 			CountryCode: n.CountryCode,
+			FreeDisk:    n.FreeDisk,
+			Version:     n.Version,
+			Tags:        n.Tags,
+			Load:        nodeload.Load{Ingress: n.IngressLoad},
 		})
 	}
 	return xs
 }
 
-func convSelectedNodesToNodes(nodes []*SelectedNode) (xs []*uploadselection.Node) {
+func convSelectedNodesToNodes(nodes []*SelectedNode, asn geoip.IPToASN) (xs []*uploadselection.Node) {
 	for _, n := range nodes {
-		xs = append(xs, &uploadselection.Node{
-			NodeURL: storj.NodeURL{
-				ID:      n.ID,
-				Address: n.Address.Address,
-			},
-			LastNet:     n.LastNet,
-			LastIPPort:  n.LastIPPort,
-			CountryCode: n.CountryCode,
-		})
+		xs = append(xs, convSelectedNodeToNode(n, asn))
 	}
 	return xs
 }
+
+// convSelectedNodeToNode converts the node and resolves its autonomous system number,
+// which is left zero when asn is nil or the lookup fails.
+func convSelectedNodeToNode(n *SelectedNode, asn geoip.IPToASN) *uploadselection.Node {
+	var number uint32
+	if asn != nil {
+		number, _ = asn.LookupASN(n.LastIPPort)
+	}
+	return &uploadselection.Node{
+		NodeURL: storj.NodeURL{
+			ID:      n.ID,
+			Address: n.Address.GetAddress(),
+		},
+		LastNet:     n.LastNet,
+		LastIPPort:  n.LastIPPort,
+		CountryCode: n.CountryCode,
+		ASN:         number,
+		FreeDisk:    n.FreeDisk,
+		Version:     n.Version,
+		IngressLoad: n.Load.Ingress,
+		Tags:        n.Tags,
+	}
+}
@@ -33,6 +33,8 @@ import (
 	"storj.io/storj/satellite/mailservice"
 	"storj.io/storj/satellite/metabase/zombiedeletion"
 	"storj.io/storj/satellite/metainfo"
+	"storj.io/storj/satellite/metainfo/bucketevents"
+	"storj.io/storj/satellite/metainfo/bucketlifecycle"
 	"storj.io/storj/satellite/metainfo/expireddeletion"
 	"storj.io/storj/satellite/metrics"
 	"storj.io/storj/satellite/nodeapiversion"
@@ -89,8 +91,14 @@ type DB interface {
 	Orders() orders.DB
 	// Containment returns database for containment
 	Containment() audit.Containment
+	// PieceAuditRequests returns database for requests of full piece audits
+	PieceAuditRequests() audit.PieceAuditRequests
+	// AuditRequests returns database for on-demand audit requests
+	AuditRequests() audit.Requests
 	// Buckets returns the database to interact with buckets
 	Buckets() buckets.DB
+	// BucketEvents returns the outbox of bucket events
+	BucketEvents() bucketevents.DB
 	// GracefulExit returns database for graceful exit
 	GracefulExit() gracefulexit.DB
 	// StripeCoinPayments returns stripecoinpayments database.
@@ -130,6 +138,8 @@ type Config struct {
 
 	ExpiredDeletion expireddeletion.Config
 	ZombieDeletion  zombiedeletion.Config
+	BucketLifecycle bucketlifecycle.Config
+	BucketEvents    bucketevents.Config
 
 	Tally            tally.Config
 	Rollup           rollup.Config
@@ -6,6 +6,7 @@ package checker
 import (
 	"bytes"
 	"context"
+	"strconv"
 	"strings"
 	"time"
 
@@ -149,6 +150,8 @@ func (checker *Checker) IdentifyInjuredSegments(ctx context.Context) (err error)
 	mon.IntVal("remote_segments_over_threshold_5").Observe(observer.monStats.remoteSegmentsOverThreshold[4])   //mon:locked
 	mon.IntVal("healthy_segments_removed_from_queue").Observe(healthyDeleted)                                  //mon:locked
 
+	mon.IntVal("remote_segments_out_of_placement").Observe(observer.monStats.remoteSegmentsOutOfPlacement)
+
 	allUnhealthy := observer.monStats.remoteSegmentsNeedingRepair + observer.monStats.remoteSegmentsFailedToCheck
 	allChecked := observer.monStats.remoteSegmentsChecked
 	allHealthy := allChecked - allUnhealthy
@@ -258,6 +261,13 @@ func (obs *checkerObserver) RemoteSegment(ctx context.Context, segment *segmentl
 		return errs.Combine(Error.New("error getting missing pieces"), err)
 	}
 
+	outOfPlacementPieces, err := obs.nodestate.OutOfPlacementPieces(ctx, segment.CreatedAt, segment.Pieces, segment.Placement)
+	if err != nil {
+		obs.monStats.remoteSegmentsFailedToCheck++
+		stats.iterationAggregates.remoteSegmentsFailedToCheck++
+		return errs.Combine(Error.New("error getting pieces out of placement"), err)
+	}
+
 	numHealthy := len(pieces) - len(missingPieces)
 	mon.IntVal("checker_segment_total_count").Observe(int64(len(pieces))) //mon:locked
 	stats.segmentTotalCount.Observe(int64(len(pieces)))
@@ -328,6 +338,30 @@ func (obs *checkerObserver) RemoteSegment(ctx context.Context, segment *segmentl
 			obs.log.Warn("checker found irreparable segment", zap.String("Segment StreamID", segment.StreamID.String()), zap.Int("Segment Position",
 				int(segment.Position.Encode())), zap.Int("total pieces", len(pieces)), zap.Int("min required", required), zap.String("unhealthy node IDs", strings.Join(unhealthyNodes, ",")))
 		}
+	} else if len(outOfPlacementPieces) > 0 {
+		// the segment is healthy, but some of its pieces are stored on nodes
+		// which don't match the placement of the segment anymore.
+		mon.Counter("checker_segments_out_of_placement", monkit.NewSeriesTag("placement", strconv.Itoa(int(segment.Placement)))).Inc(1)
+		obs.monStats.remoteSegmentsOutOfPlacement++
+		stats.iterationAggregates.remoteSegmentsOutOfPlacement++
+		obs.monStats.remoteSegmentsNeedingRepair++
+		stats.iterationAggregates.remoteSegmentsNeedingRepair++
+		alreadyInserted, err := obs.repairQueue.Insert(ctx, &queue.InjuredSegment{
+			StreamID:      segment.StreamID,
+			Position:      segment.Position,
+			UpdatedAt:     time.Now().UTC(),
+			SegmentHealth: segmentHealth,
+			Reason:        queue.ReasonPlacementViolation,
+		})
+		if err != nil {
+			obs.log.Error("error adding segment out of placement to queue", zap.Error(err))
+			return nil
+		}
+
+		if !alreadyInserted {
+			obs.monStats.newRemoteSegmentsNeedingRepair++
+			stats.iterationAggregates.newRemoteSegmentsNeedingRepair++
+		}
 	} else {
 		if numHealthy > repairThreshold && numHealthy <= (repairThreshold+len(obs.monStats.remoteSegmentsOverThreshold)) {
 			// record metrics for segments right above repair threshold
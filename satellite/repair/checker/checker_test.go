@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
 
 	"storj.io/common/storj"
 	"storj.io/common/testcontext"
@@ -17,6 +18,7 @@ import (
 	"storj.io/common/uuid"
 	"storj.io/storj/private/testplanet"
 	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/repair/checker"
 )
 
 func TestIdentifyInjuredSegments(t *testing.T) {
@@ -332,3 +334,55 @@ func insertSegment(ctx context.Context, t *testing.T, planet *testplanet.Planet,
 
 	return obj.StreamID
 }
+
+func TestSimulation(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		satellite.Repair.Checker.Loop.Pause()
+		satellite.Repair.Repairer.Loop.Pause()
+
+		rs := storj.RedundancyScheme{
+			RequiredShares: 2,
+			RepairShares:   3,
+			OptimalShares:  4,
+			TotalShares:    5,
+			ShareSize:      256,
+		}
+
+		err := planet.Uplinks[0].CreateBucket(ctx, satellite, "test-bucket")
+		require.NoError(t, err)
+
+		location := metabase.SegmentLocation{
+			ProjectID:  planet.Uplinks[0].Projects[0].ID,
+			BucketName: "test-bucket",
+		}
+		for x := 0; x < 5; x++ {
+			location.ObjectKey = metabase.ObjectKey(fmt.Sprintf("a-%d", x))
+			insertSegment(ctx, t, planet, rs, location, createPieces(planet, rs), nil)
+		}
+		location.ObjectKey = metabase.ObjectKey("b-0")
+		insertSegment(ctx, t, planet, rs, location, createLostPieces(planet, rs), nil)
+
+		simulation := checker.NewSimulation(zaptest.NewLogger(t), satellite.Metabase.SegmentLoop, satellite.Overlay.Service, satellite.Config.Checker)
+		result, err := simulation.Run(ctx)
+		require.NoError(t, err)
+
+		require.EqualValues(t, 6, result.SegmentsChecked)
+		require.EqualValues(t, 1, result.SegmentsToRepair)
+		require.NotZero(t, result.RepairDownloadBytes)
+		require.NotZero(t, result.RepairUploadBytes)
+
+		var queued int64
+		for _, count := range result.HealthBuckets {
+			queued += count
+		}
+		require.EqualValues(t, 1, queued)
+
+		// the repair queue is not modified
+		count, err := satellite.DB.RepairQueue().Count(ctx)
+		require.NoError(t, err)
+		require.Zero(t, count)
+	})
+}
@@ -58,6 +58,7 @@ type stats struct {
 	remoteSegmentsLost              *monkit.IntVal
 	objectsLost                     *monkit.IntVal
 	remoteSegmentsFailedToCheck     *monkit.IntVal
+	remoteSegmentsOutOfPlacement    *monkit.IntVal
 	remoteSegmentsHealthyPercentage *monkit.FloatVal
 
 	// remoteSegmentsOverThreshold[0]=# of healthy=rt+1, remoteSegmentsOverThreshold[1]=# of healthy=rt+2, etc...
@@ -84,6 +85,7 @@ type aggregateStats struct {
 	newRemoteSegmentsNeedingRepair int64
 	remoteSegmentsLost             int64
 	remoteSegmentsFailedToCheck    int64
+	remoteSegmentsOutOfPlacement   int64
 	objectsLost                    []uuid.UUID
 
 	// remoteSegmentsOverThreshold[0]=# of healthy=rt+1, remoteSegmentsOverThreshold[1]=# of healthy=rt+2, etc...
@@ -100,6 +102,7 @@ func newStats(rs string) *stats {
 		remoteSegmentsLost:              monkit.NewIntVal(monkit.NewSeriesKey("tagged_repair_stats").WithTag("name", "remote_segments_lost").WithTag("rs_scheme", rs)),
 		objectsLost:                     monkit.NewIntVal(monkit.NewSeriesKey("tagged_repair_stats").WithTag("name", "objects_lost").WithTag("rs_scheme", rs)),
 		remoteSegmentsFailedToCheck:     monkit.NewIntVal(monkit.NewSeriesKey("tagged_repair_stats").WithTag("name", "remote_segments_failed_to_check").WithTag("rs_scheme", rs)),
+		remoteSegmentsOutOfPlacement:    monkit.NewIntVal(monkit.NewSeriesKey("tagged_repair_stats").WithTag("name", "remote_segments_out_of_placement").WithTag("rs_scheme", rs)),
 		remoteSegmentsHealthyPercentage: monkit.NewFloatVal(monkit.NewSeriesKey("tagged_repair_stats").WithTag("name", "remote_segments_healthy_percentage").WithTag("rs_scheme", rs)),
 		remoteSegmentsOverThreshold1:    monkit.NewIntVal(monkit.NewSeriesKey("tagged_repair_stats").WithTag("name", "remote_segments_over_threshold_1").WithTag("rs_scheme", rs)),
 		remoteSegmentsOverThreshold2:    monkit.NewIntVal(monkit.NewSeriesKey("tagged_repair_stats").WithTag("name", "remote_segments_over_threshold_2").WithTag("rs_scheme", rs)),
@@ -124,6 +127,7 @@ func (stats *stats) collectAggregates() {
 	stats.remoteSegmentsLost.Observe(stats.iterationAggregates.remoteSegmentsLost)
 	stats.objectsLost.Observe(int64(len(stats.iterationAggregates.objectsLost)))
 	stats.remoteSegmentsFailedToCheck.Observe(stats.iterationAggregates.remoteSegmentsFailedToCheck)
+	stats.remoteSegmentsOutOfPlacement.Observe(stats.iterationAggregates.remoteSegmentsOutOfPlacement)
 	stats.remoteSegmentsOverThreshold1.Observe(stats.iterationAggregates.remoteSegmentsOverThreshold[0])
 	stats.remoteSegmentsOverThreshold2.Observe(stats.iterationAggregates.remoteSegmentsOverThreshold[1])
 	stats.remoteSegmentsOverThreshold3.Observe(stats.iterationAggregates.remoteSegmentsOverThreshold[2])
@@ -27,7 +27,7 @@ type ReliabilityCache struct {
 
 // reliabilityState.
 type reliabilityState struct {
-	reliable map[storj.NodeID]struct{}
+	reliable map[storj.NodeID]*overlay.SelectedNode
 	created  time.Time
 }
 
@@ -62,6 +62,28 @@ func (cache *ReliabilityCache) NumNodes(ctx context.Context) (numNodes int, err
 	return len(state.reliable), nil
 }
 
+// OutOfPlacementPieces returns the pieces which are stored on reliable nodes not allowed by the placement.
+// Pieces on unreliable nodes are returned by MissingPieces instead.
+func (cache *ReliabilityCache) OutOfPlacementPieces(ctx context.Context, created time.Time, pieces metabase.Pieces, placement storj.PlacementConstraint) (_ []metabase.Piece, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if placement == storj.EveryCountry {
+		return nil, nil
+	}
+
+	state, err := cache.loadFast(ctx, created)
+	if err != nil {
+		return nil, err
+	}
+	var outOfPlacement []metabase.Piece
+	for _, p := range pieces {
+		if node, ok := state.reliable[p.StorageNode]; ok && !cache.overlay.NodeAllowedByPlacement(node, placement) {
+			outOfPlacement = append(outOfPlacement, p)
+		}
+	}
+	return outOfPlacement, nil
+}
+
 // MissingPieces returns piece indices that are unreliable with the given staleness period.
 func (cache *ReliabilityCache) MissingPieces(ctx context.Context, created time.Time, pieces metabase.Pieces) (_ []metabase.Piece, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -120,17 +142,17 @@ func (cache *ReliabilityCache) Refresh(ctx context.Context) (err error) {
 func (cache *ReliabilityCache) refreshLocked(ctx context.Context) (_ *reliabilityState, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	nodes, err := cache.overlay.Reliable(ctx)
+	nodes, err := cache.overlay.ReliableNodes(ctx)
 	if err != nil {
 		return nil, Error.Wrap(err)
 	}
 
 	state := &reliabilityState{
 		created:  time.Now(),
-		reliable: make(map[storj.NodeID]struct{}, len(nodes)),
+		reliable: make(map[storj.NodeID]*overlay.SelectedNode, len(nodes)),
 	}
-	for _, id := range nodes {
-		state.reliable[id] = struct{}{}
+	for _, node := range nodes {
+		state.reliable[node.ID] = node
 	}
 
 	cache.state.Store(state)
@@ -52,3 +52,12 @@ func (fakeOverlayDB) Reliable(context.Context, *overlay.NodeCriteria) (storj.Nod
 		testrand.NodeID(),
 	}, nil
 }
+
+func (fakeOverlayDB) ReliableNodes(context.Context, *overlay.NodeCriteria) ([]*overlay.SelectedNode, error) {
+	return []*overlay.SelectedNode{
+		{ID: testrand.NodeID()},
+		{ID: testrand.NodeID()},
+		{ID: testrand.NodeID()},
+		{ID: testrand.NodeID()},
+	}, nil
+}
@@ -0,0 +1,185 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package checker
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/segmentloop"
+	"storj.io/storj/satellite/overlay"
+	"storj.io/storj/satellite/repair/queue"
+	"storj.io/uplink/private/eestream"
+)
+
+// SimulationResult contains what the checker would have done with the
+// simulated configuration.
+type SimulationResult struct {
+	ObjectsChecked         int64
+	SegmentsChecked        int64
+	SegmentsFailedToCheck  int64
+	SegmentsToRepair       int64
+	SegmentsOutOfPlacement int64
+	SegmentsLost           int64
+
+	// HealthBuckets contains the number of segments which would be queued for
+	// repair by segment health bucket, see queue.HealthBuckets.
+	HealthBuckets []int64
+
+	// RepairDownloadBytes and RepairUploadBytes estimate the traffic needed
+	// for repairing the segments. Irreparable segments are not included.
+	RepairDownloadBytes int64
+	RepairUploadBytes   int64
+}
+
+// Simulation runs the checker over the segment loop without modifying the
+// repair queue. It's used for evaluating checker configurations.
+type Simulation struct {
+	checker *Checker
+	queue   *simulatedQueue
+}
+
+// NewSimulation creates a new checker simulation with the config.
+func NewSimulation(logger *zap.Logger, segmentLoop *segmentloop.Service, overlay *overlay.Service, config Config) *Simulation {
+	simulated := &simulatedQueue{}
+	return &Simulation{
+		checker: NewChecker(logger, simulated, nil, segmentLoop, overlay, config),
+		queue:   simulated,
+	}
+}
+
+// Run joins the segment loop for a single iteration and returns the results.
+func (simulation *Simulation) Run(ctx context.Context) (result SimulationResult, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	checker := simulation.checker
+	observer := &simulationObserver{
+		checkerObserver: &checkerObserver{
+			repairQueue:      simulation.queue,
+			nodestate:        checker.nodestate,
+			statsCollector:   newStatsCollector(),
+			monStats:         aggregateStats{},
+			repairOverrides:  checker.repairOverrides,
+			nodeFailureRate:  checker.nodeFailureRate,
+			getNodesEstimate: checker.getNodesEstimate,
+			log:              checker.logger,
+		},
+		queue: simulation.queue,
+		result: SimulationResult{
+			HealthBuckets: make([]int64, len(queue.HealthBuckets)+1),
+		},
+	}
+
+	err = checker.segmentLoop.Join(ctx, observer)
+	if err != nil {
+		return SimulationResult{}, Error.Wrap(err)
+	}
+
+	stats := observer.checkerObserver.monStats
+	result = observer.result
+	result.ObjectsChecked = stats.objectsChecked
+	result.SegmentsChecked = stats.remoteSegmentsChecked
+	result.SegmentsFailedToCheck = stats.remoteSegmentsFailedToCheck
+	result.SegmentsToRepair = stats.remoteSegmentsNeedingRepair
+	result.SegmentsOutOfPlacement = stats.remoteSegmentsOutOfPlacement
+	result.SegmentsLost = stats.remoteSegmentsLost
+	return result, nil
+}
+
+// simulationObserver wraps the checker observer to estimate the repair traffic
+// of the segments the checker would have queued.
+type simulationObserver struct {
+	*checkerObserver
+	queue  *simulatedQueue
+	result SimulationResult
+}
+
+func (obs *simulationObserver) RemoteSegment(ctx context.Context, segment *segmentloop.Segment) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	obs.queue.last = nil
+	if err := obs.checkerObserver.RemoteSegment(ctx, segment); err != nil {
+		return err
+	}
+	injured := obs.queue.last
+	if injured == nil {
+		return nil
+	}
+
+	obs.result.HealthBuckets[queue.HealthBucket(injured.SegmentHealth)]++
+
+	redundancy, err := eestream.NewRedundancyStrategyFromStorj(segment.Redundancy)
+	if err != nil {
+		obs.log.Debug("invalid redundancy", zap.Stringer("Stream ID", segment.StreamID), zap.Error(err))
+		return nil
+	}
+
+	// the pieces are served from the reliability cache, so this is cheap.
+	missing, err := obs.nodestate.MissingPieces(ctx, segment.CreatedAt, segment.Pieces)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	outOfPlacement, err := obs.nodestate.OutOfPlacementPieces(ctx, segment.CreatedAt, segment.Pieces, segment.Placement)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	healthy := len(segment.Pieces) - len(missing)
+	if healthy < redundancy.RequiredCount() {
+		return nil
+	}
+	kept := healthy - len(outOfPlacement)
+
+	pieceSize := eestream.CalcPieceSize(int64(segment.EncryptedSize), redundancy)
+	obs.result.RepairDownloadBytes += int64(redundancy.RequiredCount()) * pieceSize
+	if uploaded := redundancy.OptimalThreshold() - kept; uploaded > 0 {
+		obs.result.RepairUploadBytes += int64(uploaded) * pieceSize
+	}
+	return nil
+}
+
+// simulatedQueue records the segments the checker would insert into the
+// repair queue.
+type simulatedQueue struct {
+	last *queue.InjuredSegment
+}
+
+var _ queue.RepairQueue = (*simulatedQueue)(nil)
+
+func (q *simulatedQueue) Insert(ctx context.Context, s *queue.InjuredSegment) (alreadyInserted bool, err error) {
+	q.last = s
+	return false, nil
+}
+
+func (q *simulatedQueue) Select(ctx context.Context, reasons ...queue.Reason) (*queue.InjuredSegment, error) {
+	return nil, Error.New("not supported by simulation")
+}
+
+func (q *simulatedQueue) Delete(ctx context.Context, s *queue.InjuredSegment) error {
+	return Error.New("not supported by simulation")
+}
+
+func (q *simulatedQueue) Clean(ctx context.Context, before time.Time) (deleted int64, err error) {
+	return 0, Error.New("not supported by simulation")
+}
+
+func (q *simulatedQueue) SelectN(ctx context.Context, limit int, reasons ...queue.Reason) ([]queue.InjuredSegment, error) {
+	return nil, Error.New("not supported by simulation")
+}
+
+func (q *simulatedQueue) Count(ctx context.Context) (count int, err error) {
+	return 0, Error.New("not supported by simulation")
+}
+
+func (q *simulatedQueue) Stats(ctx context.Context) ([]queue.Stat, error) {
+	return nil, Error.New("not supported by simulation")
+}
+
+func (q *simulatedQueue) TestingSetAttemptedTime(ctx context.Context, streamID uuid.UUID, position metabase.SegmentPosition, t time.Time) (rowsAffected int64, err error) {
+	return 0, Error.New("not supported by simulation")
+}
@@ -5,12 +5,135 @@ package queue
 
 import (
 	"context"
+	"fmt"
+	"math"
 	"time"
 
+	"github.com/zeebo/errs"
+
 	"storj.io/common/uuid"
 	"storj.io/storj/satellite/metabase"
 )
 
+// Reason describes why a segment was added to the repair queue.
+type Reason int
+
+const (
+	// ReasonBelowThreshold is used for segments whose number of healthy pieces
+	// dropped to the repair threshold.
+	ReasonBelowThreshold Reason = 0
+	// ReasonPlacementViolation is used for segments with pieces on nodes which
+	// are not allowed by the placement of the segment.
+	ReasonPlacementViolation Reason = 1
+	// ReasonOperatorRequested is used for segments which were added to the queue
+	// by a satellite operator. They are repaired even when they are above the
+	// repair threshold.
+	ReasonOperatorRequested Reason = 2
+	// ReasonGracefulExit is used for segments with pieces on gracefully exiting
+	// nodes. They are repaired even when they are above the repair threshold.
+	ReasonGracefulExit Reason = 3
+)
+
+// Reasons contains all known reasons.
+var Reasons = []Reason{ReasonBelowThreshold, ReasonPlacementViolation, ReasonOperatorRequested, ReasonGracefulExit}
+
+// String returns the name of the reason.
+func (reason Reason) String() string {
+	switch reason {
+	case ReasonBelowThreshold:
+		return "below_threshold"
+	case ReasonPlacementViolation:
+		return "placement_violation"
+	case ReasonOperatorRequested:
+		return "operator_requested"
+	case ReasonGracefulExit:
+		return "graceful_exit"
+	default:
+		return fmt.Sprintf("reason(%d)", int(reason))
+	}
+}
+
+// ParseReason parses the name of a reason.
+func ParseReason(name string) (Reason, error) {
+	for _, reason := range Reasons {
+		if reason.String() == name {
+			return reason, nil
+		}
+	}
+	return 0, errs.New("unknown repair reason %q", name)
+}
+
+// Forced returns whether segments queued for the reason should be repaired
+// regardless of their health.
+func (reason Reason) Forced() bool {
+	return reason == ReasonOperatorRequested || reason == ReasonGracefulExit
+}
+
+// Priority returns the priority of the reason. When a queued segment is
+// inserted again for a different reason, the reason with the higher priority
+// is kept. Forced reasons have the highest priorities, so that a segment
+// queued by an operator or for a graceful exit is still repaired and isn't
+// removed from the queue when the checker finds it as well.
+func (reason Reason) Priority() int {
+	switch reason {
+	case ReasonGracefulExit:
+		return 3
+	case ReasonOperatorRequested:
+		return 2
+	case ReasonBelowThreshold:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// UnknownHealth is the health of segments queued without a known health, e.g.
+// by operators. It's higher than any known health, so such segments are never
+// selected ahead of at-risk segments by health; they are scheduled by the weight
+// of their reason instead.
+var UnknownHealth = math.Inf(1)
+
+// CheckerReasons are the reasons for which the checker adds segments to the
+// queue. Only segments with these reasons are removed from the queue when the
+// checker doesn't find them unhealthy anymore.
+var CheckerReasons = []Reason{ReasonBelowThreshold, ReasonPlacementViolation}
+
+// HealthBuckets are the upper bounds of the segment health buckets used for
+// the queue statistics. The last bucket contains all segments above the last
+// bound.
+var HealthBuckets = []float64{1, 10, 100, 1000, 10000}
+
+// HealthBucket returns the index of the health bucket of the segment health.
+func HealthBucket(health float64) int {
+	for i, bound := range HealthBuckets {
+		if health < bound {
+			return i
+		}
+	}
+	return len(HealthBuckets)
+}
+
+// HealthBucketName returns the name of the bucket with the specified index.
+func HealthBucketName(bucket int) string {
+	switch {
+	case bucket <= 0:
+		return fmt.Sprintf("<%g", HealthBuckets[0])
+	case bucket >= len(HealthBuckets):
+		return fmt.Sprintf(">=%g", HealthBuckets[len(HealthBuckets)-1])
+	default:
+		return fmt.Sprintf("%g-%g", HealthBuckets[bucket-1], HealthBuckets[bucket])
+	}
+}
+
+// Stat contains the number of queued segments with a specific reason
+// and health bucket.
+type Stat struct {
+	Reason Reason
+	// HealthBucket is the index of the health bucket, see HealthBuckets.
+	HealthBucket int
+	Count        int
+}
+
 // InjuredSegment contains information about segment which
 // should be repaired.
 type InjuredSegment struct {
@@ -18,6 +141,7 @@ type InjuredSegment struct {
 	Position metabase.SegmentPosition
 
 	SegmentHealth float64
+	Reason        Reason
 	AttemptedAt   *time.Time
 	UpdatedAt     time.Time
 	InsertedAt    time.Time
@@ -30,16 +154,20 @@ type InjuredSegment struct {
 type RepairQueue interface {
 	// Insert adds an injured segment.
 	Insert(ctx context.Context, s *InjuredSegment) (alreadyInserted bool, err error)
-	// Select gets an injured segment.
-	Select(ctx context.Context) (*InjuredSegment, error)
+	// Select gets an injured segment. When reasons are specified only segments
+	// with one of the reasons are selected.
+	Select(ctx context.Context, reasons ...Reason) (*InjuredSegment, error)
 	// Delete removes an injured segment.
 	Delete(ctx context.Context, s *InjuredSegment) error
-	// Clean removes all segments last updated before a certain time
+	// Clean removes all segments queued by the checker which were last updated before a certain time
 	Clean(ctx context.Context, before time.Time) (deleted int64, err error)
-	// SelectN lists limit amount of injured segments.
-	SelectN(ctx context.Context, limit int) ([]InjuredSegment, error)
+	// SelectN lists limit amount of injured segments. When reasons are specified
+	// only segments with one of the reasons are listed.
+	SelectN(ctx context.Context, limit int, reasons ...Reason) ([]InjuredSegment, error)
 	// Count counts the number of segments in the repair queue.
 	Count(ctx context.Context) (count int, err error)
+	// Stats counts the number of segments in the repair queue by reason and health bucket.
+	Stats(ctx context.Context) ([]Stat, error)
 
 	// TestingSetAttemptedTime sets attempted time for a segment.
 	TestingSetAttemptedTime(ctx context.Context, streamID uuid.UUID, position metabase.SegmentPosition, t time.Time) (rowsAffected int64, err error)
@@ -282,3 +282,102 @@ func TestCount(t *testing.T) {
 	})
 
 }
+
+func TestSelectReasons(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		repairQueue := db.RepairQueue()
+
+		belowThreshold := &queue.InjuredSegment{StreamID: testrand.UUID(), SegmentHealth: 1, Reason: queue.ReasonBelowThreshold}
+		placement := &queue.InjuredSegment{StreamID: testrand.UUID(), SegmentHealth: 2, Reason: queue.ReasonPlacementViolation}
+		operator := &queue.InjuredSegment{StreamID: testrand.UUID(), SegmentHealth: 50, Reason: queue.ReasonOperatorRequested}
+		for _, seg := range []*queue.InjuredSegment{belowThreshold, placement, operator} {
+			_, err := repairQueue.Insert(ctx, seg)
+			require.NoError(t, err)
+		}
+
+		segments, err := repairQueue.SelectN(ctx, 10, queue.ReasonPlacementViolation, queue.ReasonOperatorRequested)
+		require.NoError(t, err)
+		require.Len(t, segments, 2)
+
+		stats, err := repairQueue.Stats(ctx)
+		require.NoError(t, err)
+		require.Equal(t, []queue.Stat{
+			{Reason: queue.ReasonBelowThreshold, HealthBucket: 1, Count: 1},
+			{Reason: queue.ReasonPlacementViolation, HealthBucket: 1, Count: 1},
+			{Reason: queue.ReasonOperatorRequested, HealthBucket: 2, Count: 1},
+		}, stats)
+
+		seg, err := repairQueue.Select(ctx, queue.ReasonOperatorRequested)
+		require.NoError(t, err)
+		require.Equal(t, operator.StreamID, seg.StreamID)
+
+		_, err = repairQueue.Select(ctx, queue.ReasonOperatorRequested, queue.ReasonGracefulExit)
+		require.True(t, storage.ErrEmptyQueue.Has(err))
+
+		seg, err = repairQueue.Select(ctx)
+		require.NoError(t, err)
+		require.Equal(t, belowThreshold.StreamID, seg.StreamID)
+
+		// segments queued by operators are not removed by the checker
+		deleted, err := repairQueue.Clean(ctx, time.Now())
+		require.NoError(t, err)
+		require.Equal(t, int64(2), deleted)
+
+		segments, err = repairQueue.SelectN(ctx, 10)
+		require.NoError(t, err)
+		require.Len(t, segments, 1)
+		require.Equal(t, operator.StreamID, segments[0].StreamID)
+	})
+}
+
+func TestInsertKeepsReasonPriority(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		repairQueue := db.RepairQueue()
+
+		streamID := testrand.UUID()
+		_, err := repairQueue.Insert(ctx, &queue.InjuredSegment{
+			StreamID:      streamID,
+			SegmentHealth: queue.UnknownHealth,
+			Reason:        queue.ReasonOperatorRequested,
+		})
+		require.NoError(t, err)
+
+		// segments without a known health are selected after at-risk segments.
+		atRisk := testrand.UUID()
+		_, err = repairQueue.Insert(ctx, &queue.InjuredSegment{StreamID: atRisk, SegmentHealth: 100, Reason: queue.ReasonBelowThreshold})
+		require.NoError(t, err)
+
+		seg, err := repairQueue.Select(ctx)
+		require.NoError(t, err)
+		require.Equal(t, atRisk, seg.StreamID)
+
+		// the checker updates the health, but doesn't replace the forced reason.
+		alreadyInserted, err := repairQueue.Insert(ctx, &queue.InjuredSegment{
+			StreamID:      streamID,
+			SegmentHealth: 5,
+			Reason:        queue.ReasonBelowThreshold,
+		})
+		require.NoError(t, err)
+		require.True(t, alreadyInserted)
+
+		segments, err := repairQueue.SelectN(ctx, 10, queue.ReasonOperatorRequested)
+		require.NoError(t, err)
+		require.Len(t, segments, 1)
+		require.Equal(t, streamID, segments[0].StreamID)
+		require.Equal(t, 5.0, segments[0].SegmentHealth)
+
+		// a reason with a higher priority replaces the stored one, without
+		// replacing the known health.
+		_, err = repairQueue.Insert(ctx, &queue.InjuredSegment{
+			StreamID:      streamID,
+			SegmentHealth: queue.UnknownHealth,
+			Reason:        queue.ReasonGracefulExit,
+		})
+		require.NoError(t, err)
+
+		segments, err = repairQueue.SelectN(ctx, 10, queue.ReasonGracefulExit)
+		require.NoError(t, err)
+		require.Len(t, segments, 1)
+		require.Equal(t, 5.0, segments[0].SegmentHealth)
+	})
+}
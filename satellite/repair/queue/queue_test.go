@@ -69,6 +69,35 @@ func TestInsertDuplicate(t *testing.T) {
 	})
 }
 
+func TestInsertReason(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		q := db.RepairQueue()
+
+		seg := &queue.InjuredSegment{
+			StreamID:      testrand.UUID(),
+			SegmentHealth: 10,
+			Reason:        queue.ReasonPlacementViolation,
+		}
+		_, err := q.Insert(ctx, seg)
+		require.NoError(t, err)
+
+		s, err := q.Select(ctx)
+		require.NoError(t, err)
+		require.Equal(t, queue.ReasonPlacementViolation, s.Reason)
+
+		// reinserting updates the reason
+		seg.Reason = queue.ReasonBelowThreshold
+		alreadyInserted, err := q.Insert(ctx, seg)
+		require.NoError(t, err)
+		require.True(t, alreadyInserted)
+
+		segments, err := q.SelectN(ctx, 1)
+		require.NoError(t, err)
+		require.Len(t, segments, 1)
+		require.Equal(t, queue.ReasonBelowThreshold, segments[0].Reason)
+	})
+}
+
 func TestDequeueEmptyQueue(t *testing.T) {
 	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
 		q := db.RepairQueue()
@@ -34,6 +34,7 @@ type Config struct {
 	MaxBufferMem                  memory.Size   `help:"maximum buffer memory (in bytes) to be allocated for read buffers" default:"4.0 MiB"`
 	MaxExcessRateOptimalThreshold float64       `help:"ratio applied to the optimal threshold to calculate the excess of the maximum number of repaired pieces to upload" default:"0.05"`
 	InMemoryRepair                bool          `help:"whether to download pieces for repair in memory (true) or download to disk (false)" default:"false"`
+	ReasonWeights                 ReasonWeights `help:"comma-separated scheduling weights of repair queue reasons in the format reason:weight, segments queued for reasons without weight are repaired only when the turn of a weighted reason finds no segments" default:"below_threshold:8,graceful_exit:4,operator_requested:2,placement_violation:1"`
 }
 
 // Service contains the information needed to run the repair service.
@@ -46,6 +47,7 @@ type Service struct {
 	JobLimiter *semaphore.Weighted
 	Loop       *sync2.Cycle
 	repairer   *SegmentRepairer
+	scheduler  *reasonScheduler
 
 	nowFn func() time.Time
 }
@@ -59,6 +61,7 @@ func NewService(log *zap.Logger, queue queue.RepairQueue, config *Config, repair
 		JobLimiter: semaphore.NewWeighted(int64(config.MaxRepair)),
 		Loop:       sync2.NewCycle(config.Interval),
 		repairer:   repairer,
+		scheduler:  newReasonScheduler(config.ReasonWeights),
 
 		nowFn: time.Now,
 	}
@@ -127,13 +130,14 @@ func (service *Service) process(ctx context.Context) (err error) {
 	// return from service.Run when queue fetch fails.
 	ctx, cancel := context.WithTimeout(ctx, service.config.TotalTimeout)
 
-	seg, err := service.queue.Select(ctx)
+	seg, err := service.selectSegment(ctx)
 	if err != nil {
 		service.JobLimiter.Release(1)
 		cancel()
 		return err
 	}
-	service.log.Debug("Retrieved segment from repair queue")
+	service.log.Debug("Retrieved segment from repair queue", zap.Stringer("reason", seg.Reason))
+	mon.Meter("repair_queue_selected", monkit.NewSeriesTag("reason", seg.Reason.String())).Mark(1)
 
 	// this goroutine inherits the JobLimiter semaphore acquisition and is now responsible
 	// for releasing it.
@@ -149,6 +153,21 @@ func (service *Service) process(ctx context.Context) (err error) {
 	return nil
 }
 
+// selectSegment selects the next segment to repair. The segment is selected
+// from the reason whose turn it is, or from the whole queue when there are no
+// segments queued for that reason.
+func (service *Service) selectSegment(ctx context.Context) (seg *queue.InjuredSegment, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if reason, ok := service.scheduler.next(); ok {
+		seg, err = service.queue.Select(ctx, reason)
+		if !storage.ErrEmptyQueue.Has(err) {
+			return seg, err
+		}
+	}
+	return service.queue.Select(ctx)
+}
+
 func (service *Service) worker(ctx context.Context, seg *queue.InjuredSegment) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
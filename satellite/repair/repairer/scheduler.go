@@ -0,0 +1,111 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package repairer
+
+import (
+	"strconv"
+	"strings"
+
+	"storj.io/storj/satellite/repair/queue"
+)
+
+// ReasonWeight is the scheduling weight of segments queued for a reason.
+type ReasonWeight struct {
+	Reason queue.Reason
+	Weight int
+}
+
+// ReasonWeights is a configuration struct that contains the scheduling weights
+// of the repair queue reasons.
+//
+// Can be used as a flag.
+type ReasonWeights struct {
+	List []ReasonWeight
+}
+
+// Type implements pflag.Value.
+func (ReasonWeights) Type() string { return "repairer.ReasonWeights" }
+
+// String is required for pflag.Value. It is a comma separated list of reason:weight pairs.
+func (weights *ReasonWeights) String() string {
+	var s strings.Builder
+	for i, weight := range weights.List {
+		if i > 0 {
+			s.WriteString(",")
+		}
+		s.WriteString(weight.Reason.String())
+		s.WriteString(":")
+		s.WriteString(strconv.Itoa(weight.Weight))
+	}
+	return s.String()
+}
+
+// Set sets the value from a string in the format "reason:weight,reason:weight,...".
+func (weights *ReasonWeights) Set(s string) error {
+	weights.List = nil
+	for _, pair := range strings.Split(s, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		info := strings.Split(pair, ":")
+		if len(info) != 2 {
+			return Error.New("invalid reason weight (expect format reason:weight, got %s)", pair)
+		}
+		reason, err := queue.ParseReason(strings.TrimSpace(info[0]))
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		weight, err := strconv.Atoi(strings.TrimSpace(info[1]))
+		if err != nil || weight <= 0 {
+			return Error.New("invalid weight of %s (should be a positive integer): %s", reason, info[1])
+		}
+		for _, existing := range weights.List {
+			if existing.Reason == reason {
+				return Error.New("weight of %s is defined multiple times", reason)
+			}
+		}
+		weights.List = append(weights.List, ReasonWeight{Reason: reason, Weight: weight})
+	}
+	return nil
+}
+
+// reasonScheduler decides which reason the next repaired segment should be
+// queued for. It uses smooth weighted round-robin, so every reason gets its
+// share of repairs without starving the others.
+//
+// reasonScheduler is not safe for concurrent use.
+type reasonScheduler struct {
+	weights []ReasonWeight
+	current []int
+	total   int
+}
+
+func newReasonScheduler(weights ReasonWeights) *reasonScheduler {
+	scheduler := &reasonScheduler{
+		weights: weights.List,
+		current: make([]int, len(weights.List)),
+	}
+	for _, weight := range weights.List {
+		scheduler.total += weight.Weight
+	}
+	return scheduler
+}
+
+// next returns the reason whose turn it is. ok is false when no weights are configured.
+func (scheduler *reasonScheduler) next() (reason queue.Reason, ok bool) {
+	if len(scheduler.weights) == 0 {
+		return 0, false
+	}
+
+	best := 0
+	for i, weight := range scheduler.weights {
+		scheduler.current[i] += weight.Weight
+		if scheduler.current[i] > scheduler.current[best] {
+			best = i
+		}
+	}
+	scheduler.current[best] -= scheduler.total
+	return scheduler.weights[best].Reason, true
+}
@@ -0,0 +1,54 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package repairer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/satellite/repair/queue"
+)
+
+func TestReasonWeightsConfig(t *testing.T) {
+	var weights ReasonWeights
+	require.NoError(t, weights.Set("below_threshold:3, operator_requested:1"))
+	require.Equal(t, []ReasonWeight{
+		{Reason: queue.ReasonBelowThreshold, Weight: 3},
+		{Reason: queue.ReasonOperatorRequested, Weight: 1},
+	}, weights.List)
+	require.Equal(t, "below_threshold:3,operator_requested:1", weights.String())
+
+	require.NoError(t, weights.Set(""))
+	require.Empty(t, weights.List)
+
+	for _, invalid := range []string{
+		"below_threshold",
+		"below_threshold:0",
+		"below_threshold:x",
+		"unknown:1",
+		"below_threshold:1,below_threshold:2",
+	} {
+		require.Error(t, weights.Set(invalid), invalid)
+	}
+}
+
+func TestReasonScheduler(t *testing.T) {
+	var weights ReasonWeights
+	require.NoError(t, weights.Set("below_threshold:3,placement_violation:1"))
+
+	scheduler := newReasonScheduler(weights)
+	var order []queue.Reason
+	for i := 0; i < 8; i++ {
+		reason, ok := scheduler.next()
+		require.True(t, ok)
+		order = append(order, reason)
+	}
+
+	below, placement := queue.ReasonBelowThreshold, queue.ReasonPlacementViolation
+	require.Equal(t, []queue.Reason{below, below, placement, below, below, below, placement, below}, order)
+
+	_, ok := newReasonScheduler(ReasonWeights{}).next()
+	require.False(t, ok)
+}
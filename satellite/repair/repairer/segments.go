@@ -8,8 +8,10 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"strconv"
 	"time"
 
+	"github.com/spacemonkeygo/monkit/v3"
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
 
@@ -169,6 +171,25 @@ func (repairer *SegmentRepairer) Repair(ctx context.Context, queueSegment *queue
 		return false, overlayQueryError.New("error identifying missing pieces: %w", err)
 	}
 
+	lostPiecesSet := sliceToSet(missingPieces)
+
+	// pieces on nodes which are not allowed by the placement anymore are still
+	// used for downloading the segment, but they have to be moved to other nodes.
+	var availablePieces metabase.Pieces
+	for _, piece := range pieces {
+		if !lostPiecesSet[piece.Number] {
+			availablePieces = append(availablePieces, piece)
+		}
+	}
+	outOfPlacementPieces, err := repairer.overlay.GetOutOfPlacementPieces(ctx, availablePieces, segment.Placement)
+	if err != nil {
+		return false, overlayQueryError.New("error identifying pieces out of placement: %w", err)
+	}
+	outOfPlacementSet := make(map[uint16]bool, len(outOfPlacementPieces))
+	for _, piece := range outOfPlacementPieces {
+		outOfPlacementSet[piece.Number] = true
+	}
+
 	numHealthy := len(pieces) - len(missingPieces)
 	// irreparable piece
 	if numHealthy < int(segment.Redundancy.RequiredShares) {
@@ -203,14 +224,29 @@ func (repairer *SegmentRepairer) Repair(ctx context.Context, queueSegment *queue
 		repairThreshold = overrideValue
 	}
 
+	// segments queued by operators or for graceful exit are repaired as long
+	// as they are not already at the optimal threshold.
+	forced := queueSegment.Reason.Forced() && numHealthy < int(segment.Redundancy.OptimalShares)
+
 	// repair not needed
-	if numHealthy > int(repairThreshold) {
+	if numHealthy > int(repairThreshold) && len(outOfPlacementPieces) == 0 && !forced {
 		mon.Meter("repair_unnecessary").Mark(1) //mon:locked
 		stats.repairUnnecessary.Mark(1)
 		repairer.log.Debug("segment above repair threshold", zap.Int("numHealthy", numHealthy), zap.Int32("repairThreshold", repairThreshold))
 		return true, nil
 	}
 
+	placementTag := monkit.NewSeriesTag("placement", strconv.Itoa(int(segment.Placement)))
+	if len(outOfPlacementPieces) > 0 {
+		mon.Meter("repair_placement_violation", placementTag).Mark(1)
+		repairer.log.Debug("segment has pieces out of placement",
+			zap.Stringer("Stream ID", segment.StreamID),
+			zap.Uint64("Position", queueSegment.Position.Encode()),
+			zap.Int("outOfPlacement", len(outOfPlacementPieces)),
+			zap.Uint16("placement", uint16(segment.Placement)),
+		)
+	}
+
 	healthyRatioBeforeRepair := 0.0
 	if segment.Redundancy.TotalShares != 0 {
 		healthyRatioBeforeRepair = float64(numHealthy) / float64(segment.Redundancy.TotalShares)
@@ -218,8 +254,6 @@ func (repairer *SegmentRepairer) Repair(ctx context.Context, queueSegment *queue
 	mon.FloatVal("healthy_ratio_before_repair").Observe(healthyRatioBeforeRepair) //mon:locked
 	stats.healthyRatioBeforeRepair.Observe(healthyRatioBeforeRepair)
 
-	lostPiecesSet := sliceToSet(missingPieces)
-
 	var healthyPieces, unhealthyPieces metabase.Pieces
 	// Populate healthyPieces with all pieces from the segment except those correlating to indices in lostPieces
 	for _, piece := range pieces {
@@ -261,18 +295,35 @@ func (repairer *SegmentRepairer) Repair(ctx context.Context, queueSegment *queue
 	}
 	healthyPieces = newHealthyPieces
 
+	// Pieces out of placement are downloaded, but their piece numbers are
+	// reused for the repaired pieces.
+	var movedPieces metabase.Pieces
+	keptLimits := getOrderLimits
+	if len(outOfPlacementPieces) > 0 {
+		keptLimits = make([]*pb.AddressedOrderLimit, len(getOrderLimits))
+		copy(keptLimits, getOrderLimits)
+		for _, piece := range healthyPieces {
+			if outOfPlacementSet[piece.Number] {
+				keptLimits[piece.Number] = nil
+				movedPieces = append(movedPieces, piece)
+			}
+		}
+	}
+	numKept := len(healthyPieces) - len(movedPieces)
+
 	var requestCount int
 	var minSuccessfulNeeded int
 	{
 		totalNeeded := math.Ceil(float64(redundancy.OptimalThreshold()) * repairer.multiplierOptimalThreshold)
-		requestCount = int(totalNeeded) - len(healthyPieces)
-		minSuccessfulNeeded = redundancy.OptimalThreshold() - len(healthyPieces)
+		requestCount = int(totalNeeded) - numKept
+		minSuccessfulNeeded = redundancy.OptimalThreshold() - numKept
 	}
 
 	// Request Overlay for n-h new storage nodes
 	request := overlay.FindStorageNodesRequest{
 		RequestedCount: requestCount,
 		ExcludedIDs:    excludeNodeIDs,
+		Placement:      segment.Placement,
 	}
 	newNodes, err := repairer.overlay.FindStorageNodesForUpload(ctx, request)
 	if err != nil {
@@ -280,7 +331,7 @@ func (repairer *SegmentRepairer) Repair(ctx context.Context, queueSegment *queue
 	}
 
 	// Create the order limits for the PUT_REPAIR action
-	putLimits, putPrivateKey, err := repairer.orders.CreatePutRepairOrderLimits(ctx, metabase.BucketLocation{}, segment, getOrderLimits, newNodes, repairer.multiplierOptimalThreshold)
+	putLimits, putPrivateKey, err := repairer.orders.CreatePutRepairOrderLimits(ctx, metabase.BucketLocation{}, segment, keptLimits, newNodes, repairer.multiplierOptimalThreshold)
 	if err != nil {
 		return false, orderLimitFailureError.New("could not create PUT_REPAIR order limits: %w", err)
 	}
@@ -392,7 +443,7 @@ func (repairer *SegmentRepairer) Repair(ctx context.Context, queueSegment *queue
 
 	mon.Meter("repair_bytes_uploaded").Mark64(bytesRepaired) //mon:locked
 
-	healthyAfterRepair := len(healthyPieces) + len(repairedPieces)
+	healthyAfterRepair := numKept + len(repairedPieces)
 	switch {
 	case healthyAfterRepair <= int(segment.Redundancy.RepairShares):
 		// Important: this indicates a failure to PUT enough pieces to the network to pass
@@ -419,9 +470,12 @@ func (repairer *SegmentRepairer) Repair(ctx context.Context, queueSegment *queue
 	stats.healthyRatioAfterRepair.Observe(healthyRatioAfterRepair)
 
 	var toRemove metabase.Pieces
+	var movedCount int64
 	if healthyAfterRepair >= int(segment.Redundancy.OptimalShares) {
-		// if full repair, remove all unhealthy pieces
-		toRemove = unhealthyPieces
+		// if full repair, remove all unhealthy and out of placement pieces
+		toRemove = append(toRemove, unhealthyPieces...)
+		toRemove = append(toRemove, movedPieces...)
+		movedCount = int64(len(movedPieces))
 	} else {
 		// if partial repair, leave unrepaired unhealthy pieces in the pointer
 		for _, piece := range unhealthyPieces {
@@ -431,7 +485,15 @@ func (repairer *SegmentRepairer) Repair(ctx context.Context, queueSegment *queue
 				toRemove = append(toRemove, piece)
 			}
 		}
+		// out of placement pieces are only removed when they have been replaced
+		for _, piece := range movedPieces {
+			if repairedMap[piece.Number] {
+				toRemove = append(toRemove, piece)
+				movedCount++
+			}
+		}
 	}
+	mon.Meter("repair_placement_pieces_moved", placementTag).Mark64(movedCount)
 
 	// add pieces that failed piece hashes verification to the removal list
 	toRemove = append(toRemove, piecesReport.Failed...)
@@ -29,6 +29,72 @@ type AuditWindow struct {
 	OnlineCount int32
 }
 
+// AddAudit adds an audit to the latest window, removes the windows outside of the
+// tracking period and recalculates the score. It returns whether the windows span a
+// full tracking period.
+func (history *AuditHistory) AddAudit(auditTime time.Time, online bool, config AuditHistoryConfig) (trackingPeriodFull bool, err error) {
+	newAuditWindowStartTime := auditTime.Truncate(config.WindowSize)
+	earliestWindow := newAuditWindowStartTime.Add(-config.TrackingPeriod)
+	// windowsModified is used to determine whether we will need to recalculate the score because windows have been added or removed.
+	windowsModified := false
+
+	// delete windows outside of tracking period scope
+	updatedWindows := history.Windows
+	for i, window := range history.Windows {
+		if window.WindowStart.Before(earliestWindow) {
+			updatedWindows = history.Windows[i+1:]
+			windowsModified = true
+		} else {
+			// windows are in order, so if this window is in the tracking period, we are done deleting windows
+			break
+		}
+	}
+	history.Windows = updatedWindows
+
+	// if there are no windows or the latest window has passed, add another window
+	if len(history.Windows) == 0 || history.Windows[len(history.Windows)-1].WindowStart.Before(newAuditWindowStartTime) {
+		windowsModified = true
+		history.Windows = append(history.Windows, &AuditWindow{WindowStart: newAuditWindowStartTime})
+	}
+
+	latestIndex := len(history.Windows) - 1
+	if history.Windows[latestIndex].WindowStart.After(newAuditWindowStartTime) {
+		return false, Error.New("cannot add audit to audit history; window already passed")
+	}
+
+	// add new audit to latest window
+	if online {
+		history.Windows[latestIndex].OnlineCount++
+	}
+	history.Windows[latestIndex].TotalCount++
+
+	windowsPerTrackingPeriod := int(config.TrackingPeriod.Seconds() / config.WindowSize.Seconds())
+	trackingPeriodFull = len(history.Windows)-1 >= windowsPerTrackingPeriod
+
+	// if no windows were added or removed, score does not change
+	if !windowsModified {
+		return trackingPeriodFull, nil
+	}
+
+	if len(history.Windows) <= 1 {
+		history.Score = 1
+		return trackingPeriodFull, nil
+	}
+
+	totalWindowScores := 0.0
+	for i, window := range history.Windows {
+		// do not include last window in score
+		if i+1 == len(history.Windows) {
+			break
+		}
+		totalWindowScores += float64(window.OnlineCount) / float64(window.TotalCount)
+	}
+
+	// divide by number of windows-1 because last window is not included
+	history.Score = totalWindowScores / float64(len(history.Windows)-1)
+	return trackingPeriodFull, nil
+}
+
 // AuditHistoryToPB converts an overlay.AuditHistory to a pb.AuditHistory.
 func AuditHistoryToPB(auditHistory AuditHistory) (historyPB *pb.AuditHistory) {
 	historyPB = &pb.AuditHistory{
@@ -0,0 +1,53 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package reputation
+
+// BetaScores are the parameters of the Beta distributions of a node's audit
+// and unknown audit reputation.
+type BetaScores struct {
+	AuditAlpha        float64
+	AuditBeta         float64
+	UnknownAuditAlpha float64
+	UnknownAuditBeta  float64
+}
+
+// Apply returns the scores after an audit with the given outcome, using the Beta distribution model.
+// lambda is the "forgetting factor" which determines how much past info is kept when determining current reputation score.
+// w is the normalization weight that affects how severely new updates affect the current reputation distribution.
+func (scores BetaScores) Apply(outcome AuditType, lambda, w float64) BetaScores {
+	switch outcome {
+	case AuditSuccess:
+		// for a successful audit, increase reputation for normal *and* unknown audits
+		scores.AuditAlpha, scores.AuditBeta = updateBeta(true, scores.AuditAlpha, scores.AuditBeta, lambda, w)
+		scores.UnknownAuditAlpha, scores.UnknownAuditBeta = updateBeta(true, scores.UnknownAuditAlpha, scores.UnknownAuditBeta, lambda, w)
+	case AuditFailure:
+		// for audit failure, only update normal alpha/beta
+		scores.AuditAlpha, scores.AuditBeta = updateBeta(false, scores.AuditAlpha, scores.AuditBeta, lambda, w)
+	case AuditUnknown:
+		// for audit unknown, only update unknown alpha/beta
+		scores.UnknownAuditAlpha, scores.UnknownAuditBeta = updateBeta(false, scores.UnknownAuditAlpha, scores.UnknownAuditBeta, lambda, w)
+	}
+	// for audit offline, the scores stay the same
+	return scores
+}
+
+// AuditScore returns the audit reputation score.
+func (scores BetaScores) AuditScore() float64 {
+	return scores.AuditAlpha / (scores.AuditAlpha + scores.AuditBeta)
+}
+
+// UnknownAuditScore returns the unknown audit reputation score.
+func (scores BetaScores) UnknownAuditScore() float64 {
+	return scores.UnknownAuditAlpha / (scores.UnknownAuditAlpha + scores.UnknownAuditBeta)
+}
+
+// updateBeta applies a single audit to a Beta distribution.
+func updateBeta(isSuccess bool, alpha, beta, lambda, w float64) (newAlpha, newBeta float64) {
+	// v is a single feedback value that allows us to update both alpha and beta
+	var v float64 = -1
+	if isSuccess {
+		v = 1
+	}
+	return lambda*alpha + w*(1+v)/2, lambda*beta + w*(1-v)/2
+}
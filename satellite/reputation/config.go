@@ -18,19 +18,25 @@ var (
 	Error = errs.Class("reputation")
 	// ErrNodeNotFound is returned if a node does not exist in database.
 	ErrNodeNotFound = errs.Class("node not found")
+	// ErrNodeNotPenalized is returned when reinstating a node, which is neither disqualified nor suspended.
+	ErrNodeNotPenalized = errs.Class("node not penalized")
 )
 
 // Config contains all config values for the reputation service.
 type Config struct {
-	AuditRepairWeight     float64       `help:"weight to apply to audit reputation for total repair reputation calculation" default:"1.0"`
-	AuditUplinkWeight     float64       `help:"weight to apply to audit reputation for total uplink reputation calculation" default:"1.0"`
-	AuditLambda           float64       `help:"the forgetting factor used to calculate the audit SNs reputation" default:"0.95"`
-	AuditWeight           float64       `help:"the normalization weight used to calculate the audit SNs reputation" default:"1.0"`
-	AuditDQ               float64       `help:"the reputation cut-off for disqualifying SNs based on audit history" default:"0.6"`
-	SuspensionGracePeriod time.Duration `help:"the time period that must pass before suspended nodes will be disqualified" releaseDefault:"168h" devDefault:"1h"`
-	SuspensionDQEnabled   bool          `help:"whether nodes will be disqualified if they have been suspended for longer than the suspended grace period" releaseDefault:"false" devDefault:"true"`
-	AuditCount            int64         `help:"the number of times a node has been audited to not be considered a New Node" releaseDefault:"100" devDefault:"0"`
-	AuditHistory          AuditHistoryConfig
+	AuditRepairWeight      float64       `help:"weight to apply to audit reputation for total repair reputation calculation" default:"1.0"`
+	AuditUplinkWeight      float64       `help:"weight to apply to audit reputation for total uplink reputation calculation" default:"1.0"`
+	AuditLambda            float64       `help:"the forgetting factor used to calculate the audit SNs reputation" default:"0.95"`
+	AuditWeight            float64       `help:"the normalization weight used to calculate the audit SNs reputation" default:"1.0"`
+	PieceAuditWeight       float64       `help:"the normalization weight used to calculate the audit SNs reputation for full piece audits" default:"1.0"`
+	AuditDQ                float64       `help:"the reputation cut-off for disqualifying SNs based on audit history" default:"0.6"`
+	SuspensionGracePeriod  time.Duration `help:"the time period that must pass before suspended nodes will be disqualified" releaseDefault:"168h" devDefault:"1h"`
+	SuspensionDQEnabled    bool          `help:"whether nodes will be disqualified if they have been suspended for longer than the suspended grace period" releaseDefault:"false" devDefault:"true"`
+	AuditCount             int64         `help:"the number of times a node has been audited to not be considered a New Node" releaseDefault:"100" devDefault:"0"`
+	RecordAuditOutcomes    bool          `help:"whether to store the outcome of every audit, which is needed for replaying reputation with a different configuration" releaseDefault:"false" devDefault:"true"`
+	AuditOutcomesRetention time.Duration `help:"how long the outcomes of audits are kept before they are folded into the reputation that replays start from" default:"720h"`
+	AuditOutcomesInterval  time.Duration `help:"how often the outcomes of audits older than the retention are folded" default:"24h" testDefault:"$TESTINTERVAL"`
+	AuditHistory           AuditHistoryConfig
 }
 
 // UpdateRequest is used to update a node's reputation status.
@@ -74,3 +80,19 @@ const (
 	// AuditOffline represents an audit where a node was offline.
 	AuditOffline
 )
+
+// String returns a string representation of the audit outcome.
+func (auditType AuditType) String() string {
+	switch auditType {
+	case AuditSuccess:
+		return "success"
+	case AuditFailure:
+		return "failure"
+	case AuditUnknown:
+		return "unknown"
+	case AuditOffline:
+		return "offline"
+	default:
+		return "invalid"
+	}
+}
@@ -11,9 +11,12 @@ import (
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
 
+	"storj.io/common/storj"
 	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
 	"storj.io/storj/private/testplanet"
 	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/overlay"
 	"storj.io/storj/satellite/reputation"
 )
 
@@ -63,6 +66,102 @@ func TestUpdate(t *testing.T) {
 	})
 }
 
+func TestReinstate(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		node := planet.StorageNodes[0]
+		node.Contact.Chore.Pause(ctx)
+
+		db := satellite.DB.Reputation()
+		cache := satellite.DB.OverlayCache()
+
+		newReinstatement := func(nodeID storj.NodeID) reputation.Reinstatement {
+			return reputation.Reinstatement{
+				ID:           testrand.UUID(),
+				NodeID:       nodeID,
+				ReinstatedBy: "operator",
+				Reason:       "satellite bug",
+				Probation:    true,
+			}
+		}
+
+		_, err := db.Reinstate(ctx, newReinstatement(node.ID()))
+		require.True(t, reputation.ErrNodeNotPenalized.Has(err))
+
+		_, err = db.Reinstate(ctx, newReinstatement(testrand.NodeID()))
+		require.True(t, reputation.ErrNodeNotFound.Has(err))
+
+		// a single failed audit of a new node is enough for disqualification
+		require.NoError(t, satellite.Reputation.Service.ApplyAudit(ctx, node.ID(), reputation.AuditFailure))
+
+		penalized, err := cache.ListPenalizedNodes(ctx, true, true, 10)
+		require.NoError(t, err)
+		require.Len(t, penalized, 1)
+		require.Equal(t, node.ID(), penalized[0].ID)
+		require.NotNil(t, penalized[0].Disqualified)
+		require.Equal(t, overlay.DisqualificationReasonAuditFailure, penalized[0].DisqualificationReason)
+
+		penalized, err = cache.ListPenalizedNodes(ctx, false, true, 10)
+		require.NoError(t, err)
+		require.Empty(t, penalized)
+
+		reinstatement, err := db.Reinstate(ctx, newReinstatement(node.ID()))
+		require.NoError(t, err)
+		require.NotNil(t, reinstatement.PreviousDisqualified)
+		require.Equal(t, overlay.DisqualificationReasonAuditFailure, reinstatement.PreviousDisqualificationReason)
+		require.False(t, reinstatement.CreatedAt.IsZero())
+
+		info, err := db.Get(ctx, node.ID())
+		require.NoError(t, err)
+		require.Nil(t, info.Disqualified)
+		require.Nil(t, info.VettedAt)
+		require.Zero(t, info.TotalAuditCount)
+		require.Equal(t, 1.0, info.AuditReputationAlpha)
+		require.Zero(t, info.AuditReputationBeta)
+
+		dossier, err := satellite.Overlay.Service.Get(ctx, node.ID())
+		require.NoError(t, err)
+		require.Nil(t, dossier.Disqualified)
+
+		penalized, err = cache.ListPenalizedNodes(ctx, true, true, 10)
+		require.NoError(t, err)
+		require.Empty(t, penalized)
+
+		reinstatements, err := db.Reinstatements(ctx, node.ID())
+		require.NoError(t, err)
+		require.Len(t, reinstatements, 1)
+		require.Equal(t, reinstatement.ID, reinstatements[0].ID)
+		require.Equal(t, "operator", reinstatements[0].ReinstatedBy)
+		require.Equal(t, "satellite bug", reinstatements[0].Reason)
+		require.True(t, reinstatements[0].Probation)
+		require.Equal(t, overlay.DisqualificationReasonAuditFailure, reinstatements[0].PreviousDisqualificationReason)
+
+		// reinstatements without probation keep the audit counts, but reset the scores,
+		// so that the node isn't disqualified again because of the earlier audits.
+		require.NoError(t, satellite.Reputation.Service.ApplyAudit(ctx, node.ID(), reputation.AuditFailure))
+
+		info, err = db.Get(ctx, node.ID())
+		require.NoError(t, err)
+		require.NotNil(t, info.Disqualified)
+
+		withoutProbation := newReinstatement(node.ID())
+		withoutProbation.Probation = false
+		_, err = db.Reinstate(ctx, withoutProbation)
+		require.NoError(t, err)
+
+		info, err = db.Get(ctx, node.ID())
+		require.NoError(t, err)
+		require.Nil(t, info.Disqualified)
+		require.EqualValues(t, 1, info.TotalAuditCount)
+		require.Equal(t, 1.0, info.AuditReputationAlpha)
+		require.Zero(t, info.AuditReputationBeta)
+		require.Equal(t, 1.0, info.UnknownAuditReputationAlpha)
+		require.Zero(t, info.UnknownAuditReputationBeta)
+	})
+}
+
 func testAuditHistoryConfig() reputation.AuditHistoryConfig {
 	return reputation.AuditHistoryConfig{
 		WindowSize:       time.Hour,
@@ -0,0 +1,81 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package reputation
+
+import (
+	"context"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/sync2"
+)
+
+// OutcomesChore folds the audit outcomes older than the retention into the
+// baselines of the nodes, so that the stored outcomes don't grow without bound.
+// Replays with a different configuration only change the outcomes after the
+// baseline, since the folded outcomes are applied with the configuration of the chore.
+//
+// architecture: Chore
+type OutcomesChore struct {
+	log    *zap.Logger
+	db     DB
+	config Config
+
+	Loop *sync2.Cycle
+}
+
+// NewOutcomesChore creates a new chore for folding old audit outcomes.
+func NewOutcomesChore(log *zap.Logger, db DB, config Config) *OutcomesChore {
+	return &OutcomesChore{
+		log:    log,
+		db:     db,
+		config: config,
+
+		Loop: sync2.NewCycle(config.AuditOutcomesInterval),
+	}
+}
+
+// Run starts the chore.
+func (chore *OutcomesChore) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return chore.Loop.Run(ctx, func(ctx context.Context) error {
+		err := chore.RunOnce(ctx, time.Now())
+		if err != nil {
+			chore.log.Error("error folding old audit outcomes", zap.Error(err))
+		}
+		return nil
+	})
+}
+
+// RunOnce folds the audit outcomes older than the retention.
+func (chore *OutcomesChore) RunOnce(ctx context.Context, now time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	before := now.Add(-chore.config.AuditOutcomesRetention)
+
+	// the baselines are stored after iterating, so that the outcomes aren't
+	// deleted while they are read.
+	var baselines []ReplayBaseline
+	err = replayAuditOutcomes(ctx, chore.db, chore.config, nil, before, func(replay *Replay) error {
+		baselines = append(baselines, replay.Baseline())
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	var errlist errs.Group
+	for _, baseline := range baselines {
+		errlist.Add(chore.db.FoldAuditOutcomes(ctx, baseline, before))
+	}
+	return errlist.Err()
+}
+
+// Close stops the chore.
+func (chore *OutcomesChore) Close() error {
+	chore.Loop.Close()
+	return nil
+}
@@ -0,0 +1,33 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package reputation
+
+import (
+	"time"
+
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/overlay"
+)
+
+// Reinstatement is the record of lifting the disqualification and suspensions of a node,
+// e.g. when the node was penalized because of a satellite bug.
+type Reinstatement struct {
+	ID     uuid.UUID
+	NodeID storj.NodeID
+	// ReinstatedBy identifies who reinstated the node.
+	ReinstatedBy string
+	// Reason explains why the node was reinstated.
+	Reason string
+	// Probation resets the vetting of the node, so that it's vetted again like a new
+	// node. The audit and online scores are reset by every reinstatement.
+	Probation bool
+
+	PreviousDisqualified           *time.Time
+	PreviousDisqualificationReason overlay.DisqualificationReason
+	PreviousUnknownAuditSuspended  *time.Time
+	PreviousOfflineSuspended       *time.Time
+
+	CreatedAt time.Time
+}
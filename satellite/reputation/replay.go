@@ -0,0 +1,334 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package reputation
+
+import (
+	"context"
+	"time"
+
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+)
+
+// AuditOutcome is the stored outcome of a single audit of a node.
+type AuditOutcome struct {
+	ID         uuid.UUID
+	NodeID     storj.NodeID
+	AuditedAt  time.Time
+	Outcome    AuditType
+	PieceAudit bool
+}
+
+// ReplayBaseline is the reputation of a node before its oldest stored audit outcome,
+// which replays of the outcomes start from.
+type ReplayBaseline struct {
+	NodeID storj.NodeID
+
+	AuditReputationAlpha        float64
+	AuditReputationBeta         float64
+	UnknownAuditReputationAlpha float64
+	UnknownAuditReputationBeta  float64
+	OnlineScore                 float64
+	TotalAuditCount             int64
+	AuditHistory                AuditHistory
+
+	VettedAt              *time.Time
+	UnknownAuditSuspended *time.Time
+	OfflineSuspended      *time.Time
+	UnderReview           *time.Time
+	Disqualified          *time.Time
+}
+
+// NewNodeBaseline returns the baseline of a node that hasn't been audited yet.
+func NewNodeBaseline(nodeID storj.NodeID) ReplayBaseline {
+	return ReplayBaseline{
+		NodeID:                      nodeID,
+		AuditReputationAlpha:        1,
+		UnknownAuditReputationAlpha: 1,
+		OnlineScore:                 1,
+	}
+}
+
+// ReplayPoint is the reputation of a node after applying a single audit outcome.
+type ReplayPoint struct {
+	Time    time.Time
+	Outcome AuditType
+
+	AuditReputationAlpha        float64
+	AuditReputationBeta         float64
+	UnknownAuditReputationAlpha float64
+	UnknownAuditReputationBeta  float64
+	OnlineScore                 float64
+	TotalAuditCount             int64
+
+	Vetted                bool
+	UnknownAuditSuspended bool
+	OfflineSuspended      bool
+	Disqualified          bool
+}
+
+// AuditScore returns the audit reputation score.
+func (point ReplayPoint) AuditScore() float64 {
+	return point.AuditReputationAlpha / (point.AuditReputationAlpha + point.AuditReputationBeta)
+}
+
+// UnknownAuditScore returns the unknown audit reputation score.
+func (point ReplayPoint) UnknownAuditScore() float64 {
+	return point.UnknownAuditReputationAlpha / (point.UnknownAuditReputationAlpha + point.UnknownAuditReputationBeta)
+}
+
+// ReplayResult is the reputation of a node reconstructed from its audit outcomes.
+type ReplayResult struct {
+	NodeID   storj.NodeID
+	Baseline ReplayBaseline
+	History  []ReplayPoint
+
+	VettedAt              *time.Time
+	UnknownAuditSuspended *time.Time
+	OfflineSuspended      *time.Time
+	UnderReview           *time.Time
+	Disqualified          *time.Time
+	// DisqualificationReason describes why the node was disqualified.
+	DisqualificationReason string
+}
+
+// Last returns the reputation after the last applied audit outcome.
+func (result *ReplayResult) Last() ReplayPoint {
+	if len(result.History) == 0 {
+		baseline := result.Baseline
+		return ReplayPoint{
+			AuditReputationAlpha:        baseline.AuditReputationAlpha,
+			AuditReputationBeta:         baseline.AuditReputationBeta,
+			UnknownAuditReputationAlpha: baseline.UnknownAuditReputationAlpha,
+			UnknownAuditReputationBeta:  baseline.UnknownAuditReputationBeta,
+			OnlineScore:                 baseline.OnlineScore,
+			TotalAuditCount:             baseline.TotalAuditCount,
+
+			Vetted:                baseline.VettedAt != nil,
+			UnknownAuditSuspended: baseline.UnknownAuditSuspended != nil,
+			OfflineSuspended:      baseline.OfflineSuspended != nil,
+			Disqualified:          baseline.Disqualified != nil,
+		}
+	}
+	return result.History[len(result.History)-1]
+}
+
+// Replay reconstructs the reputation of a single node by applying its audit
+// outcomes in order, following the same rules as the reputation DB updates.
+type Replay struct {
+	config  Config
+	result  ReplayResult
+	current ReplayPoint
+	history AuditHistory
+}
+
+// NewReplay creates a replay of the reputation of the node with the given configuration,
+// starting from the baseline.
+func NewReplay(baseline ReplayBaseline, config Config) *Replay {
+	replay := &Replay{
+		config: config,
+		result: ReplayResult{
+			NodeID:   baseline.NodeID,
+			Baseline: baseline,
+
+			VettedAt:              baseline.VettedAt,
+			UnknownAuditSuspended: baseline.UnknownAuditSuspended,
+			OfflineSuspended:      baseline.OfflineSuspended,
+			UnderReview:           baseline.UnderReview,
+			Disqualified:          baseline.Disqualified,
+		},
+		history: AuditHistory{Score: baseline.AuditHistory.Score},
+	}
+	for _, window := range baseline.AuditHistory.Windows {
+		window := *window
+		replay.history.Windows = append(replay.history.Windows, &window)
+	}
+	replay.current = replay.result.Last()
+	return replay
+}
+
+// Apply applies the audit outcome to the reputation.
+// Outcomes must be applied in the order of the audit time.
+func (replay *Replay) Apply(outcome AuditOutcome) {
+	result := &replay.result
+	// reputation of disqualified nodes is not updated.
+	if result.Disqualified != nil {
+		return
+	}
+
+	req := newUpdateRequest(replay.config, outcome.NodeID, outcome.Outcome, outcome.PieceAudit)
+	now := outcome.AuditedAt
+	point := replay.current
+	point.Time = now
+	point.Outcome = outcome.Outcome
+
+	scores := BetaScores{
+		AuditAlpha:        point.AuditReputationAlpha,
+		AuditBeta:         point.AuditReputationBeta,
+		UnknownAuditAlpha: point.UnknownAuditReputationAlpha,
+		UnknownAuditBeta:  point.UnknownAuditReputationBeta,
+	}.Apply(outcome.Outcome, req.AuditLambda, req.AuditWeight)
+	point.AuditReputationAlpha, point.AuditReputationBeta = scores.AuditAlpha, scores.AuditBeta
+	point.UnknownAuditReputationAlpha, point.UnknownAuditReputationBeta = scores.UnknownAuditAlpha, scores.UnknownAuditBeta
+	point.TotalAuditCount++
+
+	trackingPeriodFull, err := replay.history.AddAudit(now, outcome.Outcome != AuditOffline, req.AuditHistory)
+	if err != nil {
+		// the reputation DB rejects audits older than the latest window as well.
+		return
+	}
+	point.OnlineScore = replay.history.Score
+
+	if result.VettedAt == nil && point.TotalAuditCount >= req.AuditsRequiredForVetting {
+		result.VettedAt = &now
+	}
+
+	if point.AuditScore() <= req.AuditDQ {
+		replay.disqualify(now, "audit failure")
+	}
+
+	if point.UnknownAuditScore() <= req.AuditDQ {
+		if result.UnknownAuditSuspended == nil {
+			result.UnknownAuditSuspended = &now
+		} else if outcome.Outcome != AuditSuccess && req.SuspensionDQEnabled &&
+			now.Sub(*result.UnknownAuditSuspended) > req.SuspensionGracePeriod {
+			replay.disqualify(now, "suspension grace period expired for unknown audits")
+			result.UnknownAuditSuspended = nil
+		}
+	} else {
+		result.UnknownAuditSuspended = nil
+	}
+
+	replay.applyOfflineSuspension(now, req, trackingPeriodFull)
+
+	point.Vetted = result.VettedAt != nil
+	point.UnknownAuditSuspended = result.UnknownAuditSuspended != nil
+	point.OfflineSuspended = result.OfflineSuspended != nil
+	point.Disqualified = result.Disqualified != nil
+
+	replay.current = point
+	result.History = append(result.History, point)
+}
+
+// applyOfflineSuspension suspends, reinstates or disqualifies the node based on its online score.
+func (replay *Replay) applyOfflineSuspension(now time.Time, req UpdateRequest, trackingPeriodFull bool) {
+	result := &replay.result
+	config := req.AuditHistory
+
+	if !config.OfflineSuspensionEnabled {
+		result.OfflineSuspended = nil
+		result.UnderReview = nil
+		return
+	}
+
+	penalize := replay.history.Score < config.OfflineThreshold && trackingPeriodFull
+
+	if result.UnderReview == nil {
+		if penalize {
+			result.UnderReview = &now
+			result.OfflineSuspended = &now
+		}
+		return
+	}
+
+	if !penalize {
+		result.OfflineSuspended = nil
+	} else if result.OfflineSuspended == nil {
+		result.OfflineSuspended = &now
+	}
+
+	trackingPeriodEnd := result.UnderReview.Add(config.GracePeriod).Add(config.TrackingPeriod)
+	if now.After(trackingPeriodEnd) {
+		if !penalize {
+			result.UnderReview = nil
+			result.OfflineSuspended = nil
+		} else if config.OfflineDQEnabled {
+			replay.disqualify(now, "node offline")
+		}
+	}
+}
+
+func (replay *Replay) disqualify(now time.Time, reason string) {
+	if replay.result.Disqualified != nil {
+		return
+	}
+	replay.result.Disqualified = &now
+	replay.result.DisqualificationReason = reason
+}
+
+// Result returns the replayed reputation of the node.
+func (replay *Replay) Result() ReplayResult {
+	return replay.result
+}
+
+// Baseline returns the reputation after the applied outcomes, which
+// the replays of later outcomes can start from.
+func (replay *Replay) Baseline() ReplayBaseline {
+	result := &replay.result
+	baseline := ReplayBaseline{
+		NodeID: result.NodeID,
+
+		AuditReputationAlpha:        replay.current.AuditReputationAlpha,
+		AuditReputationBeta:         replay.current.AuditReputationBeta,
+		UnknownAuditReputationAlpha: replay.current.UnknownAuditReputationAlpha,
+		UnknownAuditReputationBeta:  replay.current.UnknownAuditReputationBeta,
+		OnlineScore:                 replay.current.OnlineScore,
+		TotalAuditCount:             replay.current.TotalAuditCount,
+		AuditHistory:                AuditHistory{Score: replay.history.Score},
+
+		VettedAt:              result.VettedAt,
+		UnknownAuditSuspended: result.UnknownAuditSuspended,
+		OfflineSuspended:      result.OfflineSuspended,
+		UnderReview:           result.UnderReview,
+		Disqualified:          result.Disqualified,
+	}
+	for _, window := range replay.history.Windows {
+		window := *window
+		baseline.AuditHistory.Windows = append(baseline.AuditHistory.Windows, &window)
+	}
+	return baseline
+}
+
+// ReplayAuditOutcomes replays the stored audit outcomes of the nodes with the given configuration
+// and calls fn with the result of every node. Outcomes of all nodes are replayed when nodeIDs is empty.
+func ReplayAuditOutcomes(ctx context.Context, db DB, config Config, nodeIDs []storj.NodeID, fn func(ReplayResult) error) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return replayAuditOutcomes(ctx, db, config, nodeIDs, time.Time{}, func(replay *Replay) error {
+		return fn(replay.Result())
+	})
+}
+
+// replayAuditOutcomes replays the stored audit outcomes before the time, or all of them when
+// before is zero, from the baselines of the nodes and calls fn with the replay of every node.
+func replayAuditOutcomes(ctx context.Context, db DB, config Config, nodeIDs []storj.NodeID, before time.Time, fn func(*Replay) error) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var replay *Replay
+	err = db.IterateAuditOutcomes(ctx, nodeIDs, before, func(outcome AuditOutcome) error {
+		if replay != nil && replay.result.NodeID != outcome.NodeID {
+			if err := fn(replay); err != nil {
+				return err
+			}
+			replay = nil
+		}
+		if replay == nil {
+			baseline, err := db.AuditOutcomeBaseline(ctx, outcome.NodeID)
+			if err != nil {
+				return err
+			}
+			replay = NewReplay(baseline, config)
+		}
+		replay.Apply(outcome)
+		return nil
+	})
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	if replay != nil {
+		return fn(replay)
+	}
+	return nil
+}
@@ -0,0 +1,114 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package reputation_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/storj"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite/reputation"
+)
+
+func TestReplay(t *testing.T) {
+	config := reputation.Config{
+		AuditLambda:      0.95,
+		AuditWeight:      1,
+		PieceAuditWeight: 1,
+		AuditDQ:          0.6,
+		AuditCount:       100,
+		AuditHistory:     testAuditHistoryConfig(),
+	}
+
+	nodeID := testrand.NodeID()
+	start := time.Date(2021, 10, 1, 0, 0, 0, 0, time.UTC)
+	outcomes := testOutcomes(nodeID, start, reputation.AuditFailure)
+
+	replay := func(config reputation.Config, outcomes []reputation.AuditOutcome) reputation.ReplayResult {
+		replay := reputation.NewReplay(reputation.NewNodeBaseline(nodeID), config)
+		for _, outcome := range outcomes {
+			replay.Apply(outcome)
+		}
+		return replay.Result()
+	}
+
+	t.Run("disqualified", func(t *testing.T) {
+		result := replay(config, outcomes)
+		require.NotNil(t, result.VettedAt)
+		require.Equal(t, outcomes[99].AuditedAt, *result.VettedAt)
+		require.NotNil(t, result.Disqualified)
+		require.Equal(t, outcomes[109].AuditedAt, *result.Disqualified)
+		require.Equal(t, "audit failure", result.DisqualificationReason)
+		require.Len(t, result.History, 110)
+
+		last := result.Last()
+		require.True(t, last.Disqualified)
+		require.Less(t, last.AuditScore(), config.AuditDQ)
+		require.EqualValues(t, 110, last.TotalAuditCount)
+	})
+
+	t.Run("higher lambda", func(t *testing.T) {
+		config := config
+		config.AuditLambda = 0.98
+
+		result := replay(config, outcomes)
+		require.Nil(t, result.Disqualified)
+		require.Len(t, result.History, 110)
+		require.Greater(t, result.Last().AuditScore(), config.AuditDQ)
+	})
+
+	t.Run("baseline", func(t *testing.T) {
+		// replaying the outcomes in two parts, starting the second part from the
+		// baseline after the first part, gives the same result.
+		first := reputation.NewReplay(reputation.NewNodeBaseline(nodeID), config)
+		for _, outcome := range outcomes[:105] {
+			first.Apply(outcome)
+		}
+		baseline := first.Baseline()
+		require.EqualValues(t, 105, baseline.TotalAuditCount)
+		require.NotNil(t, baseline.VettedAt)
+
+		firstResult, secondResult := first.Result(), reputation.NewReplay(baseline, config).Result()
+		require.Equal(t, firstResult.Last().AuditScore(), secondResult.Last().AuditScore())
+
+		second := reputation.NewReplay(baseline, config)
+		for _, outcome := range outcomes[105:] {
+			second.Apply(outcome)
+		}
+
+		result, full := second.Result(), replay(config, outcomes)
+		require.Len(t, result.History, 5)
+		require.Equal(t, full.Last(), result.Last())
+		require.NotNil(t, result.Disqualified)
+		require.Equal(t, outcomes[109].AuditedAt, *result.Disqualified)
+	})
+
+	t.Run("unknown audits", func(t *testing.T) {
+		result := replay(config, testOutcomes(nodeID, start, reputation.AuditUnknown))
+		require.Nil(t, result.Disqualified)
+		require.NotNil(t, result.UnknownAuditSuspended)
+		require.True(t, result.Last().UnknownAuditSuspended)
+		require.Equal(t, 1.0, result.Last().AuditScore())
+	})
+}
+
+// testOutcomes returns 100 successful audits followed by 10 audits with the outcome.
+func testOutcomes(nodeID storj.NodeID, start time.Time, outcome reputation.AuditType) []reputation.AuditOutcome {
+	var outcomes []reputation.AuditOutcome
+	for i := 0; i < 110; i++ {
+		result := reputation.AuditSuccess
+		if i >= 100 {
+			result = outcome
+		}
+		outcomes = append(outcomes, reputation.AuditOutcome{
+			NodeID:    nodeID,
+			AuditedAt: start.Add(time.Duration(i) * time.Minute),
+			Outcome:   result,
+		})
+	}
+	return outcomes
+}
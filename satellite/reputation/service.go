@@ -7,9 +7,11 @@ import (
 	"context"
 	"time"
 
+	"github.com/zeebo/errs"
 	"go.uber.org/zap"
 
 	"storj.io/common/storj"
+	"storj.io/common/uuid"
 	"storj.io/storj/satellite/overlay"
 )
 
@@ -27,6 +29,24 @@ type DB interface {
 	SuspendNodeUnknownAudit(ctx context.Context, nodeID storj.NodeID, suspendedAt time.Time) (err error)
 	// UpdateAuditHistory updates a node's audit history
 	UpdateAuditHistory(ctx context.Context, oldHistory []byte, updateReq UpdateRequest, auditTime time.Time) (res *UpdateAuditHistoryResponse, err error)
+
+	// RecordAuditOutcome stores the outcome of a single audit. The current reputation of the node
+	// is stored as its baseline when the node doesn't have stored outcomes yet.
+	RecordAuditOutcome(ctx context.Context, outcome AuditOutcome) error
+	// DeleteAuditOutcome removes a stored audit outcome, e.g. when it couldn't be applied.
+	DeleteAuditOutcome(ctx context.Context, id uuid.UUID) error
+	// IterateAuditOutcomes calls fn for the stored audit outcomes before the time, or all of them when
+	// before is zero, ordered by node and audit time. Outcomes of all nodes are iterated when nodeIDs is empty.
+	IterateAuditOutcomes(ctx context.Context, nodeIDs []storj.NodeID, before time.Time, fn func(AuditOutcome) error) error
+	// AuditOutcomeBaseline returns the reputation of the node before its oldest stored audit outcome.
+	AuditOutcomeBaseline(ctx context.Context, nodeID storj.NodeID) (ReplayBaseline, error)
+	// FoldAuditOutcomes replaces the baseline of the node and deletes its audit outcomes before the time.
+	FoldAuditOutcomes(ctx context.Context, baseline ReplayBaseline, before time.Time) error
+
+	// Reinstate lifts the disqualification and suspensions of a node and records the reinstatement.
+	Reinstate(ctx context.Context, reinstatement Reinstatement) (_ Reinstatement, err error)
+	// Reinstatements returns the reinstatements of a node, most recent first.
+	Reinstatements(ctx context.Context, nodeID storj.NodeID) (_ []Reinstatement, err error)
 }
 
 // Info contains all reputation data to be stored in DB.
@@ -70,18 +90,48 @@ func NewService(log *zap.Logger, overlay overlay.DB, db DB, config Config) *Serv
 func (service *Service) ApplyAudit(ctx context.Context, nodeID storj.NodeID, result AuditType) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	statusUpdate, changed, err := service.db.Update(ctx, UpdateRequest{
-		NodeID:       nodeID,
-		AuditOutcome: result,
+	return service.applyAudit(ctx, nodeID, result, false)
+}
+
+// ApplyPieceAudit receives the result of a full piece audit and applies it to
+// the relevant node in DB, using the weight configured for full piece audits.
+func (service *Service) ApplyPieceAudit(ctx context.Context, nodeID storj.NodeID, result AuditType) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return service.applyAudit(ctx, nodeID, result, true)
+}
+
+func (service *Service) applyAudit(ctx context.Context, nodeID storj.NodeID, result AuditType, pieceAudit bool) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	now := time.Now()
 
-		AuditLambda:              service.config.AuditLambda,
-		AuditWeight:              service.config.AuditWeight,
-		AuditDQ:                  service.config.AuditDQ,
-		SuspensionGracePeriod:    service.config.SuspensionGracePeriod,
-		SuspensionDQEnabled:      service.config.SuspensionDQEnabled,
-		AuditsRequiredForVetting: service.config.AuditCount,
-		AuditHistory:             service.config.AuditHistory,
-	}, time.Now())
+	// the outcome is recorded before the update, so that the baseline of a node
+	// without stored outcomes is its reputation before this audit.
+	var outcomeID uuid.UUID
+	if service.config.RecordAuditOutcomes {
+		outcomeID, err = uuid.New()
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		err = service.db.RecordAuditOutcome(ctx, AuditOutcome{
+			ID:         outcomeID,
+			NodeID:     nodeID,
+			AuditedAt:  now,
+			Outcome:    result,
+			PieceAudit: pieceAudit,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	statusUpdate, changed, err := service.db.Update(ctx, newUpdateRequest(service.config, nodeID, result, pieceAudit), now)
+	// the stored outcomes must match the applied ones, otherwise replays diverge
+	// from the reputation. The reputation of disqualified nodes isn't updated.
+	if !outcomeID.IsZero() && (err != nil || statusUpdate == nil) {
+		err = errs.Combine(err, service.db.DeleteAuditOutcome(ctx, outcomeID))
+	}
 	if err != nil {
 		return err
 	}
@@ -96,6 +146,27 @@ func (service *Service) ApplyAudit(ctx context.Context, nodeID storj.NodeID, res
 	return err
 }
 
+// newUpdateRequest creates the request for applying an audit outcome with the given configuration.
+func newUpdateRequest(config Config, nodeID storj.NodeID, result AuditType, pieceAudit bool) UpdateRequest {
+	weight := config.AuditWeight
+	if pieceAudit {
+		weight = config.PieceAuditWeight
+	}
+
+	return UpdateRequest{
+		NodeID:       nodeID,
+		AuditOutcome: result,
+
+		AuditLambda:              config.AuditLambda,
+		AuditWeight:              weight,
+		AuditDQ:                  config.AuditDQ,
+		SuspensionGracePeriod:    config.SuspensionGracePeriod,
+		SuspensionDQEnabled:      config.SuspensionDQEnabled,
+		AuditsRequiredForVetting: config.AuditCount,
+		AuditHistory:             config.AuditHistory,
+	}
+}
+
 // Get returns a node's reputation info from DB.
 // If a node is not found in the DB, default reputation information is returned.
 func (service *Service) Get(ctx context.Context, nodeID storj.NodeID) (info *Info, err error) {
@@ -5,12 +5,14 @@ package reputation_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
 
 	"storj.io/common/memory"
+	"storj.io/common/storj"
 	"storj.io/common/testcontext"
 	"storj.io/common/testrand"
 	"storj.io/storj/private/testplanet"
@@ -125,3 +127,147 @@ func TestGet(t *testing.T) {
 		require.EqualValues(t, 1, newNode.OnlineScore)
 	})
 }
+
+func TestReplayAuditOutcomes(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 2, UplinkCount: 0,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Reputation.RecordAuditOutcomes = true
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		service := satellite.Reputation.Service
+		config := satellite.Config.Reputation
+		nodeA, nodeB := planet.StorageNodes[0].ID(), planet.StorageNodes[1].ID()
+
+		// the reputation before the first stored outcome is where the replay starts from.
+		_, _, err := satellite.DB.Reputation().Update(ctx, reputation.UpdateRequest{
+			NodeID:       nodeA,
+			AuditOutcome: reputation.AuditSuccess,
+			AuditLambda:  config.AuditLambda,
+			AuditWeight:  config.AuditWeight,
+			AuditDQ:      config.AuditDQ,
+			AuditHistory: config.AuditHistory,
+		}, time.Now())
+		require.NoError(t, err)
+
+		outcomes := []reputation.AuditType{reputation.AuditSuccess, reputation.AuditFailure, reputation.AuditUnknown, reputation.AuditOffline}
+		for _, outcome := range outcomes {
+			require.NoError(t, service.ApplyAudit(ctx, nodeA, outcome))
+		}
+		require.NoError(t, service.ApplyPieceAudit(ctx, nodeB, reputation.AuditSuccess))
+
+		var results []reputation.ReplayResult
+		err = reputation.ReplayAuditOutcomes(ctx, satellite.DB.Reputation(), config, []storj.NodeID{nodeA}, func(result reputation.ReplayResult) error {
+			results = append(results, result)
+			return nil
+		})
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		require.Equal(t, nodeA, results[0].NodeID)
+		require.EqualValues(t, 1, results[0].Baseline.TotalAuditCount)
+		require.Len(t, results[0].History, len(outcomes))
+		for i, point := range results[0].History {
+			require.Equal(t, outcomes[i], point.Outcome)
+		}
+
+		// the replay with the same configuration matches the stored reputation.
+		info, err := service.Get(ctx, nodeA)
+		require.NoError(t, err)
+		last := results[0].Last()
+		require.Equal(t, info.TotalAuditCount, last.TotalAuditCount)
+		require.InDelta(t, info.AuditReputationAlpha, last.AuditReputationAlpha, 1e-9)
+		require.InDelta(t, info.AuditReputationBeta, last.AuditReputationBeta, 1e-9)
+		require.InDelta(t, info.UnknownAuditReputationAlpha, last.UnknownAuditReputationAlpha, 1e-9)
+		require.InDelta(t, info.UnknownAuditReputationBeta, last.UnknownAuditReputationBeta, 1e-9)
+
+		// all nodes are replayed without node ids.
+		var nodes []storj.NodeID
+		err = reputation.ReplayAuditOutcomes(ctx, satellite.DB.Reputation(), config, nil, func(result reputation.ReplayResult) error {
+			nodes = append(nodes, result.NodeID)
+			return nil
+		})
+		require.NoError(t, err)
+		require.ElementsMatch(t, []storj.NodeID{nodeA, nodeB}, nodes)
+
+		// outcomes which aren't applied to the reputation aren't stored.
+		require.NoError(t, satellite.DB.Reputation().DisqualifyNode(ctx, nodeB))
+		require.NoError(t, service.ApplyAudit(ctx, nodeB, reputation.AuditFailure))
+
+		var stored int
+		err = satellite.DB.Reputation().IterateAuditOutcomes(ctx, []storj.NodeID{nodeB}, time.Time{}, func(reputation.AuditOutcome) error {
+			stored++
+			return nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, 1, stored)
+	})
+}
+
+func TestAuditOutcomesChore(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 0,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Reputation.RecordAuditOutcomes = true
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		satellite.Reputation.OutcomesChore.Loop.Pause()
+
+		db := satellite.DB.Reputation()
+		service := satellite.Reputation.Service
+		config := satellite.Config.Reputation
+		nodeID := planet.StorageNodes[0].ID()
+
+		outcomes := []reputation.AuditType{reputation.AuditSuccess, reputation.AuditUnknown, reputation.AuditSuccess}
+		for _, outcome := range outcomes {
+			require.NoError(t, service.ApplyAudit(ctx, nodeID, outcome))
+		}
+
+		countOutcomes := func() (count int) {
+			require.NoError(t, db.IterateAuditOutcomes(ctx, []storj.NodeID{nodeID}, time.Time{}, func(reputation.AuditOutcome) error {
+				count++
+				return nil
+			}))
+			return count
+		}
+
+		// outcomes within the retention are kept.
+		require.NoError(t, satellite.Reputation.OutcomesChore.RunOnce(ctx, time.Now()))
+		require.Equal(t, len(outcomes), countOutcomes())
+
+		// outcomes older than the retention are folded into the baseline.
+		require.NoError(t, satellite.Reputation.OutcomesChore.RunOnce(ctx, time.Now().Add(config.AuditOutcomesRetention+time.Hour)))
+		require.Zero(t, countOutcomes())
+
+		info, err := service.Get(ctx, nodeID)
+		require.NoError(t, err)
+		baseline, err := db.AuditOutcomeBaseline(ctx, nodeID)
+		require.NoError(t, err)
+		require.Equal(t, info.TotalAuditCount, baseline.TotalAuditCount)
+		require.InDelta(t, info.AuditReputationAlpha, baseline.AuditReputationAlpha, 1e-9)
+		require.InDelta(t, info.UnknownAuditReputationBeta, baseline.UnknownAuditReputationBeta, 1e-9)
+
+		// later outcomes are replayed from the folded baseline.
+		require.NoError(t, service.ApplyAudit(ctx, nodeID, reputation.AuditFailure))
+		var results []reputation.ReplayResult
+		err = reputation.ReplayAuditOutcomes(ctx, db, config, []storj.NodeID{nodeID}, func(result reputation.ReplayResult) error {
+			results = append(results, result)
+			return nil
+		})
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		require.Len(t, results[0].History, 1)
+
+		info, err = service.Get(ctx, nodeID)
+		require.NoError(t, err)
+		last := results[0].Last()
+		require.Equal(t, info.TotalAuditCount, last.TotalAuditCount)
+		require.InDelta(t, info.AuditReputationAlpha, last.AuditReputationAlpha, 1e-9)
+		require.InDelta(t, info.AuditReputationBeta, last.AuditReputationBeta, 1e-9)
+	})
+}
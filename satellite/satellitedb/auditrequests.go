@@ -0,0 +1,221 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+	"storj.io/private/dbutil/pgutil"
+	"storj.io/private/tagsql"
+	"storj.io/storj/satellite/audit"
+	"storj.io/storj/satellite/metabase"
+)
+
+// ensures that auditRequests implements audit.Requests.
+var _ audit.Requests = (*auditRequests)(nil)
+
+// auditRequests implements storing on-demand audit requests and their results.
+type auditRequests struct {
+	db *satelliteDB
+}
+
+// Create stores a new pending request.
+func (requests *auditRequests) Create(ctx context.Context, request audit.Request) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var nodeID, projectID, bucketName, objectKey []byte
+	if request.IsNodeRequest() {
+		nodeID = request.NodeID.Bytes()
+	} else {
+		projectID = request.Object.ProjectID.Bytes()
+		bucketName = []byte(request.Object.BucketName)
+		objectKey = []byte(request.Object.ObjectKey)
+	}
+
+	_, err = requests.db.ExecContext(ctx, `
+		INSERT INTO audit_requests (
+			id, node_id, project_id, bucket_name, object_key, object_version, segment_limit
+		) VALUES ( $1, $2, $3, $4, $5, $6, $7 )
+	`, request.ID, nodeID, projectID, bucketName, objectKey, request.Version, request.Limit)
+	return Error.Wrap(err)
+}
+
+// Get returns the request with the given id.
+func (requests *auditRequests) Get(ctx context.Context, id uuid.UUID) (_ audit.Request, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	row := requests.db.QueryRowContext(ctx, `
+		SELECT `+auditRequestColumns+`
+		FROM audit_requests
+		WHERE id = $1
+	`, id)
+
+	request, err := scanAuditRequest(row.Scan)
+	if errors.Is(err, sql.ErrNoRows) {
+		return audit.Request{}, audit.ErrRequestNotFound.New("%s", id)
+	}
+	return request, Error.Wrap(err)
+}
+
+// List returns the most recently created requests.
+func (requests *auditRequests) List(ctx context.Context, limit int) (_ []audit.Request, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := requests.db.QueryContext(ctx, `
+		SELECT `+auditRequestColumns+`
+		FROM audit_requests
+		ORDER BY created_at DESC
+		LIMIT $1
+	`, limit)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	return scanAuditRequests(rows)
+}
+
+// ListPending returns all pending requests, oldest first.
+func (requests *auditRequests) ListPending(ctx context.Context) (_ []audit.Request, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := requests.db.QueryContext(ctx, `
+		SELECT `+auditRequestColumns+`
+		FROM audit_requests
+		WHERE status = $1
+		ORDER BY created_at
+	`, audit.RequestPending)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	return scanAuditRequests(rows)
+}
+
+// Finish marks the request as finished, or as failed when failure isn't empty.
+func (requests *auditRequests) Finish(ctx context.Context, id uuid.UUID, failure string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	status, errorMessage := audit.RequestFinished, sql.NullString{}
+	if failure != "" {
+		status, errorMessage = audit.RequestFailed, sql.NullString{String: failure, Valid: true}
+	}
+
+	_, err = requests.db.ExecContext(ctx, `
+		UPDATE audit_requests
+		SET status = $2, error = $3, finished_at = current_timestamp
+		WHERE id = $1
+	`, id, status, errorMessage)
+	return Error.Wrap(err)
+}
+
+// AddResults stores results of the request, replacing earlier results of the same pieces.
+func (requests *auditRequests) AddResults(ctx context.Context, id uuid.UUID, results []audit.RequestResult) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(results) == 0 {
+		return nil
+	}
+
+	streamIDs := make([][]byte, 0, len(results))
+	positions := make([]int64, 0, len(results))
+	nodeIDs := make([][]byte, 0, len(results))
+	outcomes := make([]int32, 0, len(results))
+	for _, result := range results {
+		streamIDs = append(streamIDs, result.StreamID.Bytes())
+		positions = append(positions, int64(result.Position.Encode()))
+		nodeIDs = append(nodeIDs, result.NodeID.Bytes())
+		outcomes = append(outcomes, int32(result.Outcome))
+	}
+
+	_, err = requests.db.ExecContext(ctx, `
+		INSERT INTO audit_request_results (
+			request_id, stream_id, position, node_id, outcome
+		)
+		SELECT $1, unnest($2::BYTEA[]), unnest($3::INT8[]), unnest($4::BYTEA[]), unnest($5::INT4[])
+		ON CONFLICT ( request_id, stream_id, position, node_id )
+		DO UPDATE SET outcome = EXCLUDED.outcome
+	`, id, pgutil.ByteaArray(streamIDs), pgutil.Int8Array(positions), pgutil.ByteaArray(nodeIDs), pgutil.Int4Array(outcomes))
+	return Error.Wrap(err)
+}
+
+// Results returns all results of the request.
+func (requests *auditRequests) Results(ctx context.Context, id uuid.UUID) (_ []audit.RequestResult, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := requests.db.QueryContext(ctx, `
+		SELECT stream_id, position, node_id, outcome
+		FROM audit_request_results
+		WHERE request_id = $1
+		ORDER BY stream_id, position, node_id
+	`, id)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var results []audit.RequestResult
+	for rows.Next() {
+		var result audit.RequestResult
+		var position uint64
+		if err := rows.Scan(&result.StreamID, &position, &result.NodeID, &result.Outcome); err != nil {
+			return nil, Error.Wrap(err)
+		}
+		result.Position = metabase.SegmentPositionFromEncoded(position)
+		results = append(results, result)
+	}
+	return results, Error.Wrap(rows.Err())
+}
+
+const auditRequestColumns = `
+	id, node_id, project_id, bucket_name, object_key, object_version,
+	segment_limit, status, error, created_at, finished_at
+`
+
+func scanAuditRequest(scan func(dest ...interface{}) error) (request audit.Request, err error) {
+	var nodeID, projectID, bucketName, objectKey []byte
+	var errorMessage sql.NullString
+	err = scan(&request.ID, &nodeID, &projectID, &bucketName, &objectKey, &request.Version,
+		&request.Limit, &request.Status, &errorMessage, &request.CreatedAt, &request.FinishedAt)
+	if err != nil {
+		return audit.Request{}, err
+	}
+
+	if nodeID != nil {
+		request.NodeID, err = storj.NodeIDFromBytes(nodeID)
+		if err != nil {
+			return audit.Request{}, err
+		}
+	}
+	if projectID != nil {
+		request.Object.ProjectID, err = uuid.FromBytes(projectID)
+		if err != nil {
+			return audit.Request{}, err
+		}
+	}
+	request.Object.BucketName = string(bucketName)
+	request.Object.ObjectKey = metabase.ObjectKey(objectKey)
+	request.Error = errorMessage.String
+
+	return request, nil
+}
+
+func scanAuditRequests(rows tagsql.Rows) (_ []audit.Request, err error) {
+	var list []audit.Request
+	for rows.Next() {
+		request, err := scanAuditRequest(rows.Scan)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		list = append(list, request)
+	}
+	return list, Error.Wrap(rows.Err())
+}
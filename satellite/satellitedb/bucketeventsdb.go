@@ -0,0 +1,118 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/uuid"
+	"storj.io/private/dbutil/pgutil"
+	"storj.io/storj/satellite/metainfo/bucketevents"
+)
+
+// ensures that bucketEventsDB implements bucketevents.DB.
+var _ bucketevents.DB = (*bucketEventsDB)(nil)
+
+// bucketEventsDB implements the outbox of bucket events.
+type bucketEventsDB struct {
+	db *satelliteDB
+}
+
+// Insert adds entries to the outbox.
+func (db *bucketEventsDB) Insert(ctx context.Context, entries []bucketevents.Entry) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(entries) == 0 {
+		return nil
+	}
+
+	var ids, projectIDs, bucketNames, payloads [][]byte
+	var sinks []string
+	var nextAttempts []time.Time
+	for _, entry := range entries {
+		sink, err := json.Marshal(entry.Sink)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		ids = append(ids, entry.ID.Bytes())
+		projectIDs = append(projectIDs, entry.Bucket.ProjectID.Bytes())
+		bucketNames = append(bucketNames, []byte(entry.Bucket.BucketName))
+		sinks = append(sinks, string(sink))
+		payloads = append(payloads, entry.Payload)
+		nextAttempts = append(nextAttempts, entry.NextAttemptAt)
+	}
+
+	_, err = db.db.ExecContext(ctx, `
+		INSERT INTO bucket_event_outbox (
+			id, project_id, bucket_name, sink, payload, next_attempt_at
+		) SELECT
+			unnest($1::bytea[]), unnest($2::bytea[]), unnest($3::bytea[]),
+			unnest($4::text[]), unnest($5::bytea[]), unnest($6::timestamptz[])
+	`, pgutil.ByteaArray(ids), pgutil.ByteaArray(projectIDs), pgutil.ByteaArray(bucketNames),
+		pgutil.TextArray(sinks), pgutil.ByteaArray(payloads), pgutil.TimestampTZArray(nextAttempts))
+	return Error.Wrap(err)
+}
+
+// ListDue returns at most limit entries, which are due for delivery at the specified time.
+func (db *bucketEventsDB) ListDue(ctx context.Context, now time.Time, limit int) (entries []bucketevents.Entry, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := db.db.QueryContext(ctx, `
+		SELECT id, project_id, bucket_name, sink, payload, attempts, next_attempt_at, created_at
+		FROM bucket_event_outbox
+		WHERE next_attempt_at <= $1
+		ORDER BY next_attempt_at
+		LIMIT $2
+	`, now, limit)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	for rows.Next() {
+		var entry bucketevents.Entry
+		var bucketName []byte
+		var sink string
+		err := rows.Scan(&entry.ID, &entry.Bucket.ProjectID, &bucketName, &sink, &entry.Payload,
+			&entry.Attempts, &entry.NextAttemptAt, &entry.CreatedAt)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		entry.Bucket.BucketName = string(bucketName)
+		if err := json.Unmarshal([]byte(sink), &entry.Sink); err != nil {
+			return nil, Error.Wrap(err)
+		}
+		entries = append(entries, entry)
+	}
+
+	return entries, Error.Wrap(rows.Err())
+}
+
+// Delete removes an entry from the outbox.
+func (db *bucketEventsDB) Delete(ctx context.Context, id uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = db.db.ExecContext(ctx, `
+		DELETE FROM bucket_event_outbox WHERE id = $1
+	`, id)
+	return Error.Wrap(err)
+}
+
+// Reschedule records a failed delivery attempt of an entry and when to attempt it again.
+func (db *bucketEventsDB) Reschedule(ctx context.Context, id uuid.UUID, nextAttemptAt time.Time, lastError string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = db.db.ExecContext(ctx, `
+		UPDATE bucket_event_outbox SET
+			attempts        = attempts + 1,
+			next_attempt_at = $2,
+			last_error      = $3
+		WHERE id = $1
+	`, id, nextAttemptAt, lastError)
+	return Error.Wrap(err)
+}
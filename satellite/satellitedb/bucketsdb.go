@@ -6,8 +6,11 @@ package satellitedb
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
 
+	"github.com/zeebo/errs"
+
 	"storj.io/common/macaroon"
 	"storj.io/common/storj"
 	"storj.io/common/uuid"
@@ -97,6 +100,252 @@ func (db *bucketsDB) GetBucketPlacement(ctx context.Context, bucketName []byte,
 	return placement, nil
 }
 
+// GetBucketRedundancy returns the default redundancy scheme of a bucket.
+func (db *bucketsDB) GetBucketRedundancy(ctx context.Context, bucketName []byte, projectID uuid.UUID) (scheme storj.RedundancyScheme, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var algorithm, shareSize, required, repair, optimal, total int
+	err = db.db.QueryRowContext(ctx, db.db.Rebind(`
+		SELECT
+			default_redundancy_algorithm, default_redundancy_share_size,
+			default_redundancy_required_shares, default_redundancy_repair_shares,
+			default_redundancy_optimal_shares, default_redundancy_total_shares
+		FROM bucket_metainfos
+		WHERE project_id = ? AND name = ?
+	`), projectID, bucketName).Scan(&algorithm, &shareSize, &required, &repair, &optimal, &total)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return storj.RedundancyScheme{}, storj.ErrBucketNotFound.New("%s", bucketName)
+		}
+		return storj.RedundancyScheme{}, storj.ErrBucket.Wrap(err)
+	}
+
+	return storj.RedundancyScheme{
+		Algorithm:      storj.RedundancyAlgorithm(algorithm),
+		ShareSize:      int32(shareSize),
+		RequiredShares: int16(required),
+		RepairShares:   int16(repair),
+		OptimalShares:  int16(optimal),
+		TotalShares:    int16(total),
+	}, nil
+}
+
+// GetBucketVersioning returns the versioning state of a bucket.
+func (db *bucketsDB) GetBucketVersioning(ctx context.Context, bucketName []byte, projectID uuid.UUID) (versioning buckets.Versioning, err error) {
+	defer mon.Task()(&ctx)(&err)
+	dbxVersioning, err := db.db.Get_BucketMetainfo_Versioning_By_ProjectId_And_Name(ctx,
+		dbx.BucketMetainfo_ProjectId(projectID[:]),
+		dbx.BucketMetainfo_Name(bucketName),
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return buckets.Unversioned, storj.ErrBucketNotFound.New("%s", bucketName)
+		}
+		return buckets.Unversioned, storj.ErrBucket.Wrap(err)
+	}
+	versioning = buckets.Unversioned
+	if dbxVersioning.Versioning != nil {
+		versioning = buckets.Versioning(*dbxVersioning.Versioning)
+	}
+
+	return versioning, nil
+}
+
+// UpdateBucketVersioning updates the versioning state of a bucket.
+func (db *bucketsDB) UpdateBucketVersioning(ctx context.Context, bucketName []byte, projectID uuid.UUID, versioning buckets.Versioning) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	dbxBucket, err := db.db.Update_BucketMetainfo_By_ProjectId_And_Name(ctx,
+		dbx.BucketMetainfo_ProjectId(projectID[:]),
+		dbx.BucketMetainfo_Name(bucketName),
+		dbx.BucketMetainfo_Update_Fields{
+			Versioning: dbx.BucketMetainfo_Versioning(int(versioning)),
+		},
+	)
+	if err != nil {
+		return storj.ErrBucket.Wrap(err)
+	}
+	if dbxBucket == nil {
+		return storj.ErrBucketNotFound.New("%s", bucketName)
+	}
+	return nil
+}
+
+// GetBucketObjectLockEnabled returns whether object lock is enabled for a bucket.
+func (db *bucketsDB) GetBucketObjectLockEnabled(ctx context.Context, bucketName []byte, projectID uuid.UUID) (enabled bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+	dbxObjectLock, err := db.db.Get_BucketMetainfo_ObjectLockEnabled_By_ProjectId_And_Name(ctx,
+		dbx.BucketMetainfo_ProjectId(projectID[:]),
+		dbx.BucketMetainfo_Name(bucketName),
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, storj.ErrBucketNotFound.New("%s", bucketName)
+		}
+		return false, storj.ErrBucket.Wrap(err)
+	}
+	if dbxObjectLock.ObjectLockEnabled != nil {
+		enabled = *dbxObjectLock.ObjectLockEnabled
+	}
+
+	return enabled, nil
+}
+
+// UpdateBucketObjectLockEnabled updates whether object lock is enabled for a bucket.
+func (db *bucketsDB) UpdateBucketObjectLockEnabled(ctx context.Context, bucketName []byte, projectID uuid.UUID, enabled bool) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	dbxBucket, err := db.db.Update_BucketMetainfo_By_ProjectId_And_Name(ctx,
+		dbx.BucketMetainfo_ProjectId(projectID[:]),
+		dbx.BucketMetainfo_Name(bucketName),
+		dbx.BucketMetainfo_Update_Fields{
+			ObjectLockEnabled: dbx.BucketMetainfo_ObjectLockEnabled(enabled),
+		},
+	)
+	if err != nil {
+		return storj.ErrBucket.Wrap(err)
+	}
+	if dbxBucket == nil {
+		return storj.ErrBucketNotFound.New("%s", bucketName)
+	}
+	return nil
+}
+
+// GetBucketLifecycle returns the lifecycle configuration of a bucket.
+func (db *bucketsDB) GetBucketLifecycle(ctx context.Context, bucketName []byte, projectID uuid.UUID) (lifecycle buckets.Lifecycle, err error) {
+	defer mon.Task()(&ctx)(&err)
+	dbxLifecycle, err := db.db.Get_BucketMetainfo_LifecycleRules_By_ProjectId_And_Name(ctx,
+		dbx.BucketMetainfo_ProjectId(projectID[:]),
+		dbx.BucketMetainfo_Name(bucketName),
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return buckets.Lifecycle{}, storj.ErrBucketNotFound.New("%s", bucketName)
+		}
+		return buckets.Lifecycle{}, storj.ErrBucket.Wrap(err)
+	}
+	if dbxLifecycle.LifecycleRules != nil {
+		if err := json.Unmarshal([]byte(*dbxLifecycle.LifecycleRules), &lifecycle); err != nil {
+			return buckets.Lifecycle{}, storj.ErrBucket.Wrap(err)
+		}
+	}
+
+	return lifecycle, nil
+}
+
+// UpdateBucketLifecycle updates the lifecycle configuration of a bucket.
+func (db *bucketsDB) UpdateBucketLifecycle(ctx context.Context, bucketName []byte, projectID uuid.UUID, lifecycle buckets.Lifecycle) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rules := dbx.BucketMetainfo_LifecycleRules_Null()
+	if len(lifecycle.Rules) > 0 {
+		data, err := json.Marshal(lifecycle)
+		if err != nil {
+			return storj.ErrBucket.Wrap(err)
+		}
+		rules = dbx.BucketMetainfo_LifecycleRules(string(data))
+	}
+
+	dbxBucket, err := db.db.Update_BucketMetainfo_By_ProjectId_And_Name(ctx,
+		dbx.BucketMetainfo_ProjectId(projectID[:]),
+		dbx.BucketMetainfo_Name(bucketName),
+		dbx.BucketMetainfo_Update_Fields{
+			LifecycleRules: rules,
+		},
+	)
+	if err != nil {
+		return storj.ErrBucket.Wrap(err)
+	}
+	if dbxBucket == nil {
+		return storj.ErrBucketNotFound.New("%s", bucketName)
+	}
+	return nil
+}
+
+// GetBucketNotifications returns the event notification configuration of a bucket.
+func (db *bucketsDB) GetBucketNotifications(ctx context.Context, bucketName []byte, projectID uuid.UUID) (notifications buckets.Notifications, err error) {
+	defer mon.Task()(&ctx)(&err)
+	dbxNotifications, err := db.db.Get_BucketMetainfo_Notifications_By_ProjectId_And_Name(ctx,
+		dbx.BucketMetainfo_ProjectId(projectID[:]),
+		dbx.BucketMetainfo_Name(bucketName),
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return buckets.Notifications{}, storj.ErrBucketNotFound.New("%s", bucketName)
+		}
+		return buckets.Notifications{}, storj.ErrBucket.Wrap(err)
+	}
+	if dbxNotifications.Notifications != nil {
+		if err := json.Unmarshal([]byte(*dbxNotifications.Notifications), &notifications); err != nil {
+			return buckets.Notifications{}, storj.ErrBucket.Wrap(err)
+		}
+	}
+
+	return notifications, nil
+}
+
+// UpdateBucketNotifications updates the event notification configuration of a bucket.
+func (db *bucketsDB) UpdateBucketNotifications(ctx context.Context, bucketName []byte, projectID uuid.UUID, notifications buckets.Notifications) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	sinks := dbx.BucketMetainfo_Notifications_Null()
+	if len(notifications.Sinks) > 0 {
+		data, err := json.Marshal(notifications)
+		if err != nil {
+			return storj.ErrBucket.Wrap(err)
+		}
+		sinks = dbx.BucketMetainfo_Notifications(string(data))
+	}
+
+	dbxBucket, err := db.db.Update_BucketMetainfo_By_ProjectId_And_Name(ctx,
+		dbx.BucketMetainfo_ProjectId(projectID[:]),
+		dbx.BucketMetainfo_Name(bucketName),
+		dbx.BucketMetainfo_Update_Fields{
+			Notifications: sinks,
+		},
+	)
+	if err != nil {
+		return storj.ErrBucket.Wrap(err)
+	}
+	if dbxBucket == nil {
+		return storj.ErrBucketNotFound.New("%s", bucketName)
+	}
+	return nil
+}
+
+// ListBucketLifecycles returns the lifecycle configurations of buckets after the cursor, which have any rules.
+func (db *bucketsDB) ListBucketLifecycles(ctx context.Context, cursor metabase.BucketLocation, limit int) (lifecycles []buckets.BucketLifecycle, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := db.db.QueryContext(ctx, db.db.Rebind(`
+		SELECT project_id, name, lifecycle_rules
+		FROM bucket_metainfos
+		WHERE
+			lifecycle_rules IS NOT NULL AND
+			(project_id, name) > (?, ?)
+		ORDER BY project_id, name
+		LIMIT ?
+	`), cursor.ProjectID, []byte(cursor.BucketName), limit)
+	if err != nil {
+		return nil, storj.ErrBucket.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	for rows.Next() {
+		var bucketName []byte
+		var rules string
+		var lifecycle buckets.BucketLifecycle
+		if err := rows.Scan(&lifecycle.Bucket.ProjectID, &bucketName, &rules); err != nil {
+			return nil, storj.ErrBucket.Wrap(err)
+		}
+		lifecycle.Bucket.BucketName = string(bucketName)
+		if err := json.Unmarshal([]byte(rules), &lifecycle.Lifecycle); err != nil {
+			return nil, storj.ErrBucket.Wrap(err)
+		}
+		lifecycles = append(lifecycles, lifecycle)
+	}
+
+	return lifecycles, storj.ErrBucket.Wrap(rows.Err())
+}
+
 // GetMinimalBucket returns existing bucket with minimal number of fields.
 func (db *bucketsDB) GetMinimalBucket(ctx context.Context, bucketName []byte, projectID uuid.UUID) (_ buckets.Bucket, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -23,6 +23,7 @@ import (
 	"storj.io/storj/satellite/compensation"
 	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/gracefulexit"
+	"storj.io/storj/satellite/metainfo/bucketevents"
 	"storj.io/storj/satellite/nodeapiversion"
 	"storj.io/storj/satellite/orders"
 	"storj.io/storj/satellite/overlay"
@@ -191,6 +192,11 @@ func (dbc *satelliteDBCollection) Reputation() reputation.DB {
 	return &reputations{db: dbc.getByName("reputations")}
 }
 
+// BucketEvents returns the outbox of bucket events.
+func (dbc *satelliteDBCollection) BucketEvents() bucketevents.DB {
+	return &bucketEventsDB{db: dbc.getByName("bucketevents")}
+}
+
 // RepairQueue is a getter for RepairQueue repository.
 func (dbc *satelliteDBCollection) RepairQueue() queue.RepairQueue {
 	return &repairQueue{db: dbc.getByName("repairqueue")}
@@ -247,6 +253,16 @@ func (dbc *satelliteDBCollection) Containment() audit.Containment {
 	return &containment{db: dbc.getByName("containment")}
 }
 
+// PieceAuditRequests returns database for requests of full piece audits.
+func (dbc *satelliteDBCollection) PieceAuditRequests() audit.PieceAuditRequests {
+	return &pieceAuditRequests{db: dbc.getByName("pieceauditrequests")}
+}
+
+// AuditRequests returns database for on-demand audit requests.
+func (dbc *satelliteDBCollection) AuditRequests() audit.Requests {
+	return &auditRequests{db: dbc.getByName("auditrequests")}
+}
+
 // GracefulExit returns database for graceful exit.
 func (dbc *satelliteDBCollection) GracefulExit() gracefulexit.DB {
 	return &gracefulexitDB{db: dbc.getByName("gracefulexit")}
@@ -336,6 +336,52 @@ CREATE TABLE accounting_timestamps (
 	value timestamp with time zone NOT NULL,
 	PRIMARY KEY ( name )
 );
+CREATE TABLE audit_outcomes (
+	id bytea NOT NULL,
+	node_id bytea NOT NULL,
+	audited_at timestamp with time zone NOT NULL,
+	outcome integer NOT NULL,
+	piece_audit boolean NOT NULL DEFAULT false,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE audit_outcome_baselines (
+	node_id bytea NOT NULL,
+	audit_reputation_alpha double precision NOT NULL,
+	audit_reputation_beta double precision NOT NULL,
+	unknown_audit_reputation_alpha double precision NOT NULL,
+	unknown_audit_reputation_beta double precision NOT NULL,
+	online_score double precision NOT NULL,
+	total_audit_count bigint NOT NULL,
+	audit_history bytea NOT NULL,
+	vetted_at timestamp with time zone,
+	unknown_audit_suspended timestamp with time zone,
+	offline_suspended timestamp with time zone,
+	under_review timestamp with time zone,
+	disqualified timestamp with time zone,
+	PRIMARY KEY ( node_id )
+);
+CREATE TABLE audit_requests (
+	id bytea NOT NULL,
+	node_id bytea,
+	project_id bytea,
+	bucket_name bytea,
+	object_key bytea,
+	object_version bigint NOT NULL DEFAULT 0,
+	segment_limit integer NOT NULL DEFAULT 0,
+	status integer NOT NULL DEFAULT 0,
+	error text,
+	created_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+	finished_at timestamp with time zone,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE audit_request_results (
+	request_id bytea NOT NULL,
+	stream_id bytea NOT NULL,
+	position bigint NOT NULL,
+	node_id bytea NOT NULL,
+	outcome integer NOT NULL,
+	PRIMARY KEY ( request_id, stream_id, position, node_id )
+);
 CREATE TABLE bucket_bandwidth_rollups (
 	bucket_name bytea NOT NULL,
 	project_id bytea NOT NULL,
@@ -358,6 +404,18 @@ CREATE TABLE bucket_bandwidth_rollup_archives (
 	settled bigint NOT NULL,
 	PRIMARY KEY ( bucket_name, project_id, interval_start, action )
 );
+CREATE TABLE bucket_event_outbox (
+	id bytea NOT NULL,
+	project_id bytea NOT NULL,
+	bucket_name bytea NOT NULL,
+	sink text NOT NULL,
+	payload bytea NOT NULL,
+	attempts integer NOT NULL DEFAULT 0,
+	last_error text,
+	next_attempt_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+	created_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+	PRIMARY KEY ( id )
+);
 CREATE TABLE bucket_storage_tallies (
 	bucket_name bytea NOT NULL,
 	project_id bytea NOT NULL,
@@ -445,6 +503,7 @@ CREATE TABLE nodes (
 	last_net text NOT NULL,
 	last_ip_port text,
 	country_code text,
+	tags text,
 	protocol integer NOT NULL DEFAULT 0,
 	type integer NOT NULL DEFAULT 0,
 	email text NOT NULL,
@@ -452,6 +511,8 @@ CREATE TABLE nodes (
 	wallet_features text NOT NULL DEFAULT '',
 	free_disk bigint NOT NULL DEFAULT -1,
 	piece_count bigint NOT NULL DEFAULT 0,
+	ingress_load double precision,
+	egress_load double precision,
 	major bigint NOT NULL DEFAULT 0,
 	minor bigint NOT NULL DEFAULT 0,
 	patch bigint NOT NULL DEFAULT 0,
@@ -483,6 +544,19 @@ CREATE TABLE node_api_versions (
 	updated_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( id )
 );
+CREATE TABLE node_reinstatements (
+	id bytea NOT NULL,
+	node_id bytea NOT NULL,
+	reinstated_by text NOT NULL,
+	reason text NOT NULL,
+	probation boolean NOT NULL,
+	previous_disqualified timestamp with time zone,
+	previous_disqualification_reason integer,
+	previous_unknown_audit_suspended timestamp with time zone,
+	previous_offline_suspended timestamp with time zone,
+	created_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+	PRIMARY KEY ( id )
+);
 CREATE TABLE offers (
 	id serial NOT NULL,
 	name text NOT NULL,
@@ -505,6 +579,11 @@ CREATE TABLE peer_identities (
 	updated_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( node_id )
 );
+CREATE TABLE piece_audit_requests (
+	node_id bytea NOT NULL,
+	requested_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+	PRIMARY KEY ( node_id )
+);
 CREATE TABLE projects (
 	id bytea NOT NULL,
 	name text NOT NULL,
@@ -549,6 +628,7 @@ CREATE TABLE repair_queue (
 	updated_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
 	inserted_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
 	segment_health double precision NOT NULL DEFAULT 1,
+	reason integer NOT NULL DEFAULT 0,
 	PRIMARY KEY ( stream_id, position )
 );
 CREATE TABLE reputations (
@@ -756,6 +836,10 @@ CREATE TABLE bucket_metainfos (
 	default_redundancy_optimal_shares integer NOT NULL,
 	default_redundancy_total_shares integer NOT NULL,
 	placement integer,
+	versioning integer,
+	object_lock_enabled boolean,
+	lifecycle_rules text,
+	notifications text,
 	PRIMARY KEY ( id ),
 	UNIQUE ( project_id, name )
 );
@@ -785,10 +869,12 @@ CREATE TABLE user_credits (
 	UNIQUE ( id, offer_id )
 );
 CREATE INDEX accounting_rollups_start_time_index ON accounting_rollups ( start_time ) ;
+CREATE INDEX audit_outcomes_node_id_audited_at_index ON audit_outcomes ( node_id, audited_at ) ;
 CREATE INDEX bucket_bandwidth_rollups_project_id_action_interval_index ON bucket_bandwidth_rollups ( project_id, action, interval_start ) ;
 CREATE INDEX bucket_bandwidth_rollups_action_interval_project_id_index ON bucket_bandwidth_rollups ( action, interval_start, project_id ) ;
 CREATE INDEX bucket_bandwidth_rollups_archive_project_id_action_interval_index ON bucket_bandwidth_rollup_archives ( project_id, action, interval_start ) ;
 CREATE INDEX bucket_bandwidth_rollups_archive_action_interval_project_id_index ON bucket_bandwidth_rollup_archives ( action, interval_start, project_id ) ;
+CREATE INDEX bucket_event_outbox_next_attempt_at_index ON bucket_event_outbox ( next_attempt_at ) ;
 CREATE INDEX bucket_storage_tallies_project_id_interval_start_index ON bucket_storage_tallies ( project_id, interval_start ) ;
 CREATE INDEX graceful_exit_segment_transfer_nid_dr_qa_fa_lfa_index ON graceful_exit_segment_transfer_queue ( node_id, durability_ratio, queued_at, finished_at, last_failed_at ) ;
 CREATE INDEX node_last_ip ON nodes ( last_net ) ;
@@ -918,6 +1004,52 @@ CREATE TABLE accounting_timestamps (
 	value timestamp with time zone NOT NULL,
 	PRIMARY KEY ( name )
 );
+CREATE TABLE audit_outcomes (
+	id bytea NOT NULL,
+	node_id bytea NOT NULL,
+	audited_at timestamp with time zone NOT NULL,
+	outcome integer NOT NULL,
+	piece_audit boolean NOT NULL DEFAULT false,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE audit_outcome_baselines (
+	node_id bytea NOT NULL,
+	audit_reputation_alpha double precision NOT NULL,
+	audit_reputation_beta double precision NOT NULL,
+	unknown_audit_reputation_alpha double precision NOT NULL,
+	unknown_audit_reputation_beta double precision NOT NULL,
+	online_score double precision NOT NULL,
+	total_audit_count bigint NOT NULL,
+	audit_history bytea NOT NULL,
+	vetted_at timestamp with time zone,
+	unknown_audit_suspended timestamp with time zone,
+	offline_suspended timestamp with time zone,
+	under_review timestamp with time zone,
+	disqualified timestamp with time zone,
+	PRIMARY KEY ( node_id )
+);
+CREATE TABLE audit_requests (
+	id bytea NOT NULL,
+	node_id bytea,
+	project_id bytea,
+	bucket_name bytea,
+	object_key bytea,
+	object_version bigint NOT NULL DEFAULT 0,
+	segment_limit integer NOT NULL DEFAULT 0,
+	status integer NOT NULL DEFAULT 0,
+	error text,
+	created_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+	finished_at timestamp with time zone,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE audit_request_results (
+	request_id bytea NOT NULL,
+	stream_id bytea NOT NULL,
+	position bigint NOT NULL,
+	node_id bytea NOT NULL,
+	outcome integer NOT NULL,
+	PRIMARY KEY ( request_id, stream_id, position, node_id )
+);
 CREATE TABLE bucket_bandwidth_rollups (
 	bucket_name bytea NOT NULL,
 	project_id bytea NOT NULL,
@@ -940,6 +1072,18 @@ CREATE TABLE bucket_bandwidth_rollup_archives (
 	settled bigint NOT NULL,
 	PRIMARY KEY ( bucket_name, project_id, interval_start, action )
 );
+CREATE TABLE bucket_event_outbox (
+	id bytea NOT NULL,
+	project_id bytea NOT NULL,
+	bucket_name bytea NOT NULL,
+	sink text NOT NULL,
+	payload bytea NOT NULL,
+	attempts integer NOT NULL DEFAULT 0,
+	last_error text,
+	next_attempt_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+	created_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+	PRIMARY KEY ( id )
+);
 CREATE TABLE bucket_storage_tallies (
 	bucket_name bytea NOT NULL,
 	project_id bytea NOT NULL,
@@ -1027,6 +1171,7 @@ CREATE TABLE nodes (
 	last_net text NOT NULL,
 	last_ip_port text,
 	country_code text,
+	tags text,
 	protocol integer NOT NULL DEFAULT 0,
 	type integer NOT NULL DEFAULT 0,
 	email text NOT NULL,
@@ -1034,6 +1179,8 @@ CREATE TABLE nodes (
 	wallet_features text NOT NULL DEFAULT '',
 	free_disk bigint NOT NULL DEFAULT -1,
 	piece_count bigint NOT NULL DEFAULT 0,
+	ingress_load double precision,
+	egress_load double precision,
 	major bigint NOT NULL DEFAULT 0,
 	minor bigint NOT NULL DEFAULT 0,
 	patch bigint NOT NULL DEFAULT 0,
@@ -1065,6 +1212,19 @@ CREATE TABLE node_api_versions (
 	updated_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( id )
 );
+CREATE TABLE node_reinstatements (
+	id bytea NOT NULL,
+	node_id bytea NOT NULL,
+	reinstated_by text NOT NULL,
+	reason text NOT NULL,
+	probation boolean NOT NULL,
+	previous_disqualified timestamp with time zone,
+	previous_disqualification_reason integer,
+	previous_unknown_audit_suspended timestamp with time zone,
+	previous_offline_suspended timestamp with time zone,
+	created_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+	PRIMARY KEY ( id )
+);
 CREATE TABLE offers (
 	id serial NOT NULL,
 	name text NOT NULL,
@@ -1087,6 +1247,11 @@ CREATE TABLE peer_identities (
 	updated_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( node_id )
 );
+CREATE TABLE piece_audit_requests (
+	node_id bytea NOT NULL,
+	requested_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+	PRIMARY KEY ( node_id )
+);
 CREATE TABLE projects (
 	id bytea NOT NULL,
 	name text NOT NULL,
@@ -1131,6 +1296,7 @@ CREATE TABLE repair_queue (
 	updated_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
 	inserted_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
 	segment_health double precision NOT NULL DEFAULT 1,
+	reason integer NOT NULL DEFAULT 0,
 	PRIMARY KEY ( stream_id, position )
 );
 CREATE TABLE reputations (
@@ -1338,6 +1504,10 @@ CREATE TABLE bucket_metainfos (
 	default_redundancy_optimal_shares integer NOT NULL,
 	default_redundancy_total_shares integer NOT NULL,
 	placement integer,
+	versioning integer,
+	object_lock_enabled boolean,
+	lifecycle_rules text,
+	notifications text,
 	PRIMARY KEY ( id ),
 	UNIQUE ( project_id, name )
 );
@@ -1367,10 +1537,12 @@ CREATE TABLE user_credits (
 	UNIQUE ( id, offer_id )
 );
 CREATE INDEX accounting_rollups_start_time_index ON accounting_rollups ( start_time ) ;
+CREATE INDEX audit_outcomes_node_id_audited_at_index ON audit_outcomes ( node_id, audited_at ) ;
 CREATE INDEX bucket_bandwidth_rollups_project_id_action_interval_index ON bucket_bandwidth_rollups ( project_id, action, interval_start ) ;
 CREATE INDEX bucket_bandwidth_rollups_action_interval_project_id_index ON bucket_bandwidth_rollups ( action, interval_start, project_id ) ;
 CREATE INDEX bucket_bandwidth_rollups_archive_project_id_action_interval_index ON bucket_bandwidth_rollup_archives ( project_id, action, interval_start ) ;
 CREATE INDEX bucket_bandwidth_rollups_archive_action_interval_project_id_index ON bucket_bandwidth_rollup_archives ( action, interval_start, project_id ) ;
+CREATE INDEX bucket_event_outbox_next_attempt_at_index ON bucket_event_outbox ( next_attempt_at ) ;
 CREATE INDEX bucket_storage_tallies_project_id_interval_start_index ON bucket_storage_tallies ( project_id, interval_start ) ;
 CREATE INDEX graceful_exit_segment_transfer_nid_dr_qa_fa_lfa_index ON graceful_exit_segment_transfer_queue ( node_id, durability_ratio, queued_at, finished_at, last_failed_at ) ;
 CREATE INDEX node_last_ip ON nodes ( last_net ) ;
@@ -1665,350 +1837,1466 @@ func (f AccountingTimestamps_Value_Field) value() interface{} {
 
 func (AccountingTimestamps_Value_Field) _Column() string { return "value" }
 
-type BucketBandwidthRollup struct {
-	BucketName      []byte
-	ProjectId       []byte
-	IntervalStart   time.Time
-	IntervalSeconds uint
-	Action          uint
-	Inline          uint64
-	Allocated       uint64
-	Settled         uint64
+type AuditOutcome struct {
+	Id         []byte
+	NodeId     []byte
+	AuditedAt  time.Time
+	Outcome    int
+	PieceAudit bool
 }
 
-func (BucketBandwidthRollup) _Table() string { return "bucket_bandwidth_rollups" }
+func (AuditOutcome) _Table() string { return "audit_outcomes" }
 
-type BucketBandwidthRollup_Update_Fields struct {
-	Inline    BucketBandwidthRollup_Inline_Field
-	Allocated BucketBandwidthRollup_Allocated_Field
-	Settled   BucketBandwidthRollup_Settled_Field
+type AuditOutcome_Create_Fields struct {
+	PieceAudit AuditOutcome_PieceAudit_Field
 }
 
-type BucketBandwidthRollup_BucketName_Field struct {
+type AuditOutcome_Update_Fields struct {
+}
+
+type AuditOutcome_Id_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func BucketBandwidthRollup_BucketName(v []byte) BucketBandwidthRollup_BucketName_Field {
-	return BucketBandwidthRollup_BucketName_Field{_set: true, _value: v}
+func AuditOutcome_Id(v []byte) AuditOutcome_Id_Field {
+	return AuditOutcome_Id_Field{_set: true, _value: v}
 }
 
-func (f BucketBandwidthRollup_BucketName_Field) value() interface{} {
+func (f AuditOutcome_Id_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketBandwidthRollup_BucketName_Field) _Column() string { return "bucket_name" }
+func (AuditOutcome_Id_Field) _Column() string { return "id" }
 
-type BucketBandwidthRollup_ProjectId_Field struct {
+type AuditOutcome_NodeId_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func BucketBandwidthRollup_ProjectId(v []byte) BucketBandwidthRollup_ProjectId_Field {
-	return BucketBandwidthRollup_ProjectId_Field{_set: true, _value: v}
+func AuditOutcome_NodeId(v []byte) AuditOutcome_NodeId_Field {
+	return AuditOutcome_NodeId_Field{_set: true, _value: v}
 }
 
-func (f BucketBandwidthRollup_ProjectId_Field) value() interface{} {
+func (f AuditOutcome_NodeId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketBandwidthRollup_ProjectId_Field) _Column() string { return "project_id" }
+func (AuditOutcome_NodeId_Field) _Column() string { return "node_id" }
 
-type BucketBandwidthRollup_IntervalStart_Field struct {
+type AuditOutcome_AuditedAt_Field struct {
 	_set   bool
 	_null  bool
 	_value time.Time
 }
 
-func BucketBandwidthRollup_IntervalStart(v time.Time) BucketBandwidthRollup_IntervalStart_Field {
-	return BucketBandwidthRollup_IntervalStart_Field{_set: true, _value: v}
+func AuditOutcome_AuditedAt(v time.Time) AuditOutcome_AuditedAt_Field {
+	return AuditOutcome_AuditedAt_Field{_set: true, _value: v}
 }
 
-func (f BucketBandwidthRollup_IntervalStart_Field) value() interface{} {
+func (f AuditOutcome_AuditedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketBandwidthRollup_IntervalStart_Field) _Column() string { return "interval_start" }
+func (AuditOutcome_AuditedAt_Field) _Column() string { return "audited_at" }
 
-type BucketBandwidthRollup_IntervalSeconds_Field struct {
+type AuditOutcome_Outcome_Field struct {
 	_set   bool
 	_null  bool
-	_value uint
+	_value int
 }
 
-func BucketBandwidthRollup_IntervalSeconds(v uint) BucketBandwidthRollup_IntervalSeconds_Field {
-	return BucketBandwidthRollup_IntervalSeconds_Field{_set: true, _value: v}
+func AuditOutcome_Outcome(v int) AuditOutcome_Outcome_Field {
+	return AuditOutcome_Outcome_Field{_set: true, _value: v}
 }
 
-func (f BucketBandwidthRollup_IntervalSeconds_Field) value() interface{} {
+func (f AuditOutcome_Outcome_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketBandwidthRollup_IntervalSeconds_Field) _Column() string { return "interval_seconds" }
+func (AuditOutcome_Outcome_Field) _Column() string { return "outcome" }
 
-type BucketBandwidthRollup_Action_Field struct {
+type AuditOutcome_PieceAudit_Field struct {
 	_set   bool
 	_null  bool
-	_value uint
+	_value bool
 }
 
-func BucketBandwidthRollup_Action(v uint) BucketBandwidthRollup_Action_Field {
-	return BucketBandwidthRollup_Action_Field{_set: true, _value: v}
+func AuditOutcome_PieceAudit(v bool) AuditOutcome_PieceAudit_Field {
+	return AuditOutcome_PieceAudit_Field{_set: true, _value: v}
 }
 
-func (f BucketBandwidthRollup_Action_Field) value() interface{} {
+func (f AuditOutcome_PieceAudit_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketBandwidthRollup_Action_Field) _Column() string { return "action" }
+func (AuditOutcome_PieceAudit_Field) _Column() string { return "piece_audit" }
 
-type BucketBandwidthRollup_Inline_Field struct {
+type AuditOutcomeBaseline struct {
+	NodeId                      []byte
+	AuditReputationAlpha        float64
+	AuditReputationBeta         float64
+	UnknownAuditReputationAlpha float64
+	UnknownAuditReputationBeta  float64
+	OnlineScore                 float64
+	TotalAuditCount             int64
+	AuditHistory                []byte
+	VettedAt                    *time.Time
+	UnknownAuditSuspended       *time.Time
+	OfflineSuspended            *time.Time
+	UnderReview                 *time.Time
+	Disqualified                *time.Time
+}
+
+func (AuditOutcomeBaseline) _Table() string { return "audit_outcome_baselines" }
+
+type AuditOutcomeBaseline_Create_Fields struct {
+	VettedAt              AuditOutcomeBaseline_VettedAt_Field
+	UnknownAuditSuspended AuditOutcomeBaseline_UnknownAuditSuspended_Field
+	OfflineSuspended      AuditOutcomeBaseline_OfflineSuspended_Field
+	UnderReview           AuditOutcomeBaseline_UnderReview_Field
+	Disqualified          AuditOutcomeBaseline_Disqualified_Field
+}
+
+type AuditOutcomeBaseline_Update_Fields struct {
+}
+
+type AuditOutcomeBaseline_NodeId_Field struct {
 	_set   bool
 	_null  bool
-	_value uint64
+	_value []byte
 }
 
-func BucketBandwidthRollup_Inline(v uint64) BucketBandwidthRollup_Inline_Field {
-	return BucketBandwidthRollup_Inline_Field{_set: true, _value: v}
+func AuditOutcomeBaseline_NodeId(v []byte) AuditOutcomeBaseline_NodeId_Field {
+	return AuditOutcomeBaseline_NodeId_Field{_set: true, _value: v}
 }
 
-func (f BucketBandwidthRollup_Inline_Field) value() interface{} {
+func (f AuditOutcomeBaseline_NodeId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketBandwidthRollup_Inline_Field) _Column() string { return "inline" }
+func (AuditOutcomeBaseline_NodeId_Field) _Column() string { return "node_id" }
 
-type BucketBandwidthRollup_Allocated_Field struct {
+type AuditOutcomeBaseline_AuditReputationAlpha_Field struct {
 	_set   bool
 	_null  bool
-	_value uint64
+	_value float64
 }
 
-func BucketBandwidthRollup_Allocated(v uint64) BucketBandwidthRollup_Allocated_Field {
-	return BucketBandwidthRollup_Allocated_Field{_set: true, _value: v}
+func AuditOutcomeBaseline_AuditReputationAlpha(v float64) AuditOutcomeBaseline_AuditReputationAlpha_Field {
+	return AuditOutcomeBaseline_AuditReputationAlpha_Field{_set: true, _value: v}
 }
 
-func (f BucketBandwidthRollup_Allocated_Field) value() interface{} {
+func (f AuditOutcomeBaseline_AuditReputationAlpha_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketBandwidthRollup_Allocated_Field) _Column() string { return "allocated" }
+func (AuditOutcomeBaseline_AuditReputationAlpha_Field) _Column() string {
+	return "audit_reputation_alpha"
+}
 
-type BucketBandwidthRollup_Settled_Field struct {
+type AuditOutcomeBaseline_AuditReputationBeta_Field struct {
 	_set   bool
 	_null  bool
-	_value uint64
+	_value float64
 }
 
-func BucketBandwidthRollup_Settled(v uint64) BucketBandwidthRollup_Settled_Field {
-	return BucketBandwidthRollup_Settled_Field{_set: true, _value: v}
+func AuditOutcomeBaseline_AuditReputationBeta(v float64) AuditOutcomeBaseline_AuditReputationBeta_Field {
+	return AuditOutcomeBaseline_AuditReputationBeta_Field{_set: true, _value: v}
 }
 
-func (f BucketBandwidthRollup_Settled_Field) value() interface{} {
+func (f AuditOutcomeBaseline_AuditReputationBeta_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketBandwidthRollup_Settled_Field) _Column() string { return "settled" }
-
-type BucketBandwidthRollupArchive struct {
-	BucketName      []byte
-	ProjectId       []byte
-	IntervalStart   time.Time
-	IntervalSeconds uint
-	Action          uint
-	Inline          uint64
-	Allocated       uint64
-	Settled         uint64
-}
-
-func (BucketBandwidthRollupArchive) _Table() string { return "bucket_bandwidth_rollup_archives" }
-
-type BucketBandwidthRollupArchive_Update_Fields struct {
-	Inline    BucketBandwidthRollupArchive_Inline_Field
-	Allocated BucketBandwidthRollupArchive_Allocated_Field
-	Settled   BucketBandwidthRollupArchive_Settled_Field
+func (AuditOutcomeBaseline_AuditReputationBeta_Field) _Column() string {
+	return "audit_reputation_beta"
 }
 
-type BucketBandwidthRollupArchive_BucketName_Field struct {
+type AuditOutcomeBaseline_UnknownAuditReputationAlpha_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value float64
 }
 
-func BucketBandwidthRollupArchive_BucketName(v []byte) BucketBandwidthRollupArchive_BucketName_Field {
-	return BucketBandwidthRollupArchive_BucketName_Field{_set: true, _value: v}
+func AuditOutcomeBaseline_UnknownAuditReputationAlpha(v float64) AuditOutcomeBaseline_UnknownAuditReputationAlpha_Field {
+	return AuditOutcomeBaseline_UnknownAuditReputationAlpha_Field{_set: true, _value: v}
 }
 
-func (f BucketBandwidthRollupArchive_BucketName_Field) value() interface{} {
+func (f AuditOutcomeBaseline_UnknownAuditReputationAlpha_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketBandwidthRollupArchive_BucketName_Field) _Column() string { return "bucket_name" }
+func (AuditOutcomeBaseline_UnknownAuditReputationAlpha_Field) _Column() string {
+	return "unknown_audit_reputation_alpha"
+}
 
-type BucketBandwidthRollupArchive_ProjectId_Field struct {
+type AuditOutcomeBaseline_UnknownAuditReputationBeta_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value float64
 }
 
-func BucketBandwidthRollupArchive_ProjectId(v []byte) BucketBandwidthRollupArchive_ProjectId_Field {
-	return BucketBandwidthRollupArchive_ProjectId_Field{_set: true, _value: v}
+func AuditOutcomeBaseline_UnknownAuditReputationBeta(v float64) AuditOutcomeBaseline_UnknownAuditReputationBeta_Field {
+	return AuditOutcomeBaseline_UnknownAuditReputationBeta_Field{_set: true, _value: v}
 }
 
-func (f BucketBandwidthRollupArchive_ProjectId_Field) value() interface{} {
+func (f AuditOutcomeBaseline_UnknownAuditReputationBeta_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketBandwidthRollupArchive_ProjectId_Field) _Column() string { return "project_id" }
+func (AuditOutcomeBaseline_UnknownAuditReputationBeta_Field) _Column() string {
+	return "unknown_audit_reputation_beta"
+}
 
-type BucketBandwidthRollupArchive_IntervalStart_Field struct {
+type AuditOutcomeBaseline_OnlineScore_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value float64
 }
 
-func BucketBandwidthRollupArchive_IntervalStart(v time.Time) BucketBandwidthRollupArchive_IntervalStart_Field {
-	return BucketBandwidthRollupArchive_IntervalStart_Field{_set: true, _value: v}
+func AuditOutcomeBaseline_OnlineScore(v float64) AuditOutcomeBaseline_OnlineScore_Field {
+	return AuditOutcomeBaseline_OnlineScore_Field{_set: true, _value: v}
 }
 
-func (f BucketBandwidthRollupArchive_IntervalStart_Field) value() interface{} {
+func (f AuditOutcomeBaseline_OnlineScore_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketBandwidthRollupArchive_IntervalStart_Field) _Column() string { return "interval_start" }
+func (AuditOutcomeBaseline_OnlineScore_Field) _Column() string { return "online_score" }
 
-type BucketBandwidthRollupArchive_IntervalSeconds_Field struct {
+type AuditOutcomeBaseline_TotalAuditCount_Field struct {
 	_set   bool
 	_null  bool
-	_value uint
+	_value int64
 }
 
-func BucketBandwidthRollupArchive_IntervalSeconds(v uint) BucketBandwidthRollupArchive_IntervalSeconds_Field {
-	return BucketBandwidthRollupArchive_IntervalSeconds_Field{_set: true, _value: v}
+func AuditOutcomeBaseline_TotalAuditCount(v int64) AuditOutcomeBaseline_TotalAuditCount_Field {
+	return AuditOutcomeBaseline_TotalAuditCount_Field{_set: true, _value: v}
 }
 
-func (f BucketBandwidthRollupArchive_IntervalSeconds_Field) value() interface{} {
+func (f AuditOutcomeBaseline_TotalAuditCount_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketBandwidthRollupArchive_IntervalSeconds_Field) _Column() string { return "interval_seconds" }
+func (AuditOutcomeBaseline_TotalAuditCount_Field) _Column() string { return "total_audit_count" }
 
-type BucketBandwidthRollupArchive_Action_Field struct {
+type AuditOutcomeBaseline_AuditHistory_Field struct {
 	_set   bool
 	_null  bool
-	_value uint
+	_value []byte
 }
 
-func BucketBandwidthRollupArchive_Action(v uint) BucketBandwidthRollupArchive_Action_Field {
-	return BucketBandwidthRollupArchive_Action_Field{_set: true, _value: v}
+func AuditOutcomeBaseline_AuditHistory(v []byte) AuditOutcomeBaseline_AuditHistory_Field {
+	return AuditOutcomeBaseline_AuditHistory_Field{_set: true, _value: v}
 }
 
-func (f BucketBandwidthRollupArchive_Action_Field) value() interface{} {
+func (f AuditOutcomeBaseline_AuditHistory_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketBandwidthRollupArchive_Action_Field) _Column() string { return "action" }
+func (AuditOutcomeBaseline_AuditHistory_Field) _Column() string { return "audit_history" }
 
-type BucketBandwidthRollupArchive_Inline_Field struct {
+type AuditOutcomeBaseline_VettedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value uint64
+	_value *time.Time
 }
 
-func BucketBandwidthRollupArchive_Inline(v uint64) BucketBandwidthRollupArchive_Inline_Field {
-	return BucketBandwidthRollupArchive_Inline_Field{_set: true, _value: v}
+func AuditOutcomeBaseline_VettedAt(v time.Time) AuditOutcomeBaseline_VettedAt_Field {
+	return AuditOutcomeBaseline_VettedAt_Field{_set: true, _value: &v}
 }
 
-func (f BucketBandwidthRollupArchive_Inline_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
+func AuditOutcomeBaseline_VettedAt_Raw(v *time.Time) AuditOutcomeBaseline_VettedAt_Field {
+	if v == nil {
+		return AuditOutcomeBaseline_VettedAt_Null()
 	}
-	return f._value
+	return AuditOutcomeBaseline_VettedAt(*v)
 }
 
-func (BucketBandwidthRollupArchive_Inline_Field) _Column() string { return "inline" }
-
-type BucketBandwidthRollupArchive_Allocated_Field struct {
-	_set   bool
-	_null  bool
-	_value uint64
+func AuditOutcomeBaseline_VettedAt_Null() AuditOutcomeBaseline_VettedAt_Field {
+	return AuditOutcomeBaseline_VettedAt_Field{_set: true, _null: true}
 }
 
-func BucketBandwidthRollupArchive_Allocated(v uint64) BucketBandwidthRollupArchive_Allocated_Field {
-	return BucketBandwidthRollupArchive_Allocated_Field{_set: true, _value: v}
+func (f AuditOutcomeBaseline_VettedAt_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
 }
 
-func (f BucketBandwidthRollupArchive_Allocated_Field) value() interface{} {
+func (f AuditOutcomeBaseline_VettedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketBandwidthRollupArchive_Allocated_Field) _Column() string { return "allocated" }
+func (AuditOutcomeBaseline_VettedAt_Field) _Column() string { return "vetted_at" }
 
-type BucketBandwidthRollupArchive_Settled_Field struct {
+type AuditOutcomeBaseline_UnknownAuditSuspended_Field struct {
 	_set   bool
 	_null  bool
-	_value uint64
+	_value *time.Time
 }
 
-func BucketBandwidthRollupArchive_Settled(v uint64) BucketBandwidthRollupArchive_Settled_Field {
-	return BucketBandwidthRollupArchive_Settled_Field{_set: true, _value: v}
+func AuditOutcomeBaseline_UnknownAuditSuspended(v time.Time) AuditOutcomeBaseline_UnknownAuditSuspended_Field {
+	return AuditOutcomeBaseline_UnknownAuditSuspended_Field{_set: true, _value: &v}
 }
 
-func (f BucketBandwidthRollupArchive_Settled_Field) value() interface{} {
+func AuditOutcomeBaseline_UnknownAuditSuspended_Raw(v *time.Time) AuditOutcomeBaseline_UnknownAuditSuspended_Field {
+	if v == nil {
+		return AuditOutcomeBaseline_UnknownAuditSuspended_Null()
+	}
+	return AuditOutcomeBaseline_UnknownAuditSuspended(*v)
+}
+
+func AuditOutcomeBaseline_UnknownAuditSuspended_Null() AuditOutcomeBaseline_UnknownAuditSuspended_Field {
+	return AuditOutcomeBaseline_UnknownAuditSuspended_Field{_set: true, _null: true}
+}
+
+func (f AuditOutcomeBaseline_UnknownAuditSuspended_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f AuditOutcomeBaseline_UnknownAuditSuspended_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketBandwidthRollupArchive_Settled_Field) _Column() string { return "settled" }
+func (AuditOutcomeBaseline_UnknownAuditSuspended_Field) _Column() string {
+	return "unknown_audit_suspended"
+}
 
-type BucketStorageTally struct {
-	BucketName          []byte
+type AuditOutcomeBaseline_OfflineSuspended_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
+}
+
+func AuditOutcomeBaseline_OfflineSuspended(v time.Time) AuditOutcomeBaseline_OfflineSuspended_Field {
+	return AuditOutcomeBaseline_OfflineSuspended_Field{_set: true, _value: &v}
+}
+
+func AuditOutcomeBaseline_OfflineSuspended_Raw(v *time.Time) AuditOutcomeBaseline_OfflineSuspended_Field {
+	if v == nil {
+		return AuditOutcomeBaseline_OfflineSuspended_Null()
+	}
+	return AuditOutcomeBaseline_OfflineSuspended(*v)
+}
+
+func AuditOutcomeBaseline_OfflineSuspended_Null() AuditOutcomeBaseline_OfflineSuspended_Field {
+	return AuditOutcomeBaseline_OfflineSuspended_Field{_set: true, _null: true}
+}
+
+func (f AuditOutcomeBaseline_OfflineSuspended_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f AuditOutcomeBaseline_OfflineSuspended_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (AuditOutcomeBaseline_OfflineSuspended_Field) _Column() string { return "offline_suspended" }
+
+type AuditOutcomeBaseline_UnderReview_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
+}
+
+func AuditOutcomeBaseline_UnderReview(v time.Time) AuditOutcomeBaseline_UnderReview_Field {
+	return AuditOutcomeBaseline_UnderReview_Field{_set: true, _value: &v}
+}
+
+func AuditOutcomeBaseline_UnderReview_Raw(v *time.Time) AuditOutcomeBaseline_UnderReview_Field {
+	if v == nil {
+		return AuditOutcomeBaseline_UnderReview_Null()
+	}
+	return AuditOutcomeBaseline_UnderReview(*v)
+}
+
+func AuditOutcomeBaseline_UnderReview_Null() AuditOutcomeBaseline_UnderReview_Field {
+	return AuditOutcomeBaseline_UnderReview_Field{_set: true, _null: true}
+}
+
+func (f AuditOutcomeBaseline_UnderReview_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f AuditOutcomeBaseline_UnderReview_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (AuditOutcomeBaseline_UnderReview_Field) _Column() string { return "under_review" }
+
+type AuditOutcomeBaseline_Disqualified_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
+}
+
+func AuditOutcomeBaseline_Disqualified(v time.Time) AuditOutcomeBaseline_Disqualified_Field {
+	return AuditOutcomeBaseline_Disqualified_Field{_set: true, _value: &v}
+}
+
+func AuditOutcomeBaseline_Disqualified_Raw(v *time.Time) AuditOutcomeBaseline_Disqualified_Field {
+	if v == nil {
+		return AuditOutcomeBaseline_Disqualified_Null()
+	}
+	return AuditOutcomeBaseline_Disqualified(*v)
+}
+
+func AuditOutcomeBaseline_Disqualified_Null() AuditOutcomeBaseline_Disqualified_Field {
+	return AuditOutcomeBaseline_Disqualified_Field{_set: true, _null: true}
+}
+
+func (f AuditOutcomeBaseline_Disqualified_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f AuditOutcomeBaseline_Disqualified_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (AuditOutcomeBaseline_Disqualified_Field) _Column() string { return "disqualified" }
+
+type AuditRequest struct {
+	Id            []byte
+	NodeId        []byte
+	ProjectId     []byte
+	BucketName    []byte
+	ObjectKey     []byte
+	ObjectVersion int64
+	SegmentLimit  int
+	Status        int
+	Error         *string
+	CreatedAt     time.Time
+	FinishedAt    *time.Time
+}
+
+func (AuditRequest) _Table() string { return "audit_requests" }
+
+type AuditRequest_Create_Fields struct {
+	NodeId        AuditRequest_NodeId_Field
+	ProjectId     AuditRequest_ProjectId_Field
+	BucketName    AuditRequest_BucketName_Field
+	ObjectKey     AuditRequest_ObjectKey_Field
+	ObjectVersion AuditRequest_ObjectVersion_Field
+	SegmentLimit  AuditRequest_SegmentLimit_Field
+	Status        AuditRequest_Status_Field
+	Error         AuditRequest_Error_Field
+	CreatedAt     AuditRequest_CreatedAt_Field
+	FinishedAt    AuditRequest_FinishedAt_Field
+}
+
+type AuditRequest_Update_Fields struct {
+	Status     AuditRequest_Status_Field
+	Error      AuditRequest_Error_Field
+	FinishedAt AuditRequest_FinishedAt_Field
+}
+
+type AuditRequest_Id_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func AuditRequest_Id(v []byte) AuditRequest_Id_Field {
+	return AuditRequest_Id_Field{_set: true, _value: v}
+}
+
+func (f AuditRequest_Id_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (AuditRequest_Id_Field) _Column() string { return "id" }
+
+type AuditRequest_NodeId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func AuditRequest_NodeId(v []byte) AuditRequest_NodeId_Field {
+	return AuditRequest_NodeId_Field{_set: true, _value: v}
+}
+
+func AuditRequest_NodeId_Raw(v []byte) AuditRequest_NodeId_Field {
+	if v == nil {
+		return AuditRequest_NodeId_Null()
+	}
+	return AuditRequest_NodeId(v)
+}
+
+func AuditRequest_NodeId_Null() AuditRequest_NodeId_Field {
+	return AuditRequest_NodeId_Field{_set: true, _null: true}
+}
+
+func (f AuditRequest_NodeId_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f AuditRequest_NodeId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (AuditRequest_NodeId_Field) _Column() string { return "node_id" }
+
+type AuditRequest_ProjectId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func AuditRequest_ProjectId(v []byte) AuditRequest_ProjectId_Field {
+	return AuditRequest_ProjectId_Field{_set: true, _value: v}
+}
+
+func AuditRequest_ProjectId_Raw(v []byte) AuditRequest_ProjectId_Field {
+	if v == nil {
+		return AuditRequest_ProjectId_Null()
+	}
+	return AuditRequest_ProjectId(v)
+}
+
+func AuditRequest_ProjectId_Null() AuditRequest_ProjectId_Field {
+	return AuditRequest_ProjectId_Field{_set: true, _null: true}
+}
+
+func (f AuditRequest_ProjectId_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f AuditRequest_ProjectId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (AuditRequest_ProjectId_Field) _Column() string { return "project_id" }
+
+type AuditRequest_BucketName_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func AuditRequest_BucketName(v []byte) AuditRequest_BucketName_Field {
+	return AuditRequest_BucketName_Field{_set: true, _value: v}
+}
+
+func AuditRequest_BucketName_Raw(v []byte) AuditRequest_BucketName_Field {
+	if v == nil {
+		return AuditRequest_BucketName_Null()
+	}
+	return AuditRequest_BucketName(v)
+}
+
+func AuditRequest_BucketName_Null() AuditRequest_BucketName_Field {
+	return AuditRequest_BucketName_Field{_set: true, _null: true}
+}
+
+func (f AuditRequest_BucketName_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f AuditRequest_BucketName_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (AuditRequest_BucketName_Field) _Column() string { return "bucket_name" }
+
+type AuditRequest_ObjectKey_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func AuditRequest_ObjectKey(v []byte) AuditRequest_ObjectKey_Field {
+	return AuditRequest_ObjectKey_Field{_set: true, _value: v}
+}
+
+func AuditRequest_ObjectKey_Raw(v []byte) AuditRequest_ObjectKey_Field {
+	if v == nil {
+		return AuditRequest_ObjectKey_Null()
+	}
+	return AuditRequest_ObjectKey(v)
+}
+
+func AuditRequest_ObjectKey_Null() AuditRequest_ObjectKey_Field {
+	return AuditRequest_ObjectKey_Field{_set: true, _null: true}
+}
+
+func (f AuditRequest_ObjectKey_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f AuditRequest_ObjectKey_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (AuditRequest_ObjectKey_Field) _Column() string { return "object_key" }
+
+type AuditRequest_ObjectVersion_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func AuditRequest_ObjectVersion(v int64) AuditRequest_ObjectVersion_Field {
+	return AuditRequest_ObjectVersion_Field{_set: true, _value: v}
+}
+
+func (f AuditRequest_ObjectVersion_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (AuditRequest_ObjectVersion_Field) _Column() string { return "object_version" }
+
+type AuditRequest_SegmentLimit_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func AuditRequest_SegmentLimit(v int) AuditRequest_SegmentLimit_Field {
+	return AuditRequest_SegmentLimit_Field{_set: true, _value: v}
+}
+
+func (f AuditRequest_SegmentLimit_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (AuditRequest_SegmentLimit_Field) _Column() string { return "segment_limit" }
+
+type AuditRequest_Status_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func AuditRequest_Status(v int) AuditRequest_Status_Field {
+	return AuditRequest_Status_Field{_set: true, _value: v}
+}
+
+func (f AuditRequest_Status_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (AuditRequest_Status_Field) _Column() string { return "status" }
+
+type AuditRequest_Error_Field struct {
+	_set   bool
+	_null  bool
+	_value *string
+}
+
+func AuditRequest_Error(v string) AuditRequest_Error_Field {
+	return AuditRequest_Error_Field{_set: true, _value: &v}
+}
+
+func AuditRequest_Error_Raw(v *string) AuditRequest_Error_Field {
+	if v == nil {
+		return AuditRequest_Error_Null()
+	}
+	return AuditRequest_Error(*v)
+}
+
+func AuditRequest_Error_Null() AuditRequest_Error_Field {
+	return AuditRequest_Error_Field{_set: true, _null: true}
+}
+
+func (f AuditRequest_Error_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f AuditRequest_Error_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (AuditRequest_Error_Field) _Column() string { return "error" }
+
+type AuditRequest_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func AuditRequest_CreatedAt(v time.Time) AuditRequest_CreatedAt_Field {
+	return AuditRequest_CreatedAt_Field{_set: true, _value: v}
+}
+
+func (f AuditRequest_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (AuditRequest_CreatedAt_Field) _Column() string { return "created_at" }
+
+type AuditRequest_FinishedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
+}
+
+func AuditRequest_FinishedAt(v time.Time) AuditRequest_FinishedAt_Field {
+	return AuditRequest_FinishedAt_Field{_set: true, _value: &v}
+}
+
+func AuditRequest_FinishedAt_Raw(v *time.Time) AuditRequest_FinishedAt_Field {
+	if v == nil {
+		return AuditRequest_FinishedAt_Null()
+	}
+	return AuditRequest_FinishedAt(*v)
+}
+
+func AuditRequest_FinishedAt_Null() AuditRequest_FinishedAt_Field {
+	return AuditRequest_FinishedAt_Field{_set: true, _null: true}
+}
+
+func (f AuditRequest_FinishedAt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f AuditRequest_FinishedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (AuditRequest_FinishedAt_Field) _Column() string { return "finished_at" }
+
+type AuditRequestResult struct {
+	RequestId []byte
+	StreamId  []byte
+	Position  uint64
+	NodeId    []byte
+	Outcome   int
+}
+
+func (AuditRequestResult) _Table() string { return "audit_request_results" }
+
+type AuditRequestResult_Update_Fields struct {
+}
+
+type AuditRequestResult_RequestId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func AuditRequestResult_RequestId(v []byte) AuditRequestResult_RequestId_Field {
+	return AuditRequestResult_RequestId_Field{_set: true, _value: v}
+}
+
+func (f AuditRequestResult_RequestId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (AuditRequestResult_RequestId_Field) _Column() string { return "request_id" }
+
+type AuditRequestResult_StreamId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func AuditRequestResult_StreamId(v []byte) AuditRequestResult_StreamId_Field {
+	return AuditRequestResult_StreamId_Field{_set: true, _value: v}
+}
+
+func (f AuditRequestResult_StreamId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (AuditRequestResult_StreamId_Field) _Column() string { return "stream_id" }
+
+type AuditRequestResult_Position_Field struct {
+	_set   bool
+	_null  bool
+	_value uint64
+}
+
+func AuditRequestResult_Position(v uint64) AuditRequestResult_Position_Field {
+	return AuditRequestResult_Position_Field{_set: true, _value: v}
+}
+
+func (f AuditRequestResult_Position_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (AuditRequestResult_Position_Field) _Column() string { return "position" }
+
+type AuditRequestResult_NodeId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func AuditRequestResult_NodeId(v []byte) AuditRequestResult_NodeId_Field {
+	return AuditRequestResult_NodeId_Field{_set: true, _value: v}
+}
+
+func (f AuditRequestResult_NodeId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (AuditRequestResult_NodeId_Field) _Column() string { return "node_id" }
+
+type AuditRequestResult_Outcome_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func AuditRequestResult_Outcome(v int) AuditRequestResult_Outcome_Field {
+	return AuditRequestResult_Outcome_Field{_set: true, _value: v}
+}
+
+func (f AuditRequestResult_Outcome_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (AuditRequestResult_Outcome_Field) _Column() string { return "outcome" }
+
+type BucketBandwidthRollup struct {
+	BucketName      []byte
+	ProjectId       []byte
+	IntervalStart   time.Time
+	IntervalSeconds uint
+	Action          uint
+	Inline          uint64
+	Allocated       uint64
+	Settled         uint64
+}
+
+func (BucketBandwidthRollup) _Table() string { return "bucket_bandwidth_rollups" }
+
+type BucketBandwidthRollup_Update_Fields struct {
+	Inline    BucketBandwidthRollup_Inline_Field
+	Allocated BucketBandwidthRollup_Allocated_Field
+	Settled   BucketBandwidthRollup_Settled_Field
+}
+
+type BucketBandwidthRollup_BucketName_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func BucketBandwidthRollup_BucketName(v []byte) BucketBandwidthRollup_BucketName_Field {
+	return BucketBandwidthRollup_BucketName_Field{_set: true, _value: v}
+}
+
+func (f BucketBandwidthRollup_BucketName_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketBandwidthRollup_BucketName_Field) _Column() string { return "bucket_name" }
+
+type BucketBandwidthRollup_ProjectId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func BucketBandwidthRollup_ProjectId(v []byte) BucketBandwidthRollup_ProjectId_Field {
+	return BucketBandwidthRollup_ProjectId_Field{_set: true, _value: v}
+}
+
+func (f BucketBandwidthRollup_ProjectId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketBandwidthRollup_ProjectId_Field) _Column() string { return "project_id" }
+
+type BucketBandwidthRollup_IntervalStart_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func BucketBandwidthRollup_IntervalStart(v time.Time) BucketBandwidthRollup_IntervalStart_Field {
+	return BucketBandwidthRollup_IntervalStart_Field{_set: true, _value: v}
+}
+
+func (f BucketBandwidthRollup_IntervalStart_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketBandwidthRollup_IntervalStart_Field) _Column() string { return "interval_start" }
+
+type BucketBandwidthRollup_IntervalSeconds_Field struct {
+	_set   bool
+	_null  bool
+	_value uint
+}
+
+func BucketBandwidthRollup_IntervalSeconds(v uint) BucketBandwidthRollup_IntervalSeconds_Field {
+	return BucketBandwidthRollup_IntervalSeconds_Field{_set: true, _value: v}
+}
+
+func (f BucketBandwidthRollup_IntervalSeconds_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketBandwidthRollup_IntervalSeconds_Field) _Column() string { return "interval_seconds" }
+
+type BucketBandwidthRollup_Action_Field struct {
+	_set   bool
+	_null  bool
+	_value uint
+}
+
+func BucketBandwidthRollup_Action(v uint) BucketBandwidthRollup_Action_Field {
+	return BucketBandwidthRollup_Action_Field{_set: true, _value: v}
+}
+
+func (f BucketBandwidthRollup_Action_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketBandwidthRollup_Action_Field) _Column() string { return "action" }
+
+type BucketBandwidthRollup_Inline_Field struct {
+	_set   bool
+	_null  bool
+	_value uint64
+}
+
+func BucketBandwidthRollup_Inline(v uint64) BucketBandwidthRollup_Inline_Field {
+	return BucketBandwidthRollup_Inline_Field{_set: true, _value: v}
+}
+
+func (f BucketBandwidthRollup_Inline_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketBandwidthRollup_Inline_Field) _Column() string { return "inline" }
+
+type BucketBandwidthRollup_Allocated_Field struct {
+	_set   bool
+	_null  bool
+	_value uint64
+}
+
+func BucketBandwidthRollup_Allocated(v uint64) BucketBandwidthRollup_Allocated_Field {
+	return BucketBandwidthRollup_Allocated_Field{_set: true, _value: v}
+}
+
+func (f BucketBandwidthRollup_Allocated_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketBandwidthRollup_Allocated_Field) _Column() string { return "allocated" }
+
+type BucketBandwidthRollup_Settled_Field struct {
+	_set   bool
+	_null  bool
+	_value uint64
+}
+
+func BucketBandwidthRollup_Settled(v uint64) BucketBandwidthRollup_Settled_Field {
+	return BucketBandwidthRollup_Settled_Field{_set: true, _value: v}
+}
+
+func (f BucketBandwidthRollup_Settled_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketBandwidthRollup_Settled_Field) _Column() string { return "settled" }
+
+type BucketBandwidthRollupArchive struct {
+	BucketName      []byte
+	ProjectId       []byte
+	IntervalStart   time.Time
+	IntervalSeconds uint
+	Action          uint
+	Inline          uint64
+	Allocated       uint64
+	Settled         uint64
+}
+
+func (BucketBandwidthRollupArchive) _Table() string { return "bucket_bandwidth_rollup_archives" }
+
+type BucketBandwidthRollupArchive_Update_Fields struct {
+	Inline    BucketBandwidthRollupArchive_Inline_Field
+	Allocated BucketBandwidthRollupArchive_Allocated_Field
+	Settled   BucketBandwidthRollupArchive_Settled_Field
+}
+
+type BucketBandwidthRollupArchive_BucketName_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func BucketBandwidthRollupArchive_BucketName(v []byte) BucketBandwidthRollupArchive_BucketName_Field {
+	return BucketBandwidthRollupArchive_BucketName_Field{_set: true, _value: v}
+}
+
+func (f BucketBandwidthRollupArchive_BucketName_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketBandwidthRollupArchive_BucketName_Field) _Column() string { return "bucket_name" }
+
+type BucketBandwidthRollupArchive_ProjectId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func BucketBandwidthRollupArchive_ProjectId(v []byte) BucketBandwidthRollupArchive_ProjectId_Field {
+	return BucketBandwidthRollupArchive_ProjectId_Field{_set: true, _value: v}
+}
+
+func (f BucketBandwidthRollupArchive_ProjectId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketBandwidthRollupArchive_ProjectId_Field) _Column() string { return "project_id" }
+
+type BucketBandwidthRollupArchive_IntervalStart_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func BucketBandwidthRollupArchive_IntervalStart(v time.Time) BucketBandwidthRollupArchive_IntervalStart_Field {
+	return BucketBandwidthRollupArchive_IntervalStart_Field{_set: true, _value: v}
+}
+
+func (f BucketBandwidthRollupArchive_IntervalStart_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketBandwidthRollupArchive_IntervalStart_Field) _Column() string { return "interval_start" }
+
+type BucketBandwidthRollupArchive_IntervalSeconds_Field struct {
+	_set   bool
+	_null  bool
+	_value uint
+}
+
+func BucketBandwidthRollupArchive_IntervalSeconds(v uint) BucketBandwidthRollupArchive_IntervalSeconds_Field {
+	return BucketBandwidthRollupArchive_IntervalSeconds_Field{_set: true, _value: v}
+}
+
+func (f BucketBandwidthRollupArchive_IntervalSeconds_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketBandwidthRollupArchive_IntervalSeconds_Field) _Column() string { return "interval_seconds" }
+
+type BucketBandwidthRollupArchive_Action_Field struct {
+	_set   bool
+	_null  bool
+	_value uint
+}
+
+func BucketBandwidthRollupArchive_Action(v uint) BucketBandwidthRollupArchive_Action_Field {
+	return BucketBandwidthRollupArchive_Action_Field{_set: true, _value: v}
+}
+
+func (f BucketBandwidthRollupArchive_Action_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketBandwidthRollupArchive_Action_Field) _Column() string { return "action" }
+
+type BucketBandwidthRollupArchive_Inline_Field struct {
+	_set   bool
+	_null  bool
+	_value uint64
+}
+
+func BucketBandwidthRollupArchive_Inline(v uint64) BucketBandwidthRollupArchive_Inline_Field {
+	return BucketBandwidthRollupArchive_Inline_Field{_set: true, _value: v}
+}
+
+func (f BucketBandwidthRollupArchive_Inline_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketBandwidthRollupArchive_Inline_Field) _Column() string { return "inline" }
+
+type BucketBandwidthRollupArchive_Allocated_Field struct {
+	_set   bool
+	_null  bool
+	_value uint64
+}
+
+func BucketBandwidthRollupArchive_Allocated(v uint64) BucketBandwidthRollupArchive_Allocated_Field {
+	return BucketBandwidthRollupArchive_Allocated_Field{_set: true, _value: v}
+}
+
+func (f BucketBandwidthRollupArchive_Allocated_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketBandwidthRollupArchive_Allocated_Field) _Column() string { return "allocated" }
+
+type BucketBandwidthRollupArchive_Settled_Field struct {
+	_set   bool
+	_null  bool
+	_value uint64
+}
+
+func BucketBandwidthRollupArchive_Settled(v uint64) BucketBandwidthRollupArchive_Settled_Field {
+	return BucketBandwidthRollupArchive_Settled_Field{_set: true, _value: v}
+}
+
+func (f BucketBandwidthRollupArchive_Settled_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketBandwidthRollupArchive_Settled_Field) _Column() string { return "settled" }
+
+type BucketEventOutbox struct {
+	Id            []byte
+	ProjectId     []byte
+	BucketName    []byte
+	Sink          string
+	Payload       []byte
+	Attempts      int
+	LastError     *string
+	NextAttemptAt time.Time
+	CreatedAt     time.Time
+}
+
+func (BucketEventOutbox) _Table() string { return "bucket_event_outbox" }
+
+type BucketEventOutbox_Create_Fields struct {
+	Attempts      BucketEventOutbox_Attempts_Field
+	LastError     BucketEventOutbox_LastError_Field
+	NextAttemptAt BucketEventOutbox_NextAttemptAt_Field
+	CreatedAt     BucketEventOutbox_CreatedAt_Field
+}
+
+type BucketEventOutbox_Update_Fields struct {
+	Attempts      BucketEventOutbox_Attempts_Field
+	LastError     BucketEventOutbox_LastError_Field
+	NextAttemptAt BucketEventOutbox_NextAttemptAt_Field
+}
+
+type BucketEventOutbox_Id_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func BucketEventOutbox_Id(v []byte) BucketEventOutbox_Id_Field {
+	return BucketEventOutbox_Id_Field{_set: true, _value: v}
+}
+
+func (f BucketEventOutbox_Id_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketEventOutbox_Id_Field) _Column() string { return "id" }
+
+type BucketEventOutbox_ProjectId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func BucketEventOutbox_ProjectId(v []byte) BucketEventOutbox_ProjectId_Field {
+	return BucketEventOutbox_ProjectId_Field{_set: true, _value: v}
+}
+
+func (f BucketEventOutbox_ProjectId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketEventOutbox_ProjectId_Field) _Column() string { return "project_id" }
+
+type BucketEventOutbox_BucketName_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func BucketEventOutbox_BucketName(v []byte) BucketEventOutbox_BucketName_Field {
+	return BucketEventOutbox_BucketName_Field{_set: true, _value: v}
+}
+
+func (f BucketEventOutbox_BucketName_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketEventOutbox_BucketName_Field) _Column() string { return "bucket_name" }
+
+type BucketEventOutbox_Sink_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func BucketEventOutbox_Sink(v string) BucketEventOutbox_Sink_Field {
+	return BucketEventOutbox_Sink_Field{_set: true, _value: v}
+}
+
+func (f BucketEventOutbox_Sink_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketEventOutbox_Sink_Field) _Column() string { return "sink" }
+
+type BucketEventOutbox_Payload_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func BucketEventOutbox_Payload(v []byte) BucketEventOutbox_Payload_Field {
+	return BucketEventOutbox_Payload_Field{_set: true, _value: v}
+}
+
+func (f BucketEventOutbox_Payload_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketEventOutbox_Payload_Field) _Column() string { return "payload" }
+
+type BucketEventOutbox_Attempts_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func BucketEventOutbox_Attempts(v int) BucketEventOutbox_Attempts_Field {
+	return BucketEventOutbox_Attempts_Field{_set: true, _value: v}
+}
+
+func (f BucketEventOutbox_Attempts_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketEventOutbox_Attempts_Field) _Column() string { return "attempts" }
+
+type BucketEventOutbox_LastError_Field struct {
+	_set   bool
+	_null  bool
+	_value *string
+}
+
+func BucketEventOutbox_LastError(v string) BucketEventOutbox_LastError_Field {
+	return BucketEventOutbox_LastError_Field{_set: true, _value: &v}
+}
+
+func BucketEventOutbox_LastError_Raw(v *string) BucketEventOutbox_LastError_Field {
+	if v == nil {
+		return BucketEventOutbox_LastError_Null()
+	}
+	return BucketEventOutbox_LastError(*v)
+}
+
+func BucketEventOutbox_LastError_Null() BucketEventOutbox_LastError_Field {
+	return BucketEventOutbox_LastError_Field{_set: true, _null: true}
+}
+
+func (f BucketEventOutbox_LastError_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f BucketEventOutbox_LastError_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketEventOutbox_LastError_Field) _Column() string { return "last_error" }
+
+type BucketEventOutbox_NextAttemptAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func BucketEventOutbox_NextAttemptAt(v time.Time) BucketEventOutbox_NextAttemptAt_Field {
+	return BucketEventOutbox_NextAttemptAt_Field{_set: true, _value: v}
+}
+
+func (f BucketEventOutbox_NextAttemptAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketEventOutbox_NextAttemptAt_Field) _Column() string { return "next_attempt_at" }
+
+type BucketEventOutbox_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func BucketEventOutbox_CreatedAt(v time.Time) BucketEventOutbox_CreatedAt_Field {
+	return BucketEventOutbox_CreatedAt_Field{_set: true, _value: v}
+}
+
+func (f BucketEventOutbox_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketEventOutbox_CreatedAt_Field) _Column() string { return "created_at" }
+
+type BucketStorageTally struct {
+	BucketName          []byte
 	ProjectId           []byte
 	IntervalStart       time.Time
 	TotalBytes          uint64
@@ -3418,6 +4706,7 @@ type Node struct {
 	LastNet                string
 	LastIpPort             *string
 	CountryCode            *string
+	Tags                   *string
 	Protocol               int
 	Type                   int
 	Email                  string
@@ -3425,6 +4714,8 @@ type Node struct {
 	WalletFeatures         string
 	FreeDisk               int64
 	PieceCount             int64
+	IngressLoad            *float64
+	EgressLoad             *float64
 	Major                  int64
 	Minor                  int64
 	Patch                  int64
@@ -3455,10 +4746,13 @@ type Node_Create_Fields struct {
 	Address                Node_Address_Field
 	LastIpPort             Node_LastIpPort_Field
 	CountryCode            Node_CountryCode_Field
+	Tags                   Node_Tags_Field
 	Protocol               Node_Protocol_Field
 	Type                   Node_Type_Field
 	WalletFeatures         Node_WalletFeatures_Field
 	FreeDisk               Node_FreeDisk_Field
+	IngressLoad            Node_IngressLoad_Field
+	EgressLoad             Node_EgressLoad_Field
 	Major                  Node_Major_Field
 	Minor                  Node_Minor_Field
 	Patch                  Node_Patch_Field
@@ -3486,6 +4780,7 @@ type Node_Update_Fields struct {
 	LastNet                Node_LastNet_Field
 	LastIpPort             Node_LastIpPort_Field
 	CountryCode            Node_CountryCode_Field
+	Tags                   Node_Tags_Field
 	Protocol               Node_Protocol_Field
 	Type                   Node_Type_Field
 	Email                  Node_Email_Field
@@ -3493,6 +4788,8 @@ type Node_Update_Fields struct {
 	WalletFeatures         Node_WalletFeatures_Field
 	FreeDisk               Node_FreeDisk_Field
 	PieceCount             Node_PieceCount_Field
+	IngressLoad            Node_IngressLoad_Field
+	EgressLoad             Node_EgressLoad_Field
 	Major                  Node_Major_Field
 	Minor                  Node_Minor_Field
 	Patch                  Node_Patch_Field
@@ -3636,6 +4933,38 @@ func (f Node_CountryCode_Field) value() interface{} {
 
 func (Node_CountryCode_Field) _Column() string { return "country_code" }
 
+type Node_Tags_Field struct {
+	_set   bool
+	_null  bool
+	_value *string
+}
+
+func Node_Tags(v string) Node_Tags_Field {
+	return Node_Tags_Field{_set: true, _value: &v}
+}
+
+func Node_Tags_Raw(v *string) Node_Tags_Field {
+	if v == nil {
+		return Node_Tags_Null()
+	}
+	return Node_Tags(*v)
+}
+
+func Node_Tags_Null() Node_Tags_Field {
+	return Node_Tags_Field{_set: true, _null: true}
+}
+
+func (f Node_Tags_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Node_Tags_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_Tags_Field) _Column() string { return "tags" }
+
 type Node_Protocol_Field struct {
 	_set   bool
 	_null  bool
@@ -3710,64 +5039,132 @@ func (f Node_Wallet_Field) value() interface{} {
 	return f._value
 }
 
-func (Node_Wallet_Field) _Column() string { return "wallet" }
+func (Node_Wallet_Field) _Column() string { return "wallet" }
+
+type Node_WalletFeatures_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func Node_WalletFeatures(v string) Node_WalletFeatures_Field {
+	return Node_WalletFeatures_Field{_set: true, _value: v}
+}
+
+func (f Node_WalletFeatures_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_WalletFeatures_Field) _Column() string { return "wallet_features" }
+
+type Node_FreeDisk_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func Node_FreeDisk(v int64) Node_FreeDisk_Field {
+	return Node_FreeDisk_Field{_set: true, _value: v}
+}
+
+func (f Node_FreeDisk_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_FreeDisk_Field) _Column() string { return "free_disk" }
+
+type Node_PieceCount_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func Node_PieceCount(v int64) Node_PieceCount_Field {
+	return Node_PieceCount_Field{_set: true, _value: v}
+}
+
+func (f Node_PieceCount_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_PieceCount_Field) _Column() string { return "piece_count" }
 
-type Node_WalletFeatures_Field struct {
+type Node_IngressLoad_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value *float64
 }
 
-func Node_WalletFeatures(v string) Node_WalletFeatures_Field {
-	return Node_WalletFeatures_Field{_set: true, _value: v}
+func Node_IngressLoad(v float64) Node_IngressLoad_Field {
+	return Node_IngressLoad_Field{_set: true, _value: &v}
 }
 
-func (f Node_WalletFeatures_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
+func Node_IngressLoad_Raw(v *float64) Node_IngressLoad_Field {
+	if v == nil {
+		return Node_IngressLoad_Null()
 	}
-	return f._value
+	return Node_IngressLoad(*v)
 }
 
-func (Node_WalletFeatures_Field) _Column() string { return "wallet_features" }
-
-type Node_FreeDisk_Field struct {
-	_set   bool
-	_null  bool
-	_value int64
+func Node_IngressLoad_Null() Node_IngressLoad_Field {
+	return Node_IngressLoad_Field{_set: true, _null: true}
 }
 
-func Node_FreeDisk(v int64) Node_FreeDisk_Field {
-	return Node_FreeDisk_Field{_set: true, _value: v}
+func (f Node_IngressLoad_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
 }
 
-func (f Node_FreeDisk_Field) value() interface{} {
+func (f Node_IngressLoad_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_FreeDisk_Field) _Column() string { return "free_disk" }
+func (Node_IngressLoad_Field) _Column() string { return "ingress_load" }
 
-type Node_PieceCount_Field struct {
+type Node_EgressLoad_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value *float64
 }
 
-func Node_PieceCount(v int64) Node_PieceCount_Field {
-	return Node_PieceCount_Field{_set: true, _value: v}
+func Node_EgressLoad(v float64) Node_EgressLoad_Field {
+	return Node_EgressLoad_Field{_set: true, _value: &v}
 }
 
-func (f Node_PieceCount_Field) value() interface{} {
+func Node_EgressLoad_Raw(v *float64) Node_EgressLoad_Field {
+	if v == nil {
+		return Node_EgressLoad_Null()
+	}
+	return Node_EgressLoad(*v)
+}
+
+func Node_EgressLoad_Null() Node_EgressLoad_Field {
+	return Node_EgressLoad_Field{_set: true, _null: true}
+}
+
+func (f Node_EgressLoad_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f Node_EgressLoad_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_PieceCount_Field) _Column() string { return "piece_count" }
+func (Node_EgressLoad_Field) _Column() string { return "egress_load" }
 
 type Node_Major_Field struct {
 	_set   bool
@@ -4257,156 +5654,438 @@ func Node_ExitLoopCompletedAt_Null() Node_ExitLoopCompletedAt_Field {
 	return Node_ExitLoopCompletedAt_Field{_set: true, _null: true}
 }
 
-func (f Node_ExitLoopCompletedAt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func (f Node_ExitLoopCompletedAt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Node_ExitLoopCompletedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_ExitLoopCompletedAt_Field) _Column() string { return "exit_loop_completed_at" }
+
+type Node_ExitFinishedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
+}
+
+func Node_ExitFinishedAt(v time.Time) Node_ExitFinishedAt_Field {
+	return Node_ExitFinishedAt_Field{_set: true, _value: &v}
+}
+
+func Node_ExitFinishedAt_Raw(v *time.Time) Node_ExitFinishedAt_Field {
+	if v == nil {
+		return Node_ExitFinishedAt_Null()
+	}
+	return Node_ExitFinishedAt(*v)
+}
+
+func Node_ExitFinishedAt_Null() Node_ExitFinishedAt_Field {
+	return Node_ExitFinishedAt_Field{_set: true, _null: true}
+}
+
+func (f Node_ExitFinishedAt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Node_ExitFinishedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_ExitFinishedAt_Field) _Column() string { return "exit_finished_at" }
+
+type Node_ExitSuccess_Field struct {
+	_set   bool
+	_null  bool
+	_value bool
+}
+
+func Node_ExitSuccess(v bool) Node_ExitSuccess_Field {
+	return Node_ExitSuccess_Field{_set: true, _value: v}
+}
+
+func (f Node_ExitSuccess_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_ExitSuccess_Field) _Column() string { return "exit_success" }
+
+type NodeApiVersion struct {
+	Id         []byte
+	ApiVersion int
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+func (NodeApiVersion) _Table() string { return "node_api_versions" }
+
+type NodeApiVersion_Update_Fields struct {
+	ApiVersion NodeApiVersion_ApiVersion_Field
+}
+
+type NodeApiVersion_Id_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func NodeApiVersion_Id(v []byte) NodeApiVersion_Id_Field {
+	return NodeApiVersion_Id_Field{_set: true, _value: v}
+}
+
+func (f NodeApiVersion_Id_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (NodeApiVersion_Id_Field) _Column() string { return "id" }
+
+type NodeApiVersion_ApiVersion_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func NodeApiVersion_ApiVersion(v int) NodeApiVersion_ApiVersion_Field {
+	return NodeApiVersion_ApiVersion_Field{_set: true, _value: v}
+}
+
+func (f NodeApiVersion_ApiVersion_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (NodeApiVersion_ApiVersion_Field) _Column() string { return "api_version" }
+
+type NodeApiVersion_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func NodeApiVersion_CreatedAt(v time.Time) NodeApiVersion_CreatedAt_Field {
+	return NodeApiVersion_CreatedAt_Field{_set: true, _value: v}
+}
+
+func (f NodeApiVersion_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (NodeApiVersion_CreatedAt_Field) _Column() string { return "created_at" }
+
+type NodeApiVersion_UpdatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func NodeApiVersion_UpdatedAt(v time.Time) NodeApiVersion_UpdatedAt_Field {
+	return NodeApiVersion_UpdatedAt_Field{_set: true, _value: v}
+}
+
+func (f NodeApiVersion_UpdatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (NodeApiVersion_UpdatedAt_Field) _Column() string { return "updated_at" }
+
+type NodeReinstatement struct {
+	Id                             []byte
+	NodeId                         []byte
+	ReinstatedBy                   string
+	Reason                         string
+	Probation                      bool
+	PreviousDisqualified           *time.Time
+	PreviousDisqualificationReason *int
+	PreviousUnknownAuditSuspended  *time.Time
+	PreviousOfflineSuspended       *time.Time
+	CreatedAt                      time.Time
+}
+
+func (NodeReinstatement) _Table() string { return "node_reinstatements" }
+
+type NodeReinstatement_Create_Fields struct {
+	PreviousDisqualified           NodeReinstatement_PreviousDisqualified_Field
+	PreviousDisqualificationReason NodeReinstatement_PreviousDisqualificationReason_Field
+	PreviousUnknownAuditSuspended  NodeReinstatement_PreviousUnknownAuditSuspended_Field
+	PreviousOfflineSuspended       NodeReinstatement_PreviousOfflineSuspended_Field
+	CreatedAt                      NodeReinstatement_CreatedAt_Field
+}
+
+type NodeReinstatement_Update_Fields struct {
+}
+
+type NodeReinstatement_Id_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func NodeReinstatement_Id(v []byte) NodeReinstatement_Id_Field {
+	return NodeReinstatement_Id_Field{_set: true, _value: v}
+}
+
+func (f NodeReinstatement_Id_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (NodeReinstatement_Id_Field) _Column() string { return "id" }
+
+type NodeReinstatement_NodeId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func NodeReinstatement_NodeId(v []byte) NodeReinstatement_NodeId_Field {
+	return NodeReinstatement_NodeId_Field{_set: true, _value: v}
+}
+
+func (f NodeReinstatement_NodeId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (NodeReinstatement_NodeId_Field) _Column() string { return "node_id" }
+
+type NodeReinstatement_ReinstatedBy_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func NodeReinstatement_ReinstatedBy(v string) NodeReinstatement_ReinstatedBy_Field {
+	return NodeReinstatement_ReinstatedBy_Field{_set: true, _value: v}
+}
+
+func (f NodeReinstatement_ReinstatedBy_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (NodeReinstatement_ReinstatedBy_Field) _Column() string { return "reinstated_by" }
+
+type NodeReinstatement_Reason_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func NodeReinstatement_Reason(v string) NodeReinstatement_Reason_Field {
+	return NodeReinstatement_Reason_Field{_set: true, _value: v}
+}
+
+func (f NodeReinstatement_Reason_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (NodeReinstatement_Reason_Field) _Column() string { return "reason" }
+
+type NodeReinstatement_Probation_Field struct {
+	_set   bool
+	_null  bool
+	_value bool
+}
+
+func NodeReinstatement_Probation(v bool) NodeReinstatement_Probation_Field {
+	return NodeReinstatement_Probation_Field{_set: true, _value: v}
+}
 
-func (f Node_ExitLoopCompletedAt_Field) value() interface{} {
+func (f NodeReinstatement_Probation_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_ExitLoopCompletedAt_Field) _Column() string { return "exit_loop_completed_at" }
+func (NodeReinstatement_Probation_Field) _Column() string { return "probation" }
 
-type Node_ExitFinishedAt_Field struct {
+type NodeReinstatement_PreviousDisqualified_Field struct {
 	_set   bool
 	_null  bool
 	_value *time.Time
 }
 
-func Node_ExitFinishedAt(v time.Time) Node_ExitFinishedAt_Field {
-	return Node_ExitFinishedAt_Field{_set: true, _value: &v}
+func NodeReinstatement_PreviousDisqualified(v time.Time) NodeReinstatement_PreviousDisqualified_Field {
+	return NodeReinstatement_PreviousDisqualified_Field{_set: true, _value: &v}
 }
 
-func Node_ExitFinishedAt_Raw(v *time.Time) Node_ExitFinishedAt_Field {
+func NodeReinstatement_PreviousDisqualified_Raw(v *time.Time) NodeReinstatement_PreviousDisqualified_Field {
 	if v == nil {
-		return Node_ExitFinishedAt_Null()
+		return NodeReinstatement_PreviousDisqualified_Null()
 	}
-	return Node_ExitFinishedAt(*v)
+	return NodeReinstatement_PreviousDisqualified(*v)
 }
 
-func Node_ExitFinishedAt_Null() Node_ExitFinishedAt_Field {
-	return Node_ExitFinishedAt_Field{_set: true, _null: true}
+func NodeReinstatement_PreviousDisqualified_Null() NodeReinstatement_PreviousDisqualified_Field {
+	return NodeReinstatement_PreviousDisqualified_Field{_set: true, _null: true}
 }
 
-func (f Node_ExitFinishedAt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func (f NodeReinstatement_PreviousDisqualified_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
 
-func (f Node_ExitFinishedAt_Field) value() interface{} {
+func (f NodeReinstatement_PreviousDisqualified_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_ExitFinishedAt_Field) _Column() string { return "exit_finished_at" }
+func (NodeReinstatement_PreviousDisqualified_Field) _Column() string { return "previous_disqualified" }
 
-type Node_ExitSuccess_Field struct {
+type NodeReinstatement_PreviousDisqualificationReason_Field struct {
 	_set   bool
 	_null  bool
-	_value bool
+	_value *int
 }
 
-func Node_ExitSuccess(v bool) Node_ExitSuccess_Field {
-	return Node_ExitSuccess_Field{_set: true, _value: v}
+func NodeReinstatement_PreviousDisqualificationReason(v int) NodeReinstatement_PreviousDisqualificationReason_Field {
+	return NodeReinstatement_PreviousDisqualificationReason_Field{_set: true, _value: &v}
 }
 
-func (f Node_ExitSuccess_Field) value() interface{} {
+func NodeReinstatement_PreviousDisqualificationReason_Raw(v *int) NodeReinstatement_PreviousDisqualificationReason_Field {
+	if v == nil {
+		return NodeReinstatement_PreviousDisqualificationReason_Null()
+	}
+	return NodeReinstatement_PreviousDisqualificationReason(*v)
+}
+
+func NodeReinstatement_PreviousDisqualificationReason_Null() NodeReinstatement_PreviousDisqualificationReason_Field {
+	return NodeReinstatement_PreviousDisqualificationReason_Field{_set: true, _null: true}
+}
+
+func (f NodeReinstatement_PreviousDisqualificationReason_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f NodeReinstatement_PreviousDisqualificationReason_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_ExitSuccess_Field) _Column() string { return "exit_success" }
+func (NodeReinstatement_PreviousDisqualificationReason_Field) _Column() string {
+	return "previous_disqualification_reason"
+}
 
-type NodeApiVersion struct {
-	Id         []byte
-	ApiVersion int
-	CreatedAt  time.Time
-	UpdatedAt  time.Time
+type NodeReinstatement_PreviousUnknownAuditSuspended_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
 }
 
-func (NodeApiVersion) _Table() string { return "node_api_versions" }
+func NodeReinstatement_PreviousUnknownAuditSuspended(v time.Time) NodeReinstatement_PreviousUnknownAuditSuspended_Field {
+	return NodeReinstatement_PreviousUnknownAuditSuspended_Field{_set: true, _value: &v}
+}
 
-type NodeApiVersion_Update_Fields struct {
-	ApiVersion NodeApiVersion_ApiVersion_Field
+func NodeReinstatement_PreviousUnknownAuditSuspended_Raw(v *time.Time) NodeReinstatement_PreviousUnknownAuditSuspended_Field {
+	if v == nil {
+		return NodeReinstatement_PreviousUnknownAuditSuspended_Null()
+	}
+	return NodeReinstatement_PreviousUnknownAuditSuspended(*v)
 }
 
-type NodeApiVersion_Id_Field struct {
-	_set   bool
-	_null  bool
-	_value []byte
+func NodeReinstatement_PreviousUnknownAuditSuspended_Null() NodeReinstatement_PreviousUnknownAuditSuspended_Field {
+	return NodeReinstatement_PreviousUnknownAuditSuspended_Field{_set: true, _null: true}
 }
 
-func NodeApiVersion_Id(v []byte) NodeApiVersion_Id_Field {
-	return NodeApiVersion_Id_Field{_set: true, _value: v}
+func (f NodeReinstatement_PreviousUnknownAuditSuspended_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
 }
 
-func (f NodeApiVersion_Id_Field) value() interface{} {
+func (f NodeReinstatement_PreviousUnknownAuditSuspended_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (NodeApiVersion_Id_Field) _Column() string { return "id" }
+func (NodeReinstatement_PreviousUnknownAuditSuspended_Field) _Column() string {
+	return "previous_unknown_audit_suspended"
+}
 
-type NodeApiVersion_ApiVersion_Field struct {
+type NodeReinstatement_PreviousOfflineSuspended_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value *time.Time
 }
 
-func NodeApiVersion_ApiVersion(v int) NodeApiVersion_ApiVersion_Field {
-	return NodeApiVersion_ApiVersion_Field{_set: true, _value: v}
+func NodeReinstatement_PreviousOfflineSuspended(v time.Time) NodeReinstatement_PreviousOfflineSuspended_Field {
+	return NodeReinstatement_PreviousOfflineSuspended_Field{_set: true, _value: &v}
 }
 
-func (f NodeApiVersion_ApiVersion_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
+func NodeReinstatement_PreviousOfflineSuspended_Raw(v *time.Time) NodeReinstatement_PreviousOfflineSuspended_Field {
+	if v == nil {
+		return NodeReinstatement_PreviousOfflineSuspended_Null()
 	}
-	return f._value
+	return NodeReinstatement_PreviousOfflineSuspended(*v)
 }
 
-func (NodeApiVersion_ApiVersion_Field) _Column() string { return "api_version" }
-
-type NodeApiVersion_CreatedAt_Field struct {
-	_set   bool
-	_null  bool
-	_value time.Time
+func NodeReinstatement_PreviousOfflineSuspended_Null() NodeReinstatement_PreviousOfflineSuspended_Field {
+	return NodeReinstatement_PreviousOfflineSuspended_Field{_set: true, _null: true}
 }
 
-func NodeApiVersion_CreatedAt(v time.Time) NodeApiVersion_CreatedAt_Field {
-	return NodeApiVersion_CreatedAt_Field{_set: true, _value: v}
+func (f NodeReinstatement_PreviousOfflineSuspended_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
 }
 
-func (f NodeApiVersion_CreatedAt_Field) value() interface{} {
+func (f NodeReinstatement_PreviousOfflineSuspended_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (NodeApiVersion_CreatedAt_Field) _Column() string { return "created_at" }
+func (NodeReinstatement_PreviousOfflineSuspended_Field) _Column() string {
+	return "previous_offline_suspended"
+}
 
-type NodeApiVersion_UpdatedAt_Field struct {
+type NodeReinstatement_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
 	_value time.Time
 }
 
-func NodeApiVersion_UpdatedAt(v time.Time) NodeApiVersion_UpdatedAt_Field {
-	return NodeApiVersion_UpdatedAt_Field{_set: true, _value: v}
+func NodeReinstatement_CreatedAt(v time.Time) NodeReinstatement_CreatedAt_Field {
+	return NodeReinstatement_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f NodeApiVersion_UpdatedAt_Field) value() interface{} {
+func (f NodeReinstatement_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (NodeApiVersion_UpdatedAt_Field) _Column() string { return "updated_at" }
+func (NodeReinstatement_CreatedAt_Field) _Column() string { return "created_at" }
 
 type Offer struct {
 	Id                        int
@@ -4807,6 +6486,59 @@ func (f PeerIdentity_UpdatedAt_Field) value() interface{} {
 
 func (PeerIdentity_UpdatedAt_Field) _Column() string { return "updated_at" }
 
+type PieceAuditRequest struct {
+	NodeId      []byte
+	RequestedAt time.Time
+}
+
+func (PieceAuditRequest) _Table() string { return "piece_audit_requests" }
+
+type PieceAuditRequest_Create_Fields struct {
+	RequestedAt PieceAuditRequest_RequestedAt_Field
+}
+
+type PieceAuditRequest_Update_Fields struct {
+	RequestedAt PieceAuditRequest_RequestedAt_Field
+}
+
+type PieceAuditRequest_NodeId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func PieceAuditRequest_NodeId(v []byte) PieceAuditRequest_NodeId_Field {
+	return PieceAuditRequest_NodeId_Field{_set: true, _value: v}
+}
+
+func (f PieceAuditRequest_NodeId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (PieceAuditRequest_NodeId_Field) _Column() string { return "node_id" }
+
+type PieceAuditRequest_RequestedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func PieceAuditRequest_RequestedAt(v time.Time) PieceAuditRequest_RequestedAt_Field {
+	return PieceAuditRequest_RequestedAt_Field{_set: true, _value: v}
+}
+
+func (f PieceAuditRequest_RequestedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (PieceAuditRequest_RequestedAt_Field) _Column() string { return "requested_at" }
+
 type Project struct {
 	Id             []byte
 	Name           string
@@ -5462,6 +7194,7 @@ type RepairQueue struct {
 	UpdatedAt     time.Time
 	InsertedAt    time.Time
 	SegmentHealth float64
+	Reason        int
 }
 
 func (RepairQueue) _Table() string { return "repair_queue" }
@@ -5471,6 +7204,7 @@ type RepairQueue_Create_Fields struct {
 	UpdatedAt     RepairQueue_UpdatedAt_Field
 	InsertedAt    RepairQueue_InsertedAt_Field
 	SegmentHealth RepairQueue_SegmentHealth_Field
+	Reason        RepairQueue_Reason_Field
 }
 
 type RepairQueue_Update_Fields struct {
@@ -5605,6 +7339,25 @@ func (f RepairQueue_SegmentHealth_Field) value() interface{} {
 
 func (RepairQueue_SegmentHealth_Field) _Column() string { return "segment_health" }
 
+type RepairQueue_Reason_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func RepairQueue_Reason(v int) RepairQueue_Reason_Field {
+	return RepairQueue_Reason_Field{_set: true, _value: v}
+}
+
+func (f RepairQueue_Reason_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (RepairQueue_Reason_Field) _Column() string { return "reason" }
+
 type Reputation struct {
 	Id                          []byte
 	AuditSuccessCount           int64
@@ -8931,14 +10684,22 @@ type BucketMetainfo struct {
 	DefaultRedundancyOptimalShares  int
 	DefaultRedundancyTotalShares    int
 	Placement                       *int
+	Versioning                      *int
+	ObjectLockEnabled               *bool
+	LifecycleRules                  *string
+	Notifications                   *string
 }
 
 func (BucketMetainfo) _Table() string { return "bucket_metainfos" }
 
 type BucketMetainfo_Create_Fields struct {
-	PartnerId BucketMetainfo_PartnerId_Field
-	UserAgent BucketMetainfo_UserAgent_Field
-	Placement BucketMetainfo_Placement_Field
+	PartnerId         BucketMetainfo_PartnerId_Field
+	UserAgent         BucketMetainfo_UserAgent_Field
+	Placement         BucketMetainfo_Placement_Field
+	Versioning        BucketMetainfo_Versioning_Field
+	ObjectLockEnabled BucketMetainfo_ObjectLockEnabled_Field
+	LifecycleRules    BucketMetainfo_LifecycleRules_Field
+	Notifications     BucketMetainfo_Notifications_Field
 }
 
 type BucketMetainfo_Update_Fields struct {
@@ -8954,6 +10715,10 @@ type BucketMetainfo_Update_Fields struct {
 	DefaultRedundancyOptimalShares  BucketMetainfo_DefaultRedundancyOptimalShares_Field
 	DefaultRedundancyTotalShares    BucketMetainfo_DefaultRedundancyTotalShares_Field
 	Placement                       BucketMetainfo_Placement_Field
+	Versioning                      BucketMetainfo_Versioning_Field
+	ObjectLockEnabled               BucketMetainfo_ObjectLockEnabled_Field
+	LifecycleRules                  BucketMetainfo_LifecycleRules_Field
+	Notifications                   BucketMetainfo_Notifications_Field
 }
 
 type BucketMetainfo_Id_Field struct {
@@ -9224,115 +10989,249 @@ type BucketMetainfo_DefaultRedundancyRequiredShares_Field struct {
 	_value int
 }
 
-func BucketMetainfo_DefaultRedundancyRequiredShares(v int) BucketMetainfo_DefaultRedundancyRequiredShares_Field {
-	return BucketMetainfo_DefaultRedundancyRequiredShares_Field{_set: true, _value: v}
+func BucketMetainfo_DefaultRedundancyRequiredShares(v int) BucketMetainfo_DefaultRedundancyRequiredShares_Field {
+	return BucketMetainfo_DefaultRedundancyRequiredShares_Field{_set: true, _value: v}
+}
+
+func (f BucketMetainfo_DefaultRedundancyRequiredShares_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketMetainfo_DefaultRedundancyRequiredShares_Field) _Column() string {
+	return "default_redundancy_required_shares"
+}
+
+type BucketMetainfo_DefaultRedundancyRepairShares_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func BucketMetainfo_DefaultRedundancyRepairShares(v int) BucketMetainfo_DefaultRedundancyRepairShares_Field {
+	return BucketMetainfo_DefaultRedundancyRepairShares_Field{_set: true, _value: v}
+}
+
+func (f BucketMetainfo_DefaultRedundancyRepairShares_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketMetainfo_DefaultRedundancyRepairShares_Field) _Column() string {
+	return "default_redundancy_repair_shares"
+}
+
+type BucketMetainfo_DefaultRedundancyOptimalShares_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func BucketMetainfo_DefaultRedundancyOptimalShares(v int) BucketMetainfo_DefaultRedundancyOptimalShares_Field {
+	return BucketMetainfo_DefaultRedundancyOptimalShares_Field{_set: true, _value: v}
+}
+
+func (f BucketMetainfo_DefaultRedundancyOptimalShares_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketMetainfo_DefaultRedundancyOptimalShares_Field) _Column() string {
+	return "default_redundancy_optimal_shares"
+}
+
+type BucketMetainfo_DefaultRedundancyTotalShares_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func BucketMetainfo_DefaultRedundancyTotalShares(v int) BucketMetainfo_DefaultRedundancyTotalShares_Field {
+	return BucketMetainfo_DefaultRedundancyTotalShares_Field{_set: true, _value: v}
+}
+
+func (f BucketMetainfo_DefaultRedundancyTotalShares_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketMetainfo_DefaultRedundancyTotalShares_Field) _Column() string {
+	return "default_redundancy_total_shares"
+}
+
+type BucketMetainfo_Placement_Field struct {
+	_set   bool
+	_null  bool
+	_value *int
+}
+
+func BucketMetainfo_Placement(v int) BucketMetainfo_Placement_Field {
+	return BucketMetainfo_Placement_Field{_set: true, _value: &v}
+}
+
+func BucketMetainfo_Placement_Raw(v *int) BucketMetainfo_Placement_Field {
+	if v == nil {
+		return BucketMetainfo_Placement_Null()
+	}
+	return BucketMetainfo_Placement(*v)
+}
+
+func BucketMetainfo_Placement_Null() BucketMetainfo_Placement_Field {
+	return BucketMetainfo_Placement_Field{_set: true, _null: true}
+}
+
+func (f BucketMetainfo_Placement_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f BucketMetainfo_Placement_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketMetainfo_Placement_Field) _Column() string { return "placement" }
+
+type BucketMetainfo_Versioning_Field struct {
+	_set   bool
+	_null  bool
+	_value *int
+}
+
+func BucketMetainfo_Versioning(v int) BucketMetainfo_Versioning_Field {
+	return BucketMetainfo_Versioning_Field{_set: true, _value: &v}
+}
+
+func BucketMetainfo_Versioning_Raw(v *int) BucketMetainfo_Versioning_Field {
+	if v == nil {
+		return BucketMetainfo_Versioning_Null()
+	}
+	return BucketMetainfo_Versioning(*v)
+}
+
+func BucketMetainfo_Versioning_Null() BucketMetainfo_Versioning_Field {
+	return BucketMetainfo_Versioning_Field{_set: true, _null: true}
 }
 
-func (f BucketMetainfo_DefaultRedundancyRequiredShares_Field) value() interface{} {
+func (f BucketMetainfo_Versioning_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f BucketMetainfo_Versioning_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketMetainfo_DefaultRedundancyRequiredShares_Field) _Column() string {
-	return "default_redundancy_required_shares"
-}
+func (BucketMetainfo_Versioning_Field) _Column() string { return "versioning" }
 
-type BucketMetainfo_DefaultRedundancyRepairShares_Field struct {
+type BucketMetainfo_ObjectLockEnabled_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value *bool
 }
 
-func BucketMetainfo_DefaultRedundancyRepairShares(v int) BucketMetainfo_DefaultRedundancyRepairShares_Field {
-	return BucketMetainfo_DefaultRedundancyRepairShares_Field{_set: true, _value: v}
+func BucketMetainfo_ObjectLockEnabled(v bool) BucketMetainfo_ObjectLockEnabled_Field {
+	return BucketMetainfo_ObjectLockEnabled_Field{_set: true, _value: &v}
 }
 
-func (f BucketMetainfo_DefaultRedundancyRepairShares_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
+func BucketMetainfo_ObjectLockEnabled_Raw(v *bool) BucketMetainfo_ObjectLockEnabled_Field {
+	if v == nil {
+		return BucketMetainfo_ObjectLockEnabled_Null()
 	}
-	return f._value
+	return BucketMetainfo_ObjectLockEnabled(*v)
 }
 
-func (BucketMetainfo_DefaultRedundancyRepairShares_Field) _Column() string {
-	return "default_redundancy_repair_shares"
-}
-
-type BucketMetainfo_DefaultRedundancyOptimalShares_Field struct {
-	_set   bool
-	_null  bool
-	_value int
+func BucketMetainfo_ObjectLockEnabled_Null() BucketMetainfo_ObjectLockEnabled_Field {
+	return BucketMetainfo_ObjectLockEnabled_Field{_set: true, _null: true}
 }
 
-func BucketMetainfo_DefaultRedundancyOptimalShares(v int) BucketMetainfo_DefaultRedundancyOptimalShares_Field {
-	return BucketMetainfo_DefaultRedundancyOptimalShares_Field{_set: true, _value: v}
+func (f BucketMetainfo_ObjectLockEnabled_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
 }
 
-func (f BucketMetainfo_DefaultRedundancyOptimalShares_Field) value() interface{} {
+func (f BucketMetainfo_ObjectLockEnabled_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketMetainfo_DefaultRedundancyOptimalShares_Field) _Column() string {
-	return "default_redundancy_optimal_shares"
-}
+func (BucketMetainfo_ObjectLockEnabled_Field) _Column() string { return "object_lock_enabled" }
 
-type BucketMetainfo_DefaultRedundancyTotalShares_Field struct {
+type BucketMetainfo_LifecycleRules_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value *string
 }
 
-func BucketMetainfo_DefaultRedundancyTotalShares(v int) BucketMetainfo_DefaultRedundancyTotalShares_Field {
-	return BucketMetainfo_DefaultRedundancyTotalShares_Field{_set: true, _value: v}
+func BucketMetainfo_LifecycleRules(v string) BucketMetainfo_LifecycleRules_Field {
+	return BucketMetainfo_LifecycleRules_Field{_set: true, _value: &v}
 }
 
-func (f BucketMetainfo_DefaultRedundancyTotalShares_Field) value() interface{} {
+func BucketMetainfo_LifecycleRules_Raw(v *string) BucketMetainfo_LifecycleRules_Field {
+	if v == nil {
+		return BucketMetainfo_LifecycleRules_Null()
+	}
+	return BucketMetainfo_LifecycleRules(*v)
+}
+
+func BucketMetainfo_LifecycleRules_Null() BucketMetainfo_LifecycleRules_Field {
+	return BucketMetainfo_LifecycleRules_Field{_set: true, _null: true}
+}
+
+func (f BucketMetainfo_LifecycleRules_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f BucketMetainfo_LifecycleRules_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketMetainfo_DefaultRedundancyTotalShares_Field) _Column() string {
-	return "default_redundancy_total_shares"
-}
+func (BucketMetainfo_LifecycleRules_Field) _Column() string { return "lifecycle_rules" }
 
-type BucketMetainfo_Placement_Field struct {
+type BucketMetainfo_Notifications_Field struct {
 	_set   bool
 	_null  bool
-	_value *int
+	_value *string
 }
 
-func BucketMetainfo_Placement(v int) BucketMetainfo_Placement_Field {
-	return BucketMetainfo_Placement_Field{_set: true, _value: &v}
+func BucketMetainfo_Notifications(v string) BucketMetainfo_Notifications_Field {
+	return BucketMetainfo_Notifications_Field{_set: true, _value: &v}
 }
 
-func BucketMetainfo_Placement_Raw(v *int) BucketMetainfo_Placement_Field {
+func BucketMetainfo_Notifications_Raw(v *string) BucketMetainfo_Notifications_Field {
 	if v == nil {
-		return BucketMetainfo_Placement_Null()
+		return BucketMetainfo_Notifications_Null()
 	}
-	return BucketMetainfo_Placement(*v)
+	return BucketMetainfo_Notifications(*v)
 }
 
-func BucketMetainfo_Placement_Null() BucketMetainfo_Placement_Field {
-	return BucketMetainfo_Placement_Field{_set: true, _null: true}
+func BucketMetainfo_Notifications_Null() BucketMetainfo_Notifications_Field {
+	return BucketMetainfo_Notifications_Field{_set: true, _null: true}
 }
 
-func (f BucketMetainfo_Placement_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func (f BucketMetainfo_Notifications_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
 
-func (f BucketMetainfo_Placement_Field) value() interface{} {
+func (f BucketMetainfo_Notifications_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketMetainfo_Placement_Field) _Column() string { return "placement" }
+func (BucketMetainfo_Notifications_Field) _Column() string { return "notifications" }
 
 type ProjectMember struct {
 	MemberId  []byte
@@ -10130,6 +12029,10 @@ type LeafSerialNumber_Row struct {
 	LeafSerialNumber []byte
 }
 
+type LifecycleRules_Row struct {
+	LifecycleRules *string
+}
+
 type MaxBuckets_Row struct {
 	MaxBuckets *int
 }
@@ -10183,6 +12086,14 @@ type Paged_StoragenodeBandwidthRollup_By_StoragenodeId_And_IntervalStart_Greater
 	_set                  bool
 }
 
+type Notifications_Row struct {
+	Notifications *string
+}
+
+type ObjectLockEnabled_Row struct {
+	ObjectLockEnabled *bool
+}
+
 type Placement_Row struct {
 	Placement *int
 }
@@ -10204,6 +12115,10 @@ type Value_Row struct {
 	Value time.Time
 }
 
+type Versioning_Row struct {
+	Versioning *int
+}
+
 func (obj *pgxImpl) Create_ValueAttribution(ctx context.Context,
 	value_attribution_project_id ValueAttribution_ProjectId_Field,
 	value_attribution_bucket_name ValueAttribution_BucketName_Field,
@@ -10855,17 +12770,21 @@ func (obj *pgxImpl) Create_BucketMetainfo(ctx context.Context,
 	__default_redundancy_optimal_shares_val := bucket_metainfo_default_redundancy_optimal_shares.value()
 	__default_redundancy_total_shares_val := bucket_metainfo_default_redundancy_total_shares.value()
 	__placement_val := optional.Placement.value()
+	__versioning_val := optional.Versioning.value()
+	__object_lock_enabled_val := optional.ObjectLockEnabled.value()
+	__lifecycle_rules_val := optional.LifecycleRules.value()
+	__notifications_val := optional.Notifications.value()
 
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO bucket_metainfos ( id, project_id, name, partner_id, user_agent, path_cipher, created_at, default_segment_size, default_encryption_cipher_suite, default_encryption_block_size, default_redundancy_algorithm, default_redundancy_share_size, default_redundancy_required_shares, default_redundancy_repair_shares, default_redundancy_optimal_shares, default_redundancy_total_shares, placement ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ? ) RETURNING bucket_metainfos.id, bucket_metainfos.project_id, bucket_metainfos.name, bucket_metainfos.partner_id, bucket_metainfos.user_agent, bucket_metainfos.path_cipher, bucket_metainfos.created_at, bucket_metainfos.default_segment_size, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.default_redundancy_algorithm, bucket_metainfos.default_redundancy_share_size, bucket_metainfos.default_redundancy_required_shares, bucket_metainfos.default_redundancy_repair_shares, bucket_metainfos.default_redundancy_optimal_shares, bucket_metainfos.default_redundancy_total_shares, bucket_metainfos.placement")
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO bucket_metainfos ( id, project_id, name, partner_id, user_agent, path_cipher, created_at, default_segment_size, default_encryption_cipher_suite, default_encryption_block_size, default_redundancy_algorithm, default_redundancy_share_size, default_redundancy_required_shares, default_redundancy_repair_shares, default_redundancy_optimal_shares, default_redundancy_total_shares, placement, versioning, object_lock_enabled, lifecycle_rules, notifications ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ? ) RETURNING bucket_metainfos.id, bucket_metainfos.project_id, bucket_metainfos.name, bucket_metainfos.partner_id, bucket_metainfos.user_agent, bucket_metainfos.path_cipher, bucket_metainfos.created_at, bucket_metainfos.default_segment_size, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.default_redundancy_algorithm, bucket_metainfos.default_redundancy_share_size, bucket_metainfos.default_redundancy_required_shares, bucket_metainfos.default_redundancy_repair_shares, bucket_metainfos.default_redundancy_optimal_shares, bucket_metainfos.default_redundancy_total_shares, bucket_metainfos.placement, bucket_metainfos.versioning, bucket_metainfos.object_lock_enabled, bucket_metainfos.lifecycle_rules, bucket_metainfos.notifications")
 
 	var __values []interface{}
-	__values = append(__values, __id_val, __project_id_val, __name_val, __partner_id_val, __user_agent_val, __path_cipher_val, __created_at_val, __default_segment_size_val, __default_encryption_cipher_suite_val, __default_encryption_block_size_val, __default_redundancy_algorithm_val, __default_redundancy_share_size_val, __default_redundancy_required_shares_val, __default_redundancy_repair_shares_val, __default_redundancy_optimal_shares_val, __default_redundancy_total_shares_val, __placement_val)
+	__values = append(__values, __id_val, __project_id_val, __name_val, __partner_id_val, __user_agent_val, __path_cipher_val, __created_at_val, __default_segment_size_val, __default_encryption_cipher_suite_val, __default_encryption_block_size_val, __default_redundancy_algorithm_val, __default_redundancy_share_size_val, __default_redundancy_required_shares_val, __default_redundancy_repair_shares_val, __default_redundancy_optimal_shares_val, __default_redundancy_total_shares_val, __placement_val, __versioning_val, __object_lock_enabled_val, __lifecycle_rules_val, __notifications_val)
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
 	bucket_metainfo = &BucketMetainfo{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&bucket_metainfo.Id, &bucket_metainfo.ProjectId, &bucket_metainfo.Name, &bucket_metainfo.PartnerId, &bucket_metainfo.UserAgent, &bucket_metainfo.PathCipher, &bucket_metainfo.CreatedAt, &bucket_metainfo.DefaultSegmentSize, &bucket_metainfo.DefaultEncryptionCipherSuite, &bucket_metainfo.DefaultEncryptionBlockSize, &bucket_metainfo.DefaultRedundancyAlgorithm, &bucket_metainfo.DefaultRedundancyShareSize, &bucket_metainfo.DefaultRedundancyRequiredShares, &bucket_metainfo.DefaultRedundancyRepairShares, &bucket_metainfo.DefaultRedundancyOptimalShares, &bucket_metainfo.DefaultRedundancyTotalShares, &bucket_metainfo.Placement)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&bucket_metainfo.Id, &bucket_metainfo.ProjectId, &bucket_metainfo.Name, &bucket_metainfo.PartnerId, &bucket_metainfo.UserAgent, &bucket_metainfo.PathCipher, &bucket_metainfo.CreatedAt, &bucket_metainfo.DefaultSegmentSize, &bucket_metainfo.DefaultEncryptionCipherSuite, &bucket_metainfo.DefaultEncryptionBlockSize, &bucket_metainfo.DefaultRedundancyAlgorithm, &bucket_metainfo.DefaultRedundancyShareSize, &bucket_metainfo.DefaultRedundancyRequiredShares, &bucket_metainfo.DefaultRedundancyRepairShares, &bucket_metainfo.DefaultRedundancyOptimalShares, &bucket_metainfo.DefaultRedundancyTotalShares, &bucket_metainfo.Placement, &bucket_metainfo.Versioning, &bucket_metainfo.ObjectLockEnabled, &bucket_metainfo.LifecycleRules, &bucket_metainfo.Notifications)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -11219,7 +13138,7 @@ func (obj *pgxImpl) Get_Node_By_Id(ctx context.Context,
 	node *Node, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.suspended, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success FROM nodes WHERE nodes.id = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.tags, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.ingress_load, nodes.egress_load, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.suspended, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success FROM nodes WHERE nodes.id = ?")
 
 	var __values []interface{}
 	__values = append(__values, node_id.value())
@@ -11228,7 +13147,7 @@ func (obj *pgxImpl) Get_Node_By_Id(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	node = &Node{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpPort, &node.CountryCode, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.WalletFeatures, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.Hash, &node.Timestamp, &node.Release, &node.Latency90, &node.VettedAt, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Disqualified, &node.DisqualificationReason, &node.Suspended, &node.UnknownAuditSuspended, &node.OfflineSuspended, &node.UnderReview, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpPort, &node.CountryCode, &node.Tags, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.WalletFeatures, &node.FreeDisk, &node.PieceCount, &node.IngressLoad, &node.EgressLoad, &node.Major, &node.Minor, &node.Patch, &node.Hash, &node.Timestamp, &node.Release, &node.Latency90, &node.VettedAt, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Disqualified, &node.DisqualificationReason, &node.Suspended, &node.UnknownAuditSuspended, &node.OfflineSuspended, &node.UnderReview, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess)
 	if err != nil {
 		return (*Node)(nil), obj.makeErr(err)
 	}
@@ -11284,9 +13203,9 @@ func (obj *pgxImpl) Paged_Node(ctx context.Context,
 	rows []*Node, next *Paged_Node_Continuation, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.suspended, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.id FROM nodes WHERE (nodes.id) > ? ORDER BY nodes.id LIMIT ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.tags, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.ingress_load, nodes.egress_load, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.suspended, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.id FROM nodes WHERE (nodes.id) > ? ORDER BY nodes.id LIMIT ?")
 
-	var __embed_first_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.suspended, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.id FROM nodes ORDER BY nodes.id LIMIT ?")
+	var __embed_first_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.tags, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.ingress_load, nodes.egress_load, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.suspended, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.id FROM nodes ORDER BY nodes.id LIMIT ?")
 
 	var __values []interface{}
 
@@ -11313,7 +13232,7 @@ func (obj *pgxImpl) Paged_Node(ctx context.Context,
 
 			for __rows.Next() {
 				node := &Node{}
-				err = __rows.Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpPort, &node.CountryCode, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.WalletFeatures, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.Hash, &node.Timestamp, &node.Release, &node.Latency90, &node.VettedAt, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Disqualified, &node.DisqualificationReason, &node.Suspended, &node.UnknownAuditSuspended, &node.OfflineSuspended, &node.UnderReview, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess, &__continuation._value_id)
+				err = __rows.Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpPort, &node.CountryCode, &node.Tags, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.WalletFeatures, &node.FreeDisk, &node.PieceCount, &node.IngressLoad, &node.EgressLoad, &node.Major, &node.Minor, &node.Patch, &node.Hash, &node.Timestamp, &node.Release, &node.Latency90, &node.VettedAt, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Disqualified, &node.DisqualificationReason, &node.Suspended, &node.UnknownAuditSuspended, &node.OfflineSuspended, &node.UnderReview, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess, &__continuation._value_id)
 				if err != nil {
 					return nil, nil, err
 				}
@@ -12921,7 +14840,7 @@ func (obj *pgxImpl) Get_BucketMetainfo_By_ProjectId_And_Name(ctx context.Context
 	bucket_metainfo *BucketMetainfo, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_metainfos.id, bucket_metainfos.project_id, bucket_metainfos.name, bucket_metainfos.partner_id, bucket_metainfos.user_agent, bucket_metainfos.path_cipher, bucket_metainfos.created_at, bucket_metainfos.default_segment_size, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.default_redundancy_algorithm, bucket_metainfos.default_redundancy_share_size, bucket_metainfos.default_redundancy_required_shares, bucket_metainfos.default_redundancy_repair_shares, bucket_metainfos.default_redundancy_optimal_shares, bucket_metainfos.default_redundancy_total_shares, bucket_metainfos.placement FROM bucket_metainfos WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_metainfos.id, bucket_metainfos.project_id, bucket_metainfos.name, bucket_metainfos.partner_id, bucket_metainfos.user_agent, bucket_metainfos.path_cipher, bucket_metainfos.created_at, bucket_metainfos.default_segment_size, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.default_redundancy_algorithm, bucket_metainfos.default_redundancy_share_size, bucket_metainfos.default_redundancy_required_shares, bucket_metainfos.default_redundancy_repair_shares, bucket_metainfos.default_redundancy_optimal_shares, bucket_metainfos.default_redundancy_total_shares, bucket_metainfos.placement, bucket_metainfos.versioning, bucket_metainfos.object_lock_enabled, bucket_metainfos.lifecycle_rules, bucket_metainfos.notifications FROM bucket_metainfos WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name = ?")
 
 	var __values []interface{}
 	__values = append(__values, bucket_metainfo_project_id.value(), bucket_metainfo_name.value())
@@ -12930,7 +14849,7 @@ func (obj *pgxImpl) Get_BucketMetainfo_By_ProjectId_And_Name(ctx context.Context
 	obj.logStmt(__stmt, __values...)
 
 	bucket_metainfo = &BucketMetainfo{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&bucket_metainfo.Id, &bucket_metainfo.ProjectId, &bucket_metainfo.Name, &bucket_metainfo.PartnerId, &bucket_metainfo.UserAgent, &bucket_metainfo.PathCipher, &bucket_metainfo.CreatedAt, &bucket_metainfo.DefaultSegmentSize, &bucket_metainfo.DefaultEncryptionCipherSuite, &bucket_metainfo.DefaultEncryptionBlockSize, &bucket_metainfo.DefaultRedundancyAlgorithm, &bucket_metainfo.DefaultRedundancyShareSize, &bucket_metainfo.DefaultRedundancyRequiredShares, &bucket_metainfo.DefaultRedundancyRepairShares, &bucket_metainfo.DefaultRedundancyOptimalShares, &bucket_metainfo.DefaultRedundancyTotalShares, &bucket_metainfo.Placement)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&bucket_metainfo.Id, &bucket_metainfo.ProjectId, &bucket_metainfo.Name, &bucket_metainfo.PartnerId, &bucket_metainfo.UserAgent, &bucket_metainfo.PathCipher, &bucket_metainfo.CreatedAt, &bucket_metainfo.DefaultSegmentSize, &bucket_metainfo.DefaultEncryptionCipherSuite, &bucket_metainfo.DefaultEncryptionBlockSize, &bucket_metainfo.DefaultRedundancyAlgorithm, &bucket_metainfo.DefaultRedundancyShareSize, &bucket_metainfo.DefaultRedundancyRequiredShares, &bucket_metainfo.DefaultRedundancyRepairShares, &bucket_metainfo.DefaultRedundancyOptimalShares, &bucket_metainfo.DefaultRedundancyTotalShares, &bucket_metainfo.Placement, &bucket_metainfo.Versioning, &bucket_metainfo.ObjectLockEnabled, &bucket_metainfo.LifecycleRules, &bucket_metainfo.Notifications)
 	if err != nil {
 		return (*BucketMetainfo)(nil), obj.makeErr(err)
 	}
@@ -13007,6 +14926,98 @@ func (obj *pgxImpl) Get_BucketMetainfo_Placement_By_ProjectId_And_Name(ctx conte
 
 }
 
+func (obj *pgxImpl) Get_BucketMetainfo_Versioning_By_ProjectId_And_Name(ctx context.Context,
+	bucket_metainfo_project_id BucketMetainfo_ProjectId_Field,
+	bucket_metainfo_name BucketMetainfo_Name_Field) (
+	row *Versioning_Row, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_metainfos.versioning FROM bucket_metainfos WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name = ?")
+
+	var __values []interface{}
+	__values = append(__values, bucket_metainfo_project_id.value(), bucket_metainfo_name.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	row = &Versioning_Row{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&row.Versioning)
+	if err != nil {
+		return (*Versioning_Row)(nil), obj.makeErr(err)
+	}
+	return row, nil
+
+}
+
+func (obj *pgxImpl) Get_BucketMetainfo_ObjectLockEnabled_By_ProjectId_And_Name(ctx context.Context,
+	bucket_metainfo_project_id BucketMetainfo_ProjectId_Field,
+	bucket_metainfo_name BucketMetainfo_Name_Field) (
+	row *ObjectLockEnabled_Row, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_metainfos.object_lock_enabled FROM bucket_metainfos WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name = ?")
+
+	var __values []interface{}
+	__values = append(__values, bucket_metainfo_project_id.value(), bucket_metainfo_name.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	row = &ObjectLockEnabled_Row{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&row.ObjectLockEnabled)
+	if err != nil {
+		return (*ObjectLockEnabled_Row)(nil), obj.makeErr(err)
+	}
+	return row, nil
+
+}
+
+func (obj *pgxImpl) Get_BucketMetainfo_LifecycleRules_By_ProjectId_And_Name(ctx context.Context,
+	bucket_metainfo_project_id BucketMetainfo_ProjectId_Field,
+	bucket_metainfo_name BucketMetainfo_Name_Field) (
+	row *LifecycleRules_Row, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_metainfos.lifecycle_rules FROM bucket_metainfos WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name = ?")
+
+	var __values []interface{}
+	__values = append(__values, bucket_metainfo_project_id.value(), bucket_metainfo_name.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	row = &LifecycleRules_Row{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&row.LifecycleRules)
+	if err != nil {
+		return (*LifecycleRules_Row)(nil), obj.makeErr(err)
+	}
+	return row, nil
+
+}
+
+func (obj *pgxImpl) Get_BucketMetainfo_Notifications_By_ProjectId_And_Name(ctx context.Context,
+	bucket_metainfo_project_id BucketMetainfo_ProjectId_Field,
+	bucket_metainfo_name BucketMetainfo_Name_Field) (
+	row *Notifications_Row, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_metainfos.notifications FROM bucket_metainfos WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name = ?")
+
+	var __values []interface{}
+	__values = append(__values, bucket_metainfo_project_id.value(), bucket_metainfo_name.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	row = &Notifications_Row{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&row.Notifications)
+	if err != nil {
+		return (*Notifications_Row)(nil), obj.makeErr(err)
+	}
+	return row, nil
+
+}
+
 func (obj *pgxImpl) Has_BucketMetainfo_By_ProjectId_And_Name(ctx context.Context,
 	bucket_metainfo_project_id BucketMetainfo_ProjectId_Field,
 	bucket_metainfo_name BucketMetainfo_Name_Field) (
@@ -13036,7 +15047,7 @@ func (obj *pgxImpl) Limited_BucketMetainfo_By_ProjectId_And_Name_GreaterOrEqual_
 	rows []*BucketMetainfo, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_metainfos.id, bucket_metainfos.project_id, bucket_metainfos.name, bucket_metainfos.partner_id, bucket_metainfos.user_agent, bucket_metainfos.path_cipher, bucket_metainfos.created_at, bucket_metainfos.default_segment_size, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.default_redundancy_algorithm, bucket_metainfos.default_redundancy_share_size, bucket_metainfos.default_redundancy_required_shares, bucket_metainfos.default_redundancy_repair_shares, bucket_metainfos.default_redundancy_optimal_shares, bucket_metainfos.default_redundancy_total_shares, bucket_metainfos.placement FROM bucket_metainfos WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name >= ? ORDER BY bucket_metainfos.name LIMIT ? OFFSET ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_metainfos.id, bucket_metainfos.project_id, bucket_metainfos.name, bucket_metainfos.partner_id, bucket_metainfos.user_agent, bucket_metainfos.path_cipher, bucket_metainfos.created_at, bucket_metainfos.default_segment_size, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.default_redundancy_algorithm, bucket_metainfos.default_redundancy_share_size, bucket_metainfos.default_redundancy_required_shares, bucket_metainfos.default_redundancy_repair_shares, bucket_metainfos.default_redundancy_optimal_shares, bucket_metainfos.default_redundancy_total_shares, bucket_metainfos.placement, bucket_metainfos.versioning, bucket_metainfos.object_lock_enabled, bucket_metainfos.lifecycle_rules, bucket_metainfos.notifications FROM bucket_metainfos WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name >= ? ORDER BY bucket_metainfos.name LIMIT ? OFFSET ?")
 
 	var __values []interface{}
 	__values = append(__values, bucket_metainfo_project_id.value(), bucket_metainfo_name_greater_or_equal.value())
@@ -13056,7 +15067,7 @@ func (obj *pgxImpl) Limited_BucketMetainfo_By_ProjectId_And_Name_GreaterOrEqual_
 
 			for __rows.Next() {
 				bucket_metainfo := &BucketMetainfo{}
-				err = __rows.Scan(&bucket_metainfo.Id, &bucket_metainfo.ProjectId, &bucket_metainfo.Name, &bucket_metainfo.PartnerId, &bucket_metainfo.UserAgent, &bucket_metainfo.PathCipher, &bucket_metainfo.CreatedAt, &bucket_metainfo.DefaultSegmentSize, &bucket_metainfo.DefaultEncryptionCipherSuite, &bucket_metainfo.DefaultEncryptionBlockSize, &bucket_metainfo.DefaultRedundancyAlgorithm, &bucket_metainfo.DefaultRedundancyShareSize, &bucket_metainfo.DefaultRedundancyRequiredShares, &bucket_metainfo.DefaultRedundancyRepairShares, &bucket_metainfo.DefaultRedundancyOptimalShares, &bucket_metainfo.DefaultRedundancyTotalShares, &bucket_metainfo.Placement)
+				err = __rows.Scan(&bucket_metainfo.Id, &bucket_metainfo.ProjectId, &bucket_metainfo.Name, &bucket_metainfo.PartnerId, &bucket_metainfo.UserAgent, &bucket_metainfo.PathCipher, &bucket_metainfo.CreatedAt, &bucket_metainfo.DefaultSegmentSize, &bucket_metainfo.DefaultEncryptionCipherSuite, &bucket_metainfo.DefaultEncryptionBlockSize, &bucket_metainfo.DefaultRedundancyAlgorithm, &bucket_metainfo.DefaultRedundancyShareSize, &bucket_metainfo.DefaultRedundancyRequiredShares, &bucket_metainfo.DefaultRedundancyRepairShares, &bucket_metainfo.DefaultRedundancyOptimalShares, &bucket_metainfo.DefaultRedundancyTotalShares, &bucket_metainfo.Placement, &bucket_metainfo.Versioning, &bucket_metainfo.ObjectLockEnabled, &bucket_metainfo.LifecycleRules, &bucket_metainfo.Notifications)
 				if err != nil {
 					return nil, err
 				}
@@ -13086,7 +15097,7 @@ func (obj *pgxImpl) Limited_BucketMetainfo_By_ProjectId_And_Name_Greater_OrderBy
 	rows []*BucketMetainfo, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_metainfos.id, bucket_metainfos.project_id, bucket_metainfos.name, bucket_metainfos.partner_id, bucket_metainfos.user_agent, bucket_metainfos.path_cipher, bucket_metainfos.created_at, bucket_metainfos.default_segment_size, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.default_redundancy_algorithm, bucket_metainfos.default_redundancy_share_size, bucket_metainfos.default_redundancy_required_shares, bucket_metainfos.default_redundancy_repair_shares, bucket_metainfos.default_redundancy_optimal_shares, bucket_metainfos.default_redundancy_total_shares, bucket_metainfos.placement FROM bucket_metainfos WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name > ? ORDER BY bucket_metainfos.name LIMIT ? OFFSET ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_metainfos.id, bucket_metainfos.project_id, bucket_metainfos.name, bucket_metainfos.partner_id, bucket_metainfos.user_agent, bucket_metainfos.path_cipher, bucket_metainfos.created_at, bucket_metainfos.default_segment_size, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.default_redundancy_algorithm, bucket_metainfos.default_redundancy_share_size, bucket_metainfos.default_redundancy_required_shares, bucket_metainfos.default_redundancy_repair_shares, bucket_metainfos.default_redundancy_optimal_shares, bucket_metainfos.default_redundancy_total_shares, bucket_metainfos.placement, bucket_metainfos.versioning, bucket_metainfos.object_lock_enabled, bucket_metainfos.lifecycle_rules, bucket_metainfos.notifications FROM bucket_metainfos WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name > ? ORDER BY bucket_metainfos.name LIMIT ? OFFSET ?")
 
 	var __values []interface{}
 	__values = append(__values, bucket_metainfo_project_id.value(), bucket_metainfo_name_greater.value())
@@ -13106,7 +15117,7 @@ func (obj *pgxImpl) Limited_BucketMetainfo_By_ProjectId_And_Name_Greater_OrderBy
 
 			for __rows.Next() {
 				bucket_metainfo := &BucketMetainfo{}
-				err = __rows.Scan(&bucket_metainfo.Id, &bucket_metainfo.ProjectId, &bucket_metainfo.Name, &bucket_metainfo.PartnerId, &bucket_metainfo.UserAgent, &bucket_metainfo.PathCipher, &bucket_metainfo.CreatedAt, &bucket_metainfo.DefaultSegmentSize, &bucket_metainfo.DefaultEncryptionCipherSuite, &bucket_metainfo.DefaultEncryptionBlockSize, &bucket_metainfo.DefaultRedundancyAlgorithm, &bucket_metainfo.DefaultRedundancyShareSize, &bucket_metainfo.DefaultRedundancyRequiredShares, &bucket_metainfo.DefaultRedundancyRepairShares, &bucket_metainfo.DefaultRedundancyOptimalShares, &bucket_metainfo.DefaultRedundancyTotalShares, &bucket_metainfo.Placement)
+				err = __rows.Scan(&bucket_metainfo.Id, &bucket_metainfo.ProjectId, &bucket_metainfo.Name, &bucket_metainfo.PartnerId, &bucket_metainfo.UserAgent, &bucket_metainfo.PathCipher, &bucket_metainfo.CreatedAt, &bucket_metainfo.DefaultSegmentSize, &bucket_metainfo.DefaultEncryptionCipherSuite, &bucket_metainfo.DefaultEncryptionBlockSize, &bucket_metainfo.DefaultRedundancyAlgorithm, &bucket_metainfo.DefaultRedundancyShareSize, &bucket_metainfo.DefaultRedundancyRequiredShares, &bucket_metainfo.DefaultRedundancyRepairShares, &bucket_metainfo.DefaultRedundancyOptimalShares, &bucket_metainfo.DefaultRedundancyTotalShares, &bucket_metainfo.Placement, &bucket_metainfo.Versioning, &bucket_metainfo.ObjectLockEnabled, &bucket_metainfo.LifecycleRules, &bucket_metainfo.Notifications)
 				if err != nil {
 					return nil, err
 				}
@@ -13756,7 +15767,7 @@ func (obj *pgxImpl) Update_Node_By_Id(ctx context.Context,
 	defer mon.Task()(&ctx)(&err)
 	var __sets = &__sqlbundle_Hole{}
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE nodes SET "), __sets, __sqlbundle_Literal(" WHERE nodes.id = ? RETURNING nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.suspended, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success")}}
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE nodes SET "), __sets, __sqlbundle_Literal(" WHERE nodes.id = ? RETURNING nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.tags, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.ingress_load, nodes.egress_load, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.suspended, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success")}}
 
 	__sets_sql := __sqlbundle_Literals{Join: ", "}
 	var __values []interface{}
@@ -13782,6 +15793,11 @@ func (obj *pgxImpl) Update_Node_By_Id(ctx context.Context,
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("country_code = ?"))
 	}
 
+	if update.Tags._set {
+		__values = append(__values, update.Tags.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("tags = ?"))
+	}
+
 	if update.Protocol._set {
 		__values = append(__values, update.Protocol.value())
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("protocol = ?"))
@@ -13817,6 +15833,16 @@ func (obj *pgxImpl) Update_Node_By_Id(ctx context.Context,
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("piece_count = ?"))
 	}
 
+	if update.IngressLoad._set {
+		__values = append(__values, update.IngressLoad.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("ingress_load = ?"))
+	}
+
+	if update.EgressLoad._set {
+		__values = append(__values, update.EgressLoad.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("egress_load = ?"))
+	}
+
 	if update.Major._set {
 		__values = append(__values, update.Major.value())
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("major = ?"))
@@ -13931,7 +15957,7 @@ func (obj *pgxImpl) Update_Node_By_Id(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	node = &Node{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpPort, &node.CountryCode, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.WalletFeatures, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.Hash, &node.Timestamp, &node.Release, &node.Latency90, &node.VettedAt, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Disqualified, &node.DisqualificationReason, &node.Suspended, &node.UnknownAuditSuspended, &node.OfflineSuspended, &node.UnderReview, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpPort, &node.CountryCode, &node.Tags, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.WalletFeatures, &node.FreeDisk, &node.PieceCount, &node.IngressLoad, &node.EgressLoad, &node.Major, &node.Minor, &node.Patch, &node.Hash, &node.Timestamp, &node.Release, &node.Latency90, &node.VettedAt, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Disqualified, &node.DisqualificationReason, &node.Suspended, &node.UnknownAuditSuspended, &node.OfflineSuspended, &node.UnderReview, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess)
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
@@ -13974,6 +16000,11 @@ func (obj *pgxImpl) UpdateNoReturn_Node_By_Id(ctx context.Context,
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("country_code = ?"))
 	}
 
+	if update.Tags._set {
+		__values = append(__values, update.Tags.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("tags = ?"))
+	}
+
 	if update.Protocol._set {
 		__values = append(__values, update.Protocol.value())
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("protocol = ?"))
@@ -14009,6 +16040,16 @@ func (obj *pgxImpl) UpdateNoReturn_Node_By_Id(ctx context.Context,
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("piece_count = ?"))
 	}
 
+	if update.IngressLoad._set {
+		__values = append(__values, update.IngressLoad.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("ingress_load = ?"))
+	}
+
+	if update.EgressLoad._set {
+		__values = append(__values, update.EgressLoad.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("egress_load = ?"))
+	}
+
 	if update.Major._set {
 		__values = append(__values, update.Major.value())
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("major = ?"))
@@ -14162,6 +16203,11 @@ func (obj *pgxImpl) UpdateNoReturn_Node_By_Id_And_Disqualified_Is_Null_And_ExitF
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("country_code = ?"))
 	}
 
+	if update.Tags._set {
+		__values = append(__values, update.Tags.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("tags = ?"))
+	}
+
 	if update.Protocol._set {
 		__values = append(__values, update.Protocol.value())
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("protocol = ?"))
@@ -14197,6 +16243,16 @@ func (obj *pgxImpl) UpdateNoReturn_Node_By_Id_And_Disqualified_Is_Null_And_ExitF
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("piece_count = ?"))
 	}
 
+	if update.IngressLoad._set {
+		__values = append(__values, update.IngressLoad.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("ingress_load = ?"))
+	}
+
+	if update.EgressLoad._set {
+		__values = append(__values, update.EgressLoad.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("egress_load = ?"))
+	}
+
 	if update.Major._set {
 		__values = append(__values, update.Major.value())
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("major = ?"))
@@ -14976,7 +17032,7 @@ func (obj *pgxImpl) Update_BucketMetainfo_By_ProjectId_And_Name(ctx context.Cont
 	defer mon.Task()(&ctx)(&err)
 	var __sets = &__sqlbundle_Hole{}
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE bucket_metainfos SET "), __sets, __sqlbundle_Literal(" WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name = ? RETURNING bucket_metainfos.id, bucket_metainfos.project_id, bucket_metainfos.name, bucket_metainfos.partner_id, bucket_metainfos.user_agent, bucket_metainfos.path_cipher, bucket_metainfos.created_at, bucket_metainfos.default_segment_size, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.default_redundancy_algorithm, bucket_metainfos.default_redundancy_share_size, bucket_metainfos.default_redundancy_required_shares, bucket_metainfos.default_redundancy_repair_shares, bucket_metainfos.default_redundancy_optimal_shares, bucket_metainfos.default_redundancy_total_shares, bucket_metainfos.placement")}}
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE bucket_metainfos SET "), __sets, __sqlbundle_Literal(" WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name = ? RETURNING bucket_metainfos.id, bucket_metainfos.project_id, bucket_metainfos.name, bucket_metainfos.partner_id, bucket_metainfos.user_agent, bucket_metainfos.path_cipher, bucket_metainfos.created_at, bucket_metainfos.default_segment_size, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.default_redundancy_algorithm, bucket_metainfos.default_redundancy_share_size, bucket_metainfos.default_redundancy_required_shares, bucket_metainfos.default_redundancy_repair_shares, bucket_metainfos.default_redundancy_optimal_shares, bucket_metainfos.default_redundancy_total_shares, bucket_metainfos.placement, bucket_metainfos.versioning, bucket_metainfos.object_lock_enabled, bucket_metainfos.lifecycle_rules, bucket_metainfos.notifications")}}
 
 	__sets_sql := __sqlbundle_Literals{Join: ", "}
 	var __values []interface{}
@@ -15042,6 +17098,26 @@ func (obj *pgxImpl) Update_BucketMetainfo_By_ProjectId_And_Name(ctx context.Cont
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("placement = ?"))
 	}
 
+	if update.Versioning._set {
+		__values = append(__values, update.Versioning.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("versioning = ?"))
+	}
+
+	if update.ObjectLockEnabled._set {
+		__values = append(__values, update.ObjectLockEnabled.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("object_lock_enabled = ?"))
+	}
+
+	if update.LifecycleRules._set {
+		__values = append(__values, update.LifecycleRules.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("lifecycle_rules = ?"))
+	}
+
+	if update.Notifications._set {
+		__values = append(__values, update.Notifications.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("notifications = ?"))
+	}
+
 	if len(__sets_sql.SQLs) == 0 {
 		return nil, emptyUpdate()
 	}
@@ -15055,7 +17131,7 @@ func (obj *pgxImpl) Update_BucketMetainfo_By_ProjectId_And_Name(ctx context.Cont
 	obj.logStmt(__stmt, __values...)
 
 	bucket_metainfo = &BucketMetainfo{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&bucket_metainfo.Id, &bucket_metainfo.ProjectId, &bucket_metainfo.Name, &bucket_metainfo.PartnerId, &bucket_metainfo.UserAgent, &bucket_metainfo.PathCipher, &bucket_metainfo.CreatedAt, &bucket_metainfo.DefaultSegmentSize, &bucket_metainfo.DefaultEncryptionCipherSuite, &bucket_metainfo.DefaultEncryptionBlockSize, &bucket_metainfo.DefaultRedundancyAlgorithm, &bucket_metainfo.DefaultRedundancyShareSize, &bucket_metainfo.DefaultRedundancyRequiredShares, &bucket_metainfo.DefaultRedundancyRepairShares, &bucket_metainfo.DefaultRedundancyOptimalShares, &bucket_metainfo.DefaultRedundancyTotalShares, &bucket_metainfo.Placement)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&bucket_metainfo.Id, &bucket_metainfo.ProjectId, &bucket_metainfo.Name, &bucket_metainfo.PartnerId, &bucket_metainfo.UserAgent, &bucket_metainfo.PathCipher, &bucket_metainfo.CreatedAt, &bucket_metainfo.DefaultSegmentSize, &bucket_metainfo.DefaultEncryptionCipherSuite, &bucket_metainfo.DefaultEncryptionBlockSize, &bucket_metainfo.DefaultRedundancyAlgorithm, &bucket_metainfo.DefaultRedundancyShareSize, &bucket_metainfo.DefaultRedundancyRequiredShares, &bucket_metainfo.DefaultRedundancyRepairShares, &bucket_metainfo.DefaultRedundancyOptimalShares, &bucket_metainfo.DefaultRedundancyTotalShares, &bucket_metainfo.Placement, &bucket_metainfo.Versioning, &bucket_metainfo.ObjectLockEnabled, &bucket_metainfo.LifecycleRules, &bucket_metainfo.Notifications)
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
@@ -15371,33 +17447,6 @@ func (obj *pgxImpl) Delete_SegmentPendingAudits_By_NodeId(ctx context.Context,
 
 }
 
-func (obj *pgxImpl) Delete_RepairQueue_By_UpdatedAt_Less(ctx context.Context,
-	repair_queue_updated_at_less RepairQueue_UpdatedAt_Field) (
-	count int64, err error) {
-	defer mon.Task()(&ctx)(&err)
-
-	var __embed_stmt = __sqlbundle_Literal("DELETE FROM repair_queue WHERE repair_queue.updated_at < ?")
-
-	var __values []interface{}
-	__values = append(__values, repair_queue_updated_at_less.value())
-
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __values...)
-
-	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
-	if err != nil {
-		return 0, obj.makeErr(err)
-	}
-
-	count, err = __res.RowsAffected()
-	if err != nil {
-		return 0, obj.makeErr(err)
-	}
-
-	return count, nil
-
-}
-
 func (obj *pgxImpl) Delete_User_By_Id(ctx context.Context,
 	user_id User_Id_Field) (
 	deleted bool, err error) {
@@ -15964,7 +18013,77 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM peer_identities;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM piece_audit_requests;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM peer_identities;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM offers;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM node_reinstatements;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM node_api_versions;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM nodes;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM graceful_exit_segment_transfer_queue;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM graceful_exit_progress;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -15974,7 +18093,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM offers;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM coupon_usages;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -15984,7 +18103,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM node_api_versions;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM coupon_codes;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -15994,7 +18113,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM nodes;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM coupons;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -16004,7 +18123,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM graceful_exit_segment_transfer_queue;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM coinpayments_transactions;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -16014,7 +18133,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM graceful_exit_progress;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM bucket_storage_tallies;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -16024,7 +18143,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM coupon_usages;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM bucket_event_outbox;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -16034,7 +18153,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM coupon_codes;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM bucket_bandwidth_rollup_archives;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -16044,7 +18163,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM coupons;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM bucket_bandwidth_rollups;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -16054,7 +18173,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM coinpayments_transactions;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM audit_request_results;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -16064,7 +18183,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM bucket_storage_tallies;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM audit_requests;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -16074,7 +18193,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM bucket_bandwidth_rollup_archives;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM audit_outcome_baselines;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -16084,7 +18203,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM bucket_bandwidth_rollups;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM audit_outcomes;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -16770,17 +18889,21 @@ func (obj *pgxcockroachImpl) Create_BucketMetainfo(ctx context.Context,
 	__default_redundancy_optimal_shares_val := bucket_metainfo_default_redundancy_optimal_shares.value()
 	__default_redundancy_total_shares_val := bucket_metainfo_default_redundancy_total_shares.value()
 	__placement_val := optional.Placement.value()
+	__versioning_val := optional.Versioning.value()
+	__object_lock_enabled_val := optional.ObjectLockEnabled.value()
+	__lifecycle_rules_val := optional.LifecycleRules.value()
+	__notifications_val := optional.Notifications.value()
 
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO bucket_metainfos ( id, project_id, name, partner_id, user_agent, path_cipher, created_at, default_segment_size, default_encryption_cipher_suite, default_encryption_block_size, default_redundancy_algorithm, default_redundancy_share_size, default_redundancy_required_shares, default_redundancy_repair_shares, default_redundancy_optimal_shares, default_redundancy_total_shares, placement ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ? ) RETURNING bucket_metainfos.id, bucket_metainfos.project_id, bucket_metainfos.name, bucket_metainfos.partner_id, bucket_metainfos.user_agent, bucket_metainfos.path_cipher, bucket_metainfos.created_at, bucket_metainfos.default_segment_size, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.default_redundancy_algorithm, bucket_metainfos.default_redundancy_share_size, bucket_metainfos.default_redundancy_required_shares, bucket_metainfos.default_redundancy_repair_shares, bucket_metainfos.default_redundancy_optimal_shares, bucket_metainfos.default_redundancy_total_shares, bucket_metainfos.placement")
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO bucket_metainfos ( id, project_id, name, partner_id, user_agent, path_cipher, created_at, default_segment_size, default_encryption_cipher_suite, default_encryption_block_size, default_redundancy_algorithm, default_redundancy_share_size, default_redundancy_required_shares, default_redundancy_repair_shares, default_redundancy_optimal_shares, default_redundancy_total_shares, placement, versioning, object_lock_enabled, lifecycle_rules, notifications ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ? ) RETURNING bucket_metainfos.id, bucket_metainfos.project_id, bucket_metainfos.name, bucket_metainfos.partner_id, bucket_metainfos.user_agent, bucket_metainfos.path_cipher, bucket_metainfos.created_at, bucket_metainfos.default_segment_size, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.default_redundancy_algorithm, bucket_metainfos.default_redundancy_share_size, bucket_metainfos.default_redundancy_required_shares, bucket_metainfos.default_redundancy_repair_shares, bucket_metainfos.default_redundancy_optimal_shares, bucket_metainfos.default_redundancy_total_shares, bucket_metainfos.placement, bucket_metainfos.versioning, bucket_metainfos.object_lock_enabled, bucket_metainfos.lifecycle_rules, bucket_metainfos.notifications")
 
 	var __values []interface{}
-	__values = append(__values, __id_val, __project_id_val, __name_val, __partner_id_val, __user_agent_val, __path_cipher_val, __created_at_val, __default_segment_size_val, __default_encryption_cipher_suite_val, __default_encryption_block_size_val, __default_redundancy_algorithm_val, __default_redundancy_share_size_val, __default_redundancy_required_shares_val, __default_redundancy_repair_shares_val, __default_redundancy_optimal_shares_val, __default_redundancy_total_shares_val, __placement_val)
+	__values = append(__values, __id_val, __project_id_val, __name_val, __partner_id_val, __user_agent_val, __path_cipher_val, __created_at_val, __default_segment_size_val, __default_encryption_cipher_suite_val, __default_encryption_block_size_val, __default_redundancy_algorithm_val, __default_redundancy_share_size_val, __default_redundancy_required_shares_val, __default_redundancy_repair_shares_val, __default_redundancy_optimal_shares_val, __default_redundancy_total_shares_val, __placement_val, __versioning_val, __object_lock_enabled_val, __lifecycle_rules_val, __notifications_val)
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
 	bucket_metainfo = &BucketMetainfo{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&bucket_metainfo.Id, &bucket_metainfo.ProjectId, &bucket_metainfo.Name, &bucket_metainfo.PartnerId, &bucket_metainfo.UserAgent, &bucket_metainfo.PathCipher, &bucket_metainfo.CreatedAt, &bucket_metainfo.DefaultSegmentSize, &bucket_metainfo.DefaultEncryptionCipherSuite, &bucket_metainfo.DefaultEncryptionBlockSize, &bucket_metainfo.DefaultRedundancyAlgorithm, &bucket_metainfo.DefaultRedundancyShareSize, &bucket_metainfo.DefaultRedundancyRequiredShares, &bucket_metainfo.DefaultRedundancyRepairShares, &bucket_metainfo.DefaultRedundancyOptimalShares, &bucket_metainfo.DefaultRedundancyTotalShares, &bucket_metainfo.Placement)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&bucket_metainfo.Id, &bucket_metainfo.ProjectId, &bucket_metainfo.Name, &bucket_metainfo.PartnerId, &bucket_metainfo.UserAgent, &bucket_metainfo.PathCipher, &bucket_metainfo.CreatedAt, &bucket_metainfo.DefaultSegmentSize, &bucket_metainfo.DefaultEncryptionCipherSuite, &bucket_metainfo.DefaultEncryptionBlockSize, &bucket_metainfo.DefaultRedundancyAlgorithm, &bucket_metainfo.DefaultRedundancyShareSize, &bucket_metainfo.DefaultRedundancyRequiredShares, &bucket_metainfo.DefaultRedundancyRepairShares, &bucket_metainfo.DefaultRedundancyOptimalShares, &bucket_metainfo.DefaultRedundancyTotalShares, &bucket_metainfo.Placement, &bucket_metainfo.Versioning, &bucket_metainfo.ObjectLockEnabled, &bucket_metainfo.LifecycleRules, &bucket_metainfo.Notifications)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -17134,7 +19257,7 @@ func (obj *pgxcockroachImpl) Get_Node_By_Id(ctx context.Context,
 	node *Node, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.suspended, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success FROM nodes WHERE nodes.id = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.tags, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.ingress_load, nodes.egress_load, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.suspended, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success FROM nodes WHERE nodes.id = ?")
 
 	var __values []interface{}
 	__values = append(__values, node_id.value())
@@ -17143,7 +19266,7 @@ func (obj *pgxcockroachImpl) Get_Node_By_Id(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	node = &Node{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpPort, &node.CountryCode, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.WalletFeatures, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.Hash, &node.Timestamp, &node.Release, &node.Latency90, &node.VettedAt, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Disqualified, &node.DisqualificationReason, &node.Suspended, &node.UnknownAuditSuspended, &node.OfflineSuspended, &node.UnderReview, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpPort, &node.CountryCode, &node.Tags, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.WalletFeatures, &node.FreeDisk, &node.PieceCount, &node.IngressLoad, &node.EgressLoad, &node.Major, &node.Minor, &node.Patch, &node.Hash, &node.Timestamp, &node.Release, &node.Latency90, &node.VettedAt, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Disqualified, &node.DisqualificationReason, &node.Suspended, &node.UnknownAuditSuspended, &node.OfflineSuspended, &node.UnderReview, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess)
 	if err != nil {
 		return (*Node)(nil), obj.makeErr(err)
 	}
@@ -17199,9 +19322,9 @@ func (obj *pgxcockroachImpl) Paged_Node(ctx context.Context,
 	rows []*Node, next *Paged_Node_Continuation, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.suspended, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.id FROM nodes WHERE (nodes.id) > ? ORDER BY nodes.id LIMIT ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.tags, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.ingress_load, nodes.egress_load, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.suspended, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.id FROM nodes WHERE (nodes.id) > ? ORDER BY nodes.id LIMIT ?")
 
-	var __embed_first_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.suspended, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.id FROM nodes ORDER BY nodes.id LIMIT ?")
+	var __embed_first_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.tags, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.ingress_load, nodes.egress_load, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.suspended, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.id FROM nodes ORDER BY nodes.id LIMIT ?")
 
 	var __values []interface{}
 
@@ -17228,7 +19351,7 @@ func (obj *pgxcockroachImpl) Paged_Node(ctx context.Context,
 
 			for __rows.Next() {
 				node := &Node{}
-				err = __rows.Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpPort, &node.CountryCode, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.WalletFeatures, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.Hash, &node.Timestamp, &node.Release, &node.Latency90, &node.VettedAt, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Disqualified, &node.DisqualificationReason, &node.Suspended, &node.UnknownAuditSuspended, &node.OfflineSuspended, &node.UnderReview, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess, &__continuation._value_id)
+				err = __rows.Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpPort, &node.CountryCode, &node.Tags, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.WalletFeatures, &node.FreeDisk, &node.PieceCount, &node.IngressLoad, &node.EgressLoad, &node.Major, &node.Minor, &node.Patch, &node.Hash, &node.Timestamp, &node.Release, &node.Latency90, &node.VettedAt, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Disqualified, &node.DisqualificationReason, &node.Suspended, &node.UnknownAuditSuspended, &node.OfflineSuspended, &node.UnderReview, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess, &__continuation._value_id)
 				if err != nil {
 					return nil, nil, err
 				}
@@ -18836,7 +20959,7 @@ func (obj *pgxcockroachImpl) Get_BucketMetainfo_By_ProjectId_And_Name(ctx contex
 	bucket_metainfo *BucketMetainfo, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_metainfos.id, bucket_metainfos.project_id, bucket_metainfos.name, bucket_metainfos.partner_id, bucket_metainfos.user_agent, bucket_metainfos.path_cipher, bucket_metainfos.created_at, bucket_metainfos.default_segment_size, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.default_redundancy_algorithm, bucket_metainfos.default_redundancy_share_size, bucket_metainfos.default_redundancy_required_shares, bucket_metainfos.default_redundancy_repair_shares, bucket_metainfos.default_redundancy_optimal_shares, bucket_metainfos.default_redundancy_total_shares, bucket_metainfos.placement FROM bucket_metainfos WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_metainfos.id, bucket_metainfos.project_id, bucket_metainfos.name, bucket_metainfos.partner_id, bucket_metainfos.user_agent, bucket_metainfos.path_cipher, bucket_metainfos.created_at, bucket_metainfos.default_segment_size, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.default_redundancy_algorithm, bucket_metainfos.default_redundancy_share_size, bucket_metainfos.default_redundancy_required_shares, bucket_metainfos.default_redundancy_repair_shares, bucket_metainfos.default_redundancy_optimal_shares, bucket_metainfos.default_redundancy_total_shares, bucket_metainfos.placement, bucket_metainfos.versioning, bucket_metainfos.object_lock_enabled, bucket_metainfos.lifecycle_rules, bucket_metainfos.notifications FROM bucket_metainfos WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name = ?")
 
 	var __values []interface{}
 	__values = append(__values, bucket_metainfo_project_id.value(), bucket_metainfo_name.value())
@@ -18845,7 +20968,7 @@ func (obj *pgxcockroachImpl) Get_BucketMetainfo_By_ProjectId_And_Name(ctx contex
 	obj.logStmt(__stmt, __values...)
 
 	bucket_metainfo = &BucketMetainfo{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&bucket_metainfo.Id, &bucket_metainfo.ProjectId, &bucket_metainfo.Name, &bucket_metainfo.PartnerId, &bucket_metainfo.UserAgent, &bucket_metainfo.PathCipher, &bucket_metainfo.CreatedAt, &bucket_metainfo.DefaultSegmentSize, &bucket_metainfo.DefaultEncryptionCipherSuite, &bucket_metainfo.DefaultEncryptionBlockSize, &bucket_metainfo.DefaultRedundancyAlgorithm, &bucket_metainfo.DefaultRedundancyShareSize, &bucket_metainfo.DefaultRedundancyRequiredShares, &bucket_metainfo.DefaultRedundancyRepairShares, &bucket_metainfo.DefaultRedundancyOptimalShares, &bucket_metainfo.DefaultRedundancyTotalShares, &bucket_metainfo.Placement)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&bucket_metainfo.Id, &bucket_metainfo.ProjectId, &bucket_metainfo.Name, &bucket_metainfo.PartnerId, &bucket_metainfo.UserAgent, &bucket_metainfo.PathCipher, &bucket_metainfo.CreatedAt, &bucket_metainfo.DefaultSegmentSize, &bucket_metainfo.DefaultEncryptionCipherSuite, &bucket_metainfo.DefaultEncryptionBlockSize, &bucket_metainfo.DefaultRedundancyAlgorithm, &bucket_metainfo.DefaultRedundancyShareSize, &bucket_metainfo.DefaultRedundancyRequiredShares, &bucket_metainfo.DefaultRedundancyRepairShares, &bucket_metainfo.DefaultRedundancyOptimalShares, &bucket_metainfo.DefaultRedundancyTotalShares, &bucket_metainfo.Placement, &bucket_metainfo.Versioning, &bucket_metainfo.ObjectLockEnabled, &bucket_metainfo.LifecycleRules, &bucket_metainfo.Notifications)
 	if err != nil {
 		return (*BucketMetainfo)(nil), obj.makeErr(err)
 	}
@@ -18922,6 +21045,98 @@ func (obj *pgxcockroachImpl) Get_BucketMetainfo_Placement_By_ProjectId_And_Name(
 
 }
 
+func (obj *pgxcockroachImpl) Get_BucketMetainfo_Versioning_By_ProjectId_And_Name(ctx context.Context,
+	bucket_metainfo_project_id BucketMetainfo_ProjectId_Field,
+	bucket_metainfo_name BucketMetainfo_Name_Field) (
+	row *Versioning_Row, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_metainfos.versioning FROM bucket_metainfos WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name = ?")
+
+	var __values []interface{}
+	__values = append(__values, bucket_metainfo_project_id.value(), bucket_metainfo_name.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	row = &Versioning_Row{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&row.Versioning)
+	if err != nil {
+		return (*Versioning_Row)(nil), obj.makeErr(err)
+	}
+	return row, nil
+
+}
+
+func (obj *pgxcockroachImpl) Get_BucketMetainfo_ObjectLockEnabled_By_ProjectId_And_Name(ctx context.Context,
+	bucket_metainfo_project_id BucketMetainfo_ProjectId_Field,
+	bucket_metainfo_name BucketMetainfo_Name_Field) (
+	row *ObjectLockEnabled_Row, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_metainfos.object_lock_enabled FROM bucket_metainfos WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name = ?")
+
+	var __values []interface{}
+	__values = append(__values, bucket_metainfo_project_id.value(), bucket_metainfo_name.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	row = &ObjectLockEnabled_Row{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&row.ObjectLockEnabled)
+	if err != nil {
+		return (*ObjectLockEnabled_Row)(nil), obj.makeErr(err)
+	}
+	return row, nil
+
+}
+
+func (obj *pgxcockroachImpl) Get_BucketMetainfo_LifecycleRules_By_ProjectId_And_Name(ctx context.Context,
+	bucket_metainfo_project_id BucketMetainfo_ProjectId_Field,
+	bucket_metainfo_name BucketMetainfo_Name_Field) (
+	row *LifecycleRules_Row, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_metainfos.lifecycle_rules FROM bucket_metainfos WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name = ?")
+
+	var __values []interface{}
+	__values = append(__values, bucket_metainfo_project_id.value(), bucket_metainfo_name.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	row = &LifecycleRules_Row{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&row.LifecycleRules)
+	if err != nil {
+		return (*LifecycleRules_Row)(nil), obj.makeErr(err)
+	}
+	return row, nil
+
+}
+
+func (obj *pgxcockroachImpl) Get_BucketMetainfo_Notifications_By_ProjectId_And_Name(ctx context.Context,
+	bucket_metainfo_project_id BucketMetainfo_ProjectId_Field,
+	bucket_metainfo_name BucketMetainfo_Name_Field) (
+	row *Notifications_Row, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_metainfos.notifications FROM bucket_metainfos WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name = ?")
+
+	var __values []interface{}
+	__values = append(__values, bucket_metainfo_project_id.value(), bucket_metainfo_name.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	row = &Notifications_Row{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&row.Notifications)
+	if err != nil {
+		return (*Notifications_Row)(nil), obj.makeErr(err)
+	}
+	return row, nil
+
+}
+
 func (obj *pgxcockroachImpl) Has_BucketMetainfo_By_ProjectId_And_Name(ctx context.Context,
 	bucket_metainfo_project_id BucketMetainfo_ProjectId_Field,
 	bucket_metainfo_name BucketMetainfo_Name_Field) (
@@ -18951,7 +21166,7 @@ func (obj *pgxcockroachImpl) Limited_BucketMetainfo_By_ProjectId_And_Name_Greate
 	rows []*BucketMetainfo, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_metainfos.id, bucket_metainfos.project_id, bucket_metainfos.name, bucket_metainfos.partner_id, bucket_metainfos.user_agent, bucket_metainfos.path_cipher, bucket_metainfos.created_at, bucket_metainfos.default_segment_size, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.default_redundancy_algorithm, bucket_metainfos.default_redundancy_share_size, bucket_metainfos.default_redundancy_required_shares, bucket_metainfos.default_redundancy_repair_shares, bucket_metainfos.default_redundancy_optimal_shares, bucket_metainfos.default_redundancy_total_shares, bucket_metainfos.placement FROM bucket_metainfos WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name >= ? ORDER BY bucket_metainfos.name LIMIT ? OFFSET ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_metainfos.id, bucket_metainfos.project_id, bucket_metainfos.name, bucket_metainfos.partner_id, bucket_metainfos.user_agent, bucket_metainfos.path_cipher, bucket_metainfos.created_at, bucket_metainfos.default_segment_size, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.default_redundancy_algorithm, bucket_metainfos.default_redundancy_share_size, bucket_metainfos.default_redundancy_required_shares, bucket_metainfos.default_redundancy_repair_shares, bucket_metainfos.default_redundancy_optimal_shares, bucket_metainfos.default_redundancy_total_shares, bucket_metainfos.placement, bucket_metainfos.versioning, bucket_metainfos.object_lock_enabled, bucket_metainfos.lifecycle_rules, bucket_metainfos.notifications FROM bucket_metainfos WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name >= ? ORDER BY bucket_metainfos.name LIMIT ? OFFSET ?")
 
 	var __values []interface{}
 	__values = append(__values, bucket_metainfo_project_id.value(), bucket_metainfo_name_greater_or_equal.value())
@@ -18971,7 +21186,7 @@ func (obj *pgxcockroachImpl) Limited_BucketMetainfo_By_ProjectId_And_Name_Greate
 
 			for __rows.Next() {
 				bucket_metainfo := &BucketMetainfo{}
-				err = __rows.Scan(&bucket_metainfo.Id, &bucket_metainfo.ProjectId, &bucket_metainfo.Name, &bucket_metainfo.PartnerId, &bucket_metainfo.UserAgent, &bucket_metainfo.PathCipher, &bucket_metainfo.CreatedAt, &bucket_metainfo.DefaultSegmentSize, &bucket_metainfo.DefaultEncryptionCipherSuite, &bucket_metainfo.DefaultEncryptionBlockSize, &bucket_metainfo.DefaultRedundancyAlgorithm, &bucket_metainfo.DefaultRedundancyShareSize, &bucket_metainfo.DefaultRedundancyRequiredShares, &bucket_metainfo.DefaultRedundancyRepairShares, &bucket_metainfo.DefaultRedundancyOptimalShares, &bucket_metainfo.DefaultRedundancyTotalShares, &bucket_metainfo.Placement)
+				err = __rows.Scan(&bucket_metainfo.Id, &bucket_metainfo.ProjectId, &bucket_metainfo.Name, &bucket_metainfo.PartnerId, &bucket_metainfo.UserAgent, &bucket_metainfo.PathCipher, &bucket_metainfo.CreatedAt, &bucket_metainfo.DefaultSegmentSize, &bucket_metainfo.DefaultEncryptionCipherSuite, &bucket_metainfo.DefaultEncryptionBlockSize, &bucket_metainfo.DefaultRedundancyAlgorithm, &bucket_metainfo.DefaultRedundancyShareSize, &bucket_metainfo.DefaultRedundancyRequiredShares, &bucket_metainfo.DefaultRedundancyRepairShares, &bucket_metainfo.DefaultRedundancyOptimalShares, &bucket_metainfo.DefaultRedundancyTotalShares, &bucket_metainfo.Placement, &bucket_metainfo.Versioning, &bucket_metainfo.ObjectLockEnabled, &bucket_metainfo.LifecycleRules, &bucket_metainfo.Notifications)
 				if err != nil {
 					return nil, err
 				}
@@ -19001,7 +21216,7 @@ func (obj *pgxcockroachImpl) Limited_BucketMetainfo_By_ProjectId_And_Name_Greate
 	rows []*BucketMetainfo, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_metainfos.id, bucket_metainfos.project_id, bucket_metainfos.name, bucket_metainfos.partner_id, bucket_metainfos.user_agent, bucket_metainfos.path_cipher, bucket_metainfos.created_at, bucket_metainfos.default_segment_size, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.default_redundancy_algorithm, bucket_metainfos.default_redundancy_share_size, bucket_metainfos.default_redundancy_required_shares, bucket_metainfos.default_redundancy_repair_shares, bucket_metainfos.default_redundancy_optimal_shares, bucket_metainfos.default_redundancy_total_shares, bucket_metainfos.placement FROM bucket_metainfos WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name > ? ORDER BY bucket_metainfos.name LIMIT ? OFFSET ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_metainfos.id, bucket_metainfos.project_id, bucket_metainfos.name, bucket_metainfos.partner_id, bucket_metainfos.user_agent, bucket_metainfos.path_cipher, bucket_metainfos.created_at, bucket_metainfos.default_segment_size, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.default_redundancy_algorithm, bucket_metainfos.default_redundancy_share_size, bucket_metainfos.default_redundancy_required_shares, bucket_metainfos.default_redundancy_repair_shares, bucket_metainfos.default_redundancy_optimal_shares, bucket_metainfos.default_redundancy_total_shares, bucket_metainfos.placement, bucket_metainfos.versioning, bucket_metainfos.object_lock_enabled, bucket_metainfos.lifecycle_rules, bucket_metainfos.notifications FROM bucket_metainfos WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name > ? ORDER BY bucket_metainfos.name LIMIT ? OFFSET ?")
 
 	var __values []interface{}
 	__values = append(__values, bucket_metainfo_project_id.value(), bucket_metainfo_name_greater.value())
@@ -19021,7 +21236,7 @@ func (obj *pgxcockroachImpl) Limited_BucketMetainfo_By_ProjectId_And_Name_Greate
 
 			for __rows.Next() {
 				bucket_metainfo := &BucketMetainfo{}
-				err = __rows.Scan(&bucket_metainfo.Id, &bucket_metainfo.ProjectId, &bucket_metainfo.Name, &bucket_metainfo.PartnerId, &bucket_metainfo.UserAgent, &bucket_metainfo.PathCipher, &bucket_metainfo.CreatedAt, &bucket_metainfo.DefaultSegmentSize, &bucket_metainfo.DefaultEncryptionCipherSuite, &bucket_metainfo.DefaultEncryptionBlockSize, &bucket_metainfo.DefaultRedundancyAlgorithm, &bucket_metainfo.DefaultRedundancyShareSize, &bucket_metainfo.DefaultRedundancyRequiredShares, &bucket_metainfo.DefaultRedundancyRepairShares, &bucket_metainfo.DefaultRedundancyOptimalShares, &bucket_metainfo.DefaultRedundancyTotalShares, &bucket_metainfo.Placement)
+				err = __rows.Scan(&bucket_metainfo.Id, &bucket_metainfo.ProjectId, &bucket_metainfo.Name, &bucket_metainfo.PartnerId, &bucket_metainfo.UserAgent, &bucket_metainfo.PathCipher, &bucket_metainfo.CreatedAt, &bucket_metainfo.DefaultSegmentSize, &bucket_metainfo.DefaultEncryptionCipherSuite, &bucket_metainfo.DefaultEncryptionBlockSize, &bucket_metainfo.DefaultRedundancyAlgorithm, &bucket_metainfo.DefaultRedundancyShareSize, &bucket_metainfo.DefaultRedundancyRequiredShares, &bucket_metainfo.DefaultRedundancyRepairShares, &bucket_metainfo.DefaultRedundancyOptimalShares, &bucket_metainfo.DefaultRedundancyTotalShares, &bucket_metainfo.Placement, &bucket_metainfo.Versioning, &bucket_metainfo.ObjectLockEnabled, &bucket_metainfo.LifecycleRules, &bucket_metainfo.Notifications)
 				if err != nil {
 					return nil, err
 				}
@@ -19671,7 +21886,7 @@ func (obj *pgxcockroachImpl) Update_Node_By_Id(ctx context.Context,
 	defer mon.Task()(&ctx)(&err)
 	var __sets = &__sqlbundle_Hole{}
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE nodes SET "), __sets, __sqlbundle_Literal(" WHERE nodes.id = ? RETURNING nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.suspended, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success")}}
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE nodes SET "), __sets, __sqlbundle_Literal(" WHERE nodes.id = ? RETURNING nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.tags, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.ingress_load, nodes.egress_load, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.suspended, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success")}}
 
 	__sets_sql := __sqlbundle_Literals{Join: ", "}
 	var __values []interface{}
@@ -19697,6 +21912,11 @@ func (obj *pgxcockroachImpl) Update_Node_By_Id(ctx context.Context,
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("country_code = ?"))
 	}
 
+	if update.Tags._set {
+		__values = append(__values, update.Tags.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("tags = ?"))
+	}
+
 	if update.Protocol._set {
 		__values = append(__values, update.Protocol.value())
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("protocol = ?"))
@@ -19732,6 +21952,16 @@ func (obj *pgxcockroachImpl) Update_Node_By_Id(ctx context.Context,
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("piece_count = ?"))
 	}
 
+	if update.IngressLoad._set {
+		__values = append(__values, update.IngressLoad.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("ingress_load = ?"))
+	}
+
+	if update.EgressLoad._set {
+		__values = append(__values, update.EgressLoad.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("egress_load = ?"))
+	}
+
 	if update.Major._set {
 		__values = append(__values, update.Major.value())
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("major = ?"))
@@ -19846,7 +22076,7 @@ func (obj *pgxcockroachImpl) Update_Node_By_Id(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	node = &Node{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpPort, &node.CountryCode, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.WalletFeatures, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.Hash, &node.Timestamp, &node.Release, &node.Latency90, &node.VettedAt, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Disqualified, &node.DisqualificationReason, &node.Suspended, &node.UnknownAuditSuspended, &node.OfflineSuspended, &node.UnderReview, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpPort, &node.CountryCode, &node.Tags, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.WalletFeatures, &node.FreeDisk, &node.PieceCount, &node.IngressLoad, &node.EgressLoad, &node.Major, &node.Minor, &node.Patch, &node.Hash, &node.Timestamp, &node.Release, &node.Latency90, &node.VettedAt, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Disqualified, &node.DisqualificationReason, &node.Suspended, &node.UnknownAuditSuspended, &node.OfflineSuspended, &node.UnderReview, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess)
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
@@ -19889,6 +22119,11 @@ func (obj *pgxcockroachImpl) UpdateNoReturn_Node_By_Id(ctx context.Context,
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("country_code = ?"))
 	}
 
+	if update.Tags._set {
+		__values = append(__values, update.Tags.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("tags = ?"))
+	}
+
 	if update.Protocol._set {
 		__values = append(__values, update.Protocol.value())
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("protocol = ?"))
@@ -19924,6 +22159,16 @@ func (obj *pgxcockroachImpl) UpdateNoReturn_Node_By_Id(ctx context.Context,
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("piece_count = ?"))
 	}
 
+	if update.IngressLoad._set {
+		__values = append(__values, update.IngressLoad.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("ingress_load = ?"))
+	}
+
+	if update.EgressLoad._set {
+		__values = append(__values, update.EgressLoad.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("egress_load = ?"))
+	}
+
 	if update.Major._set {
 		__values = append(__values, update.Major.value())
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("major = ?"))
@@ -20077,6 +22322,11 @@ func (obj *pgxcockroachImpl) UpdateNoReturn_Node_By_Id_And_Disqualified_Is_Null_
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("country_code = ?"))
 	}
 
+	if update.Tags._set {
+		__values = append(__values, update.Tags.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("tags = ?"))
+	}
+
 	if update.Protocol._set {
 		__values = append(__values, update.Protocol.value())
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("protocol = ?"))
@@ -20112,6 +22362,16 @@ func (obj *pgxcockroachImpl) UpdateNoReturn_Node_By_Id_And_Disqualified_Is_Null_
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("piece_count = ?"))
 	}
 
+	if update.IngressLoad._set {
+		__values = append(__values, update.IngressLoad.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("ingress_load = ?"))
+	}
+
+	if update.EgressLoad._set {
+		__values = append(__values, update.EgressLoad.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("egress_load = ?"))
+	}
+
 	if update.Major._set {
 		__values = append(__values, update.Major.value())
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("major = ?"))
@@ -20891,7 +23151,7 @@ func (obj *pgxcockroachImpl) Update_BucketMetainfo_By_ProjectId_And_Name(ctx con
 	defer mon.Task()(&ctx)(&err)
 	var __sets = &__sqlbundle_Hole{}
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE bucket_metainfos SET "), __sets, __sqlbundle_Literal(" WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name = ? RETURNING bucket_metainfos.id, bucket_metainfos.project_id, bucket_metainfos.name, bucket_metainfos.partner_id, bucket_metainfos.user_agent, bucket_metainfos.path_cipher, bucket_metainfos.created_at, bucket_metainfos.default_segment_size, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.default_redundancy_algorithm, bucket_metainfos.default_redundancy_share_size, bucket_metainfos.default_redundancy_required_shares, bucket_metainfos.default_redundancy_repair_shares, bucket_metainfos.default_redundancy_optimal_shares, bucket_metainfos.default_redundancy_total_shares, bucket_metainfos.placement")}}
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE bucket_metainfos SET "), __sets, __sqlbundle_Literal(" WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name = ? RETURNING bucket_metainfos.id, bucket_metainfos.project_id, bucket_metainfos.name, bucket_metainfos.partner_id, bucket_metainfos.user_agent, bucket_metainfos.path_cipher, bucket_metainfos.created_at, bucket_metainfos.default_segment_size, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.default_redundancy_algorithm, bucket_metainfos.default_redundancy_share_size, bucket_metainfos.default_redundancy_required_shares, bucket_metainfos.default_redundancy_repair_shares, bucket_metainfos.default_redundancy_optimal_shares, bucket_metainfos.default_redundancy_total_shares, bucket_metainfos.placement, bucket_metainfos.versioning, bucket_metainfos.object_lock_enabled, bucket_metainfos.lifecycle_rules, bucket_metainfos.notifications")}}
 
 	__sets_sql := __sqlbundle_Literals{Join: ", "}
 	var __values []interface{}
@@ -20957,6 +23217,26 @@ func (obj *pgxcockroachImpl) Update_BucketMetainfo_By_ProjectId_And_Name(ctx con
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("placement = ?"))
 	}
 
+	if update.Versioning._set {
+		__values = append(__values, update.Versioning.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("versioning = ?"))
+	}
+
+	if update.ObjectLockEnabled._set {
+		__values = append(__values, update.ObjectLockEnabled.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("object_lock_enabled = ?"))
+	}
+
+	if update.LifecycleRules._set {
+		__values = append(__values, update.LifecycleRules.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("lifecycle_rules = ?"))
+	}
+
+	if update.Notifications._set {
+		__values = append(__values, update.Notifications.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("notifications = ?"))
+	}
+
 	if len(__sets_sql.SQLs) == 0 {
 		return nil, emptyUpdate()
 	}
@@ -20970,7 +23250,7 @@ func (obj *pgxcockroachImpl) Update_BucketMetainfo_By_ProjectId_And_Name(ctx con
 	obj.logStmt(__stmt, __values...)
 
 	bucket_metainfo = &BucketMetainfo{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&bucket_metainfo.Id, &bucket_metainfo.ProjectId, &bucket_metainfo.Name, &bucket_metainfo.PartnerId, &bucket_metainfo.UserAgent, &bucket_metainfo.PathCipher, &bucket_metainfo.CreatedAt, &bucket_metainfo.DefaultSegmentSize, &bucket_metainfo.DefaultEncryptionCipherSuite, &bucket_metainfo.DefaultEncryptionBlockSize, &bucket_metainfo.DefaultRedundancyAlgorithm, &bucket_metainfo.DefaultRedundancyShareSize, &bucket_metainfo.DefaultRedundancyRequiredShares, &bucket_metainfo.DefaultRedundancyRepairShares, &bucket_metainfo.DefaultRedundancyOptimalShares, &bucket_metainfo.DefaultRedundancyTotalShares, &bucket_metainfo.Placement)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&bucket_metainfo.Id, &bucket_metainfo.ProjectId, &bucket_metainfo.Name, &bucket_metainfo.PartnerId, &bucket_metainfo.UserAgent, &bucket_metainfo.PathCipher, &bucket_metainfo.CreatedAt, &bucket_metainfo.DefaultSegmentSize, &bucket_metainfo.DefaultEncryptionCipherSuite, &bucket_metainfo.DefaultEncryptionBlockSize, &bucket_metainfo.DefaultRedundancyAlgorithm, &bucket_metainfo.DefaultRedundancyShareSize, &bucket_metainfo.DefaultRedundancyRequiredShares, &bucket_metainfo.DefaultRedundancyRepairShares, &bucket_metainfo.DefaultRedundancyOptimalShares, &bucket_metainfo.DefaultRedundancyTotalShares, &bucket_metainfo.Placement, &bucket_metainfo.Versioning, &bucket_metainfo.ObjectLockEnabled, &bucket_metainfo.LifecycleRules, &bucket_metainfo.Notifications)
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
@@ -21286,33 +23566,6 @@ func (obj *pgxcockroachImpl) Delete_SegmentPendingAudits_By_NodeId(ctx context.C
 
 }
 
-func (obj *pgxcockroachImpl) Delete_RepairQueue_By_UpdatedAt_Less(ctx context.Context,
-	repair_queue_updated_at_less RepairQueue_UpdatedAt_Field) (
-	count int64, err error) {
-	defer mon.Task()(&ctx)(&err)
-
-	var __embed_stmt = __sqlbundle_Literal("DELETE FROM repair_queue WHERE repair_queue.updated_at < ?")
-
-	var __values []interface{}
-	__values = append(__values, repair_queue_updated_at_less.value())
-
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __values...)
-
-	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
-	if err != nil {
-		return 0, obj.makeErr(err)
-	}
-
-	count, err = __res.RowsAffected()
-	if err != nil {
-		return 0, obj.makeErr(err)
-	}
-
-	return count, nil
-
-}
-
 func (obj *pgxcockroachImpl) Delete_User_By_Id(ctx context.Context,
 	user_id User_Id_Field) (
 	deleted bool, err error) {
@@ -21874,6 +24127,16 @@ func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err er
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM piece_audit_requests;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -21894,6 +24157,16 @@ func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err er
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM node_reinstatements;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -21984,6 +24257,16 @@ func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err er
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM bucket_event_outbox;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -22004,6 +24287,46 @@ func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err er
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM audit_request_results;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM audit_requests;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM audit_outcome_baselines;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM audit_outcomes;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -22684,17 +25007,6 @@ func (rx *Rx) Delete_Project_By_Id(ctx context.Context,
 	return tx.Delete_Project_By_Id(ctx, project_id)
 }
 
-func (rx *Rx) Delete_RepairQueue_By_UpdatedAt_Less(ctx context.Context,
-	repair_queue_updated_at_less RepairQueue_UpdatedAt_Field) (
-	count int64, err error) {
-	var tx *Tx
-	if tx, err = rx.getTx(ctx); err != nil {
-		return
-	}
-	return tx.Delete_RepairQueue_By_UpdatedAt_Less(ctx, repair_queue_updated_at_less)
-
-}
-
 func (rx *Rx) Delete_ResetPasswordToken_By_Secret(ctx context.Context,
 	reset_password_token_secret ResetPasswordToken_Secret_Field) (
 	deleted bool, err error) {
@@ -22810,6 +25122,50 @@ func (rx *Rx) Get_BucketMetainfo_Placement_By_ProjectId_And_Name(ctx context.Con
 	return tx.Get_BucketMetainfo_Placement_By_ProjectId_And_Name(ctx, bucket_metainfo_project_id, bucket_metainfo_name)
 }
 
+func (rx *Rx) Get_BucketMetainfo_Versioning_By_ProjectId_And_Name(ctx context.Context,
+	bucket_metainfo_project_id BucketMetainfo_ProjectId_Field,
+	bucket_metainfo_name BucketMetainfo_Name_Field) (
+	row *Versioning_Row, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Get_BucketMetainfo_Versioning_By_ProjectId_And_Name(ctx, bucket_metainfo_project_id, bucket_metainfo_name)
+}
+
+func (rx *Rx) Get_BucketMetainfo_ObjectLockEnabled_By_ProjectId_And_Name(ctx context.Context,
+	bucket_metainfo_project_id BucketMetainfo_ProjectId_Field,
+	bucket_metainfo_name BucketMetainfo_Name_Field) (
+	row *ObjectLockEnabled_Row, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Get_BucketMetainfo_ObjectLockEnabled_By_ProjectId_And_Name(ctx, bucket_metainfo_project_id, bucket_metainfo_name)
+}
+
+func (rx *Rx) Get_BucketMetainfo_LifecycleRules_By_ProjectId_And_Name(ctx context.Context,
+	bucket_metainfo_project_id BucketMetainfo_ProjectId_Field,
+	bucket_metainfo_name BucketMetainfo_Name_Field) (
+	row *LifecycleRules_Row, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Get_BucketMetainfo_LifecycleRules_By_ProjectId_And_Name(ctx, bucket_metainfo_project_id, bucket_metainfo_name)
+}
+
+func (rx *Rx) Get_BucketMetainfo_Notifications_By_ProjectId_And_Name(ctx context.Context,
+	bucket_metainfo_project_id BucketMetainfo_ProjectId_Field,
+	bucket_metainfo_name BucketMetainfo_Name_Field) (
+	row *Notifications_Row, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Get_BucketMetainfo_Notifications_By_ProjectId_And_Name(ctx, bucket_metainfo_project_id, bucket_metainfo_name)
+}
+
 func (rx *Rx) Get_CouponCode_By_Name(ctx context.Context,
 	coupon_code_name CouponCode_Name_Field) (
 	coupon_code *CouponCode, err error) {
@@ -23826,10 +26182,6 @@ type Methods interface {
 		project_id Project_Id_Field) (
 		deleted bool, err error)
 
-	Delete_RepairQueue_By_UpdatedAt_Less(ctx context.Context,
-		repair_queue_updated_at_less RepairQueue_UpdatedAt_Field) (
-		count int64, err error)
-
 	Delete_ResetPasswordToken_By_Secret(ctx context.Context,
 		reset_password_token_secret ResetPasswordToken_Secret_Field) (
 		deleted bool, err error)
@@ -23879,6 +26231,26 @@ type Methods interface {
 		bucket_metainfo_name BucketMetainfo_Name_Field) (
 		row *Placement_Row, err error)
 
+	Get_BucketMetainfo_Versioning_By_ProjectId_And_Name(ctx context.Context,
+		bucket_metainfo_project_id BucketMetainfo_ProjectId_Field,
+		bucket_metainfo_name BucketMetainfo_Name_Field) (
+		row *Versioning_Row, err error)
+
+	Get_BucketMetainfo_ObjectLockEnabled_By_ProjectId_And_Name(ctx context.Context,
+		bucket_metainfo_project_id BucketMetainfo_ProjectId_Field,
+		bucket_metainfo_name BucketMetainfo_Name_Field) (
+		row *ObjectLockEnabled_Row, err error)
+
+	Get_BucketMetainfo_LifecycleRules_By_ProjectId_And_Name(ctx context.Context,
+		bucket_metainfo_project_id BucketMetainfo_ProjectId_Field,
+		bucket_metainfo_name BucketMetainfo_Name_Field) (
+		row *LifecycleRules_Row, err error)
+
+	Get_BucketMetainfo_Notifications_By_ProjectId_And_Name(ctx context.Context,
+		bucket_metainfo_project_id BucketMetainfo_ProjectId_Field,
+		bucket_metainfo_name BucketMetainfo_Name_Field) (
+		row *Notifications_Row, err error)
+
 	Get_CouponCode_By_Name(ctx context.Context,
 		coupon_code_name CouponCode_Name_Field) (
 		coupon_code *CouponCode, err error)
@@ -1719,6 +1719,187 @@ func (db *satelliteDB) PostgresMigration() *migrate.Migration {
 					`UPDATE users SET project_limit = 3 WHERE project_limit = 0;`,
 				},
 			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add versioning to bucket_metainfos",
+				Version:     182,
+				Action: migrate.SQL{
+					`ALTER TABLE bucket_metainfos ADD COLUMN versioning integer;`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add object_lock_enabled to bucket_metainfos",
+				Version:     183,
+				Action: migrate.SQL{
+					`ALTER TABLE bucket_metainfos ADD COLUMN object_lock_enabled boolean;`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add lifecycle_rules to bucket_metainfos",
+				Version:     184,
+				Action: migrate.SQL{
+					`ALTER TABLE bucket_metainfos ADD COLUMN lifecycle_rules text;`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add bucket event notifications and outbox",
+				Version:     185,
+				Action: migrate.SQL{
+					`ALTER TABLE bucket_metainfos ADD COLUMN notifications text;`,
+					`CREATE TABLE bucket_event_outbox (
+						id bytea NOT NULL,
+						project_id bytea NOT NULL,
+						bucket_name bytea NOT NULL,
+						sink text NOT NULL,
+						payload bytea NOT NULL,
+						attempts integer NOT NULL DEFAULT 0,
+						last_error text,
+						next_attempt_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+						created_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+						PRIMARY KEY ( id )
+					);`,
+					`CREATE INDEX bucket_event_outbox_next_attempt_at_index ON bucket_event_outbox ( next_attempt_at );`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add tags to nodes",
+				Version:     186,
+				Action: migrate.SQL{
+					`ALTER TABLE nodes ADD COLUMN tags text;`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add reason to repair queue",
+				Version:     187,
+				Action: migrate.SQL{
+					`ALTER TABLE repair_queue ADD COLUMN reason integer NOT NULL DEFAULT 0;`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add piece_audit_requests table",
+				Version:     188,
+				Action: migrate.SQL{
+					`CREATE TABLE piece_audit_requests (
+						node_id bytea NOT NULL,
+						requested_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+						PRIMARY KEY ( node_id )
+					);`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add audit_requests and audit_request_results tables",
+				Version:     189,
+				Action: migrate.SQL{
+					`CREATE TABLE audit_requests (
+						id bytea NOT NULL,
+						node_id bytea,
+						project_id bytea,
+						bucket_name bytea,
+						object_key bytea,
+						object_version bigint NOT NULL DEFAULT 0,
+						segment_limit integer NOT NULL DEFAULT 0,
+						status integer NOT NULL DEFAULT 0,
+						error text,
+						created_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+						finished_at timestamp with time zone,
+						PRIMARY KEY ( id )
+					);`,
+					`CREATE TABLE audit_request_results (
+						request_id bytea NOT NULL,
+						stream_id bytea NOT NULL,
+						position bigint NOT NULL,
+						node_id bytea NOT NULL,
+						outcome integer NOT NULL,
+						PRIMARY KEY ( request_id, stream_id, position, node_id )
+					);`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add audit_outcomes table",
+				Version:     190,
+				Action: migrate.SQL{
+					`CREATE TABLE audit_outcomes (
+						node_id bytea NOT NULL,
+						audited_at timestamp with time zone NOT NULL,
+						outcome integer NOT NULL,
+						piece_audit boolean NOT NULL DEFAULT false,
+						PRIMARY KEY ( node_id, audited_at )
+					);`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add node_reinstatements table",
+				Version:     191,
+				Action: migrate.SQL{
+					`CREATE TABLE node_reinstatements (
+						id bytea NOT NULL,
+						node_id bytea NOT NULL,
+						reinstated_by text NOT NULL,
+						reason text NOT NULL,
+						probation boolean NOT NULL,
+						previous_disqualified timestamp with time zone,
+						previous_disqualification_reason integer,
+						previous_unknown_audit_suspended timestamp with time zone,
+						previous_offline_suspended timestamp with time zone,
+						created_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+						PRIMARY KEY ( id )
+					);`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add ingress_load and egress_load to nodes",
+				Version:     192,
+				Action: migrate.SQL{
+					`ALTER TABLE nodes ADD COLUMN ingress_load double precision;`,
+					`ALTER TABLE nodes ADD COLUMN egress_load double precision;`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add id to audit_outcomes and add audit_outcome_baselines",
+				Version:     193,
+				SeparateTx:  true,
+				Action: migrate.SQL{
+					// the outcomes recorded so far cannot be replayed without the
+					// reputation the nodes had before them, so they are dropped.
+					`DROP TABLE audit_outcomes;`,
+					`CREATE TABLE audit_outcomes (
+						id bytea NOT NULL,
+						node_id bytea NOT NULL,
+						audited_at timestamp with time zone NOT NULL,
+						outcome integer NOT NULL,
+						piece_audit boolean NOT NULL DEFAULT false,
+						PRIMARY KEY ( id )
+					);`,
+					`CREATE INDEX audit_outcomes_node_id_audited_at_index ON audit_outcomes ( node_id, audited_at );`,
+					`CREATE TABLE audit_outcome_baselines (
+						node_id bytea NOT NULL,
+						audit_reputation_alpha double precision NOT NULL,
+						audit_reputation_beta double precision NOT NULL,
+						unknown_audit_reputation_alpha double precision NOT NULL,
+						unknown_audit_reputation_beta double precision NOT NULL,
+						online_score double precision NOT NULL,
+						total_audit_count bigint NOT NULL,
+						audit_history bytea NOT NULL,
+						vetted_at timestamp with time zone,
+						unknown_audit_suspended timestamp with time zone,
+						offline_suspended timestamp with time zone,
+						under_review timestamp with time zone,
+						disqualified timestamp with time zone,
+						PRIMARY KEY ( node_id )
+					);`,
+				},
+			},
 			// NB: after updating testdata in `testdata`, run
 			//     `go generate` to update `migratez.go`.
 		},
@@ -13,7 +13,7 @@ func (db *satelliteDB) testMigration() *migrate.Migration {
 			{
 				DB:          &db.migrationDB,
 				Description: "Testing setup",
-				Version:     181,
+				Version:     193,
 				Action: migrate.SQL{`-- AUTOGENERATED BY storj.io/dbx
 -- DO NOT EDIT
 CREATE TABLE accounting_rollups (
@@ -32,6 +32,52 @@ CREATE TABLE accounting_timestamps (
 	value timestamp with time zone NOT NULL,
 	PRIMARY KEY ( name )
 );
+CREATE TABLE audit_outcomes (
+	id bytea NOT NULL,
+	node_id bytea NOT NULL,
+	audited_at timestamp with time zone NOT NULL,
+	outcome integer NOT NULL,
+	piece_audit boolean NOT NULL DEFAULT false,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE audit_outcome_baselines (
+	node_id bytea NOT NULL,
+	audit_reputation_alpha double precision NOT NULL,
+	audit_reputation_beta double precision NOT NULL,
+	unknown_audit_reputation_alpha double precision NOT NULL,
+	unknown_audit_reputation_beta double precision NOT NULL,
+	online_score double precision NOT NULL,
+	total_audit_count bigint NOT NULL,
+	audit_history bytea NOT NULL,
+	vetted_at timestamp with time zone,
+	unknown_audit_suspended timestamp with time zone,
+	offline_suspended timestamp with time zone,
+	under_review timestamp with time zone,
+	disqualified timestamp with time zone,
+	PRIMARY KEY ( node_id )
+);
+CREATE TABLE audit_requests (
+	id bytea NOT NULL,
+	node_id bytea,
+	project_id bytea,
+	bucket_name bytea,
+	object_key bytea,
+	object_version bigint NOT NULL DEFAULT 0,
+	segment_limit integer NOT NULL DEFAULT 0,
+	status integer NOT NULL DEFAULT 0,
+	error text,
+	created_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+	finished_at timestamp with time zone,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE audit_request_results (
+	request_id bytea NOT NULL,
+	stream_id bytea NOT NULL,
+	position bigint NOT NULL,
+	node_id bytea NOT NULL,
+	outcome integer NOT NULL,
+	PRIMARY KEY ( request_id, stream_id, position, node_id )
+);
 CREATE TABLE bucket_bandwidth_rollups (
 	bucket_name bytea NOT NULL,
 	project_id bytea NOT NULL,
@@ -54,6 +100,18 @@ CREATE TABLE bucket_bandwidth_rollup_archives (
 	settled bigint NOT NULL,
 	PRIMARY KEY ( bucket_name, project_id, interval_start, action )
 );
+CREATE TABLE bucket_event_outbox (
+	id bytea NOT NULL,
+	project_id bytea NOT NULL,
+	bucket_name bytea NOT NULL,
+	sink text NOT NULL,
+	payload bytea NOT NULL,
+	attempts integer NOT NULL DEFAULT 0,
+	last_error text,
+	next_attempt_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+	created_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+	PRIMARY KEY ( id )
+);
 CREATE TABLE bucket_storage_tallies (
 	bucket_name bytea NOT NULL,
 	project_id bytea NOT NULL,
@@ -147,6 +205,8 @@ CREATE TABLE nodes (
 	wallet_features text NOT NULL DEFAULT '',
 	free_disk bigint NOT NULL DEFAULT -1,
 	piece_count bigint NOT NULL DEFAULT 0,
+	ingress_load double precision,
+	egress_load double precision,
 	major bigint NOT NULL DEFAULT 0,
 	minor bigint NOT NULL DEFAULT 0,
 	patch bigint NOT NULL DEFAULT 0,
@@ -171,6 +231,7 @@ CREATE TABLE nodes (
 	exit_finished_at timestamp with time zone,
 	exit_success boolean NOT NULL DEFAULT false,
 	country_code text,
+	tags text,
 	PRIMARY KEY ( id )
 );
 CREATE TABLE node_api_versions (
@@ -180,6 +241,19 @@ CREATE TABLE node_api_versions (
 	updated_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( id )
 );
+CREATE TABLE node_reinstatements (
+	id bytea NOT NULL,
+	node_id bytea NOT NULL,
+	reinstated_by text NOT NULL,
+	reason text NOT NULL,
+	probation boolean NOT NULL,
+	previous_disqualified timestamp with time zone,
+	previous_disqualification_reason integer,
+	previous_unknown_audit_suspended timestamp with time zone,
+	previous_offline_suspended timestamp with time zone,
+	created_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+	PRIMARY KEY ( id )
+);
 CREATE TABLE offers (
 	id serial NOT NULL,
 	name text NOT NULL,
@@ -202,6 +276,11 @@ CREATE TABLE peer_identities (
 	updated_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( node_id )
 );
+CREATE TABLE piece_audit_requests (
+	node_id bytea NOT NULL,
+	requested_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+	PRIMARY KEY ( node_id )
+);
 CREATE TABLE projects (
 	id bytea NOT NULL,
 	name text NOT NULL,
@@ -246,6 +325,7 @@ CREATE TABLE repair_queue (
 	updated_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
 	inserted_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
 	segment_health double precision NOT NULL DEFAULT 1,
+	reason integer NOT NULL DEFAULT 0,
 	PRIMARY KEY ( stream_id, position )
 );
 CREATE TABLE reputations (
@@ -454,6 +534,10 @@ CREATE TABLE bucket_metainfos (
 	default_redundancy_optimal_shares integer NOT NULL,
 	default_redundancy_total_shares integer NOT NULL,
 	placement integer,
+	versioning integer,
+	object_lock_enabled boolean,
+	lifecycle_rules text,
+	notifications text,
 	PRIMARY KEY ( id ),
 	UNIQUE ( project_id, name )
 );
@@ -483,10 +567,12 @@ CREATE TABLE user_credits (
 	UNIQUE ( id, offer_id )
 );
 CREATE INDEX accounting_rollups_start_time_index ON accounting_rollups ( start_time ) ;
+CREATE INDEX audit_outcomes_node_id_audited_at_index ON audit_outcomes ( node_id, audited_at ) ;
 CREATE INDEX bucket_bandwidth_rollups_project_id_action_interval_index ON bucket_bandwidth_rollups ( project_id, action, interval_start ) ;
 CREATE INDEX bucket_bandwidth_rollups_action_interval_project_id_index ON bucket_bandwidth_rollups ( action, interval_start, project_id ) ;
 CREATE INDEX bucket_bandwidth_rollups_archive_project_id_action_interval_index ON bucket_bandwidth_rollup_archives ( project_id, action, interval_start ) ;
 CREATE INDEX bucket_bandwidth_rollups_archive_action_interval_project_id_index ON bucket_bandwidth_rollup_archives ( action, interval_start, project_id ) ;
+CREATE INDEX bucket_event_outbox_next_attempt_at_index ON bucket_event_outbox ( next_attempt_at ) ;
 CREATE INDEX bucket_storage_tallies_project_id_interval_start_index ON bucket_storage_tallies ( project_id, interval_start ) ;
 CREATE INDEX graceful_exit_segment_transfer_nid_dr_qa_fa_lfa_index ON graceful_exit_segment_transfer_queue ( node_id, durability_ratio, queued_at, finished_at, last_failed_at ) ;
 CREATE INDEX node_last_ip ON nodes ( last_net ) ;
@@ -6,6 +6,7 @@ package satellitedb
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"sort"
@@ -23,6 +24,7 @@ import (
 	"storj.io/private/dbutil/pgutil"
 	"storj.io/private/tagsql"
 	"storj.io/private/version"
+	"storj.io/storj/private/nodeload"
 	"storj.io/storj/satellite/overlay"
 	"storj.io/storj/satellite/satellitedb/dbx"
 )
@@ -57,7 +59,7 @@ func (cache *overlaycache) selectAllStorageNodesUpload(ctx context.Context, sele
 	defer mon.Task()(&ctx)(&err)
 
 	query := `
-		SELECT id, address, last_net, last_ip_port, vetted_at, country_code
+		SELECT id, address, last_net, last_ip_port, vetted_at, country_code, free_disk, major, minor, patch, tags, ingress_load, egress_load
 			FROM nodes
 			` + cache.db.impl.AsOfSystemInterval(selectionCfg.AsOfSystemTime.DefaultInterval) + `
 			WHERE disqualified IS NULL
@@ -101,13 +103,27 @@ func (cache *overlaycache) selectAllStorageNodesUpload(ctx context.Context, sele
 		node.Address = &pb.NodeAddress{}
 		var lastIPPort sql.NullString
 		var vettedAt *time.Time
-		err = rows.Scan(&node.ID, &node.Address.Address, &node.LastNet, &lastIPPort, &vettedAt, &node.CountryCode)
+		var major, minor, patch int64
+		var tags *string
+		var ingressLoad, egressLoad *float64
+		err = rows.Scan(&node.ID, &node.Address.Address, &node.LastNet, &lastIPPort, &vettedAt, &node.CountryCode, &node.FreeDisk, &major, &minor, &patch, &tags, &ingressLoad, &egressLoad)
 		if err != nil {
 			return nil, nil, err
 		}
 		if lastIPPort.Valid {
 			node.LastIPPort = lastIPPort.String
 		}
+		node.Version, err = version.NewSemVer(fmt.Sprintf("%d.%d.%d", major, minor, patch))
+		if err != nil {
+			return nil, nil, err
+		}
+		node.Tags, err = decodeNodeTags(tags)
+		if err != nil {
+			return nil, nil, err
+		}
+		if load := decodeNodeLoad(ingressLoad, egressLoad); load != nil {
+			node.Load = *load
+		}
 
 		if vettedAt == nil {
 			newNodes = append(newNodes, &node)
@@ -255,7 +271,7 @@ func (cache *overlaycache) getOnlineNodesForGetDelete(ctx context.Context, nodeI
 
 	var rows tagsql.Rows
 	rows, err = cache.db.Query(ctx, cache.db.Rebind(`
-		SELECT last_net, id, address, last_ip_port
+		SELECT last_net, id, address, last_ip_port, ingress_load, egress_load
 		FROM nodes
 		WHERE id = any($1::bytea[])
 			AND disqualified IS NULL
@@ -273,13 +289,17 @@ func (cache *overlaycache) getOnlineNodesForGetDelete(ctx context.Context, nodeI
 		node.Address = &pb.NodeAddress{Transport: pb.NodeTransport_TCP_TLS_GRPC}
 
 		var lastIPPort sql.NullString
-		err = rows.Scan(&node.LastNet, &node.ID, &node.Address.Address, &lastIPPort)
+		var ingressLoad, egressLoad *float64
+		err = rows.Scan(&node.LastNet, &node.ID, &node.Address.Address, &lastIPPort, &ingressLoad, &egressLoad)
 		if err != nil {
 			return nil, err
 		}
 		if lastIPPort.Valid {
 			node.LastIPPort = lastIPPort.String
 		}
+		if load := decodeNodeLoad(ingressLoad, egressLoad); load != nil {
+			node.Load = *load
+		}
 
 		nodes[node.ID] = &node
 	}
@@ -495,6 +515,114 @@ func (cache *overlaycache) reliable(ctx context.Context, criteria *overlay.NodeC
 	return nodes, Error.Wrap(rows.Err())
 }
 
+// ReliableNodes returns all reliable nodes together with the attributes used by placements.
+func (cache *overlaycache) ReliableNodes(ctx context.Context, criteria *overlay.NodeCriteria) (nodes []*overlay.SelectedNode, err error) {
+	for {
+		nodes, err = cache.reliableNodes(ctx, criteria)
+		if err != nil {
+			if cockroachutil.NeedsRetry(err) {
+				continue
+			}
+			return nodes, err
+		}
+		break
+	}
+
+	return nodes, err
+}
+
+func (cache *overlaycache) reliableNodes(ctx context.Context, criteria *overlay.NodeCriteria) (nodes []*overlay.SelectedNode, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := cache.db.Query(ctx, cache.db.Rebind(`
+		SELECT `+selectedNodeColumns+`
+		FROM nodes
+		`+cache.db.impl.AsOfSystemInterval(criteria.AsOfSystemInterval)+`
+		WHERE disqualified IS NULL
+		AND unknown_audit_suspended IS NULL
+		AND offline_suspended IS NULL
+		AND exit_finished_at IS NULL
+		AND last_contact_success > ?
+	`), time.Now().Add(-criteria.OnlineWindow))
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	for rows.Next() {
+		node, err := scanSelectedNode(rows)
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, node)
+	}
+	return nodes, Error.Wrap(rows.Err())
+}
+
+// GetSelectedNodes returns the nodes with the attributes used by placements. Unknown nodes are skipped.
+func (cache *overlaycache) GetSelectedNodes(ctx context.Context, nodeIDs storj.NodeIDList) (nodes []*overlay.SelectedNode, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(nodeIDs) == 0 {
+		return nil, nil
+	}
+
+	rows, err := cache.db.Query(ctx, `
+		SELECT `+selectedNodeColumns+`
+		FROM nodes
+		WHERE id = any($1::bytea[])
+	`, pgutil.NodeIDArray(nodeIDs))
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	for rows.Next() {
+		node, err := scanSelectedNode(rows)
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, node)
+	}
+	return nodes, Error.Wrap(rows.Err())
+}
+
+// selectedNodeColumns are the columns of nodes read by scanSelectedNode.
+const selectedNodeColumns = `id, address, last_net, last_ip_port, country_code, free_disk, major, minor, patch, tags, ingress_load, egress_load`
+
+func scanSelectedNode(rows tagsql.Rows) (_ *overlay.SelectedNode, err error) {
+	node := &overlay.SelectedNode{
+		Address: &pb.NodeAddress{},
+	}
+	var lastIPPort sql.NullString
+	var countryCode *location.CountryCode
+	var major, minor, patch int64
+	var tags *string
+	var ingressLoad, egressLoad *float64
+	err = rows.Scan(&node.ID, &node.Address.Address, &node.LastNet, &lastIPPort, &countryCode, &node.FreeDisk, &major, &minor, &patch, &tags, &ingressLoad, &egressLoad)
+	if err != nil {
+		return nil, err
+	}
+	if lastIPPort.Valid {
+		node.LastIPPort = lastIPPort.String
+	}
+	if countryCode != nil {
+		node.CountryCode = *countryCode
+	}
+	node.Version, err = version.NewSemVer(fmt.Sprintf("%d.%d.%d", major, minor, patch))
+	if err != nil {
+		return nil, err
+	}
+	node.Tags, err = decodeNodeTags(tags)
+	if err != nil {
+		return nil, err
+	}
+	if load := decodeNodeLoad(ingressLoad, egressLoad); load != nil {
+		node.Load = *load
+	}
+	return node, nil
+}
+
 // UpdateReputation updates the DB columns for any of the reputation fields in UpdateReputationRequest.
 func (cache *overlaycache) UpdateReputation(ctx context.Context, id storj.NodeID, request *overlay.ReputationStatus) (err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -504,6 +632,9 @@ func (cache *overlaycache) UpdateReputation(ctx context.Context, id storj.NodeID
 	updateFields.Disqualified = dbx.Node_Disqualified_Raw(request.Disqualified)
 	updateFields.OfflineSuspended = dbx.Node_OfflineSuspended_Raw(request.OfflineSuspended)
 	updateFields.VettedAt = dbx.Node_VettedAt_Raw(request.VettedAt)
+	if request.Disqualified != nil {
+		updateFields.DisqualificationReason = dbx.Node_DisqualificationReason(int(request.DisqualificationReason))
+	}
 
 	err = cache.db.UpdateNoReturn_Node_By_Id_And_Disqualified_Is_Null_And_ExitFinishedAt_Is_Null(ctx, dbx.Node_Id(id.Bytes()), updateFields)
 	return Error.Wrap(err)
@@ -570,6 +701,57 @@ func (cache *overlaycache) DisqualifyNode(ctx context.Context, nodeID storj.Node
 	return nil
 }
 
+// UpdateNodeTags sets the tags declared by the satellite operator for a storage node.
+func (cache *overlaycache) UpdateNodeTags(ctx context.Context, nodeID storj.NodeID, tags map[string]string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	updateFields := dbx.Node_Update_Fields{}
+	if len(tags) == 0 {
+		updateFields.Tags = dbx.Node_Tags_Null()
+	} else {
+		data, err := json.Marshal(tags)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		updateFields.Tags = dbx.Node_Tags(string(data))
+	}
+
+	dbNode, err := cache.db.Update_Node_By_Id(ctx, dbx.Node_Id(nodeID.Bytes()), updateFields)
+	if err != nil {
+		return err
+	}
+	if dbNode == nil {
+		return overlay.ErrNodeNotFound.New("%v", nodeID)
+	}
+	return nil
+}
+
+// decodeNodeTags decodes the tags of a node stored as json.
+func decodeNodeTags(data *string) (map[string]string, error) {
+	if data == nil || *data == "" {
+		return nil, nil
+	}
+	var tags map[string]string
+	if err := json.Unmarshal([]byte(*data), &tags); err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return tags, nil
+}
+
+// decodeNodeLoad returns the load stored for a node, nil when the node didn't report it.
+func decodeNodeLoad(ingress, egress *float64) *nodeload.Load {
+	if ingress == nil && egress == nil {
+		return nil
+	}
+	var load nodeload.Load
+	if ingress != nil {
+		load.Ingress = *ingress
+	}
+	if egress != nil {
+		load.Egress = *egress
+	}
+	return &load
+}
+
 // TestSuspendNodeUnknownAudit suspends a storage node for unknown audits.
 func (cache *overlaycache) TestSuspendNodeUnknownAudit(ctx context.Context, nodeID storj.NodeID, suspendedAt time.Time) (err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -933,6 +1115,12 @@ func convertDBNode(ctx context.Context, info *dbx.Node) (_ *overlay.NodeDossier,
 	if info.LastIpPort != nil {
 		node.LastIPPort = *info.LastIpPort
 	}
+	node.Tags, err = decodeNodeTags(info.Tags)
+	if err != nil {
+		return nil, err
+	}
+	node.Load = decodeNodeLoad(info.IngressLoad, info.EgressLoad)
+
 	if info.CountryCode != nil {
 		node.CountryCode = location.ToCountryCode(*info.CountryCode)
 	}
@@ -979,6 +1167,50 @@ func getNodeStats(dbNode *dbx.Node) *overlay.NodeStats {
 	return nodeStats
 }
 
+// ListPenalizedNodes returns up to limit nodes, which haven't exited, most recently penalized first.
+// It includes disqualified nodes when disqualified is set and suspended nodes, which aren't
+// disqualified, when suspended is set.
+func (cache *overlaycache) ListPenalizedNodes(ctx context.Context, disqualified, suspended bool, limit int) (_ []overlay.PenalizedNode, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := cache.db.Query(ctx, cache.db.Rebind(`
+		SELECT id, address, email, disqualified, disqualification_reason,
+			unknown_audit_suspended, offline_suspended, last_contact_success
+		FROM nodes
+		WHERE (
+				($1 AND disqualified IS NOT NULL) OR
+				($2 AND disqualified IS NULL AND (unknown_audit_suspended IS NOT NULL OR offline_suspended IS NOT NULL))
+			)
+			AND exit_finished_at IS NULL
+		ORDER BY GREATEST(
+			COALESCE(disqualified, '0001-01-01 00:00:00+00'::timestamptz),
+			COALESCE(unknown_audit_suspended, '0001-01-01 00:00:00+00'::timestamptz),
+			COALESCE(offline_suspended, '0001-01-01 00:00:00+00'::timestamptz)
+		) DESC, id
+		LIMIT $3
+	`), disqualified, suspended, limit)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var nodes []overlay.PenalizedNode
+	for rows.Next() {
+		var node overlay.PenalizedNode
+		var reason *int
+		err = rows.Scan(&node.ID, &node.Address, &node.Email, &node.Disqualified, &reason,
+			&node.UnknownAuditSuspended, &node.OfflineSuspended, &node.LastContactSuccess)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		if reason != nil {
+			node.DisqualificationReason = overlay.DisqualificationReason(*reason)
+		}
+		nodes = append(nodes, node)
+	}
+	return nodes, Error.Wrap(rows.Err())
+}
+
 // DQNodesLastSeenBefore disqualifies a limited number of nodes where last_contact_success < cutoff except those already disqualified
 // or gracefully exited or where last_contact_success = '0001-01-01 00:00:00+00'.
 func (cache *overlaycache) DQNodesLastSeenBefore(ctx context.Context, cutoff time.Time, limit int) (count int, err error) {
@@ -1002,14 +1234,15 @@ func (cache *overlaycache) DQNodesLastSeenBefore(ctx context.Context, cutoff tim
 	var rows tagsql.Rows
 	rows, err = cache.db.Query(ctx, cache.db.Rebind(`
 		UPDATE nodes
-		SET disqualified = current_timestamp
+		SET disqualified = current_timestamp,
+			disqualification_reason = $3
 		WHERE id = any($1::bytea[])
 			AND disqualified IS NULL
 			AND exit_finished_at IS NULL
 			AND last_contact_success < $2
 			AND last_contact_success != '0001-01-01 00:00:00+00'::timestamptz
 		RETURNING id, last_contact_success;
-	`), pgutil.NodeIDArray(nodeIDs), cutoff)
+	`), pgutil.NodeIDArray(nodeIDs), cutoff, int(overlay.DisqualificationReasonStrayNode))
 	if err != nil {
 		return 0, err
 	}
@@ -1079,6 +1312,11 @@ func (cache *overlaycache) UpdateCheckIn(ctx context.Context, node overlay.NodeC
 		return Error.Wrap(err)
 	}
 
+	var ingressLoad, egressLoad *float64
+	if node.Load != nil {
+		ingressLoad, egressLoad = &node.Load.Ingress, &node.Load.Egress
+	}
+
 	// First try the fast path.
 	var res sql.Result
 	res, err = cache.db.ExecContext(ctx, `
@@ -1101,7 +1339,9 @@ func (cache *overlaycache) UpdateCheckIn(ctx context.Context, node overlay.NodeC
 			END,
 			last_ip_port=$16,
 			wallet_features=$17,
-			country_code=$18
+			country_code=$18,
+			ingress_load=$19,
+			egress_load=$20
 		WHERE id = $1
 	`, // args $1 - $4
 		node.NodeID.Bytes(), node.Address.GetAddress(), node.LastNet, node.Address.GetTransport(),
@@ -1119,6 +1359,8 @@ func (cache *overlaycache) UpdateCheckIn(ctx context.Context, node overlay.NodeC
 		walletFeatures,
 		// args $18,
 		node.CountryCode.String(),
+		// args $19 - $20
+		ingressLoad, egressLoad,
 	)
 
 	if err == nil {
@@ -1138,7 +1380,8 @@ func (cache *overlaycache) UpdateCheckIn(ctx context.Context, node overlay.NodeC
 				major, minor, patch, hash, timestamp, release,
 				last_ip_port,
 				wallet_features,
-				country_code
+				country_code,
+				ingress_load, egress_load
 			)
 			VALUES (
 				$1, $2, $3, $4, $5,
@@ -1152,7 +1395,8 @@ func (cache *overlaycache) UpdateCheckIn(ctx context.Context, node overlay.NodeC
 				$10, $11, $12, $13, $14, $15,
 				$17,
 				$18,
-				$19
+				$19,
+				$20, $21
 			)
 			ON CONFLICT (id)
 			DO UPDATE
@@ -1174,7 +1418,9 @@ func (cache *overlaycache) UpdateCheckIn(ctx context.Context, node overlay.NodeC
 				END,
 				last_ip_port=$17,
 				wallet_features=$18,
-				country_code=$19;
+				country_code=$19,
+				ingress_load=$20,
+				egress_load=$21;
 			`,
 		// args $1 - $5
 		node.NodeID.Bytes(), node.Address.GetAddress(), node.LastNet, node.Address.GetTransport(), int(pb.NodeType_STORAGE),
@@ -1192,6 +1438,8 @@ func (cache *overlaycache) UpdateCheckIn(ctx context.Context, node overlay.NodeC
 		walletFeatures,
 		// args $19,
 		node.CountryCode.String(),
+		// args $20 - $21
+		ingressLoad, egressLoad,
 	)
 	if err != nil {
 		return Error.Wrap(err)
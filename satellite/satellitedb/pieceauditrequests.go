@@ -0,0 +1,69 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/storj"
+	"storj.io/storj/satellite/audit"
+)
+
+// ensures that pieceAuditRequests implements audit.PieceAuditRequests.
+var _ audit.PieceAuditRequests = (*pieceAuditRequests)(nil)
+
+// pieceAuditRequests implements the requests of full piece audits of nodes.
+type pieceAuditRequests struct {
+	db *satelliteDB
+}
+
+// Request requests full piece audits of the node.
+func (requests *pieceAuditRequests) Request(ctx context.Context, nodeID storj.NodeID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = requests.db.ExecContext(ctx, `
+		INSERT INTO piece_audit_requests ( node_id, requested_at )
+		VALUES ( $1, current_timestamp )
+		ON CONFLICT ( node_id ) DO UPDATE SET requested_at = EXCLUDED.requested_at
+	`, nodeID)
+	return Error.Wrap(err)
+}
+
+// List returns all pending requests.
+func (requests *pieceAuditRequests) List(ctx context.Context) (_ []audit.PieceAuditRequest, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := requests.db.QueryContext(ctx, `
+		SELECT node_id, requested_at
+		FROM piece_audit_requests
+		ORDER BY requested_at
+	`)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var list []audit.PieceAuditRequest
+	for rows.Next() {
+		var request audit.PieceAuditRequest
+		if err := rows.Scan(&request.NodeID, &request.RequestedAt); err != nil {
+			return nil, Error.Wrap(err)
+		}
+		list = append(list, request)
+	}
+	return list, Error.Wrap(rows.Err())
+}
+
+// Delete removes the request, unless the node has been requested again since.
+func (requests *pieceAuditRequests) Delete(ctx context.Context, request audit.PieceAuditRequest) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = requests.db.ExecContext(ctx, `
+		DELETE FROM piece_audit_requests
+		WHERE node_id = $1 AND requested_at <= $2
+	`, request.NodeID, request.RequestedAt)
+	return Error.Wrap(err)
+}
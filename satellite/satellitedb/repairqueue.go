@@ -7,6 +7,10 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/zeebo/errs"
@@ -15,7 +19,6 @@ import (
 	"storj.io/private/dbutil"
 	"storj.io/storj/satellite/metabase"
 	"storj.io/storj/satellite/repair/queue"
-	"storj.io/storj/satellite/satellitedb/dbx"
 	"storj.io/storj/storage"
 )
 
@@ -34,35 +37,40 @@ func (r *repairQueue) Insert(ctx context.Context, seg *queue.InjuredSegment) (al
 	// we want to insert the segment if it is not in the queue, but update the segment health if it already is in the queue
 	// we also want to know if the result was an insert or an update - this is the reasoning for the xmax section of the postgres query
 	// and the separate cockroach query (which the xmax trick does not work for)
+	//
+	// an update keeps the reason with the higher priority and keeps the stored health when the new one is unknown.
+	health := `CASE WHEN $5 THEN repair_queue.segment_health ELSE $3 END`
+	reason := `CASE WHEN ` + reasonPriority("$4") + ` > ` + reasonPriority("repair_queue.reason") + ` THEN $4 ELSE repair_queue.reason END`
 	switch r.db.impl {
 	case dbutil.Postgres:
 		query = `
 			INSERT INTO repair_queue
 			(
-				stream_id, position, segment_health
+				stream_id, position, segment_health, reason
 			)
 			VALUES (
-				$1, $2, $3
+				$1, $2, $3, $4
 			)
 			ON CONFLICT (stream_id, position)
 			DO UPDATE
-			SET segment_health=$3, updated_at=current_timestamp
+			SET segment_health=` + health + `, reason=` + reason + `, updated_at=current_timestamp
 			RETURNING (xmax != 0) AS alreadyInserted
 		`
 	case dbutil.Cockroach:
 		query = `
 			WITH updater AS (
-				UPDATE repair_queue SET segment_health = $3, updated_at = current_timestamp
+				UPDATE repair_queue SET segment_health = ` + health + `, reason = ` + reason + `, updated_at = current_timestamp
 				WHERE stream_id = $1 AND position = $2
 				RETURNING *
 			)
-			INSERT INTO repair_queue (stream_id, position, segment_health)
-			SELECT $1, $2, $3
+			INSERT INTO repair_queue (stream_id, position, segment_health, reason)
+			SELECT $1, $2, $3, $4
 			WHERE NOT EXISTS (SELECT * FROM updater)
 			RETURNING false
 		`
 	}
-	rows, err := r.db.QueryContext(ctx, query, seg.StreamID, seg.Position.Encode(), seg.SegmentHealth)
+	rows, err := r.db.QueryContext(ctx, query, seg.StreamID, seg.Position.Encode(), seg.SegmentHealth, int(seg.Reason),
+		math.IsInf(seg.SegmentHealth, 1))
 	if err != nil {
 		return false, err
 	}
@@ -80,29 +88,34 @@ func (r *repairQueue) Insert(ctx context.Context, seg *queue.InjuredSegment) (al
 	return alreadyInserted, rows.Err()
 }
 
-func (r *repairQueue) Select(ctx context.Context) (seg *queue.InjuredSegment, err error) {
+func (r *repairQueue) Select(ctx context.Context, reasons ...queue.Reason) (seg *queue.InjuredSegment, err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	reasonFilter := ""
+	if len(reasons) > 0 {
+		reasonFilter = "AND " + reasonCondition(reasons)
+	}
+
 	segment := queue.InjuredSegment{}
 	switch r.db.impl {
 	case dbutil.Cockroach:
 		err = r.db.QueryRowContext(ctx, `
 				UPDATE repair_queue SET attempted_at = now()
-				WHERE attempted_at IS NULL OR attempted_at < now() - interval '6 hours'
+				WHERE (attempted_at IS NULL OR attempted_at < now() - interval '6 hours') `+reasonFilter+`
 				ORDER BY segment_health ASC, attempted_at NULLS FIRST
 				LIMIT 1
-				RETURNING stream_id, position, attempted_at, updated_at, inserted_at, segment_health
+				RETURNING stream_id, position, attempted_at, updated_at, inserted_at, segment_health, reason
 		`).Scan(&segment.StreamID, &segment.Position, &segment.AttemptedAt,
-			&segment.UpdatedAt, &segment.InsertedAt, &segment.SegmentHealth)
+			&segment.UpdatedAt, &segment.InsertedAt, &segment.SegmentHealth, &segment.Reason)
 	case dbutil.Postgres:
 		err = r.db.QueryRowContext(ctx, `
 				UPDATE repair_queue SET attempted_at = now() WHERE (stream_id, position) = (
 					SELECT stream_id, position FROM repair_queue
-					WHERE attempted_at IS NULL OR attempted_at < now() - interval '6 hours'
+					WHERE (attempted_at IS NULL OR attempted_at < now() - interval '6 hours') `+reasonFilter+`
 					ORDER BY segment_health ASC, attempted_at NULLS FIRST FOR UPDATE SKIP LOCKED LIMIT 1
-				) RETURNING stream_id, position, attempted_at, updated_at, inserted_at, segment_health
+				) RETURNING stream_id, position, attempted_at, updated_at, inserted_at, segment_health, reason
 		`).Scan(&segment.StreamID, &segment.Position, &segment.AttemptedAt,
-			&segment.UpdatedAt, &segment.InsertedAt, &segment.SegmentHealth)
+			&segment.UpdatedAt, &segment.InsertedAt, &segment.SegmentHealth, &segment.Reason)
 	default:
 		return seg, errs.New("unhandled database: %v", r.db.impl)
 	}
@@ -123,19 +136,30 @@ func (r *repairQueue) Delete(ctx context.Context, seg *queue.InjuredSegment) (er
 
 func (r *repairQueue) Clean(ctx context.Context, before time.Time) (deleted int64, err error) {
 	defer mon.Task()(&ctx)(&err)
-	n, err := r.db.Delete_RepairQueue_By_UpdatedAt_Less(ctx, dbx.RepairQueue_UpdatedAt(before))
+	res, err := r.db.ExecContext(ctx,
+		r.db.Rebind(`DELETE FROM repair_queue WHERE updated_at < ? AND `+reasonCondition(queue.CheckerReasons)),
+		before,
+	)
+	if err != nil {
+		return 0, Error.Wrap(err)
+	}
+	n, err := res.RowsAffected()
 	return n, Error.Wrap(err)
 }
 
-func (r *repairQueue) SelectN(ctx context.Context, limit int) (segs []queue.InjuredSegment, err error) {
+func (r *repairQueue) SelectN(ctx context.Context, limit int, reasons ...queue.Reason) (segs []queue.InjuredSegment, err error) {
 	defer mon.Task()(&ctx)(&err)
 	if limit <= 0 || limit > RepairQueueSelectLimit {
 		limit = RepairQueueSelectLimit
 	}
+	reasonFilter := ""
+	if len(reasons) > 0 {
+		reasonFilter = "WHERE " + reasonCondition(reasons)
+	}
 	// TODO: strictly enforce order-by or change tests
 	rows, err := r.db.QueryContext(ctx,
-		r.db.Rebind(`SELECT stream_id, position, attempted_at, updated_at, segment_health 
-					FROM repair_queue LIMIT ?`), limit,
+		r.db.Rebind(`SELECT stream_id, position, attempted_at, updated_at, segment_health, reason
+					FROM repair_queue `+reasonFilter+` LIMIT ?`), limit,
 	)
 	if err != nil {
 		return nil, Error.Wrap(err)
@@ -145,7 +169,7 @@ func (r *repairQueue) SelectN(ctx context.Context, limit int) (segs []queue.Inju
 	for rows.Next() {
 		var seg queue.InjuredSegment
 		err = rows.Scan(&seg.StreamID, &seg.Position, &seg.AttemptedAt,
-			&seg.UpdatedAt, &seg.SegmentHealth)
+			&seg.UpdatedAt, &seg.SegmentHealth, &seg.Reason)
 		if err != nil {
 			return segs, Error.Wrap(err)
 		}
@@ -164,6 +188,57 @@ func (r *repairQueue) Count(ctx context.Context) (count int, err error) {
 	return count, Error.Wrap(err)
 }
 
+func (r *repairQueue) Stats(ctx context.Context) (stats []queue.Stat, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var bucket strings.Builder
+	bucket.WriteString("CASE")
+	for i, bound := range queue.HealthBuckets {
+		fmt.Fprintf(&bucket, " WHEN segment_health < %s THEN %d", strconv.FormatFloat(bound, 'f', -1, 64), i)
+	}
+	fmt.Fprintf(&bucket, " ELSE %d END", len(queue.HealthBuckets))
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT reason, `+bucket.String()+` AS health_bucket, count(*)
+		FROM repair_queue
+		GROUP BY 1, 2
+		ORDER BY 1, 2
+	`)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	for rows.Next() {
+		var stat queue.Stat
+		if err := rows.Scan(&stat.Reason, &stat.HealthBucket, &stat.Count); err != nil {
+			return nil, Error.Wrap(err)
+		}
+		stats = append(stats, stat)
+	}
+	return stats, Error.Wrap(rows.Err())
+}
+
+// reasonCondition returns an SQL condition matching segments with any of the reasons.
+func reasonCondition(reasons []queue.Reason) string {
+	values := make([]string, len(reasons))
+	for i, reason := range reasons {
+		values[i] = strconv.Itoa(int(reason))
+	}
+	return "reason IN (" + strings.Join(values, ", ") + ")"
+}
+
+// reasonPriority returns an SQL expression evaluating to the priority of the reason expression.
+func reasonPriority(expr string) string {
+	var priority strings.Builder
+	fmt.Fprintf(&priority, "(CASE %s", expr)
+	for _, reason := range queue.Reasons {
+		fmt.Fprintf(&priority, " WHEN %d THEN %d", int(reason), reason.Priority())
+	}
+	priority.WriteString(" ELSE 0 END)")
+	return priority.String()
+}
+
 // TestingSetAttemptedTime sets attempted time for a segment.
 func (r *repairQueue) TestingSetAttemptedTime(ctx context.Context, streamID uuid.UUID,
 	position metabase.SegmentPosition, t time.Time) (rowsAffected int64, err error) {
@@ -7,6 +7,8 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/zeebo/errs"
@@ -14,6 +16,8 @@ import (
 
 	"storj.io/common/pb"
 	"storj.io/common/storj"
+	"storj.io/common/uuid"
+	"storj.io/private/dbutil/pgutil"
 	"storj.io/storj/satellite/internalpb"
 	"storj.io/storj/satellite/overlay"
 	"storj.io/storj/satellite/reputation"
@@ -65,7 +69,8 @@ func (reputations *reputations) Update(ctx context.Context, updateReq reputation
 				AuditHistory:                auditHistoryResponse.History,
 			}
 
-			createFields := reputations.populateCreateFields(&newNode, updateReq, auditHistoryResponse, now)
+			update := reputations.populateUpdateNodeStats(&newNode, updateReq, auditHistoryResponse, now)
+			createFields := reputations.populateCreateFields(&newNode, updateReq, update)
 			stats, err := reputations.db.Create_Reputation(ctx, dbx.Reputation_Id(updateReq.NodeID.Bytes()), dbx.Reputation_AuditHistory(auditHistoryResponse.History), createFields)
 			if err != nil {
 				// if node has been added into the table during a concurrent
@@ -80,6 +85,7 @@ func (reputations *reputations) Update(ctx context.Context, updateReq reputation
 			}
 
 			rep := getNodeStatus(stats)
+			rep.DisqualificationReason = update.DisqualificationReason
 			return &rep, !rep.Equal(overlay.ReputationStatus{}), nil
 		}
 
@@ -95,7 +101,8 @@ func (reputations *reputations) Update(ctx context.Context, updateReq reputation
 			return nil, false, Error.Wrap(err)
 		}
 
-		updateFields := reputations.populateUpdateFields(dbNode, updateReq, auditHistoryResponse, now)
+		update := reputations.populateUpdateNodeStats(dbNode, updateReq, auditHistoryResponse, now)
+		updateFields := reputations.populateUpdateFields(dbNode, updateReq, auditHistoryResponse, update)
 		oldAuditHistory := dbx.Reputation_AuditHistory(dbNode.AuditHistory)
 		dbNode, err = reputations.db.Update_Reputation_By_Id_And_AuditHistory(ctx, dbx.Reputation_Id(updateReq.NodeID.Bytes()), oldAuditHistory, updateFields)
 		if err != nil && !errors.Is(err, sql.ErrNoRows) {
@@ -110,6 +117,7 @@ func (reputations *reputations) Update(ctx context.Context, updateReq reputation
 		}
 
 		newStats := getNodeStatus(dbNode)
+		newStats.DisqualificationReason = update.DisqualificationReason
 		return &newStats, !newStats.Equal(oldStats), nil
 	}
 
@@ -281,9 +289,357 @@ func (reputations *reputations) UnsuspendNodeUnknownAudit(ctx context.Context, n
 	return Error.Wrap(err)
 }
 
-func (reputations *reputations) populateCreateFields(dbNode *dbx.Reputation, updateReq reputation.UpdateRequest, auditHistoryResponse *reputation.UpdateAuditHistoryResponse, now time.Time) dbx.Reputation_Create_Fields {
-	update := reputations.populateUpdateNodeStats(dbNode, updateReq, auditHistoryResponse, now)
+// RecordAuditOutcome stores the outcome of a single audit. The current reputation of the node
+// is stored as its baseline when the node doesn't have stored outcomes yet.
+func (reputations *reputations) RecordAuditOutcome(ctx context.Context, outcome reputation.AuditOutcome) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	emptyHistory, err := pb.Marshal(&internalpb.AuditHistory{})
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	err = reputations.db.WithTx(ctx, func(ctx context.Context, tx *dbx.Tx) (err error) {
+		_, err = tx.Tx.ExecContext(ctx, `
+			INSERT INTO audit_outcome_baselines (
+				node_id,
+				audit_reputation_alpha, audit_reputation_beta,
+				unknown_audit_reputation_alpha, unknown_audit_reputation_beta,
+				online_score, total_audit_count, audit_history,
+				vetted_at, unknown_audit_suspended, offline_suspended, under_review, disqualified
+			)
+			SELECT id,
+				audit_reputation_alpha, audit_reputation_beta,
+				unknown_audit_reputation_alpha, unknown_audit_reputation_beta,
+				online_score, total_audit_count, audit_history,
+				vetted_at, unknown_audit_suspended, offline_suspended, under_review, disqualified
+			FROM reputations
+			WHERE id = $1
+			ON CONFLICT (node_id) DO NOTHING
+		`, outcome.NodeID)
+		if err != nil {
+			return err
+		}
+
+		// nodes without a reputation start from the reputation of a new node.
+		_, err = tx.Tx.ExecContext(ctx, `
+			INSERT INTO audit_outcome_baselines (
+				node_id,
+				audit_reputation_alpha, audit_reputation_beta,
+				unknown_audit_reputation_alpha, unknown_audit_reputation_beta,
+				online_score, total_audit_count, audit_history
+			) VALUES ($1, 1, 0, 1, 0, 1, 0, $2)
+			ON CONFLICT (node_id) DO NOTHING
+		`, outcome.NodeID, emptyHistory)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.Tx.ExecContext(ctx, `
+			INSERT INTO audit_outcomes (id, node_id, audited_at, outcome, piece_audit)
+			VALUES ($1, $2, $3, $4, $5)
+		`, outcome.ID, outcome.NodeID, outcome.AuditedAt.UTC(), int(outcome.Outcome), outcome.PieceAudit)
+		return err
+	})
+	return Error.Wrap(err)
+}
+
+// DeleteAuditOutcome removes a stored audit outcome.
+func (reputations *reputations) DeleteAuditOutcome(ctx context.Context, id uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = reputations.db.ExecContext(ctx, `
+		DELETE FROM audit_outcomes WHERE id = $1
+	`, id)
+	return Error.Wrap(err)
+}
+
+// IterateAuditOutcomes calls fn for the stored audit outcomes before the time, or all of them when
+// before is zero, ordered by node and audit time. Outcomes of all nodes are iterated when nodeIDs is empty.
+func (reputations *reputations) IterateAuditOutcomes(ctx context.Context, nodeIDs []storj.NodeID, before time.Time, fn func(reputation.AuditOutcome) error) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	query := `
+		SELECT id, node_id, audited_at, outcome, piece_audit
+		FROM audit_outcomes
+	`
+	var conditions []string
+	var args []interface{}
+	if len(nodeIDs) > 0 {
+		args = append(args, pgutil.NodeIDArray(nodeIDs))
+		conditions = append(conditions, fmt.Sprintf("node_id = ANY($%d)", len(args)))
+	}
+	if !before.IsZero() {
+		args = append(args, before.UTC())
+		conditions = append(conditions, fmt.Sprintf("audited_at < $%d", len(args)))
+	}
+	if len(conditions) > 0 {
+		query += `WHERE ` + strings.Join(conditions, " AND ")
+	}
+	query += ` ORDER BY node_id, audited_at`
+
+	rows, err := reputations.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	for rows.Next() {
+		var outcome reputation.AuditOutcome
+		var auditType int
+		err = rows.Scan(&outcome.ID, &outcome.NodeID, &outcome.AuditedAt, &auditType, &outcome.PieceAudit)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		outcome.Outcome = reputation.AuditType(auditType)
+
+		if err := fn(outcome); err != nil {
+			return err
+		}
+	}
+	return Error.Wrap(rows.Err())
+}
+
+// AuditOutcomeBaseline returns the reputation of the node before its oldest stored audit outcome.
+func (reputations *reputations) AuditOutcomeBaseline(ctx context.Context, nodeID storj.NodeID) (_ reputation.ReplayBaseline, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	baseline := reputation.ReplayBaseline{NodeID: nodeID}
+	var historyBytes []byte
+	err = reputations.db.QueryRowContext(ctx, `
+		SELECT audit_reputation_alpha, audit_reputation_beta,
+			unknown_audit_reputation_alpha, unknown_audit_reputation_beta,
+			online_score, total_audit_count, audit_history,
+			vetted_at, unknown_audit_suspended, offline_suspended, under_review, disqualified
+		FROM audit_outcome_baselines
+		WHERE node_id = $1
+	`, nodeID).Scan(
+		&baseline.AuditReputationAlpha, &baseline.AuditReputationBeta,
+		&baseline.UnknownAuditReputationAlpha, &baseline.UnknownAuditReputationBeta,
+		&baseline.OnlineScore, &baseline.TotalAuditCount, &historyBytes,
+		&baseline.VettedAt, &baseline.UnknownAuditSuspended, &baseline.OfflineSuspended, &baseline.UnderReview, &baseline.Disqualified,
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return reputation.NewNodeBaseline(nodeID), nil
+	}
+	if err != nil {
+		return reputation.ReplayBaseline{}, Error.Wrap(err)
+	}
+
+	history, err := auditHistoryFromPB(historyBytes)
+	if err != nil {
+		return reputation.ReplayBaseline{}, Error.Wrap(err)
+	}
+	baseline.AuditHistory = *history
+	return baseline, nil
+}
+
+// FoldAuditOutcomes replaces the baseline of the node and deletes its audit outcomes before the time.
+func (reputations *reputations) FoldAuditOutcomes(ctx context.Context, baseline reputation.ReplayBaseline, before time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	history, err := pb.Marshal(auditHistoryToPB(&baseline.AuditHistory))
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	err = reputations.db.WithTx(ctx, func(ctx context.Context, tx *dbx.Tx) (err error) {
+		_, err = tx.Tx.ExecContext(ctx, `
+			INSERT INTO audit_outcome_baselines (
+				node_id,
+				audit_reputation_alpha, audit_reputation_beta,
+				unknown_audit_reputation_alpha, unknown_audit_reputation_beta,
+				online_score, total_audit_count, audit_history,
+				vetted_at, unknown_audit_suspended, offline_suspended, under_review, disqualified
+			) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+			ON CONFLICT (node_id) DO UPDATE SET
+				audit_reputation_alpha = EXCLUDED.audit_reputation_alpha,
+				audit_reputation_beta = EXCLUDED.audit_reputation_beta,
+				unknown_audit_reputation_alpha = EXCLUDED.unknown_audit_reputation_alpha,
+				unknown_audit_reputation_beta = EXCLUDED.unknown_audit_reputation_beta,
+				online_score = EXCLUDED.online_score,
+				total_audit_count = EXCLUDED.total_audit_count,
+				audit_history = EXCLUDED.audit_history,
+				vetted_at = EXCLUDED.vetted_at,
+				unknown_audit_suspended = EXCLUDED.unknown_audit_suspended,
+				offline_suspended = EXCLUDED.offline_suspended,
+				under_review = EXCLUDED.under_review,
+				disqualified = EXCLUDED.disqualified
+		`, baseline.NodeID,
+			baseline.AuditReputationAlpha, baseline.AuditReputationBeta,
+			baseline.UnknownAuditReputationAlpha, baseline.UnknownAuditReputationBeta,
+			baseline.OnlineScore, baseline.TotalAuditCount, history,
+			baseline.VettedAt, baseline.UnknownAuditSuspended, baseline.OfflineSuspended, baseline.UnderReview, baseline.Disqualified,
+		)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.Tx.ExecContext(ctx, `
+			DELETE FROM audit_outcomes
+			WHERE node_id = $1 AND audited_at < $2
+		`, baseline.NodeID, before.UTC())
+		return err
+	})
+	return Error.Wrap(err)
+}
 
+// Reinstate lifts the disqualification and suspensions of a node, resets its audit and online
+// scores and records the reinstatement. When the reinstatement is on probation, the node also
+// has to be vetted again.
+func (reputations *reputations) Reinstate(ctx context.Context, reinstatement reputation.Reinstatement) (_ reputation.Reinstatement, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	emptyHistory, err := pb.Marshal(&internalpb.AuditHistory{})
+	if err != nil {
+		return reputation.Reinstatement{}, Error.Wrap(err)
+	}
+
+	err = reputations.db.WithTx(ctx, func(ctx context.Context, tx *dbx.Tx) (err error) {
+		_, err = tx.Tx.ExecContext(ctx, "SET TRANSACTION ISOLATION LEVEL SERIALIZABLE")
+		if err != nil {
+			return err
+		}
+
+		var reason *int
+		err = tx.Tx.QueryRowContext(ctx, `
+			SELECT disqualified, disqualification_reason, unknown_audit_suspended, offline_suspended
+			FROM nodes
+			WHERE id = $1
+		`, reinstatement.NodeID).Scan(
+			&reinstatement.PreviousDisqualified, &reason,
+			&reinstatement.PreviousUnknownAuditSuspended, &reinstatement.PreviousOfflineSuspended)
+		if errors.Is(err, sql.ErrNoRows) {
+			return reputation.ErrNodeNotFound.New("%v", reinstatement.NodeID)
+		} else if err != nil {
+			return err
+		}
+		if reinstatement.PreviousDisqualified == nil && reinstatement.PreviousUnknownAuditSuspended == nil && reinstatement.PreviousOfflineSuspended == nil {
+			return reputation.ErrNodeNotPenalized.New("%v", reinstatement.NodeID)
+		}
+		if reason != nil {
+			reinstatement.PreviousDisqualificationReason = overlay.DisqualificationReason(*reason)
+		}
+
+		_, err = tx.Tx.ExecContext(ctx, `
+			UPDATE nodes
+			SET disqualified = NULL,
+				disqualification_reason = NULL,
+				unknown_audit_suspended = NULL,
+				offline_suspended = NULL,
+				vetted_at = CASE WHEN $2 THEN NULL ELSE vetted_at END
+			WHERE id = $1
+		`, reinstatement.NodeID, reinstatement.Probation)
+		if err != nil {
+			return err
+		}
+
+		// the scores and the audit history, which caused the penalties, are reset as well,
+		// otherwise the next audit would disqualify or suspend the node again.
+		_, err = tx.Tx.ExecContext(ctx, `
+			UPDATE reputations
+			SET disqualified = NULL,
+				unknown_audit_suspended = NULL,
+				offline_suspended = NULL,
+				under_review = NULL,
+				online_score = 1,
+				audit_history = $2,
+				audit_reputation_alpha = 1,
+				audit_reputation_beta = 0,
+				unknown_audit_reputation_alpha = 1,
+				unknown_audit_reputation_beta = 0
+			WHERE id = $1
+		`, reinstatement.NodeID, emptyHistory)
+		if err != nil {
+			return err
+		}
+
+		if reinstatement.Probation {
+			_, err = tx.Tx.ExecContext(ctx, `
+				UPDATE reputations
+				SET vetted_at = NULL,
+					audit_success_count = 0,
+					total_audit_count = 0
+				WHERE id = $1
+			`, reinstatement.NodeID)
+			if err != nil {
+				return err
+			}
+		}
+
+		// the stored outcomes led to the penalties, so the replays start
+		// from the reinstated reputation instead.
+		_, err = tx.Tx.ExecContext(ctx, `DELETE FROM audit_outcomes WHERE node_id = $1`, reinstatement.NodeID)
+		if err != nil {
+			return err
+		}
+		_, err = tx.Tx.ExecContext(ctx, `DELETE FROM audit_outcome_baselines WHERE node_id = $1`, reinstatement.NodeID)
+		if err != nil {
+			return err
+		}
+
+		var previousReason *int
+		if reinstatement.PreviousDisqualified != nil {
+			previousReason = reason
+		}
+
+		return tx.Tx.QueryRowContext(ctx, `
+			INSERT INTO node_reinstatements (
+				id, node_id, reinstated_by, reason, probation,
+				previous_disqualified, previous_disqualification_reason,
+				previous_unknown_audit_suspended, previous_offline_suspended
+			) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+			RETURNING created_at
+		`, reinstatement.ID, reinstatement.NodeID, reinstatement.ReinstatedBy, reinstatement.Reason, reinstatement.Probation,
+			reinstatement.PreviousDisqualified, previousReason,
+			reinstatement.PreviousUnknownAuditSuspended, reinstatement.PreviousOfflineSuspended,
+		).Scan(&reinstatement.CreatedAt)
+	})
+	if reputation.ErrNodeNotFound.Has(err) || reputation.ErrNodeNotPenalized.Has(err) {
+		return reputation.Reinstatement{}, err
+	}
+	if err != nil {
+		return reputation.Reinstatement{}, Error.Wrap(err)
+	}
+	return reinstatement, nil
+}
+
+// Reinstatements returns the reinstatements of a node, most recent first.
+func (reputations *reputations) Reinstatements(ctx context.Context, nodeID storj.NodeID) (_ []reputation.Reinstatement, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := reputations.db.QueryContext(ctx, `
+		SELECT id, node_id, reinstated_by, reason, probation,
+			previous_disqualified, previous_disqualification_reason,
+			previous_unknown_audit_suspended, previous_offline_suspended, created_at
+		FROM node_reinstatements
+		WHERE node_id = $1
+		ORDER BY created_at DESC
+	`, nodeID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var reinstatements []reputation.Reinstatement
+	for rows.Next() {
+		var reinstatement reputation.Reinstatement
+		var reason *int
+		err = rows.Scan(&reinstatement.ID, &reinstatement.NodeID, &reinstatement.ReinstatedBy, &reinstatement.Reason, &reinstatement.Probation,
+			&reinstatement.PreviousDisqualified, &reason,
+			&reinstatement.PreviousUnknownAuditSuspended, &reinstatement.PreviousOfflineSuspended, &reinstatement.CreatedAt)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		if reason != nil {
+			reinstatement.PreviousDisqualificationReason = overlay.DisqualificationReason(*reason)
+		}
+		reinstatements = append(reinstatements, reinstatement)
+	}
+	return reinstatements, Error.Wrap(rows.Err())
+}
+
+func (reputations *reputations) populateCreateFields(dbNode *dbx.Reputation, updateReq reputation.UpdateRequest, update updateNodeStats) dbx.Reputation_Create_Fields {
 	createFields := dbx.Reputation_Create_Fields{}
 
 	if update.VettedAt.set {
@@ -341,9 +697,7 @@ func (reputations *reputations) populateCreateFields(dbNode *dbx.Reputation, upd
 
 	return createFields
 }
-func (reputations *reputations) populateUpdateFields(dbNode *dbx.Reputation, updateReq reputation.UpdateRequest, auditHistoryResponse *reputation.UpdateAuditHistoryResponse, now time.Time) dbx.Reputation_Update_Fields {
-	update := reputations.populateUpdateNodeStats(dbNode, updateReq, auditHistoryResponse, now)
-
+func (reputations *reputations) populateUpdateFields(dbNode *dbx.Reputation, updateReq reputation.UpdateRequest, auditHistoryResponse *reputation.UpdateAuditHistoryResponse, update updateNodeStats) dbx.Reputation_Update_Fields {
 	updateFields := dbx.Reputation_Update_Fields{
 		AuditHistory: dbx.Reputation_AuditHistory(auditHistoryResponse.History),
 	}
@@ -408,60 +762,19 @@ func (reputations *reputations) populateUpdateNodeStats(dbNode *dbx.Reputation,
 	// if a node fails enough audits, it gets disqualified
 	// if a node gets enough "unknown" audits, it gets put into suspension
 	// if a node gets enough successful audits, and is in suspension, it gets removed from suspension
-	auditAlpha := dbNode.AuditReputationAlpha
-	auditBeta := dbNode.AuditReputationBeta
-	unknownAuditAlpha := dbNode.UnknownAuditReputationAlpha
-	unknownAuditBeta := dbNode.UnknownAuditReputationBeta
-	totalAuditCount := dbNode.TotalAuditCount
+	scores := reputation.BetaScores{
+		AuditAlpha:        dbNode.AuditReputationAlpha,
+		AuditBeta:         dbNode.AuditReputationBeta,
+		UnknownAuditAlpha: dbNode.UnknownAuditReputationAlpha,
+		UnknownAuditBeta:  dbNode.UnknownAuditReputationBeta,
+	}.Apply(updateReq.AuditOutcome, updateReq.AuditLambda, updateReq.AuditWeight)
+	auditAlpha := scores.AuditAlpha
+	auditBeta := scores.AuditBeta
+	unknownAuditAlpha := scores.UnknownAuditAlpha
+	unknownAuditBeta := scores.UnknownAuditBeta
+	updatedTotalAuditCount := dbNode.TotalAuditCount + 1
 	vettedAt := dbNode.VettedAt
 
-	var updatedTotalAuditCount int64
-
-	switch updateReq.AuditOutcome {
-	case reputation.AuditSuccess:
-		// for a successful audit, increase reputation for normal *and* unknown audits
-		auditAlpha, auditBeta, updatedTotalAuditCount = updateReputation(
-			true,
-			auditAlpha,
-			auditBeta,
-			updateReq.AuditLambda,
-			updateReq.AuditWeight,
-			totalAuditCount,
-		)
-		// we will use updatedTotalAuditCount from the updateReputation call above
-		unknownAuditAlpha, unknownAuditBeta, _ = updateReputation(
-			true,
-			unknownAuditAlpha,
-			unknownAuditBeta,
-			updateReq.AuditLambda,
-			updateReq.AuditWeight,
-			totalAuditCount,
-		)
-	case reputation.AuditFailure:
-		// for audit failure, only update normal alpha/beta
-		auditAlpha, auditBeta, updatedTotalAuditCount = updateReputation(
-			false,
-			auditAlpha,
-			auditBeta,
-			updateReq.AuditLambda,
-			updateReq.AuditWeight,
-			totalAuditCount,
-		)
-	case reputation.AuditUnknown:
-		// for audit unknown, only update unknown alpha/beta
-		unknownAuditAlpha, unknownAuditBeta, updatedTotalAuditCount = updateReputation(
-			false,
-			unknownAuditAlpha,
-			unknownAuditBeta,
-			updateReq.AuditLambda,
-			updateReq.AuditWeight,
-			totalAuditCount,
-		)
-	case reputation.AuditOffline:
-		// for audit offline, only update total audit count
-		updatedTotalAuditCount = totalAuditCount + 1
-	}
-
 	mon.FloatVal("audit_reputation_alpha").Observe(auditAlpha)                //mon:locked
 	mon.FloatVal("audit_reputation_beta").Observe(auditBeta)                  //mon:locked
 	mon.FloatVal("unknown_audit_reputation_alpha").Observe(unknownAuditAlpha) //mon:locked
@@ -494,6 +807,7 @@ func (reputations *reputations) populateUpdateNodeStats(dbNode *dbx.Reputation,
 		reputations.db.log.Info("Disqualified", zap.String("DQ type", "audit failure"), zap.String("Node ID", updateReq.NodeID.String()))
 		mon.Meter("bad_audit_dqs").Mark(1) //mon:locked
 		updateFields.Disqualified = timeField{set: true, value: now}
+		updateFields.DisqualificationReason = overlay.DisqualificationReasonAuditFailure
 	}
 
 	// if unknown audit rep goes below threshold, suspend node. Otherwise unsuspend node.
@@ -519,6 +833,7 @@ func (reputations *reputations) populateUpdateNodeStats(dbNode *dbx.Reputation,
 				reputations.db.log.Info("Disqualified", zap.String("DQ type", "suspension grace period expired for unknown audits"), zap.String("Node ID", updateReq.NodeID.String()))
 				mon.Meter("unknown_suspension_dqs").Mark(1) //mon:locked
 				updateFields.Disqualified = timeField{set: true, value: now}
+				updateFields.DisqualificationReason = overlay.DisqualificationReasonSuspension
 				updateFields.UnknownAuditSuspended = timeField{set: true, isNil: true}
 			}
 		}
@@ -576,6 +891,7 @@ func (reputations *reputations) populateUpdateNodeStats(dbNode *dbx.Reputation,
 					reputations.db.log.Info("Disqualified", zap.String("DQ type", "node offline"), zap.String("Node ID", updateReq.NodeID.String()))
 					mon.Meter("offline_dqs").Mark(1) //mon:locked
 					updateFields.Disqualified = timeField{set: true, value: now}
+					updateFields.DisqualificationReason = overlay.DisqualificationReasonNodeOffline
 				}
 			} else {
 				updateFields.OfflineUnderReview = timeField{set: true, isNil: true}
@@ -619,6 +935,7 @@ type updateNodeStats struct {
 	AuditReputationAlpha        float64Field
 	AuditReputationBeta         float64Field
 	Disqualified                timeField
+	DisqualificationReason      overlay.DisqualificationReason
 	UnknownAuditReputationAlpha float64Field
 	UnknownAuditReputationBeta  float64Field
 	UnknownAuditSuspended       timeField
@@ -640,17 +957,3 @@ func getNodeStatus(dbNode *dbx.Reputation) overlay.ReputationStatus {
 	}
 
 }
-
-// updateReputation uses the Beta distribution model to determine a node's reputation.
-// lambda is the "forgetting factor" which determines how much past info is kept when determining current reputation score.
-// w is the normalization weight that affects how severely new updates affect the current reputation distribution.
-func updateReputation(isSuccess bool, alpha, beta, lambda, w float64, totalCount int64) (newAlpha, newBeta float64, updatedCount int64) {
-	// v is a single feedback value that allows us to update both alpha and beta
-	var v float64 = -1
-	if isSuccess {
-		v = 1
-	}
-	newAlpha = lambda*alpha + w*(1+v)/2
-	newBeta = lambda*beta + w*(1-v)/2
-	return newAlpha, newBeta, totalCount + 1
-}
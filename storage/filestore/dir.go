@@ -216,6 +216,25 @@ func (dir *Dir) blobToGarbagePath(ref storage.BlobRef) string {
 
 // Commit commits the temporary file to permanent storage.
 func (dir *Dir) Commit(ctx context.Context, file *os.File, ref storage.BlobRef, formatVersion storage.FormatVersion) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	_, err = dir.commitInPath(ctx, file, dir.blobsdir(), ref, formatVersion)
+	return err
+}
+
+// commitToTrash commits the temporary file to the trash, as if the blob had been
+// moved to the trash at trashedAt.
+func (dir *Dir) commitToTrash(ctx context.Context, file *os.File, ref storage.BlobRef, formatVersion storage.FormatVersion, trashedAt time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	path, err := dir.commitInPath(ctx, file, dir.trashdir(), ref, formatVersion)
+	if err != nil {
+		return err
+	}
+	return os.Chtimes(path, trashedAt, trashedAt)
+}
+
+// commitInPath moves the temporary file to the blob path in the specified
+// sub-directory and returns the blob path.
+func (dir *Dir) commitInPath(ctx context.Context, file *os.File, subDir string, ref storage.BlobRef, formatVersion storage.FormatVersion) (_ string, err error) {
 	defer mon.Task()(&ctx)(&err)
 	position, seekErr := file.Seek(0, io.SeekCurrent)
 	truncErr := file.Truncate(position)
@@ -225,13 +244,13 @@ func (dir *Dir) Commit(ctx context.Context, file *os.File, ref storage.BlobRef,
 
 	if seekErr != nil || truncErr != nil || syncErr != nil || chmodErr != nil || closeErr != nil {
 		removeErr := os.Remove(file.Name())
-		return errs.Combine(seekErr, truncErr, syncErr, chmodErr, closeErr, removeErr)
+		return "", errs.Combine(seekErr, truncErr, syncErr, chmodErr, closeErr, removeErr)
 	}
 
-	path, err := dir.blobToBasePath(ref)
+	path, err := dir.refToDirPath(ref, subDir)
 	if err != nil {
 		removeErr := os.Remove(file.Name())
-		return errs.Combine(err, removeErr)
+		return "", errs.Combine(err, removeErr)
 	}
 	path = blobPathForFormatVersion(path, formatVersion)
 
@@ -242,16 +261,16 @@ func (dir *Dir) Commit(ctx context.Context, file *os.File, ref storage.BlobRef,
 
 	if mkdirErr != nil {
 		removeErr := os.Remove(file.Name())
-		return errs.Combine(mkdirErr, removeErr)
+		return "", errs.Combine(mkdirErr, removeErr)
 	}
 
 	renameErr := rename(file.Name(), path)
 	if renameErr != nil {
 		removeErr := os.Remove(file.Name())
-		return errs.Combine(renameErr, removeErr)
+		return "", errs.Combine(renameErr, removeErr)
 	}
 
-	return nil
+	return path, nil
 }
 
 // Open opens the file with the specified ref. It may need to check in more than one location in
@@ -837,7 +856,12 @@ type DiskInfo struct {
 
 // Info returns information about the current state of the dir.
 func (dir *Dir) Info(ctx context.Context) (DiskInfo, error) {
-	path, err := filepath.Abs(dir.path)
+	return DiskInfoFromPath(dir.path)
+}
+
+// DiskInfoFromPath returns information about the disk containing the path.
+func DiskInfoFromPath(path string) (DiskInfo, error) {
+	path, err := filepath.Abs(path)
 	if err != nil {
 		return DiskInfo{}, err
 	}
@@ -0,0 +1,860 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package filestore
+
+import (
+	"context"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/memory"
+	"storj.io/common/storj"
+	"storj.io/storj/storage"
+)
+
+var _ storage.Blobs = (*MultiStore)(nil)
+
+// DirConfig is an additional storage directory with the disk space allocated in it.
+type DirConfig struct {
+	Path      string
+	Allocated memory.Size
+	// Draining directories don't receive new blobs and their blobs are moved
+	// to the other directories.
+	Draining bool
+}
+
+// String returns the directory in the format path=size or path=drain.
+func (config DirConfig) String() string {
+	if config.Draining {
+		return config.Path + "=drain"
+	}
+	return config.Path + "=" + config.Allocated.String()
+}
+
+// DirsConfig is a list of additional storage directories.
+//
+// Can be used as a flag.
+type DirsConfig struct {
+	List []DirConfig
+}
+
+// Type implements pflag.Value.
+func (DirsConfig) Type() string { return "filestore.DirsConfig" }
+
+// String is required for pflag.Value. It is a comma separated list of directories.
+func (dirs *DirsConfig) String() string {
+	var s strings.Builder
+	for i, dir := range dirs.List {
+		if i > 0 {
+			s.WriteString(",")
+		}
+		s.WriteString(dir.String())
+	}
+	return s.String()
+}
+
+// Set sets the value from a string in the format "path=size,path=drain,...".
+func (dirs *DirsConfig) Set(s string) error {
+	dirs.List = nil
+	for _, value := range strings.Split(s, ",") {
+		value = strings.TrimSpace(value)
+		if value == "" {
+			continue
+		}
+		// the path may contain '=' or ':', so the last separator is used.
+		i := strings.LastIndex(value, "=")
+		if i < 0 {
+			return Error.New("invalid storage directory (expect format path=size or path=drain, got %s)", value)
+		}
+		dir := DirConfig{Path: strings.TrimSpace(value[:i])}
+		if dir.Path == "" {
+			return Error.New("storage directory path is empty: %s", value)
+		}
+
+		allocation := strings.TrimSpace(value[i+1:])
+		if allocation == "drain" {
+			dir.Draining = true
+		} else {
+			if allocation == "" || allocation[0] < '0' || allocation[0] > '9' {
+				return Error.New("invalid allocated disk space for storage directory %q: %s", dir.Path, allocation)
+			}
+			size, err := memory.ParseString(allocation)
+			if err != nil {
+				return Error.New("invalid allocated disk space for storage directory %q: %v", dir.Path, err)
+			}
+			if size <= 0 {
+				return Error.New("allocated disk space must be positive for storage directory %q", dir.Path)
+			}
+			dir.Allocated = memory.Size(size)
+		}
+		dirs.List = append(dirs.List, dir)
+	}
+	return nil
+}
+
+// Allocated returns the disk space allocated in all directories.
+func (dirs *DirsConfig) Allocated() memory.Size {
+	var total memory.Size
+	for _, dir := range dirs.List {
+		total += dir.Allocated
+	}
+	return total
+}
+
+// Draining returns whether any of the directories is draining.
+func (dirs *DirsConfig) Draining() bool {
+	for _, dir := range dirs.List {
+		if dir.Draining {
+			return true
+		}
+	}
+	return false
+}
+
+// DirStatus contains information about a storage directory of a MultiStore.
+type DirStatus struct {
+	Path      string
+	Allocated int64
+	// UsedForBlobs is the space used by blobs, as far as it's known to the store.
+	UsedForBlobs int64
+	DiskFree     int64
+	Draining     bool
+	Writable     bool
+}
+
+// multiDir is a storage directory of a MultiStore.
+type multiDir struct {
+	store     *blobStore
+	allocated int64
+	draining  bool
+
+	// writable is false when the last writability check failed.
+	writable bool
+	// used is the space used by blobs by namespace.
+	used map[string]int64
+}
+
+// MultiStore implements a blob store which spans multiple directories.
+//
+// New blobs are created in the directory with the most available space, taking
+// into account the free disk space and the space allocated in the directory.
+// The space used in a directory is known after the space used of the namespaces
+// has been calculated, until then only the free disk space is considered.
+//
+// architecture: Database
+type MultiStore struct {
+	log    *zap.Logger
+	config Config
+
+	mu   sync.Mutex
+	dirs []*multiDir
+}
+
+// NewMulti creates a blob store spanning the primary directory and the additional directories.
+// The additional directories are created when they don't exist.
+func NewMulti(log *zap.Logger, primary *Dir, primaryAllocated memory.Size, additional []DirConfig, config Config) (*MultiStore, error) {
+	store := &MultiStore{
+		log:    log,
+		config: config,
+	}
+	store.dirs = append(store.dirs, &multiDir{
+		store:     &blobStore{log: log, dir: primary, config: config},
+		allocated: primaryAllocated.Int64(),
+		writable:  true,
+		used:      map[string]int64{},
+	})
+
+	for _, dirConfig := range additional {
+		dir, err := NewDir(log, dirConfig.Path)
+		if err != nil {
+			return nil, Error.New("unable to open storage directory %q: %v", dirConfig.Path, err)
+		}
+		store.dirs = append(store.dirs, &multiDir{
+			store:     &blobStore{log: log, dir: dir, config: config},
+			allocated: dirConfig.Allocated.Int64(),
+			draining:  dirConfig.Draining,
+			writable:  true,
+			used:      map[string]int64{},
+		})
+	}
+
+	return store, nil
+}
+
+// Close closes the store.
+func (store *MultiStore) Close() error {
+	var group errs.Group
+	for _, dir := range store.dirs {
+		group.Add(dir.store.Close())
+	}
+	return group.Err()
+}
+
+// Create creates a new blob in the directory with the most available space.
+func (store *MultiStore) Create(ctx context.Context, ref storage.BlobRef, size int64) (_ storage.BlobWriter, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dir, err := store.placement(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	writer, err := dir.store.Create(ctx, ref, size)
+	if err != nil {
+		return nil, err
+	}
+	return &multiWriter{BlobWriter: writer, store: store, dir: dir, namespace: string(ref.Namespace)}, nil
+}
+
+// placement returns the writable, non-draining directory with the most
+// available space, excluding the specified directory.
+func (store *MultiStore) placement(ctx context.Context, exclude *multiDir) (_ *multiDir, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var best *multiDir
+	var bestAvailable int64
+	for _, dir := range store.dirs {
+		if dir == exclude || dir.draining || !store.isWritable(dir) {
+			continue
+		}
+
+		available, err := store.available(ctx, dir)
+		if err != nil {
+			store.log.Warn("unable to get available space", zap.String("Path", dir.store.dir.Path()), zap.Error(err))
+			continue
+		}
+		if best == nil || available > bestAvailable {
+			best, bestAvailable = dir, available
+		}
+	}
+	if best == nil {
+		return nil, Error.New("no writable storage directory")
+	}
+	return best, nil
+}
+
+// available returns the space available for blobs in the directory.
+func (store *MultiStore) available(ctx context.Context, dir *multiDir) (int64, error) {
+	diskFree, err := dir.store.FreeSpace(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	available := dir.allocated - store.usedForBlobs(dir)
+	if diskFree < available {
+		available = diskFree
+	}
+	return available, nil
+}
+
+func (store *MultiStore) isWritable(dir *multiDir) bool {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	return dir.writable
+}
+
+func (store *MultiStore) usedForBlobs(dir *multiDir) (total int64) {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	for _, used := range dir.used {
+		total += used
+	}
+	return total
+}
+
+// addUsed adjusts the space used by blobs in a directory.
+func (store *MultiStore) addUsed(dir *multiDir, namespace []byte, size int64) {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	dir.used[string(namespace)] += size
+}
+
+// statSize returns the size of the blob in the directory, or zero when it doesn't exist.
+func (store *MultiStore) statSize(ctx context.Context, dir *multiDir, ref storage.BlobRef) int64 {
+	info, err := dir.store.Stat(ctx, ref)
+	if err != nil {
+		return 0
+	}
+	stat, err := info.Stat(ctx)
+	if err != nil {
+		return 0
+	}
+	return stat.Size()
+}
+
+// Open opens a reader for the blob in whichever directory contains it.
+func (store *MultiStore) Open(ctx context.Context, ref storage.BlobRef) (_ storage.BlobReader, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var lastErr error
+	for _, dir := range store.dirs {
+		reader, err := dir.store.Open(ctx, ref)
+		if err == nil {
+			return reader, nil
+		}
+		if lastErr == nil || !errs.IsFunc(err, os.IsNotExist) {
+			lastErr = err
+		}
+	}
+	return nil, lastErr
+}
+
+// OpenWithStorageFormat opens a reader for the blob with the given storage format in
+// whichever directory contains it.
+func (store *MultiStore) OpenWithStorageFormat(ctx context.Context, ref storage.BlobRef, formatVer storage.FormatVersion) (_ storage.BlobReader, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var lastErr error
+	for _, dir := range store.dirs {
+		reader, err := dir.store.OpenWithStorageFormat(ctx, ref, formatVer)
+		if err == nil {
+			return reader, nil
+		}
+		if lastErr == nil || !errs.IsFunc(err, os.IsNotExist) {
+			lastErr = err
+		}
+	}
+	return nil, lastErr
+}
+
+// Stat looks up disk metadata on the blob file in whichever directory contains it.
+func (store *MultiStore) Stat(ctx context.Context, ref storage.BlobRef) (_ storage.BlobInfo, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var lastErr error
+	for _, dir := range store.dirs {
+		info, err := dir.store.Stat(ctx, ref)
+		if err == nil {
+			return info, nil
+		}
+		if lastErr == nil || !errs.IsFunc(err, os.IsNotExist) {
+			lastErr = err
+		}
+	}
+	return nil, lastErr
+}
+
+// StatWithStorageFormat looks up disk metadata on the blob file with the given storage format
+// version in whichever directory contains it.
+func (store *MultiStore) StatWithStorageFormat(ctx context.Context, ref storage.BlobRef, formatVer storage.FormatVersion) (_ storage.BlobInfo, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var lastErr error
+	for _, dir := range store.dirs {
+		info, err := dir.store.StatWithStorageFormat(ctx, ref, formatVer)
+		if err == nil {
+			return info, nil
+		}
+		if lastErr == nil || !errs.IsFunc(err, os.IsNotExist) {
+			lastErr = err
+		}
+	}
+	return nil, lastErr
+}
+
+// Delete deletes the blob from all directories.
+func (store *MultiStore) Delete(ctx context.Context, ref storage.BlobRef) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var group errs.Group
+	for _, dir := range store.dirs {
+		size := store.statSize(ctx, dir, ref)
+		if err := dir.store.Delete(ctx, ref); err != nil {
+			group.Add(err)
+			continue
+		}
+		store.addUsed(dir, ref.Namespace, -size)
+	}
+	return group.Err()
+}
+
+// DeleteWithStorageFormat deletes the blob with the storage format version from all directories.
+func (store *MultiStore) DeleteWithStorageFormat(ctx context.Context, ref storage.BlobRef, formatVer storage.FormatVersion) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var group errs.Group
+	for _, dir := range store.dirs {
+		var size int64
+		if info, err := dir.store.StatWithStorageFormat(ctx, ref, formatVer); err == nil {
+			if stat, err := info.Stat(ctx); err == nil {
+				size = stat.Size()
+			}
+		}
+		if err := dir.store.DeleteWithStorageFormat(ctx, ref, formatVer); err != nil {
+			group.Add(err)
+			continue
+		}
+		store.addUsed(dir, ref.Namespace, -size)
+	}
+	return group.Err()
+}
+
+// DeleteNamespace deletes the blobs folder of the namespace in all directories.
+func (store *MultiStore) DeleteNamespace(ctx context.Context, ref []byte) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var group errs.Group
+	for _, dir := range store.dirs {
+		group.Add(dir.store.DeleteNamespace(ctx, ref))
+
+		store.mu.Lock()
+		delete(dir.used, string(ref))
+		store.mu.Unlock()
+	}
+	return group.Err()
+}
+
+// Trash moves the blob to the trash of the directory which contains it.
+func (store *MultiStore) Trash(ctx context.Context, ref storage.BlobRef) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var group errs.Group
+	for _, dir := range store.dirs {
+		size := store.statSize(ctx, dir, ref)
+		if err := dir.store.Trash(ctx, ref); err != nil {
+			group.Add(err)
+			continue
+		}
+		store.addUsed(dir, ref.Namespace, -size)
+	}
+	return group.Err()
+}
+
+// RestoreTrash moves every blob of the namespace in the trash back into the regular location.
+func (store *MultiStore) RestoreTrash(ctx context.Context, namespace []byte) (keysRestored [][]byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var group errs.Group
+	for _, dir := range store.dirs {
+		keys, err := dir.store.RestoreTrash(ctx, namespace)
+		group.Add(err)
+		for _, key := range keys {
+			store.addUsed(dir, namespace, store.statSize(ctx, dir, storage.BlobRef{Namespace: namespace, Key: key}))
+		}
+		keysRestored = append(keysRestored, keys...)
+	}
+	return keysRestored, group.Err()
+}
+
+// EmptyTrash removes all blobs of the namespace which were moved to the trash before trashedBefore.
+func (store *MultiStore) EmptyTrash(ctx context.Context, namespace []byte, trashedBefore time.Time) (bytesEmptied int64, keys [][]byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var group errs.Group
+	for _, dir := range store.dirs {
+		emptied, deleted, err := dir.store.EmptyTrash(ctx, namespace, trashedBefore)
+		group.Add(err)
+		bytesEmptied += emptied
+		keys = append(keys, deleted...)
+	}
+	return bytesEmptied, keys, group.Err()
+}
+
+// GarbageCollect tries to delete any files that haven't yet been deleted.
+func (store *MultiStore) GarbageCollect(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var group errs.Group
+	for _, dir := range store.dirs {
+		group.Add(dir.store.GarbageCollect(ctx))
+	}
+	return group.Err()
+}
+
+// FreeSpace returns how much space is available for new blobs in all directories
+// which receive new blobs. The free space of directories on the same filesystem
+// is only counted once.
+func (store *MultiStore) FreeSpace(ctx context.Context) (total int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	seen := map[string]bool{}
+	for _, dir := range store.dirs {
+		if dir.draining || !store.isWritable(dir) {
+			continue
+		}
+		info, err := dir.store.dir.Info(ctx)
+		if err != nil {
+			return 0, Error.New("unable to get free space of %q: %v", dir.store.dir.Path(), err)
+		}
+		if seen[info.ID] {
+			continue
+		}
+		seen[info.ID] = true
+		total += info.AvailableSpace
+	}
+	return total, nil
+}
+
+// CheckWritability tests the writability of every storage directory. Directories
+// which aren't writable don't receive new blobs until they pass the check again.
+func (store *MultiStore) CheckWritability(ctx context.Context) error {
+	var group errs.Group
+	for _, dir := range store.dirs {
+		err := dir.store.CheckWritability(ctx)
+		if err != nil {
+			group.Add(Error.New("storage directory %q is not writable: %v", dir.store.dir.Path(), err))
+		}
+
+		store.mu.Lock()
+		dir.writable = err == nil
+		store.mu.Unlock()
+	}
+	return group.Err()
+}
+
+// SpaceUsedForTrash returns the total space used by the trash in all directories.
+func (store *MultiStore) SpaceUsedForTrash(ctx context.Context) (total int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	for _, dir := range store.dirs {
+		used, err := dir.store.SpaceUsedForTrash(ctx)
+		if err != nil {
+			return 0, err
+		}
+		total += used
+	}
+	return total, nil
+}
+
+// SpaceUsedForBlobs adds up the space used in all namespaces of all directories.
+func (store *MultiStore) SpaceUsedForBlobs(ctx context.Context) (total int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	namespaces, err := store.ListNamespaces(ctx)
+	if err != nil {
+		return 0, Error.New("failed to enumerate namespaces: %v", err)
+	}
+	for _, namespace := range namespaces {
+		used, err := store.SpaceUsedForBlobsInNamespace(ctx, namespace)
+		if err != nil {
+			return 0, Error.New("failed to sum space used: %v", err)
+		}
+		total += used
+	}
+	return total, nil
+}
+
+// SpaceUsedForBlobsInNamespace adds up the space used in the namespace of all directories.
+// The result is also used to account the space used in the directories.
+func (store *MultiStore) SpaceUsedForBlobsInNamespace(ctx context.Context, namespace []byte) (total int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	for _, dir := range store.dirs {
+		used, err := dir.store.SpaceUsedForBlobsInNamespace(ctx, namespace)
+		if err != nil {
+			return 0, err
+		}
+
+		store.mu.Lock()
+		dir.used[string(namespace)] = used
+		store.mu.Unlock()
+
+		total += used
+	}
+	return total, nil
+}
+
+// ListNamespaces finds all namespaces used in any of the directories.
+func (store *MultiStore) ListNamespaces(ctx context.Context) (ids [][]byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	seen := map[string]bool{}
+	for _, dir := range store.dirs {
+		namespaces, err := dir.store.ListNamespaces(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, namespace := range namespaces {
+			if !seen[string(namespace)] {
+				seen[string(namespace)] = true
+				ids = append(ids, namespace)
+			}
+		}
+	}
+	return ids, nil
+}
+
+// WalkNamespace executes walkFunc for each blob in the namespace of all directories.
+func (store *MultiStore) WalkNamespace(ctx context.Context, namespace []byte, walkFunc func(storage.BlobInfo) error) (err error) {
+	for _, dir := range store.dirs {
+		if err := dir.store.WalkNamespace(ctx, namespace, walkFunc); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// TestCreateV0 creates a new V0 blob in the primary directory. This is ONLY appropriate in test situations.
+func (store *MultiStore) TestCreateV0(ctx context.Context, ref storage.BlobRef) (_ storage.BlobWriter, err error) {
+	return store.dirs[0].store.TestCreateV0(ctx, ref)
+}
+
+// CreateVerificationFile creates the verification file in every directory.
+func (store *MultiStore) CreateVerificationFile(ctx context.Context, id storj.NodeID) error {
+	var group errs.Group
+	for _, dir := range store.dirs {
+		group.Add(dir.store.CreateVerificationFile(ctx, id))
+	}
+	return group.Err()
+}
+
+// VerifyStorageDir verifies every storage directory by checking for the existence and
+// validity of the verification file.
+//
+// Additional directories which don't have the verification file and don't contain
+// any blobs are new, so the verification file is created for them.
+func (store *MultiStore) VerifyStorageDir(ctx context.Context, id storj.NodeID) error {
+	var group errs.Group
+	for i, dir := range store.dirs {
+		err := dir.store.VerifyStorageDir(ctx, id)
+		if err != nil && i > 0 && os.IsNotExist(err) {
+			var namespaces [][]byte
+			namespaces, err = dir.store.ListNamespaces(ctx)
+			if err == nil && len(namespaces) == 0 {
+				store.log.Info("initializing new storage directory", zap.String("Path", dir.store.dir.Path()))
+				err = dir.store.CreateVerificationFile(ctx, id)
+			} else if err == nil {
+				err = Error.New("verification file is missing")
+			}
+		}
+		if err != nil {
+			group.Add(Error.New("storage directory %q: %v", dir.store.dir.Path(), err))
+		}
+	}
+	return group.Err()
+}
+
+// Dirs returns the status of every storage directory.
+func (store *MultiStore) Dirs(ctx context.Context) (_ []DirStatus, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var statuses []DirStatus
+	for _, dir := range store.dirs {
+		free, err := dir.store.FreeSpace(ctx)
+		if err != nil {
+			return nil, Error.New("unable to get free space of %q: %v", dir.store.dir.Path(), err)
+		}
+		statuses = append(statuses, DirStatus{
+			Path:         dir.store.dir.Path(),
+			Allocated:    dir.allocated,
+			UsedForBlobs: store.usedForBlobs(dir),
+			DiskFree:     free,
+			Draining:     dir.draining,
+			Writable:     store.isWritable(dir),
+		})
+	}
+	return statuses, nil
+}
+
+// Drain moves all blobs out of the draining directories to the other directories.
+// Blobs in the trash are moved to the trash of the other directories, where they
+// expire at the same time as they would have in the draining directory.
+//
+// Blobs stored with storage format V0 can't be moved, since they are only
+// stored in the primary directory, which can't be drained.
+func (store *MultiStore) Drain(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	for _, dir := range store.dirs {
+		if !dir.draining {
+			continue
+		}
+
+		path := dir.store.dir.Path()
+		store.log.Info("draining storage directory", zap.String("Path", path))
+
+		moved, err := store.drainDir(ctx, dir)
+		if err != nil {
+			return Error.New("draining %q failed after moving %d blobs: %v", path, moved, err)
+		}
+
+		// the trash is drained last, so that blobs trashed while draining are moved too.
+		movedTrash, err := store.drainTrash(ctx, dir)
+		if err != nil {
+			return Error.New("draining trash of %q failed after moving %d blobs: %v", path, movedTrash, err)
+		}
+
+		store.log.Info("storage directory drained, it can be removed from the configuration",
+			zap.String("Path", path),
+			zap.Int("Blobs Moved", moved),
+			zap.Int("Trashed Blobs Moved", movedTrash))
+	}
+	return nil
+}
+
+func (store *MultiStore) drainDir(ctx context.Context, dir *multiDir) (moved int, err error) {
+	namespaces, err := dir.store.ListNamespaces(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, namespace := range namespaces {
+		// the blobs are collected first to avoid modifying the directory while walking it.
+		var blobs []storage.BlobInfo
+		err := dir.store.WalkNamespace(ctx, namespace, func(info storage.BlobInfo) error {
+			blobs = append(blobs, info)
+			return nil
+		})
+		if err != nil {
+			return moved, err
+		}
+
+		for _, info := range blobs {
+			if err := store.moveBlob(ctx, dir, info.BlobRef(), info.StorageFormatVersion()); err != nil {
+				return moved, err
+			}
+			moved++
+		}
+	}
+	return moved, nil
+}
+
+func (store *MultiStore) drainTrash(ctx context.Context, dir *multiDir) (moved int, err error) {
+	trashdir := dir.store.dir.trashdir()
+	namespaces, err := dir.store.dir.listNamespacesInPath(ctx, trashdir)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, namespace := range namespaces {
+		var blobs []storage.BlobInfo
+		err := dir.store.dir.walkNamespaceInPath(ctx, namespace, trashdir, func(info storage.BlobInfo) error {
+			blobs = append(blobs, info)
+			return nil
+		})
+		if err != nil {
+			return moved, err
+		}
+
+		for _, info := range blobs {
+			if err := store.moveTrashedBlob(ctx, dir, info); err != nil {
+				return moved, err
+			}
+			moved++
+		}
+	}
+	return moved, nil
+}
+
+// moveTrashedBlob moves a blob from the trash of the source directory to the
+// trash of the directory chosen by placement, keeping the time it was trashed.
+func (store *MultiStore) moveTrashedBlob(ctx context.Context, source *multiDir, info storage.BlobInfo) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	target, err := store.placement(ctx, source)
+	if err != nil {
+		return err
+	}
+
+	path, err := info.FullPath(ctx)
+	if err != nil {
+		return err
+	}
+	reader, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			// restored or emptied while draining.
+			return nil
+		}
+		return err
+	}
+	defer func() { err = errs.Combine(err, reader.Close()) }()
+
+	stat, err := reader.Stat()
+	if err != nil {
+		return err
+	}
+
+	file, err := target.store.dir.CreateTemporaryFile(ctx, stat.Size())
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(file, reader); err != nil {
+		return errs.Combine(err, target.store.dir.DeleteTemporary(ctx, file))
+	}
+	if err := target.store.dir.commitToTrash(ctx, file, info.BlobRef(), info.StorageFormatVersion(), stat.ModTime()); err != nil {
+		return err
+	}
+
+	return source.store.dir.deleteWithStorageFormatInPath(ctx, source.store.dir.trashdir(), info.BlobRef(), info.StorageFormatVersion())
+}
+
+// moveBlob moves a blob from the source directory to the directory chosen by placement.
+//
+// When the blob is deleted concurrently with moving it, it may remain in the
+// target directory, in which case it's removed by garbage collection.
+func (store *MultiStore) moveBlob(ctx context.Context, source *multiDir, ref storage.BlobRef, formatVer storage.FormatVersion) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if formatVer < MaxFormatVersionSupported {
+		return Error.New("unable to move blob %x with storage format V%d", ref.Key, formatVer)
+	}
+
+	target, err := store.placement(ctx, source)
+	if err != nil {
+		return err
+	}
+
+	reader, err := source.store.OpenWithStorageFormat(ctx, ref, formatVer)
+	if err != nil {
+		if errs.IsFunc(err, os.IsNotExist) {
+			// deleted while draining.
+			return nil
+		}
+		return err
+	}
+	defer func() { err = errs.Combine(err, reader.Close()) }()
+
+	size, err := reader.Size()
+	if err != nil {
+		return err
+	}
+
+	writer, err := target.store.Create(ctx, ref, size)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(writer, reader); err != nil {
+		return errs.Combine(err, writer.Cancel(ctx))
+	}
+	if err := writer.Commit(ctx); err != nil {
+		return err
+	}
+	store.addUsed(target, ref.Namespace, size)
+
+	if err := source.store.DeleteWithStorageFormat(ctx, ref, formatVer); err != nil {
+		return err
+	}
+	store.addUsed(source, ref.Namespace, -size)
+	return nil
+}
+
+// multiWriter accounts the space used by the blob when it's committed.
+type multiWriter struct {
+	storage.BlobWriter
+	store     *MultiStore
+	dir       *multiDir
+	namespace string
+}
+
+// Commit commits the blob and accounts the space used by it.
+func (writer *multiWriter) Commit(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	size, err := writer.BlobWriter.Size()
+	if err != nil {
+		return errs.Combine(err, writer.BlobWriter.Cancel(ctx))
+	}
+	if err := writer.BlobWriter.Commit(ctx); err != nil {
+		return err
+	}
+	writer.store.addUsed(writer.dir, []byte(writer.namespace), size)
+	return nil
+}
@@ -0,0 +1,177 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package filestore_test
+
+import (
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/memory"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/storage"
+	"storj.io/storj/storage/filestore"
+)
+
+func TestDirsConfig(t *testing.T) {
+	var dirs filestore.DirsConfig
+	require.NoError(t, dirs.Set(""))
+	require.Empty(t, dirs.List)
+
+	require.NoError(t, dirs.Set("/mnt/disk1=2TB, /mnt/disk2=drain, C:\\storj=500GB"))
+	require.Equal(t, []filestore.DirConfig{
+		{Path: "/mnt/disk1", Allocated: 2 * memory.TB},
+		{Path: "/mnt/disk2", Draining: true},
+		{Path: "C:\\storj", Allocated: 500 * memory.GB},
+	}, dirs.List)
+	require.Equal(t, 2500*memory.GB, dirs.Allocated())
+	require.True(t, dirs.Draining())
+
+	var parsed filestore.DirsConfig
+	require.NoError(t, parsed.Set(dirs.String()))
+	require.Equal(t, dirs.List, parsed.List)
+
+	for _, invalid := range []string{"/mnt/disk1", "=1TB", "/mnt/disk1=abc", "/mnt/disk1=0B"} {
+		require.Error(t, dirs.Set(invalid), invalid)
+	}
+}
+
+func TestMultiStore(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	log := zaptest.NewLogger(t)
+	const blobSize = 10 * memory.KiB
+
+	open := func(draining bool) *filestore.MultiStore {
+		primary, err := filestore.NewDir(log, ctx.Dir("primary"))
+		require.NoError(t, err)
+
+		store, err := filestore.NewMulti(log, primary, blobSize, []filestore.DirConfig{
+			{Path: ctx.Dir("additional"), Allocated: 10 * blobSize, Draining: draining},
+		}, filestore.DefaultConfig)
+		require.NoError(t, err)
+		return store
+	}
+
+	countBlobs := func(path string, namespace []byte) int {
+		store, err := filestore.NewAt(log, path, filestore.DefaultConfig)
+		require.NoError(t, err)
+		defer ctx.Check(store.Close)
+
+		count := 0
+		require.NoError(t, store.WalkNamespace(ctx, namespace, func(storage.BlobInfo) error {
+			count++
+			return nil
+		}))
+		return count
+	}
+
+	store := open(false)
+
+	namespace := testrand.Bytes(32)
+	blobs := map[string][]byte{}
+	for i := 0; i < 5; i++ {
+		ref := storage.BlobRef{Namespace: namespace, Key: testrand.Bytes(32)}
+		data := testrand.BytesInt(blobSize.Int())
+
+		writer, err := store.Create(ctx, ref, -1)
+		require.NoError(t, err)
+		_, err = writer.Write(data)
+		require.NoError(t, err)
+		require.NoError(t, writer.Commit(ctx))
+
+		blobs[string(ref.Key)] = data
+	}
+
+	// the additional directory has the most space allocated.
+	require.Equal(t, 0, countBlobs(ctx.Dir("primary"), namespace))
+	require.Equal(t, 5, countBlobs(ctx.Dir("additional"), namespace))
+
+	dirs, err := store.Dirs(ctx)
+	require.NoError(t, err)
+	require.Len(t, dirs, 2)
+	require.Equal(t, 5*blobSize.Int64(), dirs[1].UsedForBlobs)
+
+	used, err := store.SpaceUsedForBlobs(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 5*blobSize.Int64(), used)
+
+	// both directories are on the same filesystem, so its free space is counted once.
+	free, err := store.FreeSpace(ctx)
+	require.NoError(t, err)
+	info, err := filestore.DiskInfoFromPath(ctx.Dir("primary"))
+	require.NoError(t, err)
+	require.InDelta(t, info.AvailableSpace, free, float64(10*memory.MiB))
+
+	// trash two blobs, one of them a while ago.
+	var trashed []storage.BlobRef
+	for key := range blobs {
+		trashed = append(trashed, storage.BlobRef{Namespace: namespace, Key: []byte(key)})
+		if len(trashed) == 2 {
+			break
+		}
+	}
+	require.NoError(t, store.Trash(ctx, trashed[0]))
+
+	additional, err := filestore.NewDir(log, ctx.Dir("additional"))
+	require.NoError(t, err)
+	additional.ReplaceTrashnow(func() time.Time { return time.Now().Add(-48 * time.Hour) })
+	require.NoError(t, additional.Trash(ctx, trashed[1]))
+
+	require.NoError(t, store.CreateVerificationFile(ctx, testrand.NodeID()))
+	require.NoError(t, store.Close())
+
+	// drain the additional directory.
+	store = open(true)
+	defer ctx.Check(store.Close)
+
+	require.NoError(t, store.Drain(ctx))
+	require.Equal(t, 3, countBlobs(ctx.Dir("primary"), namespace))
+	require.Equal(t, 0, countBlobs(ctx.Dir("additional"), namespace))
+
+	// the trash was moved with the blobs and expires at the same time.
+	_, keys, err := additional.EmptyTrash(ctx, namespace, time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	require.Empty(t, keys)
+
+	var emptied int64
+	emptied, keys, err = store.EmptyTrash(ctx, namespace, time.Now().Add(-24*time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, blobSize.Int64(), emptied)
+	require.Equal(t, [][]byte{trashed[1].Key}, keys)
+	delete(blobs, string(trashed[1].Key))
+
+	restored, err := store.RestoreTrash(ctx, namespace)
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{trashed[0].Key}, restored)
+	require.Equal(t, 4, countBlobs(ctx.Dir("primary"), namespace))
+
+	for key, data := range blobs {
+		reader, err := store.Open(ctx, storage.BlobRef{Namespace: namespace, Key: []byte(key)})
+		require.NoError(t, err)
+
+		stored, err := ioutil.ReadAll(reader)
+		require.NoError(t, err)
+		require.NoError(t, reader.Close())
+		require.Equal(t, data, stored)
+	}
+
+	// new blobs aren't placed in the draining directory.
+	ref := storage.BlobRef{Namespace: namespace, Key: testrand.Bytes(32)}
+	writer, err := store.Create(ctx, ref, -1)
+	require.NoError(t, err)
+	_, err = writer.Write(testrand.BytesInt(blobSize.Int()))
+	require.NoError(t, err)
+	require.NoError(t, writer.Commit(ctx))
+	require.Equal(t, 0, countBlobs(ctx.Dir("additional"), namespace))
+
+	require.NoError(t, store.Delete(ctx, ref))
+	_, err = store.Stat(ctx, ref)
+	require.Error(t, err)
+}
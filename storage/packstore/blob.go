@@ -0,0 +1,219 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package packstore
+
+import (
+	"context"
+	"encoding/hex"
+	"io"
+	"os"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/storage"
+)
+
+// blobReader implements reading blobs from a log file.
+type blobReader struct {
+	*io.SectionReader
+	file          *os.File
+	formatVersion storage.FormatVersion
+}
+
+func newBlobReader(file *os.File, found entry) *blobReader {
+	return &blobReader{
+		SectionReader: io.NewSectionReader(file, found.Offset, found.Size),
+		file:          file,
+		formatVersion: found.FormatVersion,
+	}
+}
+
+// Size returns how large is the blob.
+func (blob *blobReader) Size() (int64, error) {
+	return blob.SectionReader.Size(), nil
+}
+
+// StorageFormatVersion gets the storage format version being used by the blob.
+func (blob *blobReader) StorageFormatVersion() storage.FormatVersion {
+	return blob.formatVersion
+}
+
+// Close closes the log file.
+func (blob *blobReader) Close() error {
+	return blob.file.Close()
+}
+
+// blobWriter implements writing blobs. The data is streamed into a log file,
+// which the writer uses exclusively until the blob is committed or canceled.
+type blobWriter struct {
+	ref           storage.BlobRef
+	store         *Store
+	closed        bool
+	formatVersion storage.FormatVersion
+
+	log       *activeLog
+	start     int64 // offset of the record in the log file
+	dataStart int64 // offset of the data in the log file
+	size      int64
+	pos       int64
+}
+
+func newBlobWriter(ref storage.BlobRef, store *Store, formatVersion storage.FormatVersion) (*blobWriter, error) {
+	log, err := store.acquire()
+	if err != nil {
+		return nil, err
+	}
+	return &blobWriter{
+		ref:           ref,
+		store:         store,
+		formatVersion: formatVersion,
+		log:           log,
+		start:         log.size,
+		dataStart:     log.size + recordHeaderSize + int64(len(ref.Namespace)+len(ref.Key)),
+	}, nil
+}
+
+// Write adds data to the blob at the current position.
+func (blob *blobWriter) Write(p []byte) (int, error) {
+	if blob.closed {
+		return 0, Error.New("already closed")
+	}
+	n, err := blob.log.file.WriteAt(p, blob.dataStart+blob.pos)
+	blob.pos += int64(n)
+	if blob.pos > blob.size {
+		blob.size = blob.pos
+	}
+	return n, err
+}
+
+// Seek sets the position of the next write.
+func (blob *blobWriter) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekStart:
+	case io.SeekCurrent:
+		offset += blob.pos
+	case io.SeekEnd:
+		offset += blob.size
+	default:
+		return 0, Error.New("invalid whence %d", whence)
+	}
+	if offset < 0 {
+		return 0, Error.New("negative position %d", offset)
+	}
+	blob.pos = offset
+	return offset, nil
+}
+
+// Cancel discards the blob.
+func (blob *blobWriter) Cancel(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if blob.closed {
+		return nil
+	}
+	blob.closed = true
+	return Error.Wrap(blob.discard())
+}
+
+// Commit appends the blob to the log file and points the index to it.
+func (blob *blobWriter) Commit(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return blob.commit(ctx, time.Now())
+}
+
+// commit appends the blob to the log file and points the index to it.
+func (blob *blobWriter) commit(ctx context.Context, modTime time.Time) (err error) {
+	if blob.closed {
+		return Error.New("already closed")
+	}
+	blob.closed = true
+
+	if err := blob.finish(); err != nil {
+		return Error.Wrap(errs.Combine(err, blob.discard()))
+	}
+
+	err = blob.store.putEntry(ctx, blob.ref, entry{
+		LogID:         blob.log.id,
+		Offset:        blob.dataStart,
+		Size:          blob.size,
+		ModTime:       modTime,
+		FormatVersion: blob.formatVersion,
+	})
+	return errs.Combine(err, blob.store.release(blob.log))
+}
+
+// finish writes the record header in front of the data and syncs the log file.
+func (blob *blobWriter) finish() error {
+	header := recordHeader(blob.ref.Namespace, blob.ref.Key, blob.size)
+	if _, err := blob.log.file.WriteAt(header, blob.start); err != nil {
+		return err
+	}
+	if err := blob.log.file.Sync(); err != nil {
+		return err
+	}
+	blob.log.size = blob.dataStart + blob.size
+	return nil
+}
+
+// discard removes the written data from the log file and releases it.
+func (blob *blobWriter) discard() error {
+	return errs.Combine(
+		blob.log.file.Truncate(blob.start),
+		blob.store.release(blob.log),
+	)
+}
+
+// Size returns the current position of the writer.
+func (blob *blobWriter) Size() (int64, error) {
+	return blob.pos, nil
+}
+
+// StorageFormatVersion indicates what storage format version the blob is using.
+func (blob *blobWriter) StorageFormatVersion() storage.FormatVersion {
+	return blob.formatVersion
+}
+
+// blobInfo implements storage.BlobInfo for a blob in a log file.
+type blobInfo struct {
+	ref   storage.BlobRef
+	entry entry
+}
+
+func newBlobInfo(ref storage.BlobRef, found entry) storage.BlobInfo {
+	return &blobInfo{ref: ref, entry: found}
+}
+
+// BlobRef returns the reference of the blob.
+func (info *blobInfo) BlobRef() storage.BlobRef {
+	return info.ref
+}
+
+// StorageFormatVersion indicates the storage format version used to store the blob.
+func (info *blobInfo) StorageFormatVersion() storage.FormatVersion {
+	return info.entry.FormatVersion
+}
+
+// Stat returns the size and the modification time of the blob.
+func (info *blobInfo) Stat(ctx context.Context) (os.FileInfo, error) {
+	return &blobFileInfo{name: hex.EncodeToString(info.ref.Key), entry: info.entry}, nil
+}
+
+// FullPath returns an error, because a packed blob doesn't have its own file.
+func (info *blobInfo) FullPath(ctx context.Context) (string, error) {
+	return "", Error.New("blob is packed into log file %s", logFileName(info.entry.LogID))
+}
+
+// blobFileInfo implements os.FileInfo for a blob in a log file.
+type blobFileInfo struct {
+	name  string
+	entry entry
+}
+
+func (info *blobFileInfo) Name() string       { return info.name }
+func (info *blobFileInfo) Size() int64        { return info.entry.Size }
+func (info *blobFileInfo) Mode() os.FileMode  { return filePermission }
+func (info *blobFileInfo) ModTime() time.Time { return info.entry.ModTime }
+func (info *blobFileInfo) IsDir() bool        { return false }
+func (info *blobFileInfo) Sys() interface{}   { return nil }
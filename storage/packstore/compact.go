@@ -0,0 +1,258 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package packstore
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os"
+
+	"github.com/zeebo/errs"
+	"go.etcd.io/bbolt"
+	"go.uber.org/zap"
+
+	"storj.io/common/sync2"
+	"storj.io/storj/storage"
+)
+
+// Chore compacts the log files of the store periodically.
+//
+// architecture: Chore
+type Chore struct {
+	log   *zap.Logger
+	store *Store
+
+	Loop *sync2.Cycle
+}
+
+// NewChore creates a new log compaction chore.
+func NewChore(log *zap.Logger, store *Store, config Config) *Chore {
+	return &Chore{
+		log:   log,
+		store: store,
+		Loop:  sync2.NewCycle(config.CompactionInterval),
+	}
+}
+
+// Run runs the compaction chore.
+func (chore *Chore) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return chore.Loop.Run(ctx, func(ctx context.Context) error {
+		err := chore.store.Compact(ctx)
+		if err != nil {
+			chore.log.Error("error during compacting log files", zap.Error(err))
+		}
+		return nil
+	})
+}
+
+// Close stops the compaction chore.
+func (chore *Chore) Close() error {
+	chore.Loop.Close()
+	return nil
+}
+
+// Compact rewrites the blobs of the log files, which have at least the compaction
+// threshold of dead bytes, into new log files and removes the old log files.
+func (store *Store) Compact(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	ids, err := store.compactionCandidates()
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+
+	blobs, err := store.liveBlobs(ids)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	for _, id := range ids {
+		if err := store.compactLog(ctx, id, blobs[id]); err != nil {
+			return Error.Wrap(err)
+		}
+	}
+	return nil
+}
+
+// compactionCandidates returns the ids of the log files which should be compacted.
+func (store *Store) compactionCandidates() (candidates []uint64, err error) {
+	store.mu.Lock()
+	nextID := store.nextID
+	appending := make(map[uint64]struct{}, len(store.appending))
+	for id := range store.appending {
+		appending[id] = struct{}{}
+	}
+	store.mu.Unlock()
+
+	ids, err := listLogs(store.logsdir())
+	if err != nil {
+		return nil, err
+	}
+
+	var dead []int64
+	err = store.index.View(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket(logsBucket)
+		for _, id := range ids {
+			dead = append(dead, decodeInt(bucket.Get(logKey(id))))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for i, id := range ids {
+		// log files open for appending may get new blobs.
+		if _, ok := appending[id]; ok || id >= nextID {
+			continue
+		}
+		stat, err := os.Stat(store.logPath(id))
+		if err != nil {
+			return nil, err
+		}
+		if stat.Size() == 0 || float64(dead[i]) >= store.config.CompactionThreshold*float64(stat.Size()) {
+			candidates = append(candidates, id)
+		}
+	}
+	return candidates, nil
+}
+
+// packedBlob is a blob located in a log file.
+type packedBlob struct {
+	ref   storage.BlobRef
+	entry entry
+}
+
+// liveBlobs returns the blobs of the index, which are located in the log files
+// with the ids, grouped by the log file.
+func (store *Store) liveBlobs(ids []uint64) (map[uint64][]packedBlob, error) {
+	blobs := make(map[uint64][]packedBlob, len(ids))
+	for _, id := range ids {
+		blobs[id] = nil
+	}
+
+	err := store.index.View(func(tx *bbolt.Tx) error {
+		return forEachEntry(tx, func(ref storage.BlobRef, found entry) error {
+			if _, ok := blobs[found.LogID]; ok {
+				blobs[found.LogID] = append(blobs[found.LogID], packedBlob{
+					ref: storage.BlobRef{
+						Namespace: append([]byte{}, ref.Namespace...),
+						Key:       append([]byte{}, ref.Key...),
+					},
+					entry: found,
+				})
+			}
+			return nil
+		})
+	})
+	return blobs, err
+}
+
+// forEachEntry calls fn for every blob of the index. The blob ref is only valid
+// during the call.
+func forEachEntry(tx *bbolt.Tx, fn func(ref storage.BlobRef, found entry) error) error {
+	return tx.ForEach(func(name []byte, bucket *bbolt.Bucket) error {
+		if !bytes.HasPrefix(name, namespacePrefix) {
+			return nil
+		}
+		namespace := name[len(namespacePrefix):]
+		return bucket.ForEach(func(key, data []byte) error {
+			found, err := decodeEntry(data)
+			if err != nil {
+				return err
+			}
+			return fn(storage.BlobRef{Namespace: namespace, Key: key}, found)
+		})
+	})
+}
+
+// compactLog moves the blobs of the log file into other log files and removes it.
+func (store *Store) compactLog(ctx context.Context, id uint64, blobs []packedBlob) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	path := store.logPath(id)
+	if len(blobs) > 0 {
+		file, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+
+		for _, blob := range blobs {
+			if err := ctx.Err(); err != nil {
+				_ = file.Close()
+				return err
+			}
+
+			data := io.NewSectionReader(file, blob.entry.Offset, blob.entry.Size)
+			if err := store.relocate(ctx, blob, data); err != nil {
+				_ = file.Close()
+				return err
+			}
+		}
+
+		if err := file.Close(); err != nil {
+			return err
+		}
+	}
+
+	if err := os.Remove(path); err != nil {
+		return err
+	}
+
+	mon.IntVal("packstore_compacted_blobs").Observe(int64(len(blobs)))
+	store.log.Debug("compacted log file", zap.Uint64("log", id), zap.Int("blobs", len(blobs)))
+
+	return store.index.Update(func(tx *bbolt.Tx) error {
+		return tx.Bucket(logsBucket).Delete(logKey(id))
+	})
+}
+
+// relocate appends the data of the blob to a log file and points the index to
+// it, unless the blob has been deleted or replaced in the meantime.
+func (store *Store) relocate(ctx context.Context, blob packedBlob, data io.Reader) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	writer, err := newBlobWriter(blob.ref, store, blob.entry.FormatVersion)
+	if err != nil {
+		return err
+	}
+
+	if _, err := io.Copy(writer, data); err != nil {
+		return errs.Combine(err, writer.discard())
+	}
+	if err := writer.finish(); err != nil {
+		return errs.Combine(err, writer.discard())
+	}
+
+	logID, offset := writer.log.id, writer.dataStart
+	size := recordSize(blob.ref, writer.size)
+	err = store.index.Batch(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket(namespaceBucket(blob.ref.Namespace))
+		if bucket == nil {
+			return addDeadBytes(tx, logID, size)
+		}
+		data := bucket.Get(blob.ref.Key)
+		if data == nil {
+			return addDeadBytes(tx, logID, size)
+		}
+		current, err := decodeEntry(data)
+		if err != nil {
+			return err
+		}
+		if current.LogID != blob.entry.LogID || current.Offset != blob.entry.Offset {
+			return addDeadBytes(tx, logID, size)
+		}
+
+		current.LogID = logID
+		current.Offset = offset
+		return bucket.Put(blob.ref.Key, current.encode())
+	})
+	return errs.Combine(err, store.release(writer.log))
+}
@@ -0,0 +1,106 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package packstore
+
+import (
+	"encoding/binary"
+	"time"
+
+	"storj.io/storj/storage"
+)
+
+// namespacePrefix is the prefix of the index buckets of the namespaces.
+var namespacePrefix = []byte("ns/")
+
+// namespaceBucket returns the name of the index bucket of the namespace.
+func namespaceBucket(namespace []byte) []byte {
+	return append(append([]byte{}, namespacePrefix...), namespace...)
+}
+
+// logKey returns the key of the log file with the id in the logs bucket.
+func logKey(id uint64) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, id)
+	return key
+}
+
+// encodeInt encodes a counter stored in the index.
+func encodeInt(v int64) []byte {
+	data := make([]byte, 8)
+	binary.LittleEndian.PutUint64(data, uint64(v))
+	return data
+}
+
+// decodeInt decodes a counter stored in the index, missing counters are zero.
+func decodeInt(data []byte) int64 {
+	if len(data) != 8 {
+		return 0
+	}
+	return int64(binary.LittleEndian.Uint64(data))
+}
+
+// recordSize returns the size of the log record of the blob with the data size.
+func recordSize(ref storage.BlobRef, size int64) int64 {
+	return recordHeaderSize + int64(len(ref.Namespace)+len(ref.Key)) + size
+}
+
+// entrySize is the size of an encoded entry.
+const entrySize = 8 + 8 + 8 + 8 + 8 + 1
+
+// entry is the location and the state of a blob.
+type entry struct {
+	LogID  uint64
+	Offset int64
+	Size   int64
+
+	ModTime time.Time
+	// TrashedAt is when the blob was moved to the trash, zero if it's not trashed.
+	TrashedAt time.Time
+
+	FormatVersion storage.FormatVersion
+}
+
+// trashed returns whether the blob is in the trash.
+func (e entry) trashed() bool { return !e.TrashedAt.IsZero() }
+
+// encode encodes the entry for storing in the index.
+func (e entry) encode() []byte {
+	data := make([]byte, entrySize)
+	binary.LittleEndian.PutUint64(data[0:], e.LogID)
+	binary.LittleEndian.PutUint64(data[8:], uint64(e.Offset))
+	binary.LittleEndian.PutUint64(data[16:], uint64(e.Size))
+	binary.LittleEndian.PutUint64(data[24:], uint64(encodeTime(e.ModTime)))
+	binary.LittleEndian.PutUint64(data[32:], uint64(encodeTime(e.TrashedAt)))
+	data[40] = byte(e.FormatVersion)
+	return data
+}
+
+// decodeEntry decodes an entry stored in the index.
+func decodeEntry(data []byte) (entry, error) {
+	if len(data) != entrySize {
+		return entry{}, Error.New("invalid index entry size %d", len(data))
+	}
+	return entry{
+		LogID:         binary.LittleEndian.Uint64(data[0:]),
+		Offset:        int64(binary.LittleEndian.Uint64(data[8:])),
+		Size:          int64(binary.LittleEndian.Uint64(data[16:])),
+		ModTime:       decodeTime(int64(binary.LittleEndian.Uint64(data[24:]))),
+		TrashedAt:     decodeTime(int64(binary.LittleEndian.Uint64(data[32:]))),
+		FormatVersion: storage.FormatVersion(data[40]),
+	}, nil
+}
+
+func encodeTime(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.UnixNano()
+}
+
+func decodeTime(nanos int64) time.Time {
+	if nanos == 0 {
+		return time.Time{}
+	}
+	return time.Unix(0, nanos)
+}
@@ -0,0 +1,97 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package packstore
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/zeebo/errs"
+)
+
+const (
+	logFileSuffix = ".log"
+
+	// recordMagic marks the beginning of a record in a log file.
+	recordMagic = 0x534a504b // "SJPK"
+	// recordHeaderSize is the size of the record header: magic, namespace length,
+	// key length and data size.
+	recordHeaderSize = 4 + 2 + 2 + 8
+
+	// maxRefPartLength is the maximum length of the namespace and the key of a record.
+	maxRefPartLength = 1<<16 - 1
+)
+
+// logFileName returns the file name of the log with the id.
+func logFileName(id uint64) string {
+	return fmt.Sprintf("%016x%s", id, logFileSuffix)
+}
+
+// listLogs returns the ids of the log files in the directory in ascending order.
+func listLogs(dir string) ([]uint64, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []uint64
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, logFileSuffix) {
+			continue
+		}
+		id, err := strconv.ParseUint(strings.TrimSuffix(name, logFileSuffix), 16, 64)
+		if err != nil {
+			continue
+		}
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, k int) bool { return ids[i] < ids[k] })
+	return ids, nil
+}
+
+// activeLog is a log file new records are appended to. It's used by a single
+// writer at a time, so the records of concurrent writers go to different log files.
+type activeLog struct {
+	id   uint64
+	file *os.File
+	size int64
+}
+
+// openActiveLog opens the log file with the id for appending.
+func openActiveLog(dir string, id uint64) (*activeLog, error) {
+	file, err := os.OpenFile(filepath.Join(dir, logFileName(id)), os.O_CREATE|os.O_RDWR, filePermission)
+	if err != nil {
+		return nil, err
+	}
+	size, err := file.Seek(0, io.SeekEnd)
+	if err != nil {
+		return nil, errs.Combine(err, file.Close())
+	}
+	return &activeLog{id: id, file: file, size: size}, nil
+}
+
+// recordHeader returns the header of the record of the blob with the data size.
+func recordHeader(namespace, key []byte, size int64) []byte {
+	header := make([]byte, recordHeaderSize+len(namespace)+len(key))
+	binary.LittleEndian.PutUint32(header[0:], recordMagic)
+	binary.LittleEndian.PutUint16(header[4:], uint16(len(namespace)))
+	binary.LittleEndian.PutUint16(header[6:], uint16(len(key)))
+	binary.LittleEndian.PutUint64(header[8:], uint64(size))
+	n := recordHeaderSize
+	n += copy(header[n:], namespace)
+	copy(header[n:], key)
+	return header
+}
+
+// close closes the log file.
+func (log *activeLog) close() error {
+	return log.file.Close()
+}
@@ -0,0 +1,144 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package packstore
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/storj/storage"
+	"storj.io/storj/storage/filestore"
+)
+
+const (
+	// blobsDirName is the directory of the blob store with a file per blob.
+	blobsDirName = "blobs"
+	// migratedFileName marks that the blobs were moved into the packed blob store.
+	migratedFileName = "migrated"
+)
+
+// ErrNotMigrated is returned when the storage directory has blobs, which
+// haven't been moved into the packed blob store.
+var ErrNotMigrated = errs.Class("packstore not migrated")
+
+// VerifyMigrated checks whether the packed blob store can be used in the storage
+// directory. It fails when the directory was used by the blob store with a file per
+// blob and Migrate hasn't finished moving the blobs from it.
+func VerifyMigrated(path string) error {
+	_, err := os.Stat(filepath.Join(path, logsDirName, migratedFileName))
+	if err == nil {
+		return nil
+	}
+	if !os.IsNotExist(err) {
+		return Error.Wrap(err)
+	}
+
+	_, err = os.Stat(filepath.Join(path, blobsDirName))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	return ErrNotMigrated.New("the pieces in %q must be moved with the migrate-packstore command first", path)
+}
+
+// Migrate moves all the blobs from the blob store into the packed blob store
+// and returns the number of blobs moved. It must not be run while the blob stores
+// are in use. Once all the blobs are moved, the packed blob store is marked as
+// migrated, so that VerifyMigrated succeeds.
+//
+// The trash of the source blob store is restored first, so the trashed blobs are
+// moved too and garbage collection trashes them again. Blobs stored with storage
+// format V0 keep their format version, so their metadata in the V0 piece info
+// database stays valid. When a blob is stored with both format versions, only
+// the newer one is kept, as it's the one returned when opening the blob.
+func Migrate(ctx context.Context, log *zap.Logger, from storage.Blobs, to *Store) (migrated int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	namespaces, err := from.ListNamespaces(ctx)
+	if err != nil {
+		return 0, Error.Wrap(err)
+	}
+
+	for _, namespace := range namespaces {
+		restored, err := from.RestoreTrash(ctx, namespace)
+		if err != nil {
+			return migrated, Error.Wrap(err)
+		}
+		if len(restored) > 0 {
+			log.Info("restored trash before migration", zap.Binary("namespace", namespace), zap.Int("count", len(restored)))
+		}
+
+		err = from.WalkNamespace(ctx, namespace, func(info storage.BlobInfo) error {
+			if err := migrateBlob(ctx, from, to, info); err != nil {
+				return err
+			}
+			migrated++
+			return nil
+		})
+		if err != nil {
+			return migrated, Error.Wrap(err)
+		}
+	}
+
+	return migrated, Error.Wrap(markMigrated(to.logsdir()))
+}
+
+// migrateBlob copies the blob into the packed blob store and deletes it from the source.
+func migrateBlob(ctx context.Context, from storage.Blobs, to *Store, info storage.BlobInfo) (err error) {
+	ref, formatVer := info.BlobRef(), info.StorageFormatVersion()
+	if err := checkRef(ref); err != nil {
+		return err
+	}
+
+	// a V0 blob, which was rewritten with a newer format version, is superseded.
+	if formatVer < filestore.MaxFormatVersionSupported {
+		existing, ok, err := to.lookup(ref)
+		if err != nil {
+			return err
+		}
+		if ok && existing.FormatVersion > formatVer {
+			return from.DeleteWithStorageFormat(ctx, ref, formatVer)
+		}
+	}
+
+	stat, err := info.Stat(ctx)
+	if err != nil {
+		return err
+	}
+
+	reader, err := from.OpenWithStorageFormat(ctx, ref, formatVer)
+	if err != nil {
+		return err
+	}
+
+	writer, err := newBlobWriter(ref, to, formatVer)
+	if err != nil {
+		return errs.Combine(err, reader.Close())
+	}
+	_, err = io.Copy(writer, reader)
+	if err := errs.Combine(err, reader.Close()); err != nil {
+		return errs.Combine(err, writer.Cancel(ctx))
+	}
+
+	if err := writer.commit(ctx, stat.ModTime()); err != nil {
+		return err
+	}
+	return from.DeleteWithStorageFormat(ctx, ref, formatVer)
+}
+
+// markMigrated creates the file marking the packed blob store as migrated.
+func markMigrated(dir string) error {
+	file, err := os.OpenFile(filepath.Join(dir, migratedFileName), os.O_CREATE|os.O_WRONLY, filePermission)
+	if err != nil {
+		return err
+	}
+	return errs.Combine(file.Sync(), file.Close())
+}
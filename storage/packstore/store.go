@@ -0,0 +1,770 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package packstore implements a blob store which appends the blobs to large
+// log files instead of storing every blob in its own file.
+package packstore
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.etcd.io/bbolt"
+	"go.uber.org/zap"
+
+	"storj.io/common/memory"
+	"storj.io/common/storj"
+	"storj.io/storj/storage"
+	"storj.io/storj/storage/filestore"
+)
+
+var (
+	// Error is the default packstore error class.
+	Error = errs.Class("packstore error")
+
+	mon = monkit.Package()
+
+	_ storage.Blobs = (*Store)(nil)
+)
+
+const (
+	filePermission = 0600
+	dirPermission  = 0700
+
+	logsDirName          = "packs"
+	indexFileName        = "index.db"
+	verificationFileName = "storage-dir-verification"
+)
+
+// logsBucket is the index bucket with the number of dead bytes of the log files.
+var logsBucket = []byte("logs")
+
+// Config is configuration for the packed blob store.
+type Config struct {
+	Enabled             bool          `help:"store the pieces packed into large log files instead of a file per piece" default:"false"`
+	MaxLogSize          memory.Size   `help:"size of a log file after which a new log file is started" default:"1GiB"`
+	CompactionInterval  time.Duration `help:"how frequently log files with deleted pieces are compacted" default:"1h0m0s"`
+	CompactionThreshold float64       `help:"fraction of deleted data in a log file at which it's compacted" default:"0.5"`
+}
+
+// DefaultConfig is the default value for Config.
+var DefaultConfig = Config{
+	MaxLogSize:          memory.GiB,
+	CompactionInterval:  time.Hour,
+	CompactionThreshold: 0.5,
+}
+
+// Store implements a blob store which appends blobs to log files and keeps their
+// location in an index. Deleted blobs are reclaimed by compacting the log files.
+//
+// Every blob writer appends to a log file of its own, so concurrent uploads are
+// streamed and synced independently. The log files are reused by later writers
+// until they reach the maximum log size.
+//
+// architecture: Database
+type Store struct {
+	log    *zap.Logger
+	path   string
+	config Config
+
+	index *bbolt.DB
+
+	// mu guards the log files open for appending.
+	mu        sync.Mutex
+	nextID    uint64
+	idle      []*activeLog
+	appending map[uint64]struct{}
+	closed    bool
+
+	trashnow func() time.Time // the function used by trash to determine "now"
+}
+
+// Open opens the packed blob store in the storage directory and creates it when it doesn't exist.
+func Open(log *zap.Logger, path string, config Config) (_ *Store, err error) {
+	store := &Store{
+		log:       log,
+		path:      path,
+		config:    config,
+		appending: map[uint64]struct{}{},
+		trashnow:  time.Now,
+	}
+
+	if err := os.MkdirAll(store.logsdir(), dirPermission); err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	store.index, err = bbolt.Open(filepath.Join(store.logsdir(), indexFileName), filePermission, &bbolt.Options{Timeout: time.Second})
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() {
+		if err != nil {
+			err = errs.Combine(err, Error.Wrap(store.index.Close()))
+		}
+	}()
+
+	err = store.index.Update(func(tx *bbolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(logsBucket)
+		return err
+	})
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	ids, err := listLogs(store.logsdir())
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	if err := store.recountDeadBytes(ids); err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	store.nextID = 1
+	if len(ids) > 0 {
+		last := ids[len(ids)-1]
+		store.nextID = last + 1
+
+		// continue appending to the last log file, unless it's full.
+		active, err := openActiveLog(store.logsdir(), last)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		if err := store.release(active); err != nil {
+			return nil, Error.Wrap(err)
+		}
+	}
+
+	return store, nil
+}
+
+// logsdir is the sub-directory containing the log files and the index.
+func (store *Store) logsdir() string { return filepath.Join(store.path, logsDirName) }
+
+// logPath returns the path of the log file with the id.
+func (store *Store) logPath(id uint64) string {
+	return filepath.Join(store.logsdir(), logFileName(id))
+}
+
+// Path returns the storage directory.
+func (store *Store) Path() string { return store.path }
+
+// ReplaceTrashnow allows for replacing the function used by trash to determine "now".
+func (store *Store) ReplaceTrashnow(trashnow func() time.Time) {
+	store.trashnow = trashnow
+}
+
+// Close closes the log files and the index.
+func (store *Store) Close() error {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	var group errs.Group
+	for _, active := range store.idle {
+		group.Add(active.close())
+	}
+	store.idle = nil
+	store.closed = true
+	group.Add(store.index.Close())
+	return Error.Wrap(group.Err())
+}
+
+// acquire returns a log file for the exclusive use of a writer. It reuses an idle
+// log file when there is one and starts a new log file otherwise.
+func (store *Store) acquire() (*activeLog, error) {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	if store.closed {
+		return nil, Error.New("closed")
+	}
+	if n := len(store.idle); n > 0 {
+		active := store.idle[n-1]
+		store.idle = store.idle[:n-1]
+		return active, nil
+	}
+
+	active, err := openActiveLog(store.logsdir(), store.nextID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	store.nextID++
+	store.appending[active.id] = struct{}{}
+	return active, nil
+}
+
+// release returns the log file acquired by a writer. The log file is closed once
+// it reaches the maximum log size, so that it can be compacted.
+func (store *Store) release(active *activeLog) error {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	if !store.closed && active.size < store.config.MaxLogSize.Int64() {
+		store.appending[active.id] = struct{}{}
+		store.idle = append(store.idle, active)
+		return nil
+	}
+
+	delete(store.appending, active.id)
+	return Error.Wrap(active.close())
+}
+
+// putEntry points the index to the blob and adds the record it replaces to the dead bytes.
+func (store *Store) putEntry(ctx context.Context, ref storage.BlobRef, newEntry entry) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return Error.Wrap(store.index.Batch(func(tx *bbolt.Tx) error {
+		bucket, err := tx.CreateBucketIfNotExists(namespaceBucket(ref.Namespace))
+		if err != nil {
+			return err
+		}
+		if data := bucket.Get(ref.Key); data != nil {
+			old, err := decodeEntry(data)
+			if err != nil {
+				return err
+			}
+			if err := addDeadBytes(tx, old.LogID, recordSize(ref, old.Size)); err != nil {
+				return err
+			}
+		}
+		return bucket.Put(ref.Key, newEntry.encode())
+	}))
+}
+
+// lookup returns the index entry of the blob.
+func (store *Store) lookup(ref storage.BlobRef) (found entry, ok bool, err error) {
+	if !ref.IsValid() {
+		return entry{}, false, storage.ErrInvalidBlobRef.New("")
+	}
+	err = store.index.View(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket(namespaceBucket(ref.Namespace))
+		if bucket == nil {
+			return nil
+		}
+		data := bucket.Get(ref.Key)
+		if data == nil {
+			return nil
+		}
+		found, err = decodeEntry(data)
+		ok = err == nil
+		return err
+	})
+	return found, ok, Error.Wrap(err)
+}
+
+// lookupLive returns the index entry of the blob, which isn't trashed and
+// has one of the storage format versions accepted by the match func.
+func (store *Store) lookupLive(ref storage.BlobRef, match func(storage.FormatVersion) bool) (entry, error) {
+	found, ok, err := store.lookup(ref)
+	if err != nil {
+		return entry{}, err
+	}
+	if !ok || found.trashed() || !match(found.FormatVersion) {
+		return entry{}, os.ErrNotExist
+	}
+	return found, nil
+}
+
+// Create creates a new blob that can be written.
+// The size argument is ignored, as the data is streamed into the log file.
+func (store *Store) Create(ctx context.Context, ref storage.BlobRef, size int64) (_ storage.BlobWriter, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if err := checkRef(ref); err != nil {
+		return nil, err
+	}
+	return newBlobWriter(ref, store, filestore.MaxFormatVersionSupported)
+}
+
+// Open opens a reader for the blob.
+func (store *Store) Open(ctx context.Context, ref storage.BlobRef) (_ storage.BlobReader, err error) {
+	defer mon.Task()(&ctx)(&err)
+	return store.open(ref, anyFormat)
+}
+
+// OpenWithStorageFormat opens a reader for the blob stored with the storage format version.
+func (store *Store) OpenWithStorageFormat(ctx context.Context, ref storage.BlobRef, formatVer storage.FormatVersion) (_ storage.BlobReader, err error) {
+	defer mon.Task()(&ctx)(&err)
+	return store.open(ref, exactFormat(formatVer))
+}
+
+// open opens a reader for the blob. The lookup is retried once when the log
+// file is gone, because compaction might have moved the blob in the meantime.
+func (store *Store) open(ref storage.BlobRef, match func(storage.FormatVersion) bool) (_ storage.BlobReader, err error) {
+	for attempt := 0; ; attempt++ {
+		found, err := store.lookupLive(ref, match)
+		if err != nil {
+			return nil, err
+		}
+
+		file, err := os.Open(store.logPath(found.LogID))
+		if err != nil {
+			if os.IsNotExist(err) && attempt == 0 {
+				continue
+			}
+			return nil, Error.Wrap(err)
+		}
+		return newBlobReader(file, found), nil
+	}
+}
+
+// Stat looks up the metadata of the blob.
+func (store *Store) Stat(ctx context.Context, ref storage.BlobRef) (_ storage.BlobInfo, err error) {
+	defer mon.Task()(&ctx)(&err)
+	found, err := store.lookupLive(ref, anyFormat)
+	if err != nil {
+		return nil, err
+	}
+	return newBlobInfo(ref, found), nil
+}
+
+// StatWithStorageFormat looks up the metadata of the blob stored with the storage format version.
+func (store *Store) StatWithStorageFormat(ctx context.Context, ref storage.BlobRef, formatVer storage.FormatVersion) (_ storage.BlobInfo, err error) {
+	defer mon.Task()(&ctx)(&err)
+	found, err := store.lookupLive(ref, exactFormat(formatVer))
+	if err != nil {
+		return nil, err
+	}
+	return newBlobInfo(ref, found), nil
+}
+
+// Delete deletes the blob.
+//
+// It doesn't return an error if the blob isn't found.
+func (store *Store) Delete(ctx context.Context, ref storage.BlobRef) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return store.delete(ref, anyFormat)
+}
+
+// DeleteWithStorageFormat deletes the blob stored with the storage format version.
+func (store *Store) DeleteWithStorageFormat(ctx context.Context, ref storage.BlobRef, formatVer storage.FormatVersion) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return store.delete(ref, exactFormat(formatVer))
+}
+
+// delete deletes the blob when it isn't trashed and its storage format version
+// is accepted by the match func.
+func (store *Store) delete(ref storage.BlobRef, match func(storage.FormatVersion) bool) error {
+	if !ref.IsValid() {
+		return storage.ErrInvalidBlobRef.New("")
+	}
+	return Error.Wrap(store.index.Update(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket(namespaceBucket(ref.Namespace))
+		if bucket == nil {
+			return nil
+		}
+		data := bucket.Get(ref.Key)
+		if data == nil {
+			return nil
+		}
+		found, err := decodeEntry(data)
+		if err != nil {
+			return err
+		}
+		if found.trashed() || !match(found.FormatVersion) {
+			return nil
+		}
+		if err := bucket.Delete(ref.Key); err != nil {
+			return err
+		}
+		return addDeadBytes(tx, found.LogID, recordSize(ref, found.Size))
+	}))
+}
+
+// DeleteNamespace deletes all the blobs of the namespace, including the trashed ones.
+func (store *Store) DeleteNamespace(ctx context.Context, namespace []byte) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return Error.Wrap(store.index.Update(func(tx *bbolt.Tx) error {
+		name := namespaceBucket(namespace)
+		bucket := tx.Bucket(name)
+		if bucket == nil {
+			return nil
+		}
+
+		dead := map[uint64]int64{}
+		err := bucket.ForEach(func(key, data []byte) error {
+			found, err := decodeEntry(data)
+			if err != nil {
+				return err
+			}
+			dead[found.LogID] += recordSize(storage.BlobRef{Namespace: namespace, Key: key}, found.Size)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		for id, size := range dead {
+			if err := addDeadBytes(tx, id, size); err != nil {
+				return err
+			}
+		}
+		return tx.DeleteBucket(name)
+	}))
+}
+
+// Trash marks the blob as trashed. Its data stays in the log file until the trash is emptied.
+// Like the file store, it doesn't fail when the blob doesn't exist.
+func (store *Store) Trash(ctx context.Context, ref storage.BlobRef) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !ref.IsValid() {
+		return storage.ErrInvalidBlobRef.New("")
+	}
+
+	trashedAt := store.trashnow()
+	return store.index.Update(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket(namespaceBucket(ref.Namespace))
+		if bucket == nil {
+			return nil
+		}
+		data := bucket.Get(ref.Key)
+		if data == nil {
+			return nil
+		}
+		found, err := decodeEntry(data)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		if found.trashed() {
+			return nil
+		}
+		found.TrashedAt = trashedAt
+		return Error.Wrap(bucket.Put(ref.Key, found.encode()))
+	})
+}
+
+// RestoreTrash restores all the trashed blobs of the namespace and returns their keys.
+func (store *Store) RestoreTrash(ctx context.Context, namespace []byte) (keysRestored [][]byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = store.index.Update(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket(namespaceBucket(namespace))
+		if bucket == nil {
+			return nil
+		}
+
+		restored := map[string]entry{}
+		err := bucket.ForEach(func(key, data []byte) error {
+			found, err := decodeEntry(data)
+			if err != nil {
+				return err
+			}
+			if found.trashed() {
+				found.TrashedAt = time.Time{}
+				restored[string(key)] = found
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		for key, found := range restored {
+			if err := bucket.Put([]byte(key), found.encode()); err != nil {
+				return err
+			}
+			keysRestored = append(keysRestored, []byte(key))
+		}
+		return nil
+	})
+	return keysRestored, Error.Wrap(err)
+}
+
+// EmptyTrash deletes the blobs of the namespace that were trashed before trashedBefore
+// and returns the total size and the keys of the deleted blobs.
+func (store *Store) EmptyTrash(ctx context.Context, namespace []byte, trashedBefore time.Time) (bytesEmptied int64, keys [][]byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = store.index.Update(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket(namespaceBucket(namespace))
+		if bucket == nil {
+			return nil
+		}
+
+		dead := map[uint64]int64{}
+		err := bucket.ForEach(func(key, data []byte) error {
+			found, err := decodeEntry(data)
+			if err != nil {
+				return err
+			}
+			if found.trashed() && found.TrashedAt.Before(trashedBefore) {
+				bytesEmptied += found.Size
+				keys = append(keys, append([]byte{}, key...))
+				dead[found.LogID] += recordSize(storage.BlobRef{Namespace: namespace, Key: key}, found.Size)
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, key := range keys {
+			if err := bucket.Delete(key); err != nil {
+				return err
+			}
+		}
+		for id, size := range dead {
+			if err := addDeadBytes(tx, id, size); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, nil, Error.Wrap(err)
+	}
+	return bytesEmptied, keys, nil
+}
+
+// SpaceUsedForTrash returns the total size of the trashed blobs.
+func (store *Store) SpaceUsedForTrash(ctx context.Context) (total int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	namespaces, err := store.ListNamespaces(ctx)
+	if err != nil {
+		return 0, err
+	}
+	for _, namespace := range namespaces {
+		used, err := store.spaceUsed(namespace, true)
+		if err != nil {
+			return 0, err
+		}
+		total += used
+	}
+	return total, nil
+}
+
+// SpaceUsedForBlobs adds up the space used in all namespaces for blob storage.
+func (store *Store) SpaceUsedForBlobs(ctx context.Context) (total int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	namespaces, err := store.ListNamespaces(ctx)
+	if err != nil {
+		return 0, err
+	}
+	for _, namespace := range namespaces {
+		used, err := store.spaceUsed(namespace, false)
+		if err != nil {
+			return 0, err
+		}
+		total += used
+	}
+	return total, nil
+}
+
+// SpaceUsedForBlobsInNamespace adds up how much is used in the given namespace for blob storage.
+func (store *Store) SpaceUsedForBlobsInNamespace(ctx context.Context, namespace []byte) (_ int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+	return store.spaceUsed(namespace, false)
+}
+
+// spaceUsed adds up the size of the trashed or the not trashed blobs of the namespace.
+func (store *Store) spaceUsed(namespace []byte, trashed bool) (total int64, err error) {
+	err = store.index.View(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket(namespaceBucket(namespace))
+		if bucket == nil {
+			return nil
+		}
+		return bucket.ForEach(func(key, data []byte) error {
+			found, err := decodeEntry(data)
+			if err != nil {
+				return err
+			}
+			if found.trashed() == trashed {
+				total += found.Size
+			}
+			return nil
+		})
+	})
+	return total, Error.Wrap(err)
+}
+
+// FreeSpace returns how much space is left on the disk of the storage directory.
+func (store *Store) FreeSpace(ctx context.Context) (int64, error) {
+	info, err := filestore.DiskInfoFromPath(store.path)
+	if err != nil {
+		return 0, err
+	}
+	return info.AvailableSpace, nil
+}
+
+// CheckWritability tests writability of the storage directory by creating and deleting a file.
+func (store *Store) CheckWritability(ctx context.Context) error {
+	f, err := ioutil.TempFile(store.logsdir(), "write-test")
+	if err != nil {
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Remove(f.Name())
+}
+
+// ListNamespaces finds all the namespaces which have blobs in the index.
+func (store *Store) ListNamespaces(ctx context.Context) (namespaces [][]byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+	err = store.index.View(func(tx *bbolt.Tx) error {
+		return tx.ForEach(func(name []byte, _ *bbolt.Bucket) error {
+			if bytes.HasPrefix(name, namespacePrefix) {
+				namespaces = append(namespaces, append([]byte{}, name[len(namespacePrefix):]...))
+			}
+			return nil
+		})
+	})
+	return namespaces, Error.Wrap(err)
+}
+
+// WalkNamespace executes walkFunc for each blob in the given namespace, which isn't trashed.
+// If walkFunc returns a non-nil error, WalkNamespace will stop iterating and return the
+// error immediately.
+//
+// The index is read in batches, so walkFunc can modify the blobs.
+func (store *Store) WalkNamespace(ctx context.Context, namespace []byte, walkFunc func(storage.BlobInfo) error) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	const batchSize = 1000
+
+	var after []byte
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		var batch []storage.BlobInfo
+		err := store.index.View(func(tx *bbolt.Tx) error {
+			bucket := tx.Bucket(namespaceBucket(namespace))
+			if bucket == nil {
+				return nil
+			}
+
+			cursor := bucket.Cursor()
+			key, data := cursor.First()
+			if after != nil {
+				key, data = cursor.Seek(after)
+				if bytes.Equal(key, after) {
+					key, data = cursor.Next()
+				}
+			}
+
+			for ; key != nil && len(batch) < batchSize; key, data = cursor.Next() {
+				found, err := decodeEntry(data)
+				if err != nil {
+					return err
+				}
+				if found.trashed() {
+					continue
+				}
+				ref := storage.BlobRef{
+					Namespace: namespace,
+					Key:       append([]byte{}, key...),
+				}
+				batch = append(batch, newBlobInfo(ref, found))
+			}
+			return nil
+		})
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		if len(batch) == 0 {
+			return nil
+		}
+
+		for _, info := range batch {
+			if err := walkFunc(info); err != nil {
+				return err
+			}
+		}
+		after = batch[len(batch)-1].BlobRef().Key
+	}
+}
+
+// CreateVerificationFile creates a file to be used for storage directory verification.
+func (store *Store) CreateVerificationFile(ctx context.Context, id storj.NodeID) (err error) {
+	f, err := os.Create(filepath.Join(store.path, verificationFileName))
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err = errs.Combine(err, f.Close())
+	}()
+	_, err = f.Write(id.Bytes())
+	return err
+}
+
+// VerifyStorageDir verifies that the storage directory is correct by checking for the existence and validity
+// of the verification file.
+func (store *Store) VerifyStorageDir(ctx context.Context, id storj.NodeID) error {
+	content, err := ioutil.ReadFile(filepath.Join(store.path, verificationFileName))
+	if err != nil {
+		return err
+	}
+
+	if !bytes.Equal(content, id.Bytes()) {
+		verifyID, err := storj.NodeIDFromBytes(content)
+		if err != nil {
+			return errs.New("content of file is not a valid node ID: %x", content)
+		}
+		return errs.New("node ID in file (%s) does not match running node's ID (%s)", verifyID, id.String())
+	}
+	return nil
+}
+
+// recountDeadBytes sets the number of dead bytes of the log files to the bytes, which
+// are not used by a blob of the index. Besides the bytes of deleted blobs these include
+// the bytes, which were appended but never indexed, because the node stopped while
+// writing.
+func (store *Store) recountDeadBytes(ids []uint64) error {
+	return store.index.Update(func(tx *bbolt.Tx) error {
+		live := make(map[uint64]int64, len(ids))
+		err := forEachEntry(tx, func(ref storage.BlobRef, found entry) error {
+			live[found.LogID] += recordSize(ref, found.Size)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		bucket := tx.Bucket(logsBucket)
+		for _, id := range ids {
+			stat, err := os.Stat(store.logPath(id))
+			if err != nil {
+				return err
+			}
+			if err := bucket.Put(logKey(id), encodeInt(stat.Size()-live[id])); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// addDeadBytes adds size to the number of dead bytes of the log file.
+func addDeadBytes(tx *bbolt.Tx, id uint64, size int64) error {
+	bucket := tx.Bucket(logsBucket)
+	key := logKey(id)
+	return bucket.Put(key, encodeInt(decodeInt(bucket.Get(key))+size))
+}
+
+// checkRef checks whether the blob ref can be stored in a record.
+func checkRef(ref storage.BlobRef) error {
+	if !ref.IsValid() {
+		return storage.ErrInvalidBlobRef.New("")
+	}
+	if len(ref.Namespace) > maxRefPartLength || len(ref.Key) > maxRefPartLength {
+		return storage.ErrInvalidBlobRef.New("namespace or key too long")
+	}
+	return nil
+}
+
+// anyFormat accepts all storage format versions.
+func anyFormat(storage.FormatVersion) bool { return true }
+
+// exactFormat accepts only the storage format version.
+func exactFormat(formatVer storage.FormatVersion) func(storage.FormatVersion) bool {
+	return func(v storage.FormatVersion) bool { return v == formatVer }
+}
@@ -0,0 +1,387 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package packstore_test
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/memory"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/storage"
+	"storj.io/storj/storage/filestore"
+	"storj.io/storj/storage/packstore"
+)
+
+func writeBlob(ctx *testcontext.Context, t *testing.T, store storage.Blobs, ref storage.BlobRef, data []byte) {
+	writer, err := store.Create(ctx, ref, int64(len(data)))
+	require.NoError(t, err)
+	_, err = writer.Write(data)
+	require.NoError(t, err)
+	require.NoError(t, writer.Commit(ctx))
+}
+
+func requireBlob(ctx *testcontext.Context, t *testing.T, store storage.Blobs, ref storage.BlobRef, data []byte) {
+	reader, err := store.Open(ctx, ref)
+	require.NoError(t, err)
+	defer ctx.Check(reader.Close)
+
+	size, err := reader.Size()
+	require.NoError(t, err)
+	require.Equal(t, int64(len(data)), size)
+
+	read, err := ioutil.ReadAll(reader)
+	require.NoError(t, err)
+	require.Equal(t, data, read)
+}
+
+func TestStoreReadWrite(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	store, err := packstore.Open(zaptest.NewLogger(t), ctx.Dir("store"), packstore.DefaultConfig)
+	require.NoError(t, err)
+	defer ctx.Check(store.Close)
+
+	namespace := testrand.Bytes(32)
+	refs := make([]storage.BlobRef, 10)
+	blobs := make([][]byte, len(refs))
+	for i := range refs {
+		refs[i] = storage.BlobRef{Namespace: namespace, Key: testrand.Bytes(32)}
+		blobs[i] = testrand.BytesInt(1 + testrand.Intn(4*memory.KiB.Int()))
+		writeBlob(ctx, t, store, refs[i], blobs[i])
+	}
+
+	// a blob is not visible before it's committed
+	pending := storage.BlobRef{Namespace: namespace, Key: testrand.Bytes(32)}
+	writer, err := store.Create(ctx, pending, -1)
+	require.NoError(t, err)
+	_, err = writer.Seek(512, io.SeekStart)
+	require.NoError(t, err)
+	_, err = writer.Write([]byte("data"))
+	require.NoError(t, err)
+	_, err = writer.Seek(0, io.SeekStart)
+	require.NoError(t, err)
+	_, err = writer.Write([]byte("header"))
+	require.NoError(t, err)
+	_, err = store.Open(ctx, pending)
+	require.True(t, os.IsNotExist(err))
+	require.NoError(t, writer.Cancel(ctx))
+	_, err = store.Stat(ctx, pending)
+	require.True(t, os.IsNotExist(err))
+
+	for i, ref := range refs {
+		requireBlob(ctx, t, store, ref, blobs[i])
+
+		info, err := store.StatWithStorageFormat(ctx, ref, filestore.FormatV1)
+		require.NoError(t, err)
+		stat, err := info.Stat(ctx)
+		require.NoError(t, err)
+		require.Equal(t, int64(len(blobs[i])), stat.Size())
+
+		_, err = store.OpenWithStorageFormat(ctx, ref, filestore.FormatV0)
+		require.True(t, os.IsNotExist(err))
+	}
+
+	var walked int
+	require.NoError(t, store.WalkNamespace(ctx, namespace, func(info storage.BlobInfo) error {
+		walked++
+		return nil
+	}))
+	require.Equal(t, len(refs), walked)
+
+	namespaces, err := store.ListNamespaces(ctx)
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{namespace}, namespaces)
+
+	require.NoError(t, store.Delete(ctx, refs[0]))
+	_, err = store.Open(ctx, refs[0])
+	require.True(t, os.IsNotExist(err))
+	// deleting a missing blob is not an error
+	require.NoError(t, store.Delete(ctx, refs[0]))
+
+	var total int64
+	for _, data := range blobs[1:] {
+		total += int64(len(data))
+	}
+	used, err := store.SpaceUsedForBlobs(ctx)
+	require.NoError(t, err)
+	require.Equal(t, total, used)
+}
+
+func TestStoreConcurrentWriters(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	store, err := packstore.Open(zaptest.NewLogger(t), ctx.Dir("store"), packstore.DefaultConfig)
+	require.NoError(t, err)
+	defer ctx.Check(store.Close)
+
+	namespace := testrand.Bytes(32)
+	refs := make([]storage.BlobRef, 3)
+	blobs := make([][]byte, len(refs))
+	writers := make([]storage.BlobWriter, len(refs))
+	for i := range refs {
+		refs[i] = storage.BlobRef{Namespace: namespace, Key: testrand.Bytes(32)}
+		blobs[i] = testrand.BytesInt(2 * memory.KiB.Int())
+		writers[i], err = store.Create(ctx, refs[i], -1)
+		require.NoError(t, err)
+	}
+
+	// interleave the writes of the blobs
+	for offset := 0; offset < 2*memory.KiB.Int(); offset += 512 {
+		for i, writer := range writers {
+			_, err := writer.Write(blobs[i][offset : offset+512])
+			require.NoError(t, err)
+		}
+	}
+
+	require.NoError(t, writers[1].Cancel(ctx))
+	require.NoError(t, writers[0].Commit(ctx))
+	require.NoError(t, writers[2].Commit(ctx))
+	// canceling a committed blob is a no-op
+	require.NoError(t, writers[0].Cancel(ctx))
+
+	requireBlob(ctx, t, store, refs[0], blobs[0])
+	requireBlob(ctx, t, store, refs[2], blobs[2])
+	_, err = store.Open(ctx, refs[1])
+	require.True(t, os.IsNotExist(err))
+
+	// the log file of the canceled blob is reused
+	writeBlob(ctx, t, store, refs[1], blobs[1])
+	for i, ref := range refs {
+		requireBlob(ctx, t, store, ref, blobs[i])
+	}
+}
+
+func TestStoreTrash(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	store, err := packstore.Open(zaptest.NewLogger(t), ctx.Dir("store"), packstore.DefaultConfig)
+	require.NoError(t, err)
+	defer ctx.Check(store.Close)
+
+	namespace := testrand.Bytes(32)
+	kept := storage.BlobRef{Namespace: namespace, Key: testrand.Bytes(32)}
+	trashed := storage.BlobRef{Namespace: namespace, Key: testrand.Bytes(32)}
+	data := testrand.Bytes(memory.KiB)
+	writeBlob(ctx, t, store, kept, data)
+	writeBlob(ctx, t, store, trashed, data)
+
+	now := time.Now()
+	store.ReplaceTrashnow(func() time.Time { return now.Add(-time.Hour) })
+	require.NoError(t, store.Trash(ctx, trashed))
+	// trashing a trashed or missing blob is a no-op, like in the file store
+	require.NoError(t, store.Trash(ctx, trashed))
+	require.NoError(t, store.Trash(ctx, storage.BlobRef{Namespace: namespace, Key: testrand.Bytes(32)}))
+
+	_, err = store.Open(ctx, trashed)
+	require.True(t, os.IsNotExist(err))
+	trashUsed, err := store.SpaceUsedForTrash(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(len(data)), trashUsed)
+
+	restored, err := store.RestoreTrash(ctx, namespace)
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{trashed.Key}, restored)
+	requireBlob(ctx, t, store, trashed, data)
+
+	require.NoError(t, store.Trash(ctx, trashed))
+
+	// the blob was trashed after the threshold, so it's kept
+	emptied, keys, err := store.EmptyTrash(ctx, namespace, now.Add(-2*time.Hour))
+	require.NoError(t, err)
+	require.Zero(t, emptied)
+	require.Empty(t, keys)
+
+	emptied, keys, err = store.EmptyTrash(ctx, namespace, now)
+	require.NoError(t, err)
+	require.Equal(t, int64(len(data)), emptied)
+	require.Equal(t, [][]byte{trashed.Key}, keys)
+
+	restored, err = store.RestoreTrash(ctx, namespace)
+	require.NoError(t, err)
+	require.Empty(t, restored)
+	_, err = store.Open(ctx, trashed)
+	require.True(t, os.IsNotExist(err))
+	requireBlob(ctx, t, store, kept, data)
+}
+
+func TestStoreCompact(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	config := packstore.DefaultConfig
+	config.MaxLogSize = 16 * memory.KiB
+
+	dir := ctx.Dir("store")
+	store, err := packstore.Open(zaptest.NewLogger(t), dir, config)
+	require.NoError(t, err)
+
+	namespace := testrand.Bytes(32)
+	refs := make([]storage.BlobRef, 40)
+	blobs := make([][]byte, len(refs))
+	for i := range refs {
+		refs[i] = storage.BlobRef{Namespace: namespace, Key: testrand.Bytes(32)}
+		blobs[i] = testrand.Bytes(memory.KiB)
+		writeBlob(ctx, t, store, refs[i], blobs[i])
+	}
+
+	logsBefore, err := ioutil.ReadDir(dir + "/packs")
+	require.NoError(t, err)
+
+	// delete most of the blobs, so that the old log files are compacted
+	for i := range refs {
+		if i%4 != 0 {
+			require.NoError(t, store.Delete(ctx, refs[i]))
+		}
+	}
+	// keep a reader open during the compaction
+	reader, err := store.Open(ctx, refs[0])
+	require.NoError(t, err)
+
+	require.NoError(t, store.Compact(ctx))
+
+	read, err := ioutil.ReadAll(reader)
+	require.NoError(t, err)
+	require.Equal(t, blobs[0], read)
+	require.NoError(t, reader.Close())
+
+	logsAfter, err := ioutil.ReadDir(dir + "/packs")
+	require.NoError(t, err)
+	require.Less(t, len(logsAfter), len(logsBefore))
+
+	// the blobs are readable after compaction and after reopening the store
+	require.NoError(t, store.Close())
+	store, err = packstore.Open(zaptest.NewLogger(t), dir, config)
+	require.NoError(t, err)
+	defer ctx.Check(store.Close)
+
+	for i, ref := range refs {
+		if i%4 != 0 {
+			_, err := store.Open(ctx, ref)
+			require.True(t, os.IsNotExist(err))
+			continue
+		}
+		requireBlob(ctx, t, store, ref, blobs[i])
+	}
+}
+
+func TestStoreCompactUnindexed(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	config := packstore.DefaultConfig
+	config.MaxLogSize = 16 * memory.KiB
+
+	dir := ctx.Dir("store")
+	store, err := packstore.Open(zaptest.NewLogger(t), dir, config)
+	require.NoError(t, err)
+
+	ref := storage.BlobRef{Namespace: testrand.Bytes(32), Key: testrand.Bytes(32)}
+	data := testrand.Bytes(memory.KiB)
+	writeBlob(ctx, t, store, ref, data)
+	require.NoError(t, store.Close())
+
+	// simulate a node, which stopped while appending a blob
+	logPath := dir + "/packs/0000000000000001.log"
+	file, err := os.OpenFile(logPath, os.O_APPEND|os.O_WRONLY, 0600)
+	require.NoError(t, err)
+	_, err = file.Write(testrand.Bytes(config.MaxLogSize))
+	require.NoError(t, err)
+	require.NoError(t, file.Close())
+
+	store, err = packstore.Open(zaptest.NewLogger(t), dir, config)
+	require.NoError(t, err)
+	defer ctx.Check(store.Close)
+
+	require.NoError(t, store.Compact(ctx))
+
+	_, err = os.Stat(logPath)
+	require.True(t, os.IsNotExist(err))
+	requireBlob(ctx, t, store, ref, data)
+}
+
+func TestMigrate(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	log := zaptest.NewLogger(t)
+	dir := ctx.Dir("store")
+
+	from, err := filestore.NewAt(log, dir, filestore.DefaultConfig)
+	require.NoError(t, err)
+	defer ctx.Check(from.Close)
+
+	namespace := testrand.Bytes(32)
+	refs := make([]storage.BlobRef, 5)
+	blobs := make([][]byte, len(refs))
+	for i := range refs {
+		refs[i] = storage.BlobRef{Namespace: namespace, Key: testrand.Bytes(32)}
+		blobs[i] = testrand.Bytes(memory.KiB)
+		writeBlob(ctx, t, from, refs[i], blobs[i])
+	}
+	require.NoError(t, from.Trash(ctx, refs[0]))
+
+	// V0 blobs are migrated with their format version, and a V0 blob rewritten
+	// with a newer format version is superseded by it.
+	v0Ref := storage.BlobRef{Namespace: namespace, Key: testrand.Bytes(32)}
+	v0Data := testrand.Bytes(memory.KiB)
+	writeV0Blob(ctx, t, from, v0Ref, v0Data)
+	writeV0Blob(ctx, t, from, refs[1], testrand.Bytes(memory.KiB))
+
+	require.True(t, packstore.ErrNotMigrated.Has(packstore.VerifyMigrated(dir)))
+
+	to, err := packstore.Open(log, dir, packstore.DefaultConfig)
+	require.NoError(t, err)
+	defer ctx.Check(to.Close)
+
+	migrated, err := packstore.Migrate(ctx, log, from, to)
+	require.NoError(t, err)
+	require.Equal(t, int64(len(refs)+2), migrated)
+	require.NoError(t, packstore.VerifyMigrated(dir))
+
+	for i, ref := range refs {
+		requireBlob(ctx, t, to, ref, blobs[i])
+
+		_, err := from.Open(ctx, ref)
+		require.True(t, os.IsNotExist(err))
+	}
+
+	info, err := to.StatWithStorageFormat(ctx, v0Ref, filestore.FormatV0)
+	require.NoError(t, err)
+	require.Equal(t, filestore.FormatV0, info.StorageFormatVersion())
+	reader, err := to.OpenWithStorageFormat(ctx, v0Ref, filestore.FormatV0)
+	require.NoError(t, err)
+	read, err := ioutil.ReadAll(reader)
+	require.NoError(t, err)
+	require.NoError(t, reader.Close())
+	require.Equal(t, v0Data, read)
+
+	info, err = to.Stat(ctx, refs[1])
+	require.NoError(t, err)
+	require.Equal(t, filestore.FormatV1, info.StorageFormatVersion())
+}
+
+func writeV0Blob(ctx *testcontext.Context, t *testing.T, store storage.Blobs, ref storage.BlobRef, data []byte) {
+	v0Store, ok := store.(interface {
+		TestCreateV0(ctx context.Context, ref storage.BlobRef) (_ storage.BlobWriter, err error)
+	})
+	require.True(t, ok)
+
+	writer, err := v0Store.TestCreateV0(ctx, ref)
+	require.NoError(t, err)
+	_, err = writer.Write(data)
+	require.NoError(t, err)
+	require.NoError(t, writer.Commit(ctx))
+}
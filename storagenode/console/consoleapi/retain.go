@@ -0,0 +1,105 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consoleapi
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/storj"
+	"storj.io/storj/storagenode/retain"
+)
+
+// ErrRetainAPI - console retain api error type.
+var ErrRetainAPI = errs.Class("consoleapi retain")
+
+// Retain is an api controller that exposes the reports of the processed retain requests.
+type Retain struct {
+	service *retain.Service
+
+	log *zap.Logger
+}
+
+// NewRetain is a constructor for retain controller.
+func NewRetain(log *zap.Logger, service *retain.Service) *Retain {
+	return &Retain{
+		log:     log,
+		service: service,
+	}
+}
+
+// Reports returns the retain reports of all satellites.
+func (controller *Retain) Reports(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set(contentType, applicationJSON)
+
+	reports, err := controller.service.Reports(ctx)
+	if err != nil {
+		controller.serveJSONError(w, http.StatusInternalServerError, ErrRetainAPI.Wrap(err))
+		return
+	}
+
+	controller.serveReports(w, reports)
+}
+
+// SatelliteReports returns the retain reports of a satellite.
+func (controller *Retain) SatelliteReports(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set(contentType, applicationJSON)
+
+	params := mux.Vars(r)
+
+	satelliteID, err := storj.NodeIDFromString(params["satelliteId"])
+	if err != nil {
+		controller.serveJSONError(w, http.StatusBadRequest, ErrRetainAPI.Wrap(err))
+		return
+	}
+
+	reports, err := controller.service.SatelliteReports(ctx, satelliteID)
+	if err != nil {
+		controller.serveJSONError(w, http.StatusInternalServerError, ErrRetainAPI.Wrap(err))
+		return
+	}
+
+	controller.serveReports(w, reports)
+}
+
+// serveReports writes the reports to response output stream.
+func (controller *Retain) serveReports(w http.ResponseWriter, reports []retain.Report) {
+	if reports == nil {
+		reports = []retain.Report{}
+	}
+
+	if err := json.NewEncoder(w).Encode(reports); err != nil {
+		controller.log.Error("failed to encode json response", zap.Error(ErrRetainAPI.Wrap(err)))
+		return
+	}
+}
+
+// serveJSONError writes JSON error to response output stream.
+func (controller *Retain) serveJSONError(w http.ResponseWriter, status int, err error) {
+	w.WriteHeader(status)
+
+	var response struct {
+		Error string `json:"error"`
+	}
+
+	response.Error = err.Error()
+
+	err = json.NewEncoder(w).Encode(response)
+	if err != nil {
+		controller.log.Error("failed to write json error response", zap.Error(ErrRetainAPI.Wrap(err)))
+		return
+	}
+}
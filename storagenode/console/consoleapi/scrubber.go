@@ -0,0 +1,103 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consoleapi
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/storj"
+	"storj.io/storj/storagenode/scrubber"
+)
+
+// ErrScrubberAPI - console scrubber api error type.
+var ErrScrubberAPI = errs.Class("consoleapi scrubber")
+
+// Scrubber is an api controller that exposes the pieces found corrupted by the scrubber.
+type Scrubber struct {
+	service *scrubber.Service
+
+	log *zap.Logger
+}
+
+// NewScrubber is a constructor for scrubber controller.
+func NewScrubber(log *zap.Logger, service *scrubber.Service) *Scrubber {
+	return &Scrubber{
+		log:     log,
+		service: service,
+	}
+}
+
+// CorruptedPieces returns the list of corrupted pieces.
+func (controller *Scrubber) CorruptedPieces(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set(contentType, applicationJSON)
+
+	corrupted, err := controller.service.CorruptedPieces(ctx)
+	if err != nil {
+		controller.serveJSONError(w, http.StatusInternalServerError, ErrScrubberAPI.Wrap(err))
+		return
+	}
+	if corrupted == nil {
+		corrupted = []scrubber.CorruptedPiece{}
+	}
+
+	if err := json.NewEncoder(w).Encode(corrupted); err != nil {
+		controller.log.Error("failed to encode json response", zap.Error(ErrScrubberAPI.Wrap(err)))
+		return
+	}
+}
+
+// DismissCorruptedPiece removes a piece from the list of corrupted pieces.
+func (controller *Scrubber) DismissCorruptedPiece(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set(contentType, applicationJSON)
+
+	params := mux.Vars(r)
+
+	satelliteID, err := storj.NodeIDFromString(params["satelliteId"])
+	if err != nil {
+		controller.serveJSONError(w, http.StatusBadRequest, ErrScrubberAPI.Wrap(err))
+		return
+	}
+
+	pieceID, err := storj.PieceIDFromString(params["pieceId"])
+	if err != nil {
+		controller.serveJSONError(w, http.StatusBadRequest, ErrScrubberAPI.Wrap(err))
+		return
+	}
+
+	err = controller.service.DismissCorrupted(ctx, satelliteID, pieceID)
+	if err != nil {
+		controller.serveJSONError(w, http.StatusInternalServerError, ErrScrubberAPI.Wrap(err))
+		return
+	}
+}
+
+// serveJSONError writes JSON error to response output stream.
+func (controller *Scrubber) serveJSONError(w http.ResponseWriter, status int, err error) {
+	w.WriteHeader(status)
+
+	var response struct {
+		Error string `json:"error"`
+	}
+
+	response.Error = err.Error()
+
+	err = json.NewEncoder(w).Encode(response)
+	if err != nil {
+		controller.log.Error("failed to write json error response", zap.Error(ErrScrubberAPI.Wrap(err)))
+		return
+	}
+}
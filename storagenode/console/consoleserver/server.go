@@ -21,6 +21,8 @@ import (
 	"storj.io/storj/storagenode/console/consoleapi"
 	"storj.io/storj/storagenode/notifications"
 	"storj.io/storj/storagenode/payouts"
+	"storj.io/storj/storagenode/retain"
+	"storj.io/storj/storagenode/scrubber"
 )
 
 var (
@@ -44,19 +46,23 @@ type Server struct {
 	service       *console.Service
 	notifications *notifications.Service
 	payout        *payouts.Service
+	scrubber      *scrubber.Service
+	retain        *retain.Service
 	listener      net.Listener
 
 	server http.Server
 }
 
 // NewServer creates new instance of storagenode console web server.
-func NewServer(logger *zap.Logger, assets http.FileSystem, notifications *notifications.Service, service *console.Service, payout *payouts.Service, listener net.Listener) *Server {
+func NewServer(logger *zap.Logger, assets http.FileSystem, notifications *notifications.Service, service *console.Service, payout *payouts.Service, scrubber *scrubber.Service, retain *retain.Service, listener net.Listener) *Server {
 	server := Server{
 		log:           logger,
 		service:       service,
 		listener:      listener,
 		notifications: notifications,
 		payout:        payout,
+		scrubber:      scrubber,
+		retain:        retain,
 	}
 
 	router := mux.NewRouter()
@@ -86,6 +92,18 @@ func NewServer(logger *zap.Logger, assets http.FileSystem, notifications *notifi
 	payoutRouter.HandleFunc("/periods", payoutController.HeldAmountPeriods).Methods(http.MethodGet)
 	payoutRouter.HandleFunc("/payout-history/{period}", payoutController.PayoutHistory).Methods(http.MethodGet)
 
+	scrubberController := consoleapi.NewScrubber(server.log, server.scrubber)
+	scrubberRouter := router.PathPrefix("/api/scrubber").Subrouter()
+	scrubberRouter.StrictSlash(true)
+	scrubberRouter.HandleFunc("/corrupted-pieces", scrubberController.CorruptedPieces).Methods(http.MethodGet)
+	scrubberRouter.HandleFunc("/corrupted-pieces/{satelliteId}/{pieceId}", scrubberController.DismissCorruptedPiece).Methods(http.MethodDelete)
+
+	retainController := consoleapi.NewRetain(server.log, server.retain)
+	retainRouter := router.PathPrefix("/api/retain").Subrouter()
+	retainRouter.StrictSlash(true)
+	retainRouter.HandleFunc("/reports", retainController.Reports).Methods(http.MethodGet)
+	retainRouter.HandleFunc("/reports/{satelliteId}", retainController.SatelliteReports).Methods(http.MethodGet)
+
 	if assets != nil {
 		fs := http.FileServer(assets)
 		router.PathPrefix("/static/").Handler(server.cacheMiddleware(http.StripPrefix("/static", fs)))
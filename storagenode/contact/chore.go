@@ -60,6 +60,17 @@ func (chore *Chore) Run(ctx context.Context) (err error) {
 
 	defer refreshCycle.Close()
 
+	if chore.service.reportsLoad() {
+		// set up a cycle to report significant changes of the load before the next check-in
+		loadCycle := sync2.NewCycle(chore.service.loadInterval())
+		loadCycle.Start(ctx, &group, func(ctx context.Context) error {
+			chore.reportLoad(ctx)
+			return nil
+		})
+
+		defer loadCycle.Close()
+	}
+
 	chore.started.Release()
 	return group.Wait()
 }
@@ -98,6 +109,32 @@ func (chore *Chore) updateCycles(ctx context.Context, group *errgroup.Group, sat
 	}
 }
 
+// reportLoad triggers the ping cycles of the satellites, which were told a load that
+// differs significantly from the current load of the node.
+func (chore *Chore) reportLoad(ctx context.Context) {
+	load, err := chore.service.Load(ctx)
+	if err != nil {
+		chore.log.Warn("failed to determine the load of the node", zap.Error(err))
+		return
+	}
+	if load == nil {
+		return
+	}
+
+	chore.mu.Lock()
+	defer chore.mu.Unlock()
+	for satellite, cycle := range chore.cycles {
+		if !chore.service.loadOutdated(satellite, *load) {
+			continue
+		}
+		chore.log.Debug("Reporting changed load", zap.Stringer("Satellite ID", satellite))
+		cycle := cycle
+		go func() {
+			cycle.Trigger()
+		}()
+	}
+}
+
 // Pause stops all the cycles in the contact chore.
 func (chore *Chore) Pause(ctx context.Context) {
 	chore.started.Wait(ctx)
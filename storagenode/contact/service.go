@@ -13,10 +13,13 @@ import (
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
 
+	"storj.io/common/memory"
 	"storj.io/common/pb"
 	"storj.io/common/rpc"
 	"storj.io/common/storj"
 	"storj.io/common/sync2"
+	"storj.io/storj/private/nodeload"
+	"storj.io/storj/storagenode/bandwidth"
 	"storj.io/storj/storagenode/trust"
 )
 
@@ -36,7 +39,11 @@ type Config struct {
 	ExternalAddress string `user:"true" help:"the public address of the node, useful for nodes behind NAT" default:""`
 
 	// Chore config values
-	Interval time.Duration `help:"how frequently the node contact chore should run" releaseDefault:"1h" devDefault:"30s"`
+	Interval     time.Duration `help:"how frequently the node contact chore should run" releaseDefault:"1h" devDefault:"30s"`
+	LoadInterval time.Duration `help:"period over which the load of the node is measured. The load is reported to the satellites before the next check-in when it changed significantly" releaseDefault:"5m" devDefault:"30s"`
+
+	MaxIngressRate memory.Size `user:"true" help:"upload bandwidth per second the node is able to take, used for reporting the load of the node to the satellites. 0 disables reporting it" default:"0B"`
+	MaxEgressRate  memory.Size `user:"true" help:"download bandwidth per second the node is able to take, used for reporting the load of the node to the satellites. 0 disables reporting it" default:"0B"`
 }
 
 // NodeInfo contains information necessary for introducing storagenode to satellite.
@@ -52,9 +59,13 @@ type NodeInfo struct {
 type Service struct {
 	log    *zap.Logger
 	dialer rpc.Dialer
+	usage  bandwidth.DB
+	config Config
 
 	mu   sync.Mutex
 	self NodeInfo
+	// reported is the last significantly changed load reported to each satellite.
+	reported map[storj.NodeID]nodeload.Load
 
 	trust *trust.Pool
 
@@ -62,12 +73,16 @@ type Service struct {
 }
 
 // NewService creates a new contact service.
-func NewService(log *zap.Logger, dialer rpc.Dialer, self NodeInfo, trust *trust.Pool) *Service {
+func NewService(log *zap.Logger, dialer rpc.Dialer, self NodeInfo, trust *trust.Pool, usage bandwidth.DB, config Config) *Service {
 	return &Service{
 		log:    log,
 		dialer: dialer,
+		usage:  usage,
+		config: config,
 		trust:  trust,
 		self:   self,
+
+		reported: make(map[storj.NodeID]nodeload.Load),
 	}
 }
 
@@ -128,12 +143,23 @@ func (service *Service) pingSatelliteOnce(ctx context.Context, id storj.NodeID)
 	defer func() { err = errs.Combine(err, conn.Close()) }()
 
 	self := service.Local()
-	resp, err := pb.NewDRPCNodeClient(conn).CheckIn(ctx, &pb.CheckInRequest{
+	req := &pb.CheckInRequest{
 		Address:  self.Address,
 		Version:  &self.Version,
 		Capacity: &self.Capacity,
 		Operator: &self.Operator,
-	})
+	}
+
+	load, err := service.Load(ctx)
+	if err != nil {
+		service.log.Warn("failed to determine the load of the node", zap.Error(err))
+	} else if load != nil {
+		if err := nodeload.Encode(req, *load); err != nil {
+			return errPingSatellite.Wrap(err)
+		}
+	}
+
+	resp, err := pb.NewDRPCNodeClient(conn).CheckIn(ctx, req)
 	if err != nil {
 		return errPingSatellite.Wrap(err)
 	}
@@ -143,9 +169,78 @@ func (service *Service) pingSatelliteOnce(ctx context.Context, id storj.NodeID)
 	if resp.PingErrorMessage != "" {
 		service.log.Warn("Your node is still considered to be online but encountered an error.", zap.Stringer("Satellite ID", id), zap.String("Error", resp.GetPingErrorMessage()))
 	}
+	if load != nil {
+		service.setReportedLoad(id, *load)
+	}
 	return nil
 }
 
+// setReportedLoad remembers the load reported to the satellite. Like the satellite, it
+// ignores changes below nodeload.ChangeThreshold, so that small changes can't add up
+// without being reported.
+func (service *Service) setReportedLoad(satellite storj.NodeID, load nodeload.Load) {
+	service.mu.Lock()
+	defer service.mu.Unlock()
+
+	reported, ok := service.reported[satellite]
+	if !ok || nodeload.Changed(&reported, &load) {
+		service.reported[satellite] = load
+	}
+}
+
+// loadOutdated returns whether the load last reported to the satellite differs
+// significantly from the current load.
+func (service *Service) loadOutdated(satellite storj.NodeID, load nodeload.Load) bool {
+	service.mu.Lock()
+	defer service.mu.Unlock()
+
+	reported, ok := service.reported[satellite]
+	return ok && nodeload.Changed(&reported, &load)
+}
+
+// Load returns the load of the node based on the bandwidth used in the last load interval.
+// It returns nil when neither the maximum ingress nor the maximum egress rate is configured.
+func (service *Service) Load(ctx context.Context) (_ *nodeload.Load, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if !service.reportsLoad() {
+		return nil, nil
+	}
+
+	interval := service.loadInterval()
+
+	now := time.Now()
+	usage, err := service.usage.Summary(ctx, now.Add(-interval), now)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	rate := func(amount int64, limit memory.Size) float64 {
+		if limit <= 0 {
+			return 0
+		}
+		return float64(amount) / interval.Seconds() / float64(limit)
+	}
+
+	return &nodeload.Load{
+		Ingress: rate(usage.Put+usage.PutRepair, service.config.MaxIngressRate),
+		Egress:  rate(usage.Get+usage.GetAudit+usage.GetRepair, service.config.MaxEgressRate),
+	}, nil
+}
+
+// reportsLoad returns whether the load of the node is reported to the satellites.
+func (service *Service) reportsLoad() bool {
+	return service.config.MaxIngressRate > 0 || service.config.MaxEgressRate > 0
+}
+
+// loadInterval returns the period over which the load of the node is measured.
+func (service *Service) loadInterval() time.Duration {
+	if service.config.LoadInterval <= 0 {
+		return 5 * time.Minute
+	}
+	return service.config.LoadInterval
+}
+
 // Local returns the storagenode info.
 func (service *Service) Local() NodeInfo {
 	service.mu.Lock()
@@ -92,7 +92,7 @@ func (inspector *Endpoint) retrieveStats(ctx context.Context) (_ *internalpb.Sta
 	egress := usage.Get + usage.GetAudit + usage.GetRepair
 
 	totalUsedBandwidth := usage.Total()
-	availableSpace := inspector.pieceStoreConfig.AllocatedDiskSpace.Int64() - piecesContentSize
+	availableSpace := inspector.pieceStoreConfig.TotalAllocatedDiskSpace().Int64() - piecesContentSize
 
 	return &internalpb.StatSummaryResponse{
 		UsedSpace:      piecesContentSize,
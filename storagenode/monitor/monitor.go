@@ -125,6 +125,10 @@ func (service *Service) Run(ctx context.Context) (err error) {
 		return Error.New("disk space requirement not met")
 	}
 
+	if err := service.checkStorageDirs(ctx); err != nil {
+		return Error.Wrap(err)
+	}
+
 	group, ctx := errgroup.WithContext(ctx)
 	group.Go(func() error {
 		return service.VerifyDirReadableLoop.Run(ctx, func(ctx context.Context) error {
@@ -139,6 +143,11 @@ func (service *Service) Run(ctx context.Context) (err error) {
 		return service.VerifyDirWritableLoop.Run(ctx, func(ctx context.Context) error {
 			err := service.store.CheckWritability(ctx)
 			if err != nil {
+				if service.anyStorageDirWritable(ctx) {
+					// the directories which aren't writable don't receive new pieces.
+					service.log.Error("error verifying writability of storage directory", zap.Error(err))
+					return nil
+				}
 				return Error.New("error verifying writability of storage directory: %v", err)
 			}
 			return nil
@@ -170,6 +179,46 @@ func (service *Service) Run(ctx context.Context) (err error) {
 	return group.Wait()
 }
 
+// checkStorageDirs warns about the storage directories which don't have enough free disk
+// space for the space allocated in them.
+func (service *Service) checkStorageDirs(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dirs, multiple, err := service.store.StorageDirs(ctx)
+	if err != nil || !multiple {
+		return err
+	}
+
+	for _, dir := range dirs {
+		if dir.Draining {
+			service.log.Info("Storage directory is draining", zap.String("Path", dir.Path))
+			continue
+		}
+		if dir.DiskFree < dir.Allocated-dir.UsedForBlobs {
+			service.log.Warn("Disk space is less than allocated in storage directory",
+				zap.String("Path", dir.Path),
+				zap.Int64("Allocated", dir.Allocated),
+				zap.Int64("Free", dir.DiskFree))
+		}
+	}
+	return nil
+}
+
+// anyStorageDirWritable returns whether the pieces are stored in multiple directories
+// and at least one of them, which isn't draining, is writable.
+func (service *Service) anyStorageDirWritable(ctx context.Context) bool {
+	dirs, multiple, err := service.store.StorageDirs(ctx)
+	if err != nil || !multiple {
+		return false
+	}
+	for _, dir := range dirs {
+		if dir.Writable && !dir.Draining {
+			return true
+		}
+	}
+	return false
+}
+
 // NotifyLowDisk reports disk space to satellites if cooldown timer has expired.
 func (service *Service) NotifyLowDisk() {
 	service.cooldown.Trigger()
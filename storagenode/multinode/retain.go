@@ -0,0 +1,72 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package multinode
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/storj/private/multinodepb"
+	"storj.io/storj/storagenode/apikeys"
+	"storj.io/storj/storagenode/retain"
+)
+
+var _ multinodepb.DRPCRetainServer = (*RetainEndpoint)(nil)
+
+// RetainEndpoint implements multinode retain endpoint.
+//
+// architecture: Endpoint
+type RetainEndpoint struct {
+	multinodepb.DRPCRetainUnimplementedServer
+
+	log     *zap.Logger
+	apiKeys *apikeys.Service
+	reports retain.DB
+}
+
+// NewRetainEndpoint creates new multinode retain endpoint.
+func NewRetainEndpoint(log *zap.Logger, apiKeys *apikeys.Service, reports retain.DB) *RetainEndpoint {
+	return &RetainEndpoint{
+		log:     log,
+		apiKeys: apiKeys,
+		reports: reports,
+	}
+}
+
+// Reports returns the reports of the processed retain requests.
+func (endpoint *RetainEndpoint) Reports(ctx context.Context, req *multinodepb.RetainReportsRequest) (_ *multinodepb.RetainReportsResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err = authenticate(ctx, endpoint.apiKeys, req.GetHeader()); err != nil {
+		return nil, rpcstatus.Wrap(rpcstatus.Unauthenticated, err)
+	}
+
+	reports, err := endpoint.reports.ListReports(ctx)
+	if err != nil {
+		endpoint.log.Error("retain reports internal error", zap.Error(err))
+		return nil, rpcstatus.Wrap(rpcstatus.Internal, err)
+	}
+
+	var resp multinodepb.RetainReportsResponse
+	for _, report := range reports {
+		resp.Reports = append(resp.Reports, &multinodepb.RetainReport{
+			SatelliteId:    report.SatelliteID,
+			CreatedBefore:  report.CreatedBefore,
+			FilterSize:     report.FilterSize,
+			DryRun:         report.DryRun,
+			PiecesCount:    report.PiecesCount,
+			PiecesSkipped:  report.PiecesSkipped,
+			PiecesToTrash:  report.PiecesToTrash,
+			BytesToTrash:   report.BytesToTrash,
+			PiecesTrashed:  report.PiecesTrashed,
+			SamplePieceIds: report.SamplePieceIDs,
+			StartedAt:      report.StartedAt,
+			FinishedAt:     report.FinishedAt,
+		})
+	}
+
+	return &resp, nil
+}
@@ -32,6 +32,7 @@ import (
 	"storj.io/storj/private/version/checker"
 	"storj.io/storj/storage"
 	"storj.io/storj/storage/filestore"
+	"storj.io/storj/storage/packstore"
 	"storj.io/storj/storagenode/apikeys"
 	"storj.io/storj/storagenode/bandwidth"
 	"storj.io/storj/storagenode/collector"
@@ -59,6 +60,7 @@ import (
 	"storj.io/storj/storagenode/reputation"
 	"storj.io/storj/storagenode/retain"
 	"storj.io/storj/storagenode/satellites"
+	"storj.io/storj/storagenode/scrubber"
 	"storj.io/storj/storagenode/storagenodedb"
 	"storj.io/storj/storagenode/storageusage"
 	"storj.io/storj/storagenode/trust"
@@ -92,6 +94,8 @@ type DB interface {
 	Payout() payouts.DB
 	Pricing() pricing.DB
 	APIKeys() apikeys.DB
+	Scrubber() scrubber.DB
+	Retain() retain.DB
 
 	Preflight(ctx context.Context) error
 }
@@ -113,6 +117,7 @@ type Config struct {
 	Collector collector.Config
 
 	Filestore filestore.Config
+	Packstore packstore.Config
 
 	Pieces pieces.Config
 
@@ -127,6 +132,8 @@ type Config struct {
 	Bandwidth bandwidth.Config
 
 	GracefulExit gracefulexit.Config
+
+	Scrubber scrubber.Config
 }
 
 // DatabaseConfig returns the storagenodedb.Config that should be used with this Config.
@@ -141,6 +148,11 @@ func (config *Config) DatabaseConfig() storagenodedb.Config {
 		Info2:     filepath.Join(dbdir, "info.db"),
 		Pieces:    config.Storage.Path,
 		Filestore: config.Filestore,
+
+		PiecesAllocated:  config.Storage.AllocatedDiskSpace,
+		AdditionalPieces: config.Storage.AdditionalDirs.List,
+
+		Packstore: config.Packstore,
 	}
 }
 
@@ -165,6 +177,10 @@ func (config *Config) Verify(log *zap.Logger) error {
 		}
 	}
 
+	if config.Packstore.Enabled && len(config.Storage.AdditionalDirs.List) > 0 {
+		return errs.New("packstore.enabled can't be used with storage.additional-dirs")
+	}
+
 	return nil
 }
 
@@ -243,10 +259,13 @@ type Peer struct {
 		Inspector     *inspector.Endpoint
 		Monitor       *monitor.Service
 		Orders        *orders.Service
+		Compaction    *packstore.Chore
 	}
 
 	Collector *collector.Service
 
+	Scrubber *scrubber.Service
+
 	NodeStats struct {
 		Service *nodestats.Service
 		Cache   *nodestats.Cache
@@ -288,6 +307,7 @@ type Peer struct {
 		Bandwidth *multinode.BandwidthEndpoint
 		Node      *multinode.NodeEndpoint
 		Payout    *multinode.PayoutEndpoint
+		Retain    *multinode.RetainEndpoint
 	}
 }
 
@@ -410,7 +430,7 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 			Version: *pbVersion,
 		}
 		peer.Contact.PingStats = new(contact.PingStats)
-		peer.Contact.Service = contact.NewService(peer.Log.Named("contact:service"), peer.Dialer, self, peer.Storage2.Trust)
+		peer.Contact.Service = contact.NewService(peer.Log.Named("contact:service"), peer.Dialer, self, peer.Storage2.Trust, peer.DB.Bandwidth(), c)
 
 		peer.Contact.Chore = contact.NewChore(peer.Log.Named("contact:chore"), config.Contact.Interval, peer.Contact.Service)
 		peer.Services.Add(lifecycle.Item{
@@ -435,6 +455,7 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 			peer.DB.PieceSpaceUsedDB(),
 			config.Pieces,
 		)
+		peer.Storage2.Store.SetCorruptedPiecesDB(peer.DB.Scrubber())
 
 		peer.Storage2.PieceDeleter = pieces.NewDeleter(log.Named("piecedeleter"), peer.Storage2.Store, config.Storage2.DeleteWorkers, config.Storage2.DeleteQueueSize)
 		peer.Services.Add(lifecycle.Item{
@@ -470,12 +491,30 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 		peer.Debug.Server.Panel.Add(
 			debug.Cycle("Piecestore Cache", peer.Storage2.CacheService.Loop))
 
+		if multi, ok := peer.DB.Pieces().(*filestore.MultiStore); ok && config.Storage.AdditionalDirs.Draining() {
+			peer.Services.Add(lifecycle.Item{
+				Name: "pieces:drain",
+				Run:  multi.Drain,
+			})
+		}
+
+		if packed, ok := peer.DB.Pieces().(*packstore.Store); ok {
+			peer.Storage2.Compaction = packstore.NewChore(peer.Log.Named("packstore:compaction"), packed, config.Packstore)
+			peer.Services.Add(lifecycle.Item{
+				Name:  "packstore:compaction",
+				Run:   peer.Storage2.Compaction.Run,
+				Close: peer.Storage2.Compaction.Close,
+			})
+			peer.Debug.Server.Panel.Add(
+				debug.Cycle("Packstore Compaction", peer.Storage2.Compaction.Loop))
+		}
+
 		peer.Storage2.Monitor = monitor.NewService(
 			log.Named("piecestore:monitor"),
 			peer.Storage2.Store,
 			peer.Contact.Service,
 			peer.DB.Bandwidth(),
-			config.Storage.AllocatedDiskSpace.Int64(),
+			config.Storage.TotalAllocatedDiskSpace().Int64(),
 			// TODO: use config.Storage.Monitor.Interval, but for some reason is not set
 			config.Storage.KBucketRefreshInterval,
 			peer.Contact.Chore.Trigger,
@@ -492,6 +531,7 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 		peer.Storage2.RetainService = retain.NewService(
 			peer.Log.Named("retain"),
 			peer.Storage2.Store,
+			peer.DB.Retain(),
 			config.Retain,
 		)
 		peer.Services.Add(lifecycle.Item{
@@ -634,13 +674,29 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 		)
 	}
 
+	peer.Scrubber = scrubber.NewService(
+		peer.Log.Named("scrubber"),
+		peer.Storage2.Store,
+		peer.Storage2.Trust,
+		peer.DB.Scrubber(),
+		peer.Notifications.Service,
+		config.Scrubber,
+	)
+	peer.Services.Add(lifecycle.Item{
+		Name:  "scrubber",
+		Run:   peer.Scrubber.Run,
+		Close: peer.Scrubber.Close,
+	})
+	peer.Debug.Server.Panel.Add(
+		debug.Cycle("Scrubber", peer.Scrubber.Loop))
+
 	{ // setup storage node operator dashboard
 		peer.Console.Service, err = console.NewService(
 			peer.Log.Named("console:service"),
 			peer.DB.Bandwidth(),
 			peer.Storage2.Store,
 			peer.Version.Service,
-			config.Storage.AllocatedDiskSpace,
+			config.Storage.TotalAllocatedDiskSpace(),
 			config.Operator.Wallet,
 			versionInfo,
 			peer.Storage2.Trust,
@@ -675,6 +731,8 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 			peer.Notifications.Service,
 			peer.Console.Service,
 			peer.Payout.Service,
+			peer.Scrubber,
+			peer.Storage2.RetainService,
 			peer.Console.Listener,
 		)
 		peer.Services.Add(lifecycle.Item{
@@ -813,6 +871,12 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 			peer.Payout.Service,
 		)
 
+		peer.Multinode.Retain = multinode.NewRetainEndpoint(
+			peer.Log.Named("multinode:retain-endpoint"),
+			apiKeys,
+			peer.DB.Retain(),
+		)
+
 		if err = multinodepb.DRPCRegisterStorage(peer.Server.DRPC(), peer.Multinode.Storage); err != nil {
 			return nil, errs.Combine(err, peer.Close())
 		}
@@ -828,6 +892,9 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 		if err = multinodepb.DRPCRegisterPayouts(peer.Server.DRPC(), peer.Multinode.Payout); err != nil {
 			return nil, errs.Combine(err, peer.Close())
 		}
+		if err = multinodepb.DRPCRegisterRetain(peer.Server.DRPC(), peer.Multinode.Retain); err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
 	}
 
 	return peer, nil
@@ -123,6 +123,14 @@ type PieceSpaceUsedDB interface {
 	UpdateTrashTotal(ctx context.Context, newTotal int64) error
 }
 
+// CorruptedPiecesDB stores the pieces whose content was found to be corrupted.
+//
+// architecture: Database
+type CorruptedPiecesDB interface {
+	// DeleteCorrupted removes a corrupted piece, e.g. when it has been deleted from the node.
+	DeleteCorrupted(ctx context.Context, satelliteID storj.NodeID, pieceID storj.PieceID) error
+}
+
 // StoredPieceAccess allows inspection and manipulation of a piece during iteration with
 // WalkSatellitePieces-type methods.
 type StoredPieceAccess interface {
@@ -174,6 +182,7 @@ type Store struct {
 	v0PieceInfo    V0PieceInfoDB
 	expirationInfo PieceExpirationDB
 	spaceUsedDB    PieceSpaceUsedDB
+	corruptedInfo  CorruptedPiecesDB
 }
 
 // StoreForTest is a wrapper around Store to be used only in test scenarios. It enables writing
@@ -196,6 +205,12 @@ func NewStore(log *zap.Logger, blobs storage.Blobs, v0PieceInfo V0PieceInfoDB,
 	}
 }
 
+// SetCorruptedPiecesDB sets the database of corrupted pieces, which records are
+// removed when the pieces are deleted or trashed.
+func (store *Store) SetCorruptedPiecesDB(corruptedInfo CorruptedPiecesDB) {
+	store.corruptedInfo = corruptedInfo
+}
+
 // CreateVerificationFile creates a file to be used for storage directory verification.
 func (store *Store) CreateVerificationFile(ctx context.Context, id storj.NodeID) error {
 	return store.blobs.CreateVerificationFile(ctx, id)
@@ -312,6 +327,9 @@ func (store *Store) Delete(ctx context.Context, satellite storj.NodeID, pieceID
 	if store.v0PieceInfo != nil {
 		err = errs.Combine(err, store.v0PieceInfo.Delete(ctx, satellite, pieceID))
 	}
+	if store.corruptedInfo != nil {
+		err = errs.Combine(err, store.corruptedInfo.DeleteCorrupted(ctx, satellite, pieceID))
+	}
 
 	store.log.Debug("deleted piece", zap.String("Satellite ID", satellite.String()),
 		zap.String("Piece ID", pieceID.String()))
@@ -356,6 +374,9 @@ func (store *Store) Trash(ctx context.Context, satellite storj.NodeID, pieceID s
 		Namespace: satellite.Bytes(),
 		Key:       pieceID.Bytes(),
 	}))
+	if store.corruptedInfo != nil {
+		err = errs.Combine(err, store.corruptedInfo.DeleteCorrupted(ctx, satellite, pieceID))
+	}
 
 	return Error.Wrap(err)
 }
@@ -712,6 +733,24 @@ func (store *Store) CheckWritability(ctx context.Context) error {
 	return store.blobs.CheckWritability(ctx)
 }
 
+// StorageDirs returns the status of the storage directories when the pieces are
+// stored in multiple directories. It returns false when there's a single directory.
+func (store *Store) StorageDirs(ctx context.Context) (_ []filestore.DirStatus, multiple bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	blobs := store.blobs
+	if cache, ok := blobs.(*BlobsUsageCache); ok {
+		blobs = cache.Blobs
+	}
+	multi, ok := blobs.(*filestore.MultiStore)
+	if !ok {
+		return nil, false, nil
+	}
+
+	dirs, err := multi.Dirs(ctx)
+	return dirs, true, Error.Wrap(err)
+}
+
 type storedPieceAccess struct {
 	storage.BlobInfo
 	store   *Store
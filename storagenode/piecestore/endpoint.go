@@ -27,6 +27,7 @@ import (
 	"storj.io/common/signing"
 	"storj.io/common/storj"
 	"storj.io/common/sync2"
+	"storj.io/storj/storage/filestore"
 	"storj.io/storj/storagenode/bandwidth"
 	"storj.io/storj/storagenode/monitor"
 	"storj.io/storj/storagenode/orders"
@@ -48,6 +49,13 @@ type OldConfig struct {
 	AllocatedDiskSpace     memory.Size    `user:"true" help:"total allocated disk space in bytes" default:"1TB"`
 	AllocatedBandwidth     memory.Size    `user:"true" help:"total allocated bandwidth in bytes (deprecated)" default:"0B"`
 	KBucketRefreshInterval time.Duration  `help:"how frequently Kademlia bucket should be refreshed with node stats" default:"1h0m0s"`
+
+	AdditionalDirs filestore.DirsConfig `user:"true" help:"comma-separated list of additional directories to store data in, in the format path=allocated-disk-space; use path=drain to move the data out of a directory" default:""`
+}
+
+// TotalAllocatedDiskSpace returns the disk space allocated in the storage path and the additional directories.
+func (config *OldConfig) TotalAllocatedDiskSpace() memory.Size {
+	return config.AllocatedDiskSpace + config.AdditionalDirs.Allocated()
 }
 
 // Config defines parameters for piecestore endpoint.
@@ -254,7 +254,8 @@ func TestServiceStore(t *testing.T) {
 		require.NoError(t, err)
 		amount, err = notificationsDB.UnreadAmount(ctx)
 		require.NoError(t, err)
-		require.Equal(t, amount, 3)
+		// both suspension and reinstatement notifications are received.
+		require.Equal(t, amount, 4)
 
 		later = later.AddDate(0, 1, 0)
 
@@ -267,7 +268,7 @@ func TestServiceStore(t *testing.T) {
 		require.NoError(t, err)
 		amount, err = notificationsDB.UnreadAmount(ctx)
 		require.NoError(t, err)
-		require.Equal(t, amount, 4)
+		require.Equal(t, amount, 5)
 
 		statsNew = reputation.Stats{
 			SatelliteID:        id,
@@ -278,7 +279,7 @@ func TestServiceStore(t *testing.T) {
 		require.NoError(t, err)
 		amount, err = notificationsDB.UnreadAmount(ctx)
 		require.NoError(t, err)
-		require.Equal(t, amount, 4)
+		require.Equal(t, amount, 5)
 
 		id2 := testrand.NodeID()
 
@@ -291,6 +292,6 @@ func TestServiceStore(t *testing.T) {
 		require.NoError(t, err)
 		amount, err = notificationsDB.UnreadAmount(ctx)
 		require.NoError(t, err)
-		require.Equal(t, amount, 5)
+		require.Equal(t, amount, 6)
 	})
 }
@@ -55,9 +55,23 @@ func (s *Service) Store(ctx context.Context, stats Stats, satelliteID storj.Node
 		}
 	}
 
+	if isReinstated(stats, *rep) {
+		notification := newReinstatementNotification(satelliteID, s.nodeID)
+
+		_, err = s.notifications.Receive(ctx, notification)
+		if err != nil {
+			s.log.Sugar().Errorf("Failed to receive notification", err.Error())
+		}
+	}
+
 	return nil
 }
 
+// isReinstated returns if the node was disqualified before and isn't anymore.
+func isReinstated(new, old Stats) bool {
+	return old.DisqualifiedAt != nil && new.DisqualifiedAt == nil
+}
+
 // isSuspended returns if there's new downtime suspension.
 func isSuspended(new, old Stats) bool {
 	if new.OfflineSuspendedAt == nil {
@@ -84,3 +98,13 @@ func newSuspensionNotification(satelliteID storj.NodeID, senderID storj.NodeID,
 		Message:  "This is a reminder that your StorageNode on " + satelliteID.String() + "Satellite is suspended",
 	}
 }
+
+// newReinstatementNotification - returns reinstatement notification.
+func newReinstatementNotification(satelliteID storj.NodeID, senderID storj.NodeID) (_ notifications.NewNotification) {
+	return notifications.NewNotification{
+		SenderID: senderID,
+		Type:     notifications.TypeCustom,
+		Title:    "Your Node was reinstated",
+		Message:  "Your StorageNode on " + satelliteID.String() + " Satellite is no longer disqualified",
+	}
+}
@@ -0,0 +1,54 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package retain
+
+import (
+	"context"
+	"time"
+
+	"storj.io/common/storj"
+)
+
+// DB works with the retain reports database.
+//
+// architecture: Database
+type DB interface {
+	// SaveReport stores the report of a retain request. A report of an earlier
+	// request of the satellite with the same creation date is replaced.
+	SaveReport(ctx context.Context, report Report) error
+	// ListReports returns the stored reports, the newest first.
+	ListReports(ctx context.Context) ([]Report, error)
+	// ListSatelliteReports returns the stored reports of a satellite, the newest first.
+	ListSatelliteReports(ctx context.Context, satelliteID storj.NodeID) ([]Report, error)
+	// DeleteReportsBefore removes the reports of the requests processed before the given time.
+	DeleteReportsBefore(ctx context.Context, before time.Time) error
+}
+
+// Report describes the outcome of a retain request.
+type Report struct {
+	SatelliteID storj.NodeID `json:"satelliteId"`
+	// CreatedBefore is the creation date of the retain request, pieces created
+	// after it (minus MaxTimeSkew) are kept regardless of the filter.
+	CreatedBefore time.Time `json:"createdBefore"`
+	// FilterSize is the size of the bloom filter in bytes.
+	FilterSize int64 `json:"filterSize"`
+	// DryRun is set when the pieces weren't trashed, because retain runs in debug mode.
+	DryRun bool `json:"dryRun"`
+
+	// PiecesCount is the number of pieces of the satellite which were checked.
+	PiecesCount int64 `json:"piecesCount"`
+	// PiecesSkipped is the number of pieces whose modification time couldn't be read.
+	PiecesSkipped int64 `json:"piecesSkipped"`
+	// PiecesToTrash is the number of pieces which are not in the filter.
+	PiecesToTrash int64 `json:"piecesToTrash"`
+	// BytesToTrash is the content size of the pieces which are not in the filter.
+	BytesToTrash int64 `json:"bytesToTrash"`
+	// PiecesTrashed is the number of pieces which were moved to the trash.
+	PiecesTrashed int64 `json:"piecesTrashed"`
+	// SamplePieceIDs is a random sample of the pieces which are not in the filter.
+	SamplePieceIDs []storj.PieceID `json:"samplePieceIds"`
+
+	StartedAt  time.Time `json:"startedAt"`
+	FinishedAt time.Time `json:"finishedAt"`
+}
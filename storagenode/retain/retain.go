@@ -5,6 +5,7 @@ package retain
 
 import (
 	"context"
+	"math/rand"
 	"runtime"
 	"sync"
 	"time"
@@ -31,6 +32,9 @@ type Config struct {
 	MaxTimeSkew time.Duration `help:"allows for small differences in the satellite and storagenode clocks" default:"72h0m0s"`
 	Status      Status        `help:"allows configuration to enable, disable, or test retain requests from the satellite. Options: (disabled/enabled/debug)" default:"enabled"`
 	Concurrency int           `help:"how many concurrent retain requests can be processed at the same time." default:"5"`
+
+	ReportSamples   int           `help:"how many of the piece ids to be trashed are included in a retain report" default:"20"`
+	ReportRetention time.Duration `help:"how long the retain reports are kept" default:"720h0m0s"`
 }
 
 // Request contains all the info necessary to process a retain request.
@@ -100,11 +104,14 @@ type Service struct {
 	closed     chan struct{}
 	started    bool
 
-	store *pieces.Store
+	store   *pieces.Store
+	reports DB
+
+	nowFn func() time.Time
 }
 
 // NewService creates a new retain service.
-func NewService(log *zap.Logger, store *pieces.Store, config Config) *Service {
+func NewService(log *zap.Logger, store *pieces.Store, reports DB, config Config) *Service {
 	return &Service{
 		log:    log,
 		config: config,
@@ -114,7 +121,10 @@ func NewService(log *zap.Logger, store *pieces.Store, config Config) *Service {
 		working: make(map[storj.NodeID]struct{}),
 		closed:  make(chan struct{}),
 
-		store: store,
+		store:   store,
+		reports: reports,
+
+		nowFn: time.Now,
 	}
 }
 
@@ -297,6 +307,19 @@ func (s *Service) Status() Status {
 	return s.config.Status
 }
 
+// Reports returns the reports of the processed retain requests, the newest first.
+func (s *Service) Reports(ctx context.Context) (_ []Report, err error) {
+	defer mon.Task()(&ctx)(&err)
+	return s.reports.ListReports(ctx)
+}
+
+// SatelliteReports returns the reports of the processed retain requests of a satellite,
+// the newest first.
+func (s *Service) SatelliteReports(ctx context.Context, satelliteID storj.NodeID) (_ []Report, err error) {
+	defer mon.Task()(&ctx)(&err)
+	return s.reports.ListSatelliteReports(ctx, satelliteID)
+}
+
 // ------------------------------------------------------------------------------------------------
 // On the correctness of using access.ModTime() in place of the more precise access.CreationTime()
 // in retainPieces():
@@ -354,13 +377,15 @@ func (s *Service) retainPieces(ctx context.Context, req Request) (err error) {
 	var piecesCount int64
 	var piecesSkipped int64
 	var piecesToDeleteCount int64
+	var bytesToDelete int64
+	var samples []storj.PieceID
 	numDeleted := 0
 	satelliteID := req.SatelliteID
 	filter := req.Filter
 
 	// subtract some time to leave room for clock difference between the satellite and storage node
 	createdBefore := req.CreatedBefore.Add(-s.config.MaxTimeSkew)
-	started := time.Now().UTC()
+	started := s.nowFn().UTC()
 	filterHashCount, _ := req.Filter.Parameters()
 	mon.IntVal("garbage_collection_created_before").Observe(createdBefore.Unix())
 	mon.IntVal("garbage_collection_filter_hash_count").Observe(int64(filterHashCount))
@@ -400,6 +425,14 @@ func (s *Service) retainPieces(ctx context.Context, req Request) (err error) {
 				zap.String("Status", s.config.Status.String()))
 
 			piecesToDeleteCount++
+			samples = samplePiece(samples, s.config.ReportSamples, piecesToDeleteCount, pieceID)
+
+			_, contentSize, sizeErr := access.Size(ctx)
+			if sizeErr != nil {
+				s.log.Warn("failed to determine size of blob", zap.Stringer("Piece ID", pieceID), zap.Error(sizeErr))
+			} else {
+				bytesToDelete += contentSize
+			}
 
 			// if retain status is enabled, delete pieceid
 			if s.config.Status == Enabled {
@@ -432,7 +465,52 @@ func (s *Service) retainPieces(ctx context.Context, req Request) (err error) {
 	mon.DurationVal("garbage_collection_loop_duration").Observe(time.Now().UTC().Sub(started))
 	s.log.Debug("Moved pieces to trash during retain", zap.Int("num deleted", numDeleted), zap.String("Retain Status", s.config.Status.String()))
 
-	return nil
+	report := Report{
+		SatelliteID:    satelliteID,
+		CreatedBefore:  req.CreatedBefore,
+		FilterSize:     filter.Size(),
+		DryRun:         s.config.Status != Enabled,
+		PiecesCount:    piecesCount,
+		PiecesSkipped:  piecesSkipped,
+		PiecesToTrash:  piecesToDeleteCount,
+		BytesToTrash:   bytesToDelete,
+		SamplePieceIDs: samples,
+		StartedAt:      started,
+		FinishedAt:     s.nowFn().UTC(),
+	}
+	if report.DryRun {
+		s.log.Info("Retain request evaluated without trashing pieces",
+			zap.Stringer("Satellite ID", satelliteID),
+			zap.Time("Created Before", createdBefore),
+			zap.Int64("Pieces To Trash", report.PiecesToTrash),
+			zap.Int64("Bytes To Trash", report.BytesToTrash))
+	} else {
+		report.PiecesTrashed = int64(numDeleted)
+	}
+
+	return s.saveReport(ctx, report)
+}
+
+// saveReport stores the report of a retain request and removes the expired reports.
+func (s *Service) saveReport(ctx context.Context, report Report) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := s.reports.SaveReport(ctx, report); err != nil {
+		return Error.Wrap(err)
+	}
+	return Error.Wrap(s.reports.DeleteReportsBefore(ctx, report.FinishedAt.Add(-s.config.ReportRetention)))
+}
+
+// samplePiece adds the n-th piece to a random sample of at most size pieces
+// using reservoir sampling.
+func samplePiece(samples []storj.PieceID, size int, n int64, pieceID storj.PieceID) []storj.PieceID {
+	if len(samples) < size {
+		return append(samples, pieceID)
+	}
+	if i := rand.Int63n(n); i < int64(size) {
+		samples[i] = pieceID
+	}
+	return samples
 }
 
 // trash wraps retains piece deletion to monitor moving retained piece to trash error during garbage collection.
@@ -45,6 +45,9 @@ func TestRetainPieces(t *testing.T) {
 
 		pieceIDs := generateTestIDs(numPieces)
 
+		// content size of the pieces which should be deleted, as reported by the piece store
+		var oldPiecesSize int64
+
 		satellite0 := testidentity.MustPregeneratedSignedIdentity(0, storj.LatestIDVersion())
 		satellite1 := testidentity.MustPregeneratedSignedIdentity(2, storj.LatestIDVersion())
 
@@ -67,6 +70,15 @@ func TestRetainPieces(t *testing.T) {
 			}
 
 			const size = 100 * memory.B
+			const v0PieceSize = 4
+
+			if index >= numPiecesToKeep && index < numPiecesToKeep+numOldPieces {
+				if formatVer == filestore.FormatV0 {
+					oldPiecesSize += v0PieceSize
+				} else {
+					oldPiecesSize += size.Int64()
+				}
+			}
 
 			// Write file for all satellites
 			for _, satelliteID := range []storj.NodeID{satellite0.ID, satellite1.ID} {
@@ -93,7 +105,7 @@ func TestRetainPieces(t *testing.T) {
 					v0db := testStore.GetV0PieceInfoDBForTest()
 					err = v0db.Add(ctx, &pieces.Info{
 						SatelliteID:     satelliteID,
-						PieceSize:       4,
+						PieceSize:       v0PieceSize,
 						PieceID:         id,
 						PieceCreation:   now,
 						UplinkPieceHash: piecehash,
@@ -104,22 +116,31 @@ func TestRetainPieces(t *testing.T) {
 			}
 		}
 
-		retainEnabled := retain.NewService(zaptest.NewLogger(t), store, retain.Config{
+		retainEnabled := retain.NewService(zaptest.NewLogger(t), store, db.Retain(), retain.Config{
 			Status:      retain.Enabled,
 			Concurrency: 1,
 			MaxTimeSkew: 0,
+
+			ReportSamples:   numPieces,
+			ReportRetention: time.Hour,
 		})
 
-		retainDisabled := retain.NewService(zaptest.NewLogger(t), store, retain.Config{
+		retainDisabled := retain.NewService(zaptest.NewLogger(t), store, db.Retain(), retain.Config{
 			Status:      retain.Disabled,
 			Concurrency: 1,
 			MaxTimeSkew: 0,
+
+			ReportSamples:   numPieces,
+			ReportRetention: time.Hour,
 		})
 
-		retainDebug := retain.NewService(zaptest.NewLogger(t), store, retain.Config{
+		retainDebug := retain.NewService(zaptest.NewLogger(t), store, db.Retain(), retain.Config{
 			Status:      retain.Debug,
 			Concurrency: 1,
 			MaxTimeSkew: 0,
+
+			ReportSamples:   numPieces,
+			ReportRetention: time.Hour,
 		})
 
 		// start the retain services
@@ -159,6 +180,17 @@ func TestRetainPieces(t *testing.T) {
 		require.NoError(t, err)
 		require.Equal(t, numPieces, len(satellite0Pieces))
 
+		// expect that the debug endpoint reports the pieces it would trash
+		reports, err := retainDebug.SatelliteReports(ctx, satellite0.ID)
+		require.NoError(t, err)
+		require.Len(t, reports, 1)
+		require.True(t, reports[0].DryRun)
+		require.EqualValues(t, numPieces, reports[0].PiecesCount)
+		require.EqualValues(t, numOldPieces, reports[0].PiecesToTrash)
+		require.EqualValues(t, oldPiecesSize, reports[0].BytesToTrash)
+		require.Zero(t, reports[0].PiecesTrashed)
+		require.ElementsMatch(t, pieceIDs[numPiecesToKeep:numPiecesToKeep+numOldPieces], reports[0].SamplePieceIDs)
+
 		// expect that enabled endpoint deletes the correct pieces
 		queued = retainEnabled.Queue(req)
 		require.True(t, queued)
@@ -187,6 +219,14 @@ func TestRetainPieces(t *testing.T) {
 			require.NotContains(t, satellite0Pieces, id, "piece should have been deleted")
 		}
 
+		// the report of the enabled endpoint replaces the report of the same request
+		reports, err = retainEnabled.Reports(ctx)
+		require.NoError(t, err)
+		require.Len(t, reports, 1)
+		require.False(t, reports[0].DryRun)
+		require.EqualValues(t, numOldPieces, reports[0].PiecesToTrash)
+		require.EqualValues(t, numOldPieces, reports[0].PiecesTrashed)
+
 		// shut down retain services
 		cancel()
 		err = group.Wait()
@@ -0,0 +1,50 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package scrubber
+
+import (
+	"context"
+	"time"
+
+	"storj.io/common/storj"
+)
+
+// DB works with the scrubber database.
+//
+// architecture: Database
+type DB interface {
+	// GetProgress returns the scrubbing progress of a satellite. Zero value is returned
+	// when the satellite hasn't been scrubbed yet.
+	GetProgress(ctx context.Context, satelliteID storj.NodeID) (Progress, error)
+	// SetProgress stores the scrubbing progress of a satellite.
+	SetProgress(ctx context.Context, progress Progress) error
+	// AddCorrupted stores a corrupted piece. Pieces which are already stored are ignored.
+	AddCorrupted(ctx context.Context, piece CorruptedPiece) (added bool, err error)
+	// ListCorrupted returns all corrupted pieces, ordered by detection time.
+	ListCorrupted(ctx context.Context) ([]CorruptedPiece, error)
+	// DeleteCorrupted removes a corrupted piece, e.g. when it has been deleted from the node.
+	DeleteCorrupted(ctx context.Context, satelliteID storj.NodeID, pieceID storj.PieceID) error
+}
+
+// Progress contains the position of the scrubber in a pass over the pieces
+// of a satellite.
+type Progress struct {
+	SatelliteID storj.NodeID
+	// Cursor is the last checked piece. Pieces are checked in ascending order.
+	Cursor storj.PieceID
+	// PassStartedAt is when the current pass started.
+	PassStartedAt time.Time
+	// PassCompletedAt is when the last complete pass finished.
+	PassCompletedAt *time.Time
+	// PiecesChecked is the number of pieces checked in the current pass.
+	PiecesChecked int64
+}
+
+// CorruptedPiece is a piece whose content doesn't match its hash.
+type CorruptedPiece struct {
+	SatelliteID storj.NodeID  `json:"satelliteId"`
+	PieceID     storj.PieceID `json:"pieceId"`
+	Reason      string        `json:"reason"`
+	DetectedAt  time.Time     `json:"detectedAt"`
+}
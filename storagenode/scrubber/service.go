@@ -0,0 +1,364 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package scrubber implements verifying the integrity of pieces stored on the storage node.
+package scrubber
+
+import (
+	"bytes"
+	"container/heap"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	"golang.org/x/time/rate"
+
+	"storj.io/common/memory"
+	"storj.io/common/pkcrypto"
+	"storj.io/common/storj"
+	"storj.io/common/sync2"
+	"storj.io/storj/storage"
+	"storj.io/storj/storage/filestore"
+	"storj.io/storj/storagenode/notifications"
+	"storj.io/storj/storagenode/pieces"
+	"storj.io/storj/storagenode/trust"
+)
+
+var (
+	mon = monkit.Package()
+
+	// Error is the default error class for the scrubber.
+	Error = errs.Class("scrubber")
+)
+
+// Config defines parameters for the storage node scrubber.
+type Config struct {
+	Enabled   bool          `help:"whether the pieces are periodically checked for corruption" default:"false"`
+	Interval  time.Duration `help:"how long to wait between two complete scrubbing passes" default:"168h0m0s"`
+	ReadRate  memory.Size   `help:"maximum number of bytes read per second while scrubbing" default:"4MiB"`
+	BatchSize int           `help:"number of pieces checked before the progress is persisted" default:"1000"`
+}
+
+// maxReadChunk is the maximum number of bytes read at once.
+const maxReadChunk = 256 * memory.KiB
+
+// checkInterval is how often the scrubber checks whether a pass is due.
+const checkInterval = time.Hour
+
+// Service periodically reads the stored pieces and verifies that their
+// content matches the hash in the piece header.
+//
+// architecture: Chore
+type Service struct {
+	log           *zap.Logger
+	config        Config
+	store         *pieces.Store
+	trust         *trust.Pool
+	db            DB
+	notifications *notifications.Service
+	limiter       *rate.Limiter
+
+	Loop *sync2.Cycle
+}
+
+// NewService creates a new scrubber service.
+func NewService(log *zap.Logger, store *pieces.Store, trust *trust.Pool, db DB, notifications *notifications.Service, config Config) *Service {
+	burst := maxReadChunk.Int()
+	if config.ReadRate > 0 && config.ReadRate.Int() < burst {
+		burst = config.ReadRate.Int()
+	}
+	limit := rate.Inf
+	if config.ReadRate > 0 {
+		limit = rate.Limit(config.ReadRate.Int64())
+	}
+
+	loopInterval := config.Interval
+	if loopInterval > checkInterval {
+		loopInterval = checkInterval
+	}
+
+	return &Service{
+		log:           log,
+		config:        config,
+		store:         store,
+		trust:         trust,
+		db:            db,
+		notifications: notifications,
+		limiter:       rate.NewLimiter(limit, burst),
+		Loop:          sync2.NewCycle(loopInterval),
+	}
+}
+
+// Run runs the scrubber service.
+func (service *Service) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if !service.config.Enabled {
+		return nil
+	}
+
+	return service.Loop.Run(ctx, func(ctx context.Context) error {
+		for _, satellite := range service.trust.GetSatellites(ctx) {
+			err := service.Scrub(ctx, satellite)
+			if err != nil {
+				if errs.Is(err, context.Canceled) {
+					return err
+				}
+				service.log.Error("scrubbing failed", zap.Stringer("Satellite ID", satellite), zap.Error(err))
+			}
+		}
+		return nil
+	})
+}
+
+// Close stops the scrubber service.
+func (service *Service) Close() (err error) {
+	service.Loop.Close()
+	return nil
+}
+
+// Scrub continues the scrubbing pass over the pieces of the satellite from the
+// persisted progress until all pieces have been checked. A new pass is started
+// only when Interval has passed since the last one completed. The progress is
+// persisted after every BatchSize checked pieces.
+func (service *Service) Scrub(ctx context.Context, satellite storj.NodeID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	progress, err := service.db.GetProgress(ctx, satellite)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	progress.SatelliteID = satellite
+	if progress.PassStartedAt.IsZero() {
+		if progress.PassCompletedAt != nil && time.Now().Before(progress.PassCompletedAt.Add(service.config.Interval)) {
+			return nil
+		}
+		progress.PassStartedAt = time.Now().UTC()
+	}
+
+	batchSize := service.config.BatchSize
+	if batchSize <= 0 {
+		batchSize = 1000
+	}
+
+	var corrupted int
+	for {
+		// the pieces are checked in ascending order, so that the cursor allows
+		// continuing an interrupted pass.
+		batch, err := service.nextPieces(ctx, satellite, progress.Cursor, batchSize)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		if len(batch) == 0 {
+			break
+		}
+
+		for _, piece := range batch {
+			reason, err := service.verify(ctx, satellite, piece)
+			if err != nil {
+				if ctx.Err() != nil {
+					return ctx.Err()
+				}
+				service.log.Warn("unable to verify piece",
+					zap.Stringer("Satellite ID", satellite),
+					zap.Stringer("Piece ID", piece.id),
+					zap.Error(err))
+			}
+			if reason != "" {
+				added, err := service.db.AddCorrupted(ctx, CorruptedPiece{
+					SatelliteID: satellite,
+					PieceID:     piece.id,
+					Reason:      reason,
+					DetectedAt:  time.Now().UTC(),
+				})
+				if err != nil {
+					return Error.Wrap(err)
+				}
+				if added {
+					corrupted++
+					mon.Meter("scrubber_corrupted_pieces").Mark(1)
+					service.log.Error("corrupted piece detected",
+						zap.Stringer("Satellite ID", satellite),
+						zap.Stringer("Piece ID", piece.id),
+						zap.String("Reason", reason))
+				}
+			}
+
+			progress.Cursor = piece.id
+			progress.PiecesChecked++
+			mon.Meter("scrubber_checked_pieces").Mark(1)
+		}
+
+		if err := service.db.SetProgress(ctx, progress); err != nil {
+			return Error.Wrap(err)
+		}
+		if len(batch) < batchSize {
+			break
+		}
+	}
+
+	service.log.Info("scrubbing pass completed",
+		zap.Stringer("Satellite ID", satellite),
+		zap.Int64("Pieces Checked", progress.PiecesChecked),
+		zap.Int("Corrupted Pieces", corrupted))
+
+	completedAt := time.Now().UTC()
+	err = service.db.SetProgress(ctx, Progress{
+		SatelliteID:     satellite,
+		PassCompletedAt: &completedAt,
+	})
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	if corrupted > 0 && service.notifications != nil {
+		_, err = service.notifications.Receive(ctx, NewCorruptionNotification(satellite, corrupted))
+		if err != nil {
+			service.log.Error("unable to notify about corrupted pieces", zap.Error(err))
+		}
+	}
+	return nil
+}
+
+// CorruptedPieces returns the corrupted pieces found by the scrubber.
+func (service *Service) CorruptedPieces(ctx context.Context) (_ []CorruptedPiece, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	corrupted, err := service.db.ListCorrupted(ctx)
+	return corrupted, Error.Wrap(err)
+}
+
+// DismissCorrupted removes a corrupted piece from the list of found corrupted pieces.
+func (service *Service) DismissCorrupted(ctx context.Context, satelliteID storj.NodeID, pieceID storj.PieceID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return Error.Wrap(service.db.DeleteCorrupted(ctx, satelliteID, pieceID))
+}
+
+// storedPiece is a piece found while walking the pieces of a satellite.
+type storedPiece struct {
+	id     storj.PieceID
+	format storage.FormatVersion
+}
+
+// nextPieces returns at most limit pieces following the cursor in ascending order.
+// The pieces are not walked in order, so every call walks all pieces of the satellite
+// and keeps the smallest ones. Walking does not open the pieces, so it's cheap compared
+// to checking them.
+func (service *Service) nextPieces(ctx context.Context, satellite storj.NodeID, cursor storj.PieceID, limit int) (_ []storedPiece, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	next := make(largestFirst, 0, limit)
+	err = service.store.WalkSatellitePieces(ctx, satellite, func(access pieces.StoredPieceAccess) error {
+		id := access.PieceID()
+		if bytes.Compare(id[:], cursor[:]) <= 0 {
+			return nil
+		}
+		piece := storedPiece{id: id, format: access.StorageFormatVersion()}
+		switch {
+		case len(next) < limit:
+			heap.Push(&next, piece)
+		case bytes.Compare(id[:], next[0].id[:]) < 0:
+			next[0] = piece
+			heap.Fix(&next, 0)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(next, func(i, k int) bool {
+		return bytes.Compare(next[i].id[:], next[k].id[:]) < 0
+	})
+	return next, nil
+}
+
+// largestFirst is a heap of pieces with the largest piece ID on top.
+type largestFirst []storedPiece
+
+func (h largestFirst) Len() int { return len(h) }
+func (h largestFirst) Less(i, k int) bool {
+	return bytes.Compare(h[i].id[:], h[k].id[:]) > 0
+}
+func (h largestFirst) Swap(i, k int) { h[i], h[k] = h[k], h[i] }
+
+func (h *largestFirst) Push(x interface{}) { *h = append(*h, x.(storedPiece)) }
+func (h *largestFirst) Pop() interface{} {
+	old := *h
+	x := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return x
+}
+
+// verify checks the content of a piece against the hash in its header. It
+// returns a non-empty reason when the piece is corrupted.
+func (service *Service) verify(ctx context.Context, satellite storj.NodeID, piece storedPiece) (reason string, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	reader, err := service.store.ReaderWithStorageFormat(ctx, satellite, piece.id, piece.format)
+	if err != nil {
+		if errs.IsFunc(err, os.IsNotExist) {
+			// the piece was deleted after walking.
+			return "", nil
+		}
+		return "", err
+	}
+	defer func() { err = errs.Combine(err, reader.Close()) }()
+
+	hash, _, err := service.store.GetHashAndLimit(ctx, satellite, piece.id, reader)
+	if err != nil {
+		if piece.format < filestore.FormatV1 {
+			// V0 piece headers are stored in the database, which is not a sign of corruption.
+			return "", err
+		}
+		return fmt.Sprintf("invalid piece header: %v", err), nil
+	}
+
+	h := pkcrypto.NewHash()
+	_, err = io.Copy(h, &throttledReader{ctx: ctx, reader: reader, limiter: service.limiter})
+	if err != nil {
+		if ctx.Err() != nil {
+			return "", ctx.Err()
+		}
+		return fmt.Sprintf("unable to read piece content: %v", err), nil
+	}
+
+	if !bytes.Equal(h.Sum(nil), hash.Hash) {
+		return "piece content does not match the hash", nil
+	}
+	return "", nil
+}
+
+// throttledReader limits the read throughput of the wrapped reader.
+type throttledReader struct {
+	ctx     context.Context
+	reader  io.Reader
+	limiter *rate.Limiter
+}
+
+func (r *throttledReader) Read(p []byte) (int, error) {
+	if burst := r.limiter.Burst(); len(p) > burst {
+		p = p[:burst]
+	}
+	if err := r.limiter.WaitN(r.ctx, len(p)); err != nil {
+		return 0, err
+	}
+	return r.reader.Read(p)
+}
+
+// NewCorruptionNotification returns a notification about corrupted pieces of a satellite.
+func NewCorruptionNotification(satelliteID storj.NodeID, corrupted int) notifications.NewNotification {
+	return notifications.NewNotification{
+		SenderID: satelliteID,
+		Type:     notifications.TypeCustom,
+		Title:    fmt.Sprintf("%d corrupted pieces found", corrupted),
+		Message:  "The scrubber found pieces of satellite " + satelliteID.String() + " whose content is corrupted. This may be a sign of a failing disk.",
+	}
+}
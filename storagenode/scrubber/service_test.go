@@ -0,0 +1,177 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package scrubber_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/memory"
+	"storj.io/common/pb"
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/storagenode"
+	"storj.io/storj/storagenode/notifications"
+	"storj.io/storj/storagenode/pieces"
+	"storj.io/storj/storagenode/scrubber"
+	"storj.io/storj/storagenode/storagenodedb/storagenodedbtest"
+)
+
+func TestScrub(t *testing.T) {
+	storagenodedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db storagenode.DB) {
+		log := zaptest.NewLogger(t)
+		store := pieces.NewStore(log, db.Pieces(), db.V0PieceInfo(), db.PieceExpirationDB(), db.PieceSpaceUsedDB(), pieces.DefaultConfig)
+		store.SetCorruptedPiecesDB(db.Scrubber())
+		notificationService := notifications.NewService(log, db.Notifications())
+
+		satelliteID := testrand.NodeID()
+
+		writePiece := func(pieceID storj.PieceID, corrupt bool) {
+			writer, err := store.Writer(ctx, satelliteID, pieceID)
+			require.NoError(t, err)
+
+			_, err = writer.Write(testrand.BytesInt(10 * memory.KiB.Int()))
+			require.NoError(t, err)
+
+			hash := writer.Hash()
+			if corrupt {
+				hash = testrand.BytesInt(len(hash))
+			}
+			require.NoError(t, writer.Commit(ctx, &pb.PieceHeader{
+				Hash:         hash,
+				CreationTime: time.Now(),
+			}))
+		}
+
+		corrupted := testrand.PieceID()
+		writePiece(corrupted, true)
+		for i := 0; i < 4; i++ {
+			writePiece(testrand.PieceID(), false)
+		}
+
+		service := scrubber.NewService(log, store, nil, db.Scrubber(), notificationService, scrubber.Config{
+			Interval:  time.Hour,
+			ReadRate:  memory.MiB,
+			BatchSize: 2,
+		})
+
+		require.NoError(t, service.Scrub(ctx, satelliteID))
+
+		found, err := service.CorruptedPieces(ctx)
+		require.NoError(t, err)
+		require.Len(t, found, 1)
+		require.Equal(t, satelliteID, found[0].SatelliteID)
+		require.Equal(t, corrupted, found[0].PieceID)
+
+		progress, err := db.Scrubber().GetProgress(ctx, satelliteID)
+		require.NoError(t, err)
+		require.NotNil(t, progress.PassCompletedAt)
+		require.True(t, progress.Cursor.IsZero())
+
+		unread, err := notificationService.UnreadAmount(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 1, unread)
+
+		// the next pass is not due before the interval has passed.
+		corruptedLater := testrand.PieceID()
+		writePiece(corruptedLater, true)
+		require.NoError(t, service.Scrub(ctx, satelliteID))
+
+		found, err = service.CorruptedPieces(ctx)
+		require.NoError(t, err)
+		require.Len(t, found, 1)
+
+		// the next pass should not report the same piece again.
+		service = scrubber.NewService(log, store, nil, db.Scrubber(), notificationService, scrubber.Config{
+			Interval:  time.Nanosecond,
+			ReadRate:  memory.MiB,
+			BatchSize: 2,
+		})
+		require.NoError(t, service.Scrub(ctx, satelliteID))
+
+		found, err = service.CorruptedPieces(ctx)
+		require.NoError(t, err)
+		require.Len(t, found, 2)
+
+		unread, err = notificationService.UnreadAmount(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 2, unread)
+
+		// deleting or trashing a piece removes it from the corrupted pieces.
+		require.NoError(t, store.Delete(ctx, satelliteID, corrupted))
+		require.NoError(t, store.Trash(ctx, satelliteID, corruptedLater))
+		found, err = service.CorruptedPieces(ctx)
+		require.NoError(t, err)
+		require.Empty(t, found)
+	})
+}
+
+func TestDismissCorrupted(t *testing.T) {
+	storagenodedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db storagenode.DB) {
+		log := zaptest.NewLogger(t)
+		store := pieces.NewStore(log, db.Pieces(), db.V0PieceInfo(), db.PieceExpirationDB(), db.PieceSpaceUsedDB(), pieces.DefaultConfig)
+		service := scrubber.NewService(log, store, nil, db.Scrubber(), nil, scrubber.Config{})
+
+		satelliteID := testrand.NodeID()
+		pieceID := testrand.PieceID()
+		_, err := db.Scrubber().AddCorrupted(ctx, scrubber.CorruptedPiece{
+			SatelliteID: satelliteID,
+			PieceID:     pieceID,
+			Reason:      "piece content does not match the hash",
+			DetectedAt:  time.Now().UTC(),
+		})
+		require.NoError(t, err)
+
+		require.NoError(t, service.DismissCorrupted(ctx, satelliteID, pieceID))
+		found, err := service.CorruptedPieces(ctx)
+		require.NoError(t, err)
+		require.Empty(t, found)
+	})
+}
+
+func TestScrubResume(t *testing.T) {
+	storagenodedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db storagenode.DB) {
+		satelliteID := testrand.NodeID()
+		startedAt := time.Now().Add(-time.Hour).UTC().Truncate(time.Second)
+		cursor := testrand.PieceID()
+
+		progress := scrubber.Progress{
+			SatelliteID:   satelliteID,
+			Cursor:        cursor,
+			PassStartedAt: startedAt,
+			PiecesChecked: 10,
+		}
+		require.NoError(t, db.Scrubber().SetProgress(ctx, progress))
+
+		stored, err := db.Scrubber().GetProgress(ctx, satelliteID)
+		require.NoError(t, err)
+		require.Equal(t, cursor, stored.Cursor)
+		require.True(t, startedAt.Equal(stored.PassStartedAt))
+		require.Nil(t, stored.PassCompletedAt)
+		require.EqualValues(t, 10, stored.PiecesChecked)
+
+		completedAt := time.Now().UTC().Truncate(time.Second)
+		require.NoError(t, db.Scrubber().SetProgress(ctx, scrubber.Progress{
+			SatelliteID:     satelliteID,
+			PassCompletedAt: &completedAt,
+		}))
+
+		// the completion time is kept while the next pass is in progress.
+		require.NoError(t, db.Scrubber().SetProgress(ctx, scrubber.Progress{
+			SatelliteID:   satelliteID,
+			Cursor:        cursor,
+			PassStartedAt: time.Now(),
+			PiecesChecked: 1,
+		}))
+
+		stored, err = db.Scrubber().GetProgress(ctx, satelliteID)
+		require.NoError(t, err)
+		require.NotNil(t, stored.PassCompletedAt)
+		require.True(t, completedAt.Equal(*stored.PassCompletedAt))
+	})
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
 
+	"storj.io/common/memory"
 	"storj.io/private/dbutil"
 	"storj.io/private/dbutil/dbschema"
 	"storj.io/private/dbutil/sqliteutil"
@@ -25,6 +26,7 @@ import (
 	"storj.io/storj/private/migrate"
 	"storj.io/storj/storage"
 	"storj.io/storj/storage/filestore"
+	"storj.io/storj/storage/packstore"
 	"storj.io/storj/storagenode/apikeys"
 	"storj.io/storj/storagenode/bandwidth"
 	"storj.io/storj/storagenode/notifications"
@@ -33,7 +35,9 @@ import (
 	"storj.io/storj/storagenode/pieces"
 	"storj.io/storj/storagenode/pricing"
 	"storj.io/storj/storagenode/reputation"
+	"storj.io/storj/storagenode/retain"
 	"storj.io/storj/storagenode/satellites"
+	"storj.io/storj/storagenode/scrubber"
 	"storj.io/storj/storagenode/storageusage"
 )
 
@@ -83,6 +87,15 @@ type Config struct {
 	Driver    string // if unset, uses sqlite3
 	Pieces    string
 	Filestore filestore.Config
+
+	// PiecesAllocated is the disk space allocated in Pieces. It's only used
+	// when there are additional piece directories.
+	PiecesAllocated memory.Size
+	// AdditionalPieces are the additional directories to store pieces in.
+	AdditionalPieces []filestore.DirConfig
+
+	// Packstore configures storing the pieces packed into log files.
+	Packstore packstore.Config
 }
 
 // DB contains access to different database tables.
@@ -108,19 +121,19 @@ type DB struct {
 	payoutDB          *payoutDB
 	pricingDB         *pricingDB
 	apiKeysDB         *apiKeysDB
+	scrubberDB        *scrubberDB
+	retainDB          *retainDB
 
 	SQLDBs map[string]DBContainer
 }
 
 // OpenNew creates a new master database for storage node.
 func OpenNew(ctx context.Context, log *zap.Logger, config Config) (*DB, error) {
-	piecesDir, err := filestore.NewDir(log, config.Pieces)
+	pieces, err := openPieces(log, config, filestore.NewDir)
 	if err != nil {
 		return nil, err
 	}
 
-	pieces := filestore.New(log, piecesDir, config.Filestore)
-
 	deprecatedInfoDB := &deprecatedInfoDB{}
 	v0PieceInfoDB := &v0PieceInfoDB{}
 	bandwidthDB := &bandwidthDB{}
@@ -135,6 +148,8 @@ func OpenNew(ctx context.Context, log *zap.Logger, config Config) (*DB, error) {
 	payoutDB := &payoutDB{}
 	pricingDB := &pricingDB{}
 	apiKeysDB := &apiKeysDB{}
+	scrubberDB := &scrubberDB{}
+	retainDB := &retainDB{}
 
 	db := &DB{
 		log:    log,
@@ -158,6 +173,8 @@ func OpenNew(ctx context.Context, log *zap.Logger, config Config) (*DB, error) {
 		payoutDB:          payoutDB,
 		pricingDB:         pricingDB,
 		apiKeysDB:         apiKeysDB,
+		scrubberDB:        scrubberDB,
+		retainDB:          retainDB,
 
 		SQLDBs: map[string]DBContainer{
 			DeprecatedInfoDBName:  deprecatedInfoDB,
@@ -174,6 +191,8 @@ func OpenNew(ctx context.Context, log *zap.Logger, config Config) (*DB, error) {
 			HeldAmountDBName:      payoutDB,
 			PricingDBName:         pricingDB,
 			APIKeysDBName:         apiKeysDB,
+			ScrubberDBName:        scrubberDB,
+			RetainDBName:          retainDB,
 		},
 	}
 
@@ -182,13 +201,11 @@ func OpenNew(ctx context.Context, log *zap.Logger, config Config) (*DB, error) {
 
 // OpenExisting opens an existing master database for storage node.
 func OpenExisting(ctx context.Context, log *zap.Logger, config Config) (*DB, error) {
-	piecesDir, err := filestore.OpenDir(log, config.Pieces)
+	pieces, err := openPieces(log, config, filestore.OpenDir)
 	if err != nil {
 		return nil, err
 	}
 
-	pieces := filestore.New(log, piecesDir, config.Filestore)
-
 	deprecatedInfoDB := &deprecatedInfoDB{}
 	v0PieceInfoDB := &v0PieceInfoDB{}
 	bandwidthDB := &bandwidthDB{}
@@ -203,6 +220,8 @@ func OpenExisting(ctx context.Context, log *zap.Logger, config Config) (*DB, err
 	payoutDB := &payoutDB{}
 	pricingDB := &pricingDB{}
 	apiKeysDB := &apiKeysDB{}
+	scrubberDB := &scrubberDB{}
+	retainDB := &retainDB{}
 
 	db := &DB{
 		log:    log,
@@ -226,6 +245,8 @@ func OpenExisting(ctx context.Context, log *zap.Logger, config Config) (*DB, err
 		payoutDB:          payoutDB,
 		pricingDB:         pricingDB,
 		apiKeysDB:         apiKeysDB,
+		scrubberDB:        scrubberDB,
+		retainDB:          retainDB,
 
 		SQLDBs: map[string]DBContainer{
 			DeprecatedInfoDBName:  deprecatedInfoDB,
@@ -242,6 +263,8 @@ func OpenExisting(ctx context.Context, log *zap.Logger, config Config) (*DB, err
 			HeldAmountDBName:      payoutDB,
 			PricingDBName:         pricingDB,
 			APIKeysDBName:         apiKeysDB,
+			ScrubberDBName:        scrubberDB,
+			RetainDBName:          retainDB,
 		},
 	}
 
@@ -253,6 +276,27 @@ func OpenExisting(ctx context.Context, log *zap.Logger, config Config) (*DB, err
 	return db, nil
 }
 
+// openPieces opens the blob store of the pieces, which is the packed blob store
+// when it's enabled or spans multiple directories when there are additional piece
+// directories configured.
+func openPieces(log *zap.Logger, config Config, openDir func(*zap.Logger, string) (*filestore.Dir, error)) (storage.Blobs, error) {
+	if config.Packstore.Enabled {
+		if err := packstore.VerifyMigrated(config.Pieces); err != nil {
+			return nil, err
+		}
+		return packstore.Open(log.Named("packstore"), config.Pieces, config.Packstore)
+	}
+
+	piecesDir, err := openDir(log, config.Pieces)
+	if err != nil {
+		return nil, err
+	}
+	if len(config.AdditionalPieces) == 0 {
+		return filestore.New(log, piecesDir, config.Filestore), nil
+	}
+	return filestore.NewMulti(log, piecesDir, config.PiecesAllocated, config.AdditionalPieces, config.Filestore)
+}
+
 // openDatabases opens all the SQLite3 storage node databases and returns if any fails to open successfully.
 func (db *DB) openDatabases(ctx context.Context) error {
 	// These objects have a Configure method to allow setting the underlining SQLDB connection
@@ -275,6 +319,8 @@ func (db *DB) openDatabases(ctx context.Context) error {
 		HeldAmountDBName,
 		PricingDBName,
 		APIKeysDBName,
+		ScrubberDBName,
+		RetainDBName,
 	}
 
 	for _, dbName := range dbs {
@@ -457,7 +503,7 @@ func (db *DB) preflight(ctx context.Context, dbName string, dbContainer DBContai
 
 // Close closes any resources.
 func (db *DB) Close() error {
-	return db.closeDatabases()
+	return errs.Combine(db.closeDatabases(), db.pieces.Close())
 }
 
 // closeDatabases closes all the SQLite database connections and removes them from the associated maps.
@@ -554,6 +600,16 @@ func (db *DB) APIKeys() apikeys.DB {
 	return db.apiKeysDB
 }
 
+// Scrubber returns instance of the Scrubber database.
+func (db *DB) Scrubber() scrubber.DB {
+	return db.scrubberDB
+}
+
+// Retain returns instance of the Retain database.
+func (db *DB) Retain() retain.DB {
+	return db.retainDB
+}
+
 // RawDatabases are required for testing purposes.
 func (db *DB) RawDatabases() map[string]DBContainer {
 	return db.SQLDBs
@@ -2002,6 +2058,64 @@ func (db *DB) Migration(ctx context.Context) *migrate.Migration {
 					 UPDATE satellites SET address = 'satellite.stefan-benten.de:7777' WHERE node_id = X'004ae89e970e703df42ba4ab1416a3b30b7e1d8e14aa0e558f7ee26800000000'`,
 				},
 			},
+			{
+				DB:          &db.scrubberDB.DB,
+				Description: "Create scrub_progress and corrupted_pieces tables",
+				Version:     54,
+				CreateDB: func(ctx context.Context, log *zap.Logger) error {
+					if err := db.openDatabase(ctx, ScrubberDBName); err != nil {
+						return ErrDatabase.Wrap(err)
+					}
+
+					return nil
+				},
+				Action: migrate.SQL{
+					`CREATE TABLE scrub_progress (
+						satellite_id BLOB NOT NULL,
+						cursor BLOB NOT NULL,
+						pass_started_at TIMESTAMP NOT NULL,
+						pass_completed_at TIMESTAMP,
+						pieces_checked INTEGER NOT NULL,
+						PRIMARY KEY (satellite_id)
+					);`,
+					`CREATE TABLE corrupted_pieces (
+						satellite_id BLOB NOT NULL,
+						piece_id BLOB NOT NULL,
+						reason TEXT NOT NULL,
+						detected_at TIMESTAMP NOT NULL,
+						PRIMARY KEY (satellite_id, piece_id)
+					);`,
+				},
+			},
+			{
+				DB:          &db.retainDB.DB,
+				Description: "Create retain_reports table",
+				Version:     55,
+				CreateDB: func(ctx context.Context, log *zap.Logger) error {
+					if err := db.openDatabase(ctx, RetainDBName); err != nil {
+						return ErrDatabase.Wrap(err)
+					}
+
+					return nil
+				},
+				Action: migrate.SQL{
+					`CREATE TABLE retain_reports (
+						satellite_id BLOB NOT NULL,
+						created_before TIMESTAMP NOT NULL,
+						filter_size INTEGER NOT NULL,
+						dry_run INTEGER NOT NULL,
+						pieces_count INTEGER NOT NULL,
+						pieces_skipped INTEGER NOT NULL,
+						pieces_to_trash INTEGER NOT NULL,
+						bytes_to_trash INTEGER NOT NULL,
+						pieces_trashed INTEGER NOT NULL,
+						sample_piece_ids BLOB NOT NULL,
+						started_at TIMESTAMP NOT NULL,
+						finished_at TIMESTAMP NOT NULL,
+						PRIMARY KEY (satellite_id, created_before)
+					);`,
+				},
+			},
 		},
 	}
 }
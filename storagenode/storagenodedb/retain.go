@@ -0,0 +1,132 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package storagenodedb
+
+import (
+	"context"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/storj"
+	"storj.io/private/tagsql"
+	"storj.io/storj/storagenode/retain"
+)
+
+// ensures that retainDB implements retain.DB interface.
+var _ retain.DB = (*retainDB)(nil)
+
+// ErrRetainDB represents errors from the retain database.
+var ErrRetainDB = errs.Class("retaindb")
+
+// RetainDBName represents the database name.
+const RetainDBName = "retain"
+
+// retainDB works with the reports of the processed retain requests.
+//
+// architecture: Database
+type retainDB struct {
+	dbContainerImpl
+}
+
+// SaveReport stores the report of a retain request.
+func (db *retainDB) SaveReport(ctx context.Context, report retain.Report) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	samples := make([]byte, 0, len(report.SamplePieceIDs)*len(storj.PieceID{}))
+	for _, pieceID := range report.SamplePieceIDs {
+		samples = append(samples, pieceID.Bytes()...)
+	}
+
+	_, err = db.ExecContext(ctx, `
+		INSERT OR REPLACE INTO retain_reports (
+			satellite_id, created_before, filter_size, dry_run,
+			pieces_count, pieces_skipped, pieces_to_trash, bytes_to_trash, pieces_trashed,
+			sample_piece_ids, started_at, finished_at
+		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`, report.SatelliteID, report.CreatedBefore.UTC(), report.FilterSize, report.DryRun,
+		report.PiecesCount, report.PiecesSkipped, report.PiecesToTrash, report.BytesToTrash, report.PiecesTrashed,
+		samples, report.StartedAt.UTC(), report.FinishedAt.UTC())
+
+	return ErrRetainDB.Wrap(err)
+}
+
+// ListReports returns the stored reports, the newest first.
+func (db *retainDB) ListReports(ctx context.Context) (_ []retain.Report, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := db.QueryContext(ctx, `
+		SELECT satellite_id, created_before, filter_size, dry_run,
+			pieces_count, pieces_skipped, pieces_to_trash, bytes_to_trash, pieces_trashed,
+			sample_piece_ids, started_at, finished_at
+		FROM retain_reports
+		ORDER BY finished_at DESC, satellite_id
+	`)
+	if err != nil {
+		return nil, ErrRetainDB.Wrap(err)
+	}
+
+	return db.scanReports(rows)
+}
+
+// ListSatelliteReports returns the stored reports of a satellite, the newest first.
+func (db *retainDB) ListSatelliteReports(ctx context.Context, satelliteID storj.NodeID) (_ []retain.Report, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := db.QueryContext(ctx, `
+		SELECT satellite_id, created_before, filter_size, dry_run,
+			pieces_count, pieces_skipped, pieces_to_trash, bytes_to_trash, pieces_trashed,
+			sample_piece_ids, started_at, finished_at
+		FROM retain_reports
+		WHERE satellite_id = ?
+		ORDER BY finished_at DESC
+	`, satelliteID)
+	if err != nil {
+		return nil, ErrRetainDB.Wrap(err)
+	}
+
+	return db.scanReports(rows)
+}
+
+// DeleteReportsBefore removes the reports of the requests processed before the given time.
+func (db *retainDB) DeleteReportsBefore(ctx context.Context, before time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = db.ExecContext(ctx, `
+		DELETE FROM retain_reports
+		WHERE finished_at < ?
+	`, before.UTC())
+
+	return ErrRetainDB.Wrap(err)
+}
+
+// scanReports reads the reports from the rows and closes them.
+func (db *retainDB) scanReports(rows tagsql.Rows) (_ []retain.Report, err error) {
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var reports []retain.Report
+	for rows.Next() {
+		var report retain.Report
+		var samples []byte
+		err := rows.Scan(&report.SatelliteID, &report.CreatedBefore, &report.FilterSize, &report.DryRun,
+			&report.PiecesCount, &report.PiecesSkipped, &report.PiecesToTrash, &report.BytesToTrash, &report.PiecesTrashed,
+			&samples, &report.StartedAt, &report.FinishedAt)
+		if err != nil {
+			return nil, ErrRetainDB.Wrap(err)
+		}
+
+		for len(samples) >= len(storj.PieceID{}) {
+			pieceID, err := storj.PieceIDFromBytes(samples[:len(storj.PieceID{})])
+			if err != nil {
+				return nil, ErrRetainDB.Wrap(err)
+			}
+			report.SamplePieceIDs = append(report.SamplePieceIDs, pieceID)
+			samples = samples[len(storj.PieceID{}):]
+		}
+
+		reports = append(reports, report)
+	}
+
+	return reports, ErrRetainDB.Wrap(rows.Err())
+}
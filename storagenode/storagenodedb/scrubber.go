@@ -0,0 +1,132 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package storagenodedb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/storj"
+	"storj.io/storj/storagenode/scrubber"
+)
+
+// ensures that scrubberDB implements scrubber.DB interface.
+var _ scrubber.DB = (*scrubberDB)(nil)
+
+// ErrScrubberDB represents errors from the scrubber database.
+var ErrScrubberDB = errs.Class("scrubberdb")
+
+// ScrubberDBName represents the database name.
+const ScrubberDBName = "scrubber"
+
+// scrubberDB works with the scrubber progress and the found corrupted pieces.
+//
+// architecture: Database
+type scrubberDB struct {
+	dbContainerImpl
+}
+
+// GetProgress returns the scrubbing progress of a satellite.
+func (db *scrubberDB) GetProgress(ctx context.Context, satelliteID storj.NodeID) (progress scrubber.Progress, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	progress.SatelliteID = satelliteID
+
+	row := db.QueryRowContext(ctx, `
+		SELECT cursor, pass_started_at, pass_completed_at, pieces_checked
+		FROM scrub_progress
+		WHERE satellite_id = ?
+	`, satelliteID)
+
+	err = row.Scan(&progress.Cursor, &progress.PassStartedAt, &progress.PassCompletedAt, &progress.PiecesChecked)
+	if errors.Is(err, sql.ErrNoRows) {
+		return progress, nil
+	}
+	if err != nil {
+		return scrubber.Progress{}, ErrScrubberDB.Wrap(err)
+	}
+
+	return progress, nil
+}
+
+// SetProgress stores the scrubbing progress of a satellite. The completion time
+// of the last pass is kept when the progress doesn't contain one.
+func (db *scrubberDB) SetProgress(ctx context.Context, progress scrubber.Progress) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = db.ExecContext(ctx, `
+		INSERT INTO scrub_progress (satellite_id, cursor, pass_started_at, pass_completed_at, pieces_checked)
+		VALUES (?, ?, ?, ?, ?)
+		ON CONFLICT (satellite_id) DO UPDATE SET
+			cursor = excluded.cursor,
+			pass_started_at = excluded.pass_started_at,
+			pass_completed_at = COALESCE(excluded.pass_completed_at, scrub_progress.pass_completed_at),
+			pieces_checked = excluded.pieces_checked
+	`, progress.SatelliteID, progress.Cursor, progress.PassStartedAt.UTC(), progress.PassCompletedAt, progress.PiecesChecked)
+
+	return ErrScrubberDB.Wrap(err)
+}
+
+// AddCorrupted stores a corrupted piece. Pieces which are already stored are ignored.
+func (db *scrubberDB) AddCorrupted(ctx context.Context, piece scrubber.CorruptedPiece) (added bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	result, err := db.ExecContext(ctx, `
+		INSERT INTO corrupted_pieces (satellite_id, piece_id, reason, detected_at)
+		VALUES (?, ?, ?, ?)
+		ON CONFLICT (satellite_id, piece_id) DO NOTHING
+	`, piece.SatelliteID, piece.PieceID, piece.Reason, piece.DetectedAt.UTC())
+	if err != nil {
+		return false, ErrScrubberDB.Wrap(err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, ErrScrubberDB.Wrap(err)
+	}
+
+	return affected > 0, nil
+}
+
+// ListCorrupted returns all corrupted pieces, ordered by detection time.
+func (db *scrubberDB) ListCorrupted(ctx context.Context) (_ []scrubber.CorruptedPiece, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := db.QueryContext(ctx, `
+		SELECT satellite_id, piece_id, reason, detected_at
+		FROM corrupted_pieces
+		ORDER BY detected_at, satellite_id, piece_id
+	`)
+	if err != nil {
+		return nil, ErrScrubberDB.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var corrupted []scrubber.CorruptedPiece
+	for rows.Next() {
+		var piece scrubber.CorruptedPiece
+		err := rows.Scan(&piece.SatelliteID, &piece.PieceID, &piece.Reason, &piece.DetectedAt)
+		if err != nil {
+			return nil, ErrScrubberDB.Wrap(err)
+		}
+		corrupted = append(corrupted, piece)
+	}
+
+	return corrupted, ErrScrubberDB.Wrap(rows.Err())
+}
+
+// DeleteCorrupted removes a corrupted piece.
+func (db *scrubberDB) DeleteCorrupted(ctx context.Context, satelliteID storj.NodeID, pieceID storj.PieceID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = db.ExecContext(ctx, `
+		DELETE FROM corrupted_pieces
+		WHERE satellite_id = ? AND piece_id = ?
+	`, satelliteID, pieceID)
+
+	return ErrScrubberDB.Wrap(err)
+}
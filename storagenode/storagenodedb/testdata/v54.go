@@ -0,0 +1,47 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package testdata
+
+import "storj.io/storj/storagenode/storagenodedb"
+
+var v54 = MultiDBState{
+	Version: 54,
+	DBStates: DBStates{
+		storagenodedb.UsedSerialsDBName:     v53.DBStates[storagenodedb.UsedSerialsDBName],
+		storagenodedb.StorageUsageDBName:    v53.DBStates[storagenodedb.StorageUsageDBName],
+		storagenodedb.ReputationDBName:      v53.DBStates[storagenodedb.ReputationDBName],
+		storagenodedb.PieceSpaceUsedDBName:  v53.DBStates[storagenodedb.PieceSpaceUsedDBName],
+		storagenodedb.PieceInfoDBName:       v53.DBStates[storagenodedb.PieceInfoDBName],
+		storagenodedb.PieceExpirationDBName: v53.DBStates[storagenodedb.PieceExpirationDBName],
+		storagenodedb.OrdersDBName:          v53.DBStates[storagenodedb.OrdersDBName],
+		storagenodedb.BandwidthDBName:       v53.DBStates[storagenodedb.BandwidthDBName],
+		storagenodedb.SatellitesDBName:      v53.DBStates[storagenodedb.SatellitesDBName],
+		storagenodedb.DeprecatedInfoDBName:  v53.DBStates[storagenodedb.DeprecatedInfoDBName],
+		storagenodedb.NotificationsDBName:   v53.DBStates[storagenodedb.NotificationsDBName],
+		storagenodedb.HeldAmountDBName:      v53.DBStates[storagenodedb.HeldAmountDBName],
+		storagenodedb.PricingDBName:         v53.DBStates[storagenodedb.PricingDBName],
+		storagenodedb.APIKeysDBName:         v53.DBStates[storagenodedb.APIKeysDBName],
+		storagenodedb.ScrubberDBName: &DBState{
+			SQL: `
+				-- table to store the scrubbing progress
+				CREATE TABLE scrub_progress (
+					satellite_id BLOB NOT NULL,
+					cursor BLOB NOT NULL,
+					pass_started_at TIMESTAMP NOT NULL,
+					pass_completed_at TIMESTAMP,
+					pieces_checked INTEGER NOT NULL,
+					PRIMARY KEY (satellite_id)
+				);
+				-- table to store pieces found to be corrupted
+				CREATE TABLE corrupted_pieces (
+					satellite_id BLOB NOT NULL,
+					piece_id BLOB NOT NULL,
+					reason TEXT NOT NULL,
+					detected_at TIMESTAMP NOT NULL,
+					PRIMARY KEY (satellite_id, piece_id)
+				);
+			`,
+		},
+	},
+}
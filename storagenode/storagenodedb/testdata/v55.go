@@ -0,0 +1,47 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package testdata
+
+import "storj.io/storj/storagenode/storagenodedb"
+
+var v55 = MultiDBState{
+	Version: 55,
+	DBStates: DBStates{
+		storagenodedb.UsedSerialsDBName:     v54.DBStates[storagenodedb.UsedSerialsDBName],
+		storagenodedb.StorageUsageDBName:    v54.DBStates[storagenodedb.StorageUsageDBName],
+		storagenodedb.ReputationDBName:      v54.DBStates[storagenodedb.ReputationDBName],
+		storagenodedb.PieceSpaceUsedDBName:  v54.DBStates[storagenodedb.PieceSpaceUsedDBName],
+		storagenodedb.PieceInfoDBName:       v54.DBStates[storagenodedb.PieceInfoDBName],
+		storagenodedb.PieceExpirationDBName: v54.DBStates[storagenodedb.PieceExpirationDBName],
+		storagenodedb.OrdersDBName:          v54.DBStates[storagenodedb.OrdersDBName],
+		storagenodedb.BandwidthDBName:       v54.DBStates[storagenodedb.BandwidthDBName],
+		storagenodedb.SatellitesDBName:      v54.DBStates[storagenodedb.SatellitesDBName],
+		storagenodedb.DeprecatedInfoDBName:  v54.DBStates[storagenodedb.DeprecatedInfoDBName],
+		storagenodedb.NotificationsDBName:   v54.DBStates[storagenodedb.NotificationsDBName],
+		storagenodedb.HeldAmountDBName:      v54.DBStates[storagenodedb.HeldAmountDBName],
+		storagenodedb.PricingDBName:         v54.DBStates[storagenodedb.PricingDBName],
+		storagenodedb.APIKeysDBName:         v54.DBStates[storagenodedb.APIKeysDBName],
+		storagenodedb.ScrubberDBName:        v54.DBStates[storagenodedb.ScrubberDBName],
+		storagenodedb.RetainDBName: &DBState{
+			SQL: `
+				-- table to store the reports of the processed retain requests
+				CREATE TABLE retain_reports (
+					satellite_id BLOB NOT NULL,
+					created_before TIMESTAMP NOT NULL,
+					filter_size INTEGER NOT NULL,
+					dry_run INTEGER NOT NULL,
+					pieces_count INTEGER NOT NULL,
+					pieces_skipped INTEGER NOT NULL,
+					pieces_to_trash INTEGER NOT NULL,
+					bytes_to_trash INTEGER NOT NULL,
+					pieces_trashed INTEGER NOT NULL,
+					sample_piece_ids BLOB NOT NULL,
+					started_at TIMESTAMP NOT NULL,
+					finished_at TIMESTAMP NOT NULL,
+					PRIMARY KEY (satellite_id, created_before)
+				);
+			`,
+		},
+	},
+}